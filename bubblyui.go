@@ -42,7 +42,11 @@
 //	import "github.com/newbpydev/bubblyui/pkg/components"          // UI components
 package bubblyui
 
-import "github.com/newbpydev/bubblyui/pkg/bubbly"
+import (
+	"context"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
 
 // =============================================================================
 // Core Types - Re-exported for convenient access
@@ -142,8 +146,76 @@ func NewRef[T any](value T) *Ref[T] {
 //	total := bubblyui.NewComputed(func() int {
 //	    return price.Get() * quantity.Get()
 //	})
-func NewComputed[T any](fn func() T) *Computed[T] {
-	return bubbly.NewComputed(fn)
+func NewComputed[T any](fn func() T, opts ...ComputedOption) *Computed[T] {
+	return bubbly.NewComputed(fn, opts...)
+}
+
+// ComputedOption configures a Computed value created with NewComputed.
+type ComputedOption = bubbly.ComputedOption
+
+// WithRecomputeDebounce delays a Computed's recomputation until its
+// dependencies stop changing, serving the stale value in the meantime.
+//
+// Example:
+//
+//	filtered := bubblyui.NewComputed(filterItems, bubblyui.WithRecomputeDebounce(150*time.Millisecond))
+var WithRecomputeDebounce = bubbly.WithRecomputeDebounce
+
+// Select derives a Computed from one part of a Ref's value that only
+// notifies dependents and watchers when that part changes.
+//
+// Example:
+//
+//	user := bubblyui.Select(state, func(s AppState) string { return s.User })
+func Select[T any, F comparable](source *Ref[T], selector func(T) F) *Computed[F] {
+	return bubbly.Select(source, selector)
+}
+
+// RingRef is a reactive fixed-capacity circular buffer that drops its oldest
+// values when full, for rolling logs and metric history.
+type RingRef[T any] = bubbly.RingRef[T]
+
+// NewRingRef creates an empty RingRef holding at most capacity values.
+//
+// Example:
+//
+//	logs := bubblyui.NewRingRef[string](100)
+//	logs.Push("server started")
+func NewRingRef[T any](capacity int) *RingRef[T] {
+	return bubbly.NewRingRef[T](capacity)
+}
+
+// AsyncComputedValue holds the Data/Loading/Error state of an AsyncComputed.
+type AsyncComputedValue[T any] = bubbly.AsyncComputedValue[T]
+
+// AsyncComputed derives a value with asynchronous work, re-running it (and
+// cancelling the previous run) whenever one of its dependencies changes.
+//
+// Example:
+//
+//	details := bubblyui.AsyncComputed(ctx, func(c context.Context) (Details, error) {
+//	    return api.FetchDetails(c, selectedID.GetTyped())
+//	}, selectedID)
+func AsyncComputed[T any](ctx *Context, fn func(ctx context.Context) (T, error), deps ...bubbly.Dependency) *AsyncComputedValue[T] {
+	return bubbly.AsyncComputed(ctx, fn, deps...)
+}
+
+// RefSet is a group of Refs created and exposed together by ctx.Refs.
+type RefSet = bubbly.RefSet
+
+// RefSetGet returns the value stored under a RefSet key as a T, panicking
+// with the key and types on mismatch.
+//
+// Example:
+//
+//	tab := bubblyui.RefSetGet[int](state, "activeTab")
+func RefSetGet[T any](s *RefSet, key string) T {
+	return bubbly.RefSetGet[T](s, key)
+}
+
+// RefSetSet sets the Ref stored under a RefSet key.
+func RefSetSet[T any](s *RefSet, key string, value T) {
+	bubbly.RefSetSet(s, key, value)
 }
 
 // Watch creates a watcher that executes the callback when the watched value changes.
@@ -183,6 +255,204 @@ var WatchEffect = bubbly.WatchEffect
 //	)
 var Run = bubbly.Run
 
+// LazyComponent wraps a component factory so the component is built and
+// initialized only when first rendered.
+//
+// Example:
+//
+//	reports := bubblyui.LazyComponent(CreateReportsScreen)
+var LazyComponent = bubbly.LazyComponent
+
+// WithDisposeAfter discards a lazy component after it goes unrendered for a while.
+var WithDisposeAfter = bubbly.WithDisposeAfter
+
+// LifecycleState is a snapshot of a component's lifecycle phase and its
+// render and update counts since mount.
+type LifecycleState = bubbly.LifecycleState
+
+// GetLifecycleState returns whether a component is initialized, mounted, or
+// disposed, with its render and update counts since mount.
+//
+// Example:
+//
+//	fmt.Println(bubblyui.GetLifecycleState(child)) // mounted (renders: 3, updates: 5)
+var GetLifecycleState = bubbly.GetLifecycleState
+
+// =============================================================================
+// Typed Events
+// =============================================================================
+
+// Emitter is anything events can be emitted from, such as a Component or a Context.
+type Emitter = bubbly.Emitter
+
+// TypedEvent is an event name bound to a payload type, checked at compile time.
+type TypedEvent[T any] = bubbly.TypedEvent[T]
+
+// NewTypedEvent declares an event carrying a T payload.
+//
+// Example:
+//
+//	var Submitted = bubblyui.NewTypedEvent[FormData]("submit")
+//	Submitted.On(ctx, func(data FormData) { save(data) })
+func NewTypedEvent[T any](name string) TypedEvent[T] {
+	return bubbly.NewTypedEvent[T](name)
+}
+
+// OnTyped registers an event handler that receives its payload as a T,
+// panicking with the event name and types on mismatch.
+func OnTyped[T any](ctx *Context, event string, handler func(data T)) {
+	bubbly.OnTyped(ctx, event, handler)
+}
+
+// EmitTyped emits an event with a T payload.
+func EmitTyped[T any](target Emitter, event string, data T) {
+	bubbly.EmitTyped(target, event, data)
+}
+
+// =============================================================================
+// Event Middleware
+// =============================================================================
+
+// Event is an emitted event with its name, source, payload, and propagation state.
+type Event = bubbly.Event
+
+// EventDispatchFunc dispatches an event; it is the unit middleware wraps.
+type EventDispatchFunc = bubbly.EventDispatchFunc
+
+// EventMiddleware wraps event dispatch for logging, blocking, or transforming events.
+// Register it with Context.UseEventMiddleware or Context.UseSubtreeEventMiddleware.
+type EventMiddleware = bubbly.EventMiddleware
+
+// =============================================================================
+// Key Maps
+// =============================================================================
+
+// KeyMap is a serializable key-to-event binding map for user-configurable keys.
+type KeyMap = bubbly.KeyMap
+
+// KeyMapConflict describes a key binding replaced by a KeyMap override.
+type KeyMapConflict = bubbly.KeyMapConflict
+
+// ExportKeyMap returns a component's key bindings as a KeyMap.
+var ExportKeyMap = bubbly.ExportKeyMap
+
+// ParseKeyMap decodes a KeyMap from JSON.
+var ParseKeyMap = bubbly.ParseKeyMap
+
+// ApplyKeyMap remaps a component's key bindings with user overrides.
+var ApplyKeyMap = bubbly.ApplyKeyMap
+
+// =============================================================================
+// Event Bus
+// =============================================================================
+
+// EventBus is a publish/subscribe channel for cross-component communication.
+// Inside components, prefer ctx.Subscribe and ctx.Publish, which clean up
+// subscriptions automatically on unmount.
+type EventBus = bubbly.EventBus
+
+// NewEventBus creates an isolated EventBus.
+var NewEventBus = bubbly.NewEventBus
+
+// DefaultEventBus returns the application-wide EventBus.
+var DefaultEventBus = bubbly.DefaultEventBus
+
+// =============================================================================
+// Dependency Graph
+// =============================================================================
+
+// WithComputedName names a Computed for cycle errors and dependency graphs.
+var WithComputedName = bubbly.WithComputedName
+
+// CycleReport describes a circular dependency between reactive values.
+type CycleReport = bubbly.CycleReport
+
+// CycleError is raised when a Computed depends on itself; it includes the cycle path.
+type CycleError = bubbly.CycleError
+
+// DetectCycles evaluates values and reports the first dependency cycle without panicking.
+var DetectCycles = bubbly.DetectCycles
+
+// ReactiveGraph is a serializable snapshot of reactive wiring, with a DOT export.
+type ReactiveGraph = bubbly.ReactiveGraph
+
+// DependencyNode is a Ref, Computed, watcher, or effect in a ReactiveGraph.
+type DependencyNode = bubbly.DependencyNode
+
+// DependencyEdge connects a reactive source to something that depends on it.
+type DependencyEdge = bubbly.DependencyEdge
+
+// DependencyGraph returns the reactive graph reachable from the given values.
+//
+// Example:
+//
+//	fmt.Println(bubblyui.DependencyGraph(count).DOT())
+var DependencyGraph = bubbly.DependencyGraph
+
+// ComponentDependencyGraph returns the reactive graph of a component tree's exposed values.
+var ComponentDependencyGraph = bubbly.ComponentDependencyGraph
+
+// =============================================================================
+// Requests
+// =============================================================================
+
+// RequestHandler answers a request sent to a component.
+// Register one in Setup with ctx.OnRequest.
+type RequestHandler = bubbly.RequestHandler
+
+// Request sends a synchronous query to a component and returns its answer.
+var Request = bubbly.Request
+
+// RequestWithTimeout is like Request, but fails with ErrRequestTimeout if the
+// handler does not respond in time.
+var RequestWithTimeout = bubbly.RequestWithTimeout
+
+// ErrNoRequestHandler is returned when a component has no handler for a request.
+var ErrNoRequestHandler = bubbly.ErrNoRequestHandler
+
+// ErrRequestTimeout is returned when a request handler does not respond in time.
+var ErrRequestTimeout = bubbly.ErrRequestTimeout
+
+// =============================================================================
+// Text Measurement
+// =============================================================================
+
+// VisibleWidth returns the number of terminal cells a string occupies,
+// ignoring ANSI escape sequences.
+var VisibleWidth = bubbly.VisibleWidth
+
+// TruncateVisible shortens a string to a cell width, appending "..." when cut.
+var TruncateVisible = bubbly.TruncateVisible
+
+// TruncateVisibleWith is like TruncateVisible with a custom tail marker.
+var TruncateVisibleWith = bubbly.TruncateVisibleWith
+
+// PadVisible right-pads a string with spaces to a cell width.
+var PadVisible = bubbly.PadVisible
+
+// FitVisible truncates or pads a string to exactly a cell width.
+var FitVisible = bubbly.FitVisible
+
+// =============================================================================
+// Styles
+// =============================================================================
+
+// StyleCond is a lipgloss style applied by MergeStyles only when its condition holds.
+type StyleCond = bubbly.StyleCond
+
+// StyleIf returns a StyleCond applying a style when a condition is true.
+var StyleIf = bubbly.StyleIf
+
+// MergeStyles merges the styles of enabled conditions over a base style, in order.
+//
+// Example:
+//
+//	style := bubblyui.MergeStyles(base,
+//	    bubblyui.StyleIf(focused, focusStyle),
+//	    bubblyui.StyleIf(hasError, errorStyle),
+//	)
+var MergeStyles = bubbly.MergeStyles
+
 // =============================================================================
 // Run Options - Screen and Display
 // =============================================================================
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -20,9 +21,13 @@ type User struct {
 }
 
 // fetchUser simulates an async API call
-func fetchUser() (*User, error) {
-	// Simulate network delay
-	time.Sleep(2 * time.Second)
+func fetchUser(ctx context.Context) (*User, error) {
+	// Simulate network delay, giving up if the fetch is cancelled
+	select {
+	case <-time.After(2 * time.Second):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 
 	// Simulate successful fetch
 	// In a real app, this would be an HTTP request
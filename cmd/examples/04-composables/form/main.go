@@ -234,8 +234,8 @@ func createFormDemo() (bubbly.Component, error) {
 			// This handles validation, dirty state, and field updates
 			form := composables.UseForm(ctx, LoginForm{}, validateLoginForm)
 
-			// Track which field is focused
-			focusedField := ctx.Ref("Username")
+			// Track which field is focused; Tab cycles with wraparound
+			focus := composables.UseFocusGroup(ctx, []string{"Username", "Email", "Password"})
 
 			// Track submission attempts
 			submitAttempts := ctx.Ref(0)
@@ -243,27 +243,19 @@ func createFormDemo() (bubbly.Component, error) {
 
 			// Expose state to template
 			ctx.Expose("form", form)
-			ctx.Expose("focusedField", focusedField)
+			ctx.Expose("focus", focus)
 			ctx.Expose("submitAttempts", submitAttempts)
 			ctx.Expose("lastSubmitSuccess", lastSubmitSuccess)
 
 			// Event handler for field navigation
 			ctx.On("nextField", func(_ interface{}) {
-				current := focusedField.GetTyped().(string)
-				switch current {
-				case "Username":
-					focusedField.Set("Email")
-				case "Email":
-					focusedField.Set("Password")
-				case "Password":
-					focusedField.Set("Username")
-				}
+				focus.Next()
 			})
 
 			// Event handler for adding characters
 			ctx.On("addChar", func(data interface{}) {
 				char := data.(string)
-				field := focusedField.GetTyped().(string)
+				field := focus.Current.GetTyped()
 				currentForm := form.Values.GetTyped()
 
 				// Get current field value and append character
@@ -283,7 +275,7 @@ func createFormDemo() (bubbly.Component, error) {
 
 			// Event handler for removing characters
 			ctx.On("removeChar", func(_ interface{}) {
-				field := focusedField.GetTyped().(string)
+				field := focus.Current.GetTyped()
 				currentForm := form.Values.GetTyped()
 
 				// Get current field value and remove last character
@@ -326,7 +318,7 @@ func createFormDemo() (bubbly.Component, error) {
 			// Event handler for form reset
 			ctx.On("reset", func(_ interface{}) {
 				form.Reset()
-				focusedField.Set("Username")
+				focus.Focus("Username")
 				lastSubmitSuccess.Set(false)
 				submitAttempts.Set(0)
 			})
@@ -334,14 +326,14 @@ func createFormDemo() (bubbly.Component, error) {
 		Template(func(ctx bubbly.RenderContext) string {
 			// Get state
 			form := ctx.Get("form").(composables.UseFormReturn[LoginForm])
-			focusedField := ctx.Get("focusedField").(*bubbly.Ref[interface{}])
+			focus := ctx.Get("focus").(*composables.FocusGroupReturn)
 			submitAttempts := ctx.Get("submitAttempts").(*bubbly.Ref[interface{}])
 
 			currentForm := form.Values.GetTyped()
 			errors := form.Errors.GetTyped()
 			isDirty := form.IsDirty.GetTyped()
 			isValid := form.IsValid.GetTyped()
-			focused := focusedField.GetTyped().(string)
+			focused := focus.Current.GetTyped()
 			attempts := submitAttempts.GetTyped().(int)
 
 			// Form fields box
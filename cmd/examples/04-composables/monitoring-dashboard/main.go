@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -168,7 +169,7 @@ func createMonitoringDashboard() (bubbly.Component, error) {
 				// Create various composables to generate real metrics
 				_ = composables.UseState(ctx, "test")
 				_ = composables.UseState(ctx, 42)
-				_ = composables.UseAsync(ctx, func() (*string, error) {
+				_ = composables.UseAsync(ctx, func(_ context.Context) (*string, error) {
 					result := "async data"
 					return &result, nil
 				})
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -152,7 +153,7 @@ func createProfilingDemo() (bubbly.Component, error) {
 				}
 
 				for i := 0; i < 50; i++ {
-					_ = composables.UseAsync(ctx, func() (*int, error) {
+					_ = composables.UseAsync(ctx, func(_ context.Context) (*int, error) {
 						result := 42
 						return &result, nil
 					})
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -136,10 +137,22 @@ func (m model) View() string {
 	return fmt.Sprintf("%s\n%s\n\n%s\n%s\n", title, subtitle, componentView, help)
 }
 
+// simulateLatency waits for d, returning early if ctx is cancelled
+func simulateLatency(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // fetchUserProfile simulates fetching user profile from API
-func fetchUserProfile() (*UserProfile, error) {
+func fetchUserProfile(ctx context.Context) (*UserProfile, error) {
 	// Simulate network delay
-	time.Sleep(1500 * time.Millisecond)
+	if err := simulateLatency(ctx, 1500*time.Millisecond); err != nil {
+		return nil, err
+	}
 
 	// Simulate successful fetch
 	return &UserProfile{
@@ -152,9 +165,11 @@ func fetchUserProfile() (*UserProfile, error) {
 }
 
 // fetchRecentActivity simulates fetching recent activity from API
-func fetchRecentActivity() (*[]Activity, error) {
+func fetchRecentActivity(ctx context.Context) (*[]Activity, error) {
 	// Simulate network delay
-	time.Sleep(2000 * time.Millisecond)
+	if err := simulateLatency(ctx, 2000*time.Millisecond); err != nil {
+		return nil, err
+	}
 
 	// Simulate successful fetch
 	activities := []Activity{
@@ -169,9 +184,11 @@ func fetchRecentActivity() (*[]Activity, error) {
 }
 
 // fetchStatistics simulates fetching statistics from API
-func fetchStatistics() (*Statistics, error) {
+func fetchStatistics(ctx context.Context) (*Statistics, error) {
 	// Simulate network delay
-	time.Sleep(1000 * time.Millisecond)
+	if err := simulateLatency(ctx, 1000*time.Millisecond); err != nil {
+		return nil, err
+	}
 
 	// Simulate successful fetch
 	return &Statistics{
@@ -206,6 +206,7 @@ func createFormComponent() (bubbly.Component, error) {
 			name := bubbly.NewRef("")
 			email := bubbly.NewRef("")
 			age := bubbly.NewRef(0)
+			ageInput := directives.BindNumber(age, directives.WithMin(0), directives.WithMax(150))
 			agreed := bubbly.NewRef(false)
 			country := bubbly.NewRef("USA")
 
@@ -258,10 +259,8 @@ func createFormComponent() (bubbly.Component, error) {
 				case "email":
 					email.Set(value)
 				case "age":
-					// Parse age as integer
-					var ageVal int
-					fmt.Sscanf(value, "%d", &ageVal)
-					age.Set(ageVal)
+					// Parse and clamp age; non-numeric input keeps the last age
+					ageInput.Input(value)
 				case "country":
 					country.Set(value)
 				}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
-	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -515,6 +514,9 @@ func renderOverviewTab(metrics []Metric, servers []Server, lastUpdate time.Time)
 	)
 }
 
+// serverPageSize is the number of servers per page of the servers table.
+const serverPageSize = 3
+
 func renderServersTab(servers []Server, selectedIndex int, navigationMode bool) string {
 	// Create table for servers
 	serversRef := bubbly.NewRef(servers)
@@ -523,11 +525,24 @@ func renderServersTab(servers []Server, selectedIndex int, navigationMode bool)
 		Columns: []components.TableColumn[Server]{
 			{Header: "Server", Field: "Name", Width: 12},
 			{Header: "Status", Field: "Status", Width: 12},
-			{Header: "CPU %", Field: "CPU", Width: 8},
-			{Header: "Memory %", Field: "Memory", Width: 10},
+			{Header: "CPU %", Field: "CPU", Width: 8, Align: components.AlignRight},
+			{Header: "Memory %", Field: "Memory", Width: 10, Align: components.AlignRight},
 			{Header: "Uptime", Field: "Uptime", Width: 12},
 		},
+		// Highlight servers that need attention
+		RowStyle: func(s Server, index int) lipgloss.Style {
+			switch s.Status {
+			case "Offline":
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+			case "Maintenance":
+				return lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+			}
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+		},
 		Sortable: false,
+		// Page through the servers, showing the page with the selected one
+		PageSize:    serverPageSize,
+		CurrentPage: bubbly.NewRef(selectedIndex/serverPageSize + 1),
 		OnRowClick: func(s Server, index int) {
 			// Handle row click
 		},
@@ -539,53 +554,43 @@ func renderServersTab(servers []Server, selectedIndex int, navigationMode bool)
 		selected := servers[selectedIndex]
 
 		// Create detail card
-		detailContent := fmt.Sprintf(
-			"Server: %s\nStatus: %s\nCPU Usage: %d%%\nMemory Usage: %d%%\nUptime: %s",
-			selected.Name,
-			selected.Status,
-			selected.CPU,
-			selected.Memory,
-			selected.Uptime,
-		)
+		details := components.KeyValue(components.KeyValueProps{
+			Pairs: []components.KV{
+				{Label: "Server", Value: selected.Name},
+				{Label: "Status", Value: selected.Status},
+				{Label: "CPU Usage", Value: fmt.Sprintf("%d%%", selected.CPU)},
+				{Label: "Memory Usage", Value: fmt.Sprintf("%d%%", selected.Memory)},
+				{Label: "Uptime", Value: selected.Uptime},
+			},
+		})
+		details.Init()
 
 		detailCard := components.Card(components.CardProps{
 			Title:   "Selected Server Details",
-			Content: detailContent,
+			Content: details.View(),
 			Width:   40,
 		})
 		detailCard.Init()
 
-		// Create CPU gauge
-		cpuBar := components.Text(components.TextProps{
-			Content: fmt.Sprintf("CPU: %s %d%%",
-				strings.Repeat("█", selected.CPU/10),
-				selected.CPU),
-			Color: func() lipgloss.Color {
-				if selected.CPU > 80 {
-					return lipgloss.Color("196")
-				} else if selected.CPU > 60 {
-					return lipgloss.Color("220")
-				}
-				return lipgloss.Color("35")
-			}(),
-		})
-		cpuBar.Init()
-
-		// Create Memory gauge
-		memBar := components.Text(components.TextProps{
-			Content: fmt.Sprintf("MEM: %s %d%%",
-				strings.Repeat("█", selected.Memory/10),
-				selected.Memory),
-			Color: func() lipgloss.Color {
-				if selected.Memory > 80 {
-					return lipgloss.Color("196")
-				} else if selected.Memory > 60 {
-					return lipgloss.Color("220")
-				}
-				return lipgloss.Color("35")
-			}(),
+		// Create usage gauges
+		usageColor := func(percent int) lipgloss.Color {
+			if percent > 80 {
+				return lipgloss.Color("196")
+			} else if percent > 60 {
+				return lipgloss.Color("220")
+			}
+			return lipgloss.Color("35")
+		}
+		usageChart := components.BarChart(components.BarChartProps{
+			Bars: []components.Bar{
+				{Label: "CPU", Value: float64(selected.CPU), Color: usageColor(selected.CPU)},
+				{Label: "MEM", Value: float64(selected.Memory), Color: usageColor(selected.Memory)},
+			},
+			Width:      10,
+			Max:        100,
+			ShowValues: true,
 		})
-		memBar.Init()
+		usageChart.Init()
 
 		// Navigation indicator
 		navIndicator := ""
@@ -604,8 +609,7 @@ func renderServersTab(servers []Server, selectedIndex int, navigationMode bool)
 			"",
 			detailCard.View(),
 			"",
-			cpuBar.View(),
-			memBar.View(),
+			usageChart.View(),
 		)
 	}
 
@@ -155,16 +155,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Check if we should start countdown after successful submission
 	if !m.countdownActive {
-		// This is a simple way to check - in production you'd use proper message passing
-		m.component.Emit("getSubmitStatus", func(success bool) {
-			if success && !m.countdownActive {
-				m.countdownActive = true
-				m.countdownSecs = 3
-				cmds = append(cmds, tea.Tick(time.Second, func(t time.Time) tea.Msg {
-					return tickMsg(t)
-				}))
-			}
-		})
+		if success, err := bubbly.Request(m.component, "submitStatus", nil); err == nil && success.(bool) {
+			m.countdownActive = true
+			m.countdownSecs = 3
+			cmds = append(cmds, tea.Tick(time.Second, func(t time.Time) tea.Msg {
+				return tickMsg(t)
+			}))
+		}
 	}
 
 	if len(cmds) > 0 {
@@ -507,10 +504,8 @@ func createFormBuilder() (bubbly.Component, error) {
 				currentField.Set(0)
 			})
 
-			ctx.On("getSubmitStatus", func(callback interface{}) {
-				if cb, ok := callback.(func(bool)); ok {
-					cb(lastSubmitSuccess.Get().(bool) && submitAttempts.Get().(int) > 0)
-				}
+			ctx.OnRequest("submitStatus", func(_ interface{}) (interface{}, error) {
+				return lastSubmitSuccess.Get().(bool) && submitAttempts.Get().(int) > 0, nil
 			})
 
 			ctx.On("checkSubmitSuccess", func(_ interface{}) {
@@ -135,7 +135,8 @@ func (m model) View() string {
 		"Controls:\n" +
 			"  ↑/↓ or k/j : Navigate rows\n" +
 			"  Enter/Space : Select row\n" +
-			"  1/2/3/4    : Sort by ID/Name/Email/Status\n" +
+			"  1/2/3/4    : Cycle sort by ID/Name/Email/Status (▲, ▼, off)\n" +
+			"  [/] then s : Focus a column and cycle its sort\n" +
 			"  q or Ctrl+C : Quit",
 	)
 
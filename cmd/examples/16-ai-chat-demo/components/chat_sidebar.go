@@ -105,20 +105,14 @@ func CreateChatSidebar() (bubbly.Component, error) {
 				}
 
 				// Truncate title if too long
-				displayTitle := session.Title
 				maxLen := innerWidth - 6 // Account for prefix
-				if len(displayTitle) > maxLen && maxLen > 3 {
-					displayTitle = displayTitle[:maxLen-3] + "..."
-				}
 
 				// Add number hint for selection
 				hint := ""
 				if i < 9 {
 					hint = fmt.Sprintf(" [%d]", i+1)
-					if len(displayTitle)+len(hint) > maxLen {
-						displayTitle = displayTitle[:maxLen-len(hint)-3] + "..."
-					}
 				}
+				displayTitle := bubbly.TruncateVisible(session.Title, maxLen-bubbly.VisibleWidth(hint))
 
 				sessionLines = append(sessionLines, style.Render(fmt.Sprintf("%s%s%s", prefix, displayTitle, hint)))
 			}
@@ -63,12 +63,46 @@ var Text = components.Text
 // TextProps configures a Text component.
 type TextProps = components.TextProps
 
+// Markdown renders a subset of markdown as styled text.
+var Markdown = components.Markdown
+
+// MarkdownProps configures a Markdown component.
+type MarkdownProps = components.MarkdownProps
+
+// MarkdownStyles defines the styles used by a Markdown component.
+type MarkdownStyles = components.MarkdownStyles
+
+// DefaultMarkdownStyles returns markdown styles derived from a theme.
+var DefaultMarkdownStyles = components.DefaultMarkdownStyles
+
+// CodeBlock displays syntax-highlighted source code.
+var CodeBlock = components.CodeBlock
+
+// CodeBlockProps configures a CodeBlock component.
+type CodeBlockProps = components.CodeBlockProps
+
+// CodeOverflow controls how a CodeBlock handles long lines.
+type CodeOverflow = components.CodeOverflow
+
+// CodeOverflow modes.
+const (
+	CodeOverflowClip   = components.CodeOverflowClip
+	CodeOverflowWrap   = components.CodeOverflowWrap
+	CodeOverflowScroll = components.CodeOverflowScroll
+)
+
 // Toggle creates a toggle/switch component.
 var Toggle = components.Toggle
 
 // ToggleProps configures a Toggle component.
 type ToggleProps = components.ToggleProps
 
+// Avatar creates a colored initials badge for a name.
+var Avatar = components.Avatar
+
+// AvatarProps configures an Avatar component.
+type AvatarProps = components.AvatarProps
+
 // =============================================================================
 // Molecules - Composite Components
 // =============================================================================
@@ -142,6 +176,15 @@ type AccordionProps = components.AccordionProps
 // AccordionItem represents a single accordion item.
 type AccordionItem = components.AccordionItem
 
+// KeyValue creates an aligned label/value description list.
+var KeyValue = components.KeyValue
+
+// KeyValueProps configures a KeyValue component.
+type KeyValueProps = components.KeyValueProps
+
+// KV is a single label/value row of a KeyValue list.
+type KV = components.KV
+
 // =============================================================================
 // Organisms - Complex Components
 // =============================================================================
@@ -245,6 +288,69 @@ var Divider = components.Divider
 // DividerProps configures a Divider component.
 type DividerProps = components.DividerProps
 
+// ScrollView creates a scrollable container that clips content to a fixed height.
+var ScrollView = components.ScrollView
+
+// ScrollViewProps configures a ScrollView component.
+type ScrollViewProps = components.ScrollViewProps
+
+// ScrollTo scrolls a ScrollView so the given line is at the top.
+var ScrollTo = components.ScrollTo
+
+// ScrollIntoView scrolls a ScrollView until a child component is visible.
+var ScrollIntoView = components.ScrollIntoView
+
+// Router renders one named route at a time with back/forward history.
+var Router = components.Router
+
+// RouterProps configures a Router component.
+type RouterProps = components.RouterProps
+
+// RouteFactory creates the component rendered for a route.
+type RouteFactory = components.RouteFactory
+
+// RouteLocation is a route name and its parameters.
+type RouteLocation = components.RouteLocation
+
+// UseRoute returns the location of the enclosing Router.
+var UseRoute = components.UseRoute
+
+// Navigate navigates a Router to a named route.
+var Navigate = components.Navigate
+
+// NavigateBack moves a Router back in its history.
+var NavigateBack = components.NavigateBack
+
+// NavigateForward moves a Router forward in its history.
+var NavigateForward = components.NavigateForward
+
+// LoadingOverlay renders a child dimmed with a centered spinner while loading.
+var LoadingOverlay = components.LoadingOverlay
+
+// LoadingOverlayProps configures a LoadingOverlay component.
+type LoadingOverlayProps = components.LoadingOverlayProps
+
+// WithLoadingOverlay wraps a component in a LoadingOverlay.
+var WithLoadingOverlay = components.WithLoadingOverlay
+
+// HelpOverlay renders a toggleable help panel built from a component's key bindings.
+var HelpOverlay = components.HelpOverlay
+
+// HelpOverlayProps configures a HelpOverlay component.
+type HelpOverlayProps = components.HelpOverlayProps
+
+// HelpToggleEvent shows or hides a HelpOverlay.
+const HelpToggleEvent = components.HelpToggleEvent
+
+// StatusBar renders a single-line bar with left, center, and right segments.
+var StatusBar = components.StatusBar
+
+// StatusBarProps configures a StatusBar component.
+type StatusBarProps = components.StatusBarProps
+
+// Segment is a single cell of a StatusBar.
+type Segment = components.Segment
+
 // =============================================================================
 // Themes
 // =============================================================================
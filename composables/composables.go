@@ -18,9 +18,13 @@
 // # Data Composables
 //
 //   - UseList: Reactive list operations (add, remove, filter)
+//   - UseFilteredList: Reactive filter/search/sort pipeline over a slice
+//   - UsePagination: Reactive page window over a slice
+//   - UseStats: Reactive named aggregates (counts, totals) over a slice
 //   - UseHistory: Undo/redo state management
 //   - UseForm: Form state with validation
 //   - UseLocalStorage: Persistent storage integration
+//   - UsePersistedRef: Ref with debounced automatic persistence
 //
 // # UI Composables
 //
@@ -48,6 +52,7 @@
 package composables
 
 import (
+	"context"
 	"time"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
@@ -86,6 +91,36 @@ func UseList[T any](ctx *bubbly.Context, initial []T) *ListReturn[T] {
 	return composables.UseList(ctx, initial)
 }
 
+// UseFilteredList provides a reactive filter/search/sort pipeline over a slice.
+func UseFilteredList[T any](ctx *bubbly.Context, source *bubbly.Ref[[]T]) *FilteredListReturn[T] {
+	return composables.UseFilteredList(ctx, source)
+}
+
+// UsePagination provides a reactive page window over a slice.
+func UsePagination[T any](ctx *bubbly.Context, items *bubbly.Ref[[]T], pageSize int) *PaginationReturn[T] {
+	return composables.UsePagination(ctx, items, pageSize)
+}
+
+// UseStats provides reactive named aggregates over a slice.
+func UseStats[T any](ctx *bubbly.Context, items *bubbly.Ref[[]T], reducers map[string]func([]T) any) *StatsReturn[T] {
+	return composables.UseStats(ctx, items, reducers)
+}
+
+// Count returns a UseStats reducer counting the items matching a predicate.
+func Count[T any](pred func(T) bool) func([]T) any {
+	return composables.Count(pred)
+}
+
+// Sum returns a UseStats reducer totalling a numeric field.
+func Sum[T any, N Number](extract func(T) N) func([]T) any {
+	return composables.Sum(extract)
+}
+
+// Stat returns a UseStats aggregate as a typed value.
+func Stat[V, T any](s *StatsReturn[T], name string) V {
+	return composables.Stat[V](s, name)
+}
+
 // UseHistory provides undo/redo state management.
 func UseHistory[T any](ctx *bubbly.Context, initial T, maxSize int) *HistoryReturn[T] {
 	return composables.UseHistory(ctx, initial, maxSize)
@@ -97,8 +132,13 @@ func UseForm[T any](ctx *bubbly.Context, initial T, validate func(T) map[string]
 }
 
 // UseLocalStorage provides persistent storage integration.
-func UseLocalStorage[T any](ctx *bubbly.Context, key string, initial T, storage Storage) UseStateReturn[T] {
-	return composables.UseLocalStorage(ctx, key, initial, storage)
+func UseLocalStorage[T any](ctx *bubbly.Context, key string, initial T, storage Storage, opts ...LocalStorageOption) UseStateReturn[T] {
+	return composables.UseLocalStorage(ctx, key, initial, storage, opts...)
+}
+
+// UsePersistedRef creates a Ref that loads from and auto-saves to storage.
+func UsePersistedRef[T any](ctx *bubbly.Context, key string, initial T, storage Storage, opts ...PersistOption) *bubbly.Ref[T] {
+	return composables.UsePersistedRef(ctx, key, initial, storage, opts...)
 }
 
 // =============================================================================
@@ -110,6 +150,9 @@ func UseFocus[T comparable](ctx *bubbly.Context, initial T, order []T) *FocusRet
 	return composables.UseFocus(ctx, initial, order)
 }
 
+// UseFocusManager provides component-level focus management with focus traps.
+var UseFocusManager = composables.UseFocusManager
+
 // UseWindowSize provides responsive breakpoint tracking.
 var UseWindowSize = composables.UseWindowSize
 
@@ -123,7 +166,7 @@ var UseInterval = composables.UseInterval
 var UseLogger = composables.UseLogger
 
 // UseAsync provides async operation handling.
-func UseAsync[T any](ctx *bubbly.Context, fetcher func() (*T, error)) UseAsyncReturn[T] {
+func UseAsync[T any](ctx *bubbly.Context, fetcher func(context.Context) (*T, error)) UseAsyncReturn[T] {
 	return composables.UseAsync(ctx, fetcher)
 }
 
@@ -152,12 +195,27 @@ type UseEffectCleanup = composables.UseEffectCleanup
 // FocusReturn is the return type for UseFocus.
 type FocusReturn[T comparable] = composables.FocusReturn[T]
 
+// FocusManagerReturn is the return type for UseFocusManager.
+type FocusManagerReturn = composables.FocusManagerReturn
+
 // HistoryReturn is the return type for UseHistory.
 type HistoryReturn[T any] = composables.HistoryReturn[T]
 
 // ListReturn is the return type for UseList.
 type ListReturn[T any] = composables.ListReturn[T]
 
+// FilteredListReturn is the return type for UseFilteredList.
+type FilteredListReturn[T any] = composables.FilteredListReturn[T]
+
+// PaginationReturn is the return type for UsePagination.
+type PaginationReturn[T any] = composables.PaginationReturn[T]
+
+// StatsReturn is the return type for UseStats.
+type StatsReturn[T any] = composables.StatsReturn[T]
+
+// Number is the set of numeric types Sum can total.
+type Number = composables.Number
+
 // CounterReturn is the return type for UseCounter.
 type CounterReturn = composables.CounterReturn
 
@@ -224,6 +282,45 @@ type FileStorage = composables.FileStorage
 // NewFileStorage creates a new file-based storage.
 var NewFileStorage = composables.NewFileStorage
 
+// FileStorageOption configures a FileStorage.
+type FileStorageOption = composables.FileStorageOption
+
+// WithBackup keeps the previous contents of each key in "<key>.bak".
+var WithBackup = composables.WithBackup
+
+// MemoryStorage provides in-memory storage, recommended for tests.
+type MemoryStorage = composables.MemoryStorage
+
+// NewMemoryStorage creates a new in-memory storage.
+var NewMemoryStorage = composables.NewMemoryStorage
+
+// EncryptedStorage wraps a Storage with AES-GCM encryption.
+type EncryptedStorage = composables.EncryptedStorage
+
+// NewEncryptedStorage creates an AES-GCM encrypted storage wrapper.
+var NewEncryptedStorage = composables.NewEncryptedStorage
+
+// BatchStorage wraps a Storage and coalesces writes of several keys.
+type BatchStorage = composables.BatchStorage
+
+// NewBatchStorage creates a write-batching storage wrapper.
+var NewBatchStorage = composables.NewBatchStorage
+
+// LocalStorageOption configures UseLocalStorage behavior.
+type LocalStorageOption = composables.LocalStorageOption
+
+// StorageMigration upgrades a stored value by one schema version.
+type StorageMigration = composables.StorageMigration
+
+// Migrate adds a schema migration step to UseLocalStorage.
+var Migrate = composables.Migrate
+
+// PersistOption configures UsePersistedRef behavior.
+type PersistOption = composables.PersistOption
+
+// WithPersistDelay sets the debounce delay for UsePersistedRef writes.
+var WithPersistDelay = composables.WithPersistDelay
+
 // =============================================================================
 // Logging Types
 // =============================================================================
@@ -248,3 +345,9 @@ const (
 
 // ErrComposableOutsideSetup is returned when a composable is used outside Setup.
 var ErrComposableOutsideSetup = composables.ErrComposableOutsideSetup
+
+// ErrInvalidEncryptionKey is returned for encryption keys of invalid length.
+var ErrInvalidEncryptionKey = composables.ErrInvalidEncryptionKey
+
+// ErrDecryptionFailed is returned when encrypted data cannot be decrypted.
+var ErrDecryptionFailed = composables.ErrDecryptionFailed
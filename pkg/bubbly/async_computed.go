@@ -0,0 +1,158 @@
+package bubbly
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// AsyncComputedValue is the reactive state of an AsyncComputed derivation.
+//
+// Data, Loading, and Error mirror the composables.UseAsync return values:
+// Data is nil until the first successful run, Loading is true while a run is
+// in flight, and Error holds the error of the last completed run.
+//
+// AsyncComputedValue is thread-safe.
+type AsyncComputedValue[T any] struct {
+	// Data holds the result of the latest successful run.
+	// It is nil initially and after a run fails.
+	Data *Ref[*T]
+
+	// Loading is true while a run is in flight.
+	Loading *Ref[bool]
+
+	// Error holds the error of the latest completed run, or nil.
+	Error *Ref[error]
+
+	fn          func(ctx context.Context) (T, error)
+	mu          sync.Mutex
+	generation  uint64
+	cancel      context.CancelFunc
+	stopped     bool
+	stopWatcher WatchCleanup
+}
+
+// AsyncComputed derives a value with asynchronous work, recomputing it
+// whenever one of deps changes.
+//
+// fn runs on its own goroutine: once immediately, then after every change
+// to any of deps. Starting a new run cancels the context passed to the
+// previous one, and results of superseded runs are discarded, so Data
+// always reflects the latest dependency values. Reactive values read inside
+// fn are not tracked; list every input in deps.
+//
+// When ctx is non-nil, the derivation stops and any in-flight run is
+// cancelled when the component unmounts. Otherwise, call Stop.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    selectedID := ctx.Ref(0)
+//	    details := bubbly.AsyncComputed(ctx, func(c context.Context) (Details, error) {
+//	        return api.FetchDetails(c, selectedID.Get().(int))
+//	    }, selectedID)
+//
+//	    ctx.Expose("details", details.Data)
+//	    ctx.Expose("loading", details.Loading)
+//	})
+func AsyncComputed[T any](ctx *Context, fn func(ctx context.Context) (T, error), deps ...Dependency) *AsyncComputedValue[T] {
+	a := &AsyncComputedValue[T]{
+		Data:    NewRef[*T](nil),
+		Loading: NewRef(false),
+		Error:   NewRef[error](nil),
+		fn:      fn,
+	}
+
+	// Reading deps inside the effect subscribes to them; the run itself
+	// happens on another goroutine, so fn's own reads are not tracked.
+	a.stopWatcher = WatchEffect(func() {
+		for _, dep := range deps {
+			dep.Get()
+		}
+		a.Refresh()
+	})
+
+	if ctx != nil {
+		ctx.OnUnmounted(a.Stop)
+	}
+
+	return a
+}
+
+// Refresh re-runs the derivation now, cancelling any in-flight run.
+// It does nothing after Stop.
+func (a *AsyncComputedValue[T]) Refresh() {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return
+	}
+	if a.cancel != nil {
+		a.cancel()
+	}
+	runCtx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.generation++
+	generation := a.generation
+	a.mu.Unlock()
+
+	a.Loading.Set(true)
+	go a.run(runCtx, generation)
+}
+
+// run executes fn and publishes its result unless a newer run superseded it.
+func (a *AsyncComputedValue[T]) run(ctx context.Context, generation uint64) {
+	result, err := a.call(ctx)
+
+	a.mu.Lock()
+	current := generation == a.generation && !a.stopped
+	if current {
+		a.cancel = nil
+	}
+	a.mu.Unlock()
+
+	if !current {
+		return
+	}
+
+	if err != nil {
+		a.Error.Set(err)
+		a.Data.Set(nil)
+	} else {
+		a.Data.Set(&result)
+		a.Error.Set(nil)
+	}
+	a.Loading.Set(false)
+}
+
+// call invokes fn, converting a panic into an error.
+func (a *AsyncComputedValue[T]) call(ctx context.Context) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("async computed panicked: %v", r)
+		}
+	}()
+	return a.fn(ctx)
+}
+
+// Stop stops recomputing on dependency changes and cancels any in-flight
+// run. Loading is reset to false; Data and Error keep their last values.
+func (a *AsyncComputedValue[T]) Stop() {
+	a.mu.Lock()
+	if a.stopped {
+		a.mu.Unlock()
+		return
+	}
+	a.stopped = true
+	if a.cancel != nil {
+		a.cancel()
+		a.cancel = nil
+	}
+	stopWatcher := a.stopWatcher
+	a.mu.Unlock()
+
+	if stopWatcher != nil {
+		stopWatcher()
+	}
+	a.Loading.Set(false)
+}
@@ -0,0 +1,145 @@
+package bubbly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitAsyncComputed waits until a has finished loading.
+func waitAsyncComputed[T any](t *testing.T, a *AsyncComputedValue[T]) {
+	t.Helper()
+	require.Eventually(t, func() bool { return !a.Loading.GetTyped() }, time.Second, time.Millisecond)
+}
+
+// TestAsyncComputed_RecomputesOnDependencyChange tests the initial run and reruns
+func TestAsyncComputed_RecomputesOnDependencyChange(t *testing.T) {
+	id := NewRef(1)
+	suffix := NewRef("a")
+	var runs atomic.Int32
+
+	details := AsyncComputed(nil, func(ctx context.Context) (string, error) {
+		runs.Add(1)
+		return fmt.Sprintf("item-%d-%s", id.GetTyped(), suffix.GetTyped()), nil
+	}, id, suffix)
+	defer details.Stop()
+
+	waitAsyncComputed(t, details)
+	require.NotNil(t, details.Data.GetTyped())
+	assert.Equal(t, "item-1-a", *details.Data.GetTyped())
+
+	id.Set(2)
+	require.Eventually(t, func() bool {
+		data := details.Data.GetTyped()
+		return data != nil && *data == "item-2-a"
+	}, time.Second, time.Millisecond)
+
+	suffix.Set("b")
+	require.Eventually(t, func() bool {
+		data := details.Data.GetTyped()
+		return data != nil && *data == "item-2-b"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(3), runs.Load())
+}
+
+// TestAsyncComputed_CancelsSupersededRun tests that only the latest run publishes
+func TestAsyncComputed_CancelsSupersededRun(t *testing.T) {
+	query := NewRef("slow")
+	started := make(chan struct{}, 1)
+	cancelled := make(chan struct{}, 1)
+
+	result := AsyncComputed(nil, func(ctx context.Context) (string, error) {
+		q := query.GetTyped()
+		if q == "slow" {
+			started <- struct{}{}
+			select {
+			case <-ctx.Done():
+				cancelled <- struct{}{}
+				return "stale", ctx.Err()
+			case <-time.After(time.Second):
+				return "stale", nil
+			}
+		}
+		return q, nil
+	}, query)
+	defer result.Stop()
+
+	assert.True(t, result.Loading.GetTyped())
+	<-started
+	query.Set("fast")
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("previous run was not cancelled")
+	}
+	waitAsyncComputed(t, result)
+	require.NotNil(t, result.Data.GetTyped())
+	assert.Equal(t, "fast", *result.Data.GetTyped())
+	assert.NoError(t, result.Error.GetTyped(), "cancelled run's error is discarded")
+}
+
+// TestAsyncComputed_ErrorsAndPanics tests error reporting
+func TestAsyncComputed_ErrorsAndPanics(t *testing.T) {
+	mode := NewRef("error")
+	errBoom := errors.New("boom")
+
+	result := AsyncComputed(nil, func(ctx context.Context) (int, error) {
+		switch mode.GetTyped() {
+		case "error":
+			return 0, errBoom
+		case "panic":
+			panic("bad")
+		}
+		return 42, nil
+	}, mode)
+	defer result.Stop()
+
+	waitAsyncComputed(t, result)
+	assert.ErrorIs(t, result.Error.GetTyped(), errBoom)
+	assert.Nil(t, result.Data.GetTyped())
+
+	mode.Set("ok")
+	require.Eventually(t, func() bool { return result.Data.GetTyped() != nil }, time.Second, time.Millisecond)
+	assert.Equal(t, 42, *result.Data.GetTyped())
+	assert.NoError(t, result.Error.GetTyped())
+
+	mode.Set("panic")
+	require.Eventually(t, func() bool { return result.Error.GetTyped() != nil }, time.Second, time.Millisecond)
+	assert.Contains(t, result.Error.GetTyped().Error(), "bad")
+}
+
+// TestAsyncComputed_StopsOnUnmount tests lifecycle cleanup
+func TestAsyncComputed_StopsOnUnmount(t *testing.T) {
+	dep := NewRef(0)
+	var runs atomic.Int32
+	var result *AsyncComputedValue[int]
+
+	comp, err := NewComponent("Details").
+		Setup(func(ctx *Context) {
+			result = AsyncComputed(ctx, func(ctx context.Context) (int, error) {
+				runs.Add(1)
+				return dep.GetTyped(), nil
+			}, dep)
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	comp.View()
+	waitAsyncComputed(t, result)
+
+	comp.(interface{ Unmount() }).Unmount()
+	dep.Set(1)
+	result.Refresh()
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Equal(t, int32(1), runs.Load(), "no runs after unmount")
+	assert.False(t, result.Loading.GetTyped())
+}
@@ -0,0 +1,148 @@
+package bubbly
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// batchEntry is a deferred notification. first holds the old value from
+// the first change in the batch, so coalesced notifications report the
+// change across the whole batch.
+type batchEntry struct {
+	first any
+	run   func()
+}
+
+// batchState tracks the Batch calls in progress and their deferred
+// notifications, in the order they were first deferred.
+type batchState struct {
+	mu       sync.Mutex
+	depth    int
+	flushing bool        // Set while the outermost Batch delivers entries
+	active   atomic.Bool // Mirrors depth > 0 || flushing for lock-free checks
+	entries  map[any]*batchEntry
+	order    []any
+}
+
+// globalBatch is the batch state shared by all reactive values.
+var globalBatch = &batchState{
+	entries: make(map[any]*batchEntry),
+}
+
+// Batch runs fn with change notifications suspended, then delivers them
+// once fn returns. Use it in event handlers that set several refs, so
+// watchers, watched computed values, and automatic re-render commands run
+// once with the final state instead of once per Set.
+//
+// While fn runs:
+//   - Refs and Computed values update as usual: reads inside fn see the
+//     new values
+//   - Watcher callbacks are deferred, and a watcher notified several times
+//     runs once, with the value before the batch as old value and the
+//     final value as new value
+//   - Computed values with watchers are not recomputed eagerly; each is
+//     recomputed once, when the batch ends
+//   - Automatic command generation (the component re-render bridge) runs
+//     once per Ref
+//
+// Nested Batch calls only deliver notifications when the outermost one
+// returns. Notifications are delivered even if fn panics.
+//
+// Unlike WithFlush("post"), which needs FlushWatchers and applies to single
+// watchers, Batch needs no flush call and covers every watcher, computed
+// value, and ref changed inside fn.
+//
+// Batch is meant for the event loop: changes made by other goroutines
+// while fn runs are deferred too.
+//
+// Example:
+//
+//	ctx.On("reset", func(_ interface{}) {
+//	    bubbly.Batch(func() {
+//	        query.Set("")
+//	        page.Set(1)
+//	        filters.Set(nil)
+//	    }) // Watchers of query, page, and filters run once here
+//	})
+func Batch(fn func()) {
+	globalBatch.mu.Lock()
+	globalBatch.depth++
+	globalBatch.active.Store(true)
+	globalBatch.mu.Unlock()
+
+	defer globalBatch.end()
+	fn()
+}
+
+// end closes a Batch, delivering the deferred notifications if it was the
+// outermost one.
+//
+// Entries are delivered one at a time, in the order they were deferred. A
+// notification triggered while delivering is merged into its entry if that
+// entry is still pending (e.g., a watched computed recomputed by an earlier
+// entry), and runs immediately otherwise.
+func (b *batchState) end() {
+	b.mu.Lock()
+	b.depth--
+	if b.depth > 0 || b.flushing {
+		b.mu.Unlock()
+		return
+	}
+	b.flushing = true
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		b.flushing = false
+		b.entries = make(map[any]*batchEntry)
+		b.order = nil
+		b.active.Store(b.depth > 0)
+		b.mu.Unlock()
+	}()
+
+	for i := 0; ; i++ {
+		b.mu.Lock()
+		if i >= len(b.order) {
+			b.mu.Unlock()
+			return
+		}
+		key := b.order[i]
+		entry := b.entries[key]
+		delete(b.entries, key)
+		b.mu.Unlock()
+
+		entry.run()
+	}
+}
+
+// inBatch reports whether a Batch is in progress. It is a cheap check for
+// hot paths, made before building the arguments of deferInBatch.
+func inBatch() bool {
+	return globalBatch.active.Load()
+}
+
+// deferInBatch defers a notification of a change from oldVal to newVal if
+// a Batch is in progress (or a pending notification with the same key is
+// being delivered), and reports whether it did. Notifications with
+// the same key are coalesced into one call of run, with the first old value
+// and the last new value.
+func deferInBatch[T any](key any, newVal, oldVal T, run func(newVal, oldVal T)) bool {
+	b := globalBatch
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, ok := b.entries[key]
+	if !ok {
+		if b.depth == 0 {
+			return false
+		}
+		entry = &batchEntry{first: oldVal}
+		b.entries[key] = entry
+		b.order = append(b.order, key)
+	}
+	first, _ := entry.first.(T)
+	entry.run = func() {
+		run(newVal, first)
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBatch_CoalescesWatchers tests that watchers run once per batch with
+// the old value from before the batch
+func TestBatch_CoalescesWatchers(t *testing.T) {
+	count := NewRef(0)
+	name := NewRef("a")
+
+	var counts [][2]int
+	var names []string
+	defer Watch(count, func(n, o int) { counts = append(counts, [2]int{o, n}) })()
+	defer Watch(name, func(n, _ string) { names = append(names, n) })()
+
+	Batch(func() {
+		count.Set(1)
+		count.Set(2)
+		name.Set("b")
+		count.Set(3)
+
+		// Values are current inside the batch
+		assert.Equal(t, 3, count.GetTyped())
+		assert.Empty(t, counts)
+	})
+
+	assert.Equal(t, [][2]int{{0, 3}}, counts)
+	assert.Equal(t, []string{"b"}, names)
+
+	// Outside a batch, every Set notifies
+	count.Set(4)
+	count.Set(5)
+	assert.Len(t, counts, 3)
+}
+
+// TestBatch_Computed tests that watched computed values recompute once
+// and stay readable inside the batch
+func TestBatch_Computed(t *testing.T) {
+	a := NewRef(1)
+	b := NewRef(2)
+	computations := 0
+	sum := NewComputed(func() int {
+		computations++
+		return a.GetTyped() + b.GetTyped()
+	})
+
+	var sums []int
+	defer Watch(sum, func(n, _ int) { sums = append(sums, n) })()
+	computations = 0
+
+	Batch(func() {
+		a.Set(10)
+		b.Set(20)
+		assert.Equal(t, 0, computations, "watched computed should not recompute eagerly")
+	})
+
+	assert.Equal(t, 1, computations)
+	assert.Equal(t, []int{30}, sums)
+
+	// Reading inside the batch recomputes lazily and still notifies once
+	Batch(func() {
+		a.Set(100)
+		assert.Equal(t, 120, sum.GetTyped())
+		b.Set(200)
+	})
+	assert.Equal(t, []int{30, 300}, sums)
+}
+
+// TestBatch_Nested tests that only the outermost batch delivers notifications
+func TestBatch_Nested(t *testing.T) {
+	count := NewRef(0)
+	calls := 0
+	defer Watch(count, func(_, _ int) { calls++ })()
+
+	Batch(func() {
+		Batch(func() {
+			count.Set(1)
+		})
+		assert.Equal(t, 0, calls, "inner batch must not flush")
+		count.Set(2)
+	})
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2, count.GetTyped())
+}
+
+// TestBatch_SetHook tests that the command generation hook runs once per Ref
+func TestBatch_SetHook(t *testing.T) {
+	count := NewRef(0)
+	var changes [][2]int
+	count.setHook = func(o, n int) { changes = append(changes, [2]int{o, n}) }
+
+	Batch(func() {
+		count.Set(1)
+		count.Set(2)
+	})
+
+	assert.Equal(t, [][2]int{{0, 2}}, changes)
+}
+
+// TestBatch_DeepWatcherSkipsRevertedChange tests that a value restored
+// within a batch does not notify deep watchers
+func TestBatch_DeepWatcherSkipsRevertedChange(t *testing.T) {
+	items := NewRef([]int{1})
+	calls := 0
+	defer Watch(items, func(_, _ []int) { calls++ }, WithDeep())()
+
+	Batch(func() {
+		items.Set([]int{1, 2})
+		items.Set([]int{1})
+	})
+
+	assert.Equal(t, 0, calls)
+}
+
+// TestBatch_Panic tests that notifications are delivered when fn panics
+func TestBatch_Panic(t *testing.T) {
+	count := NewRef(0)
+	calls := 0
+	defer Watch(count, func(_, _ int) { calls++ })()
+
+	assert.Panics(t, func() {
+		Batch(func() {
+			count.Set(1)
+			panic("boom")
+		})
+	})
+
+	assert.Equal(t, 1, calls)
+	assert.False(t, inBatch())
+}
+
+// TestBatch_WatchersMaySet tests that watchers setting refs while the batch
+// is delivered notify immediately
+func TestBatch_WatchersMaySet(t *testing.T) {
+	source := NewRef(0)
+	mirror := NewRef(0)
+	var mirrored []int
+	defer Watch(source, func(n, _ int) { mirror.Set(n * 2) })()
+	defer Watch(mirror, func(n, _ int) { mirrored = append(mirrored, n) })()
+
+	Batch(func() {
+		source.Set(1)
+		source.Set(2)
+	})
+
+	assert.Equal(t, []int{4}, mirrored)
+}
@@ -230,6 +230,8 @@ func (b *ComponentBuilder) Children(children ...Component) *ComponentBuilder {
 	for _, child := range children {
 		if childImpl, ok := child.(*componentImpl); ok {
 			childImpl.parent = b.component
+		} else if aware, ok := child.(parentAware); ok {
+			aware.setParent(b.component)
 		}
 	}
 
@@ -580,6 +582,30 @@ func (b *ComponentBuilder) WithMessageHandler(handler MessageHandler) *Component
 	return b
 }
 
+// Focusable marks the component as a focus target.
+// Focusable components are discovered by focus managers (see
+// composables.UseFocusManager) when walking a component tree, and are
+// visited in depth-first order when cycling with Tab/Shift+Tab.
+//
+// Marking a component focusable has no effect on rendering; components
+// decide how to present their focused state themselves.
+//
+// Example:
+//
+//	input, _ := NewComponent("Input").
+//	    Focusable().
+//	    Template(func(ctx RenderContext) string {
+//	        return "..."
+//	    }).
+//	    Build()
+//
+// Returns:
+//   - *ComponentBuilder: The builder for method chaining
+func (b *ComponentBuilder) Focusable() *ComponentBuilder {
+	b.component.focusable = true
+	return b
+}
+
 // Build validates the component configuration and returns the final Component.
 // This is the terminal method in the builder chain that performs validation
 // and creates the component instance.
@@ -11,6 +11,9 @@ var (
 
 	// ErrChildNotFound is returned when attempting to remove a child that doesn't exist
 	ErrChildNotFound = errors.New("child component not found")
+
+	// ErrDuplicateChildKey is returned when keyed children share a key
+	ErrDuplicateChildKey = errors.New("duplicate child key")
 )
 
 // Children returns a copy of the component's children slice.
@@ -119,8 +122,13 @@ func (c *componentImpl) AddChild(child Component) error {
 	// Set parent reference
 	if childImpl, ok := child.(*componentImpl); ok {
 		childImpl.parent = c
+	} else if aware, ok := child.(parentAware); ok {
+		aware.setParent(c)
 	}
 
+	// The template may render the new child
+	c.invalidateMemo()
+
 	// Notify hook after successful add
 	notifyHookChildAdded(c.id, child.ID())
 
@@ -178,11 +186,22 @@ func (c *componentImpl) RemoveChild(child Component) error {
 	// Remove child from slice
 	c.children = append(c.children[:foundIndex], c.children[foundIndex+1:]...)
 
+	// Forget its key if it was a keyed child
+	for key, keyed := range c.childKeys {
+		if keyed.ID() == childID {
+			delete(c.childKeys, key)
+		}
+	}
+
 	// Clear parent reference
 	if childImpl, ok := child.(*componentImpl); ok {
 		childImpl.parent = nil
+	} else if aware, ok := child.(parentAware); ok {
+		aware.setParent(nil)
 	}
 
+	c.invalidateMemo()
+
 	// Notify hook after successful remove
 	notifyHookChildRemoved(c.id, childID)
 
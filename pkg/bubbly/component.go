@@ -243,8 +243,9 @@ type componentImpl struct {
 	//nolint:unused // Will be used in Task 5.1
 	parent *componentImpl // Parent component (for inject tree traversal and event bubbling)
 	//nolint:unused // Will be used in Task 5.1
-	children   []Component  // Child components
-	childrenMu sync.RWMutex // Protects children slice
+	children   []Component          // Child components
+	childKeys  map[string]Component // Keyed children (see Context.ReconcileChildren)
+	childrenMu sync.RWMutex         // Protects children slice and childKeys
 
 	// Provide/Inject (Composition API)
 	provides      map[string]interface{} // Provided values for dependency injection
@@ -256,6 +257,21 @@ type componentImpl struct {
 	handlersMu sync.RWMutex              // Protects handlers map
 	handlers   map[string][]EventHandler // Event name -> handlers
 
+	// Events emitted before setup (see WithQueuedEvents)
+	eventQueueMu sync.Mutex    // Serializes queueing with setup completion
+	queueEvents  bool          // Whether early events are buffered
+	setupDone    atomic.Bool   // Whether Init() has finished running setup
+	queuedEvents []queuedEvent // Buffered events awaiting replay
+
+	// Event middleware (see UseEventMiddleware)
+	eventMiddlewareMu      sync.RWMutex      // Protects middleware slices
+	eventMiddleware        []EventMiddleware // Wraps this component's handlers
+	subtreeEventMiddleware []EventMiddleware // Wraps events emitted in the subtree
+
+	// Request/response handlers (see Request)
+	requestHandlersMu sync.RWMutex              // Protects requestHandlers map
+	requestHandlers   map[string]RequestHandler // Request name -> handler
+
 	// Command generation (Automatic Reactive Bridge - Feature 08)
 	commandQueue   *CommandQueue    // Queue for pending commands from state changes
 	commandGen     CommandGenerator // Generator for creating commands from state changes
@@ -266,6 +282,10 @@ type componentImpl struct {
 	inTemplate   bool         // Whether currently executing inside template function
 	inTemplateMu sync.RWMutex // Protects inTemplate flag
 
+	// Error boundary state (OnErrorCaptured)
+	capturedErr   error        // Last error handled by this component's boundary hooks
+	capturedErrMu sync.RWMutex // Protects capturedErr
+
 	// Loop detection (Automatic Reactive Bridge - Feature 08)
 	loopDetector *loopDetector // Detects infinite command generation loops
 
@@ -276,9 +296,29 @@ type componentImpl struct {
 	keyBindings   map[string][]KeyBinding // Key -> []Binding (supports multiple bindings per key)
 	keyBindingsMu sync.RWMutex            // Protects keyBindings map
 
+	// Key handlers registered by the template (see RenderContext.OnKey)
+	templateKeys      map[string]func(tea.KeyMsg) // Key pattern -> handler from the last render
+	templateKeyEvents map[string]bool             // Key patterns with an event handler registered
+	templateKeysMu    sync.Mutex                  // Protects templateKeys and templateKeyEvents
+
 	// Message handler (Automatic Reactive Bridge - Feature 08, Task 8.4)
 	messageHandler MessageHandler // Optional handler for complex message processing
 
+	// Focus management
+	focusable bool // Whether the component participates in focus cycling
+
+	// Render cache (see Memo); nil unless memoized
+	memo *memoState
+
+	// Slots (see WithSlot); set by the builder and read-only afterwards
+	slots       map[string]SlotFunc   // Slot name -> render fragment
+	scopedSlots map[string]ScopedSlot // Scoped slot name -> render fragment
+
+	// Layout measurement
+	layout   layoutInfo   // Rendered size and resolved screen bounds
+	layoutMu sync.RWMutex // Protects layout
+	tracking atomic.Int32 // Active RenderWithPositions calls on this component
+
 	// Lifecycle
 	lifecycle *LifecycleManager // Lifecycle manager for hooks
 	//nolint:unused // Will be used in Task 1.3
@@ -287,6 +327,12 @@ type componentImpl struct {
 	// Initialization tracking (Task 10.1: Auto-Initialization Enhancement)
 	initialized bool       // Whether Init() has been called and setup has executed
 	initMu      sync.Mutex // Protects initialized flag for thread-safe initialization
+
+	// Lifecycle inspection (see LifecycleState)
+	rendered atomic.Bool  // Whether View() has run (the component is mounted)
+	disposed atomic.Bool  // Whether Unmount() has run
+	renders  atomic.Int64 // View() calls since mount
+	updates  atomic.Int64 // Update() calls since mount
 }
 
 // newComponentImpl creates a new component instance with the given name.
@@ -462,6 +508,15 @@ func (c *componentImpl) HelpText() string {
 //
 //	component.Emit("submit", FormData{Username: "user"})
 func (c *componentImpl) Emit(eventName string, data interface{}) {
+	// Buffer events emitted before setup when queueing is enabled, and flag
+	// early events that no handler will receive otherwise
+	if !c.setupDone.Load() {
+		if c.queueEventBeforeSetup(eventName, data) {
+			return
+		}
+		c.warnUnhandledEarlyEvent(eventName)
+	}
+
 	// Notify framework hooks that event is being emitted
 	notifyHookEvent(c.id, eventName, data)
 
@@ -475,8 +530,13 @@ func (c *componentImpl) Emit(eventName string, data interface{}) {
 	event.Timestamp = time.Now()
 	event.Stopped = false
 
-	// Start event bubbling from this component
-	c.bubbleEvent(event)
+	// Start event bubbling from this component, wrapped by any subtree
+	// middleware registered on this component or its ancestors
+	if chain := c.subtreeEventMiddlewareChain(); len(chain) > 0 {
+		applyEventMiddleware(chain, c.bubbleEvent)(event)
+	} else {
+		c.bubbleEvent(event)
+	}
 
 	// Return event to pool after bubbling completes
 	// Safe because bubbling is synchronous (no goroutines)
@@ -545,6 +605,9 @@ func (c *componentImpl) Init() tea.Cmd {
 	}
 	notifyHookComponentMount(c.id, c.name)
 
+	// Replay events emitted before setup registered their handlers
+	c.finishSetup()
+
 	// Initialize child components
 	if len(c.children) > 0 {
 		cmds := make([]tea.Cmd, len(c.children))
@@ -623,6 +686,18 @@ func (c *componentImpl) updateChildren(msg tea.Msg) []tea.Cmd {
 	return childCmds
 }
 
+// executeBeforeUpdateHooks executes onBeforeUpdate hooks for msg. Like
+// onUpdated, a StateChangedMsg only counts for the component it names.
+func (c *componentImpl) executeBeforeUpdateHooks(msg tea.Msg) {
+	if c.lifecycle == nil {
+		return
+	}
+	if stateMsg, ok := msg.(StateChangedMsg); ok && stateMsg.ComponentID != c.id {
+		return
+	}
+	c.lifecycle.executeBeforeUpdate()
+}
+
 // executeNonStateUpdatedHooks executes onUpdated hooks for non-StateChangedMsg.
 func (c *componentImpl) executeNonStateUpdatedHooks(msg tea.Msg) {
 	if _, isStateChanged := msg.(StateChangedMsg); !isStateChanged {
@@ -648,6 +723,13 @@ func (c *componentImpl) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Notify framework hooks that component is updating
 	notifyHookComponentUpdate(c.id, msg)
 
+	if c.rendered.Load() && !c.disposed.Load() {
+		c.updates.Add(1)
+	}
+
+	// Execute onBeforeUpdate hooks before the message changes any state
+	c.executeBeforeUpdateHooks(msg)
+
 	// Auto-handle WindowSizeMsg - emit "windowResize" event (Task 6.1: Zero Bubbletea Boilerplate)
 	// This fires BEFORE messageHandler to ensure backward compatibility with existing code
 	// that uses WithMessageHandler for resize handling.
@@ -670,6 +752,7 @@ func (c *componentImpl) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if c.handleKeyBindings(keyMsg) {
 			return c, tea.Quit
 		}
+		c.handleTemplateKeys(keyMsg)
 	}
 
 	// Handle StateChangedMsg from automatic reactive bridge
@@ -677,6 +760,11 @@ func (c *componentImpl) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		c.handleStateChangedMsg(stateMsg)
 	}
 
+	// Swap the template during development (see TemplateReloadMsg)
+	if reloadMsg, ok := msg.(TemplateReloadMsg); ok {
+		c.handleTemplateReloadMsg(reloadMsg)
+	}
+
 	// Update child components
 	if len(c.children) > 0 {
 		cmds = append(cmds, c.updateChildren(msg)...)
@@ -720,7 +808,12 @@ func (c *componentImpl) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (c *componentImpl) View() string {
 	// Track render timing for framework hooks
 	start := time.Now()
+	skipped := false
 	defer func() {
+		if skipped {
+			notifyHookRenderSkipped(c.id)
+			return
+		}
 		duration := time.Since(start)
 		notifyHookRenderComplete(c.id, duration)
 	}()
@@ -729,11 +822,22 @@ func (c *componentImpl) View() string {
 	if c.lifecycle != nil && !c.lifecycle.IsMounted() {
 		c.lifecycle.executeMounted()
 	}
+	c.rendered.Store(true)
+	c.renders.Add(1)
 
 	if c.template == nil {
 		return ""
 	}
 
+	// Memoized components reuse their output while their inputs are unchanged,
+	// except when RenderWithPositions needs their descendants' markers
+	if c.memo != nil && !c.positionsTracked() {
+		if output, ok := c.memo.lookup(c.props); ok {
+			skipped = true
+			return c.finishView(output)
+		}
+	}
+
 	// Mark template context as active
 	// Use Context to access the methods (though we could access component directly)
 	ctx := Context{component: c}
@@ -742,9 +846,42 @@ func (c *componentImpl) View() string {
 	// Ensure we exit template context even if template panics
 	defer ctx.exitTemplate()
 
-	// Render with RenderContext
+	// Key handlers are re-registered by every render
+	c.resetTemplateKeys()
+
+	// Render with RenderContext; a panic handled by an error boundary
+	// renders nothing
 	renderCtx := RenderContext{component: c}
-	return c.template(renderCtx)
+	output, ok := c.safeRender(func() string {
+		if c.memo != nil {
+			return c.memo.render(c.props, func() string { return c.template(renderCtx) })
+		}
+		return c.template(renderCtx)
+	})
+	if !ok {
+		return ""
+	}
+
+	return c.finishView(output)
+}
+
+// finishView records the rendered output for Measure() and marks it as a
+// mouse zone.
+func (c *componentImpl) finishView(output string) string {
+	c.setOutput(output)
+
+	// Mark the output as a mouse hit zone when mouse support is enabled, or
+	// for RenderWithPositions; only then is the output measured eagerly
+	track := c.positionsTracked()
+	if !track && !MouseZonesEnabled() {
+		return output
+	}
+	width, height := c.Measure()
+	target := zoneTarget{component: c, width: width, height: height}
+	if track {
+		return globalMouseZones.register(output, target)
+	}
+	return globalMouseZones.mark(output, target)
 }
 
 // Unmount cleans up the component and its children.
@@ -771,6 +908,7 @@ func (c *componentImpl) View() string {
 func (c *componentImpl) Unmount() {
 	// Notify framework hooks that component is unmounting
 	notifyHookComponentUnmount(c.id)
+	c.disposed.Store(true)
 
 	// Execute lifecycle cleanup (onUnmounted hooks + cleanup functions)
 	if c.lifecycle != nil {
@@ -784,10 +922,14 @@ func (c *componentImpl) Unmount() {
 	c.handlers = make(map[string][]EventHandler)
 	c.handlersMu.Unlock()
 
+	c.requestHandlersMu.Lock()
+	c.requestHandlers = nil
+	c.requestHandlersMu.Unlock()
+
 	// Unmount children recursively
 	for _, child := range c.children {
-		if impl, ok := child.(*componentImpl); ok {
-			impl.Unmount()
+		if unmounter, ok := child.(interface{ Unmount() }); ok {
+			unmounter.Unmount()
 		}
 	}
 }
@@ -1,6 +1,7 @@
 package composables
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -60,7 +61,7 @@ func BenchmarkUseAsync(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		async := UseAsync(ctx, func() (*int, error) {
+		async := UseAsync(ctx, func(_ context.Context) (*int, error) {
 			result := 42
 			return &result, nil
 		})
@@ -72,7 +73,7 @@ func BenchmarkUseAsync(b *testing.B) {
 func BenchmarkUseAsync_Execute(b *testing.B) {
 	ctx := bubbly.NewTestContext()
 	executed := 0
-	async := UseAsync(ctx, func() (*int, error) {
+	async := UseAsync(ctx, func(_ context.Context) (*int, error) {
 		executed++
 		result := executed
 		return &result, nil
@@ -421,33 +422,6 @@ func BenchmarkMemory_MultipleComposables(b *testing.B) {
 	}
 }
 
-// ============================================================================
-// Helper Functions
-// ============================================================================
-
-// MemoryStorage is a simple in-memory storage for benchmarks
-type MemoryStorage struct {
-	data map[string][]byte
-}
-
-func NewMemoryStorage() *MemoryStorage {
-	return &MemoryStorage{
-		data: make(map[string][]byte),
-	}
-}
-
-func (m *MemoryStorage) Load(key string) ([]byte, error) {
-	if data, ok := m.data[key]; ok {
-		return data, nil
-	}
-	return nil, nil
-}
-
-func (m *MemoryStorage) Save(key string, data []byte) error {
-	m.data[key] = data
-	return nil
-}
-
 // ============================================================================
 // Multi-CPU Scaling Benchmarks
 // ============================================================================
@@ -564,7 +538,7 @@ func BenchmarkMemoryGrowth_ManyComposables(b *testing.B) {
 		_ = UseState(ctx, 0)
 		_ = UseState(ctx, "test")
 		_ = UseState(ctx, true)
-		_ = UseAsync(ctx, func() (*int, error) {
+		_ = UseAsync(ctx, func(_ context.Context) (*int, error) {
 			result := 42
 			return &result, nil
 		})
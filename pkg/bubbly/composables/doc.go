@@ -33,8 +33,8 @@ Use composables in a component's Setup function:
 	        count.Set(count.Get() + 1)
 
 	        // Async data fetching
-	        userData := composables.UseAsync(ctx, func() (*User, error) {
-	            return fetchUser()
+	        userData := composables.UseAsync(ctx, func(c context.Context) (*User, error) {
+	            return fetchUser(c)
 	        })
 
 	        ctx.OnMounted(func() {
@@ -67,19 +67,51 @@ UseEffect: Side effect management with dependency tracking.
 
 UseAsync[T]: Async data fetching with loading, error, and data states.
 
-	async := composables.UseAsync(ctx, func() (*User, error) {
-	    return api.FetchUser()
+	async := composables.UseAsync(ctx, func(c context.Context) (*User, error) {
+	    return api.FetchUser(c)
 	})
 	async.Execute()                // Trigger fetch
 	user := async.Data.Get()       // Access result
 	loading := async.Loading.Get() // Check loading state
 
+UseFetch[T]: Keyed fetching with a shared cache and stale-while-revalidate.
+
+	user := composables.UseFetch(ctx, "user:"+id, api.GetUser,
+	    composables.WithStaleTime(30*time.Second))
+	composables.InvalidateFetch("user:" + id) // After a mutation
+
+UseCircuitBreaker[T]: Fail-fast protection for flaky backends.
+
+	breaker := composables.UseCircuitBreaker(ctx, api.FetchUser,
+	    composables.WithFailureThreshold(3, 5),
+	    composables.WithCooldown(10*time.Second),
+	)
+	user, err := breaker.Call(c)  // ErrCircuitOpen while the circuit is open
+	state := breaker.State.Get()  // closed, open, or half-open
+
+UseTask: Background job with reported progress and status.
+
+	task := composables.UseTask(ctx, func(report composables.TaskReportFunc) error {
+	    report(0.5, "Halfway there")
+	    return nil
+	})
+	task.Run()
+	progress := task.Progress.Get() // 0 to 1, alongside Status, State, and Error
+
 UseDebounce[T]: Debounced reactive values with configurable delay.
 
 	searchTerm := ctx.Ref("")
 	debounced := composables.UseDebounce(ctx, searchTerm, 300*time.Millisecond)
 	// debounced updates only after 300ms of no changes to searchTerm
 
+UseSearchableList[T]: Debounced search over a slice with match ranges for highlighting.
+
+	search := composables.UseSearchableList(ctx, products,
+	    composables.MatchSubstring(func(p Product) string { return p.Name }))
+	search.Query.Set("key")           // Filters after the search delay
+	visible := search.Items.Get()     // Matching items, for List.Items
+	// Pass search.Highlights to ListProps.Highlights to emphasize matches
+
 UseThrottle: Throttled function execution for rate limiting.
 
 	handleScroll := func() { updateScrollPosition() }
@@ -104,6 +136,32 @@ UseForm[T]: Form state management with validation support.
 	form.SetField("Email", "user@example.com")
 	form.Submit() // Validates and submits if valid
 
+Per-field validators (AddFieldValidator, and AddAsyncFieldValidator for slow
+checks tracked by Validating) run for touched fields as the user types.
+
+UseAutoSave[T]: Debounced saving of a form with a reactive save state.
+
+	autoSave := composables.UseAutoSave(ctx, form, saveNote, time.Second)
+	state := autoSave.State.Get() // idle, saving, saved, or error
+
+UseTransition: Eased animation of a float toward its target value.
+
+	smooth := composables.UseTransition(ctx, progress, 300*time.Millisecond,
+	    composables.EaseOutCubic)
+	value := smooth.Value.Get() // Moves gradually whenever progress changes
+
+UseClipboard: Copy and paste with the system clipboard and a brief Copied flag.
+
+	clip := composables.UseClipboard(ctx)
+	err := clip.Copy(url)          // clip.Copied is true for a moment
+	text, err := clip.Read()
+
+UseClipboardHistory: Bounded history of copied values with exclusion of secrets.
+
+	history := composables.UseClipboardHistory(ctx, 20)
+	history.Record(copied)       // Newest entries first in history.Recent
+	text, ok := history.Paste(0) // Most recent entry
+
 UseLocalStorage[T]: Persistent state with JSON serialization.
 
 	storage := composables.NewFileStorage("/path/to/data")
@@ -112,6 +170,11 @@ UseLocalStorage[T]: Persistent state with JSON serialization.
 	}, storage)
 	// Automatically saved to disk on changes
 
+Besides FileStorage, NewMemoryStorage keeps values in memory (for tests) and
+NewEncryptedStorage wraps another storage with AES-GCM encryption. Wrap the
+storage with NewBatchStorage to coalesce writes of several keys, and pass
+Migrate options to upgrade values stored by older schema versions.
+
 UseEventListener: Event handling with automatic cleanup.
 
 	cleanup := composables.UseEventListener(ctx, "click", func() {
@@ -267,8 +330,8 @@ Form validation:
 Async data loading:
 
 	Setup(func(ctx *bubbly.Context) {
-	    userData := composables.UseAsync(ctx, func() (*User, error) {
-	        return api.FetchUser(userID)
+	    userData := composables.UseAsync(ctx, func(c context.Context) (*User, error) {
+	        return api.FetchUser(c, userID)
 	    })
 
 	    ctx.OnMounted(func() {
@@ -145,4 +145,45 @@ var (
 	//   - Don't share composable state across components
 	//   - Ensure thread-safe access to shared state
 	ErrInvalidComposableState = errors.New("composable is in an invalid state")
+
+	// ErrInvalidEncryptionKey occurs when NewEncryptedStorage is given a key
+	// that is not a valid AES key length.
+	//
+	// How to fix:
+	//   - Use a 16, 24, or 32 byte key (AES-128, AES-192, or AES-256)
+	//   - Derive keys from passphrases with a KDF rather than using them directly
+	ErrInvalidEncryptionKey = errors.New("encryption key must be 16, 24, or 32 bytes")
+
+	// ErrDecryptionFailed occurs when EncryptedStorage cannot decrypt stored data.
+	//
+	// This can happen when:
+	//   - The data was written with a different key
+	//   - The stored data was modified or truncated
+	//   - The data was stored under a different storage key
+	//
+	// UseLocalStorage treats this like any other load failure: the error is
+	// reported via observability and the initial value is used.
+	ErrDecryptionFailed = errors.New("failed to decrypt stored data")
+
+	// ErrCircuitOpen is returned by UseCircuitBreaker's Call when the circuit
+	// is open and the operation was not attempted.
+	//
+	// The circuit opens after too many recent failures and stays open for the
+	// configured cooldown, so a failing backend is not hammered with requests.
+	//
+	// How to handle:
+	//   - Show a "service unavailable" message instead of an error
+	//   - Check the breaker's State ref to disable actions while open
+	ErrCircuitOpen = errors.New("circuit breaker is open")
+
+	// ErrFetchTypeMismatch is returned by UseFetch when the shared result
+	// for its key has a different type than the one it fetches.
+	//
+	// This happens when two UseFetch calls use the same key with different
+	// result types, since results are cached and shared by key alone.
+	//
+	// How to fix:
+	//   - Use distinct keys for different result types (e.g., prefix keys
+	//     with the resource: "user:42", "user-summary:42")
+	ErrFetchTypeMismatch = errors.New("fetch result for key has a different type")
 )
@@ -1,6 +1,7 @@
 package composables
 
 import (
+	"context"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -111,7 +112,7 @@ func TestUseAsync_MetricsIntegration(t *testing.T) {
 	ctx := createTestContext()
 
 	// Use composable
-	async := UseAsync(ctx, func() (*string, error) {
+	async := UseAsync(ctx, func(_ context.Context) (*string, error) {
 		result := "test"
 		return &result, nil
 	})
@@ -157,7 +158,7 @@ func TestMetricsIntegration_ZeroOverheadWhenDisabled(t *testing.T) {
 		})
 		_ = form
 
-		async := UseAsync(ctx, func() (*int, error) {
+		async := UseAsync(ctx, func(_ context.Context) (*int, error) {
 			val := 123
 			return &val, nil
 		})
@@ -1,9 +1,16 @@
 package composables
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
@@ -28,9 +35,33 @@ type Storage interface {
 // FileStorage implements Storage using the local file system.
 // Each key corresponds to a file in the base directory.
 //
+// Writes are crash-safe: data is written to a temporary file in the same
+// directory, synced, and atomically renamed over the target, so a process
+// dying mid-write never leaves a truncated file behind. Saves also hold an
+// exclusive advisory lock (flock on Unix) on a "<key>.lock" file, so multiple
+// processes sharing a storage directory don't interleave writes.
+//
 // FileStorage is thread-safe and can be used concurrently.
 type FileStorage struct {
 	baseDir string
+	backup  bool
+	mu      sync.Mutex // Serializes saves within this process
+}
+
+// FileStorageOption configures a FileStorage.
+type FileStorageOption func(*FileStorage)
+
+// WithBackup enables backup-on-write: before each save replaces an existing
+// file, its previous contents are preserved in "<key>.bak". Use LoadBackup
+// to recover them.
+//
+// Example:
+//
+//	storage := NewFileStorage(configDir, WithBackup())
+func WithBackup() FileStorageOption {
+	return func(fs *FileStorage) {
+		fs.backup = true
+	}
 }
 
 // NewFileStorage creates a new FileStorage with the specified base directory.
@@ -38,6 +69,7 @@ type FileStorage struct {
 //
 // Parameters:
 //   - baseDir: The directory where storage files will be kept
+//   - opts: Optional configuration (e.g., WithBackup)
 //
 // Returns:
 //   - *FileStorage: A new file storage instance
@@ -46,10 +78,14 @@ type FileStorage struct {
 //
 //	storage := NewFileStorage("/home/user/.config/myapp")
 //	data, err := storage.Load("settings")
-func NewFileStorage(baseDir string) *FileStorage {
-	return &FileStorage{
+func NewFileStorage(baseDir string, opts ...FileStorageOption) *FileStorage {
+	fs := &FileStorage{
 		baseDir: baseDir,
 	}
+	for _, opt := range opts {
+		opt(fs)
+	}
+	return fs
 }
 
 // Load retrieves data from a file identified by the key.
@@ -78,10 +114,22 @@ func (fs *FileStorage) Load(key string) ([]byte, error) {
 	return data, nil
 }
 
+// LoadBackup retrieves the previous contents saved for key when backups
+// are enabled (see WithBackup).
+//
+// Returns os.ErrNotExist if no backup exists.
+func (fs *FileStorage) LoadBackup(key string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(fs.baseDir, key+".bak"))
+}
+
 // Save writes data to a file identified by the key.
 // The file path is constructed as baseDir/key.
 // Creates the base directory if it doesn't exist.
 //
+// The write is atomic (temp file + rename) and serialized across processes
+// with an advisory file lock. With WithBackup, the previous contents are
+// copied to "<key>.bak" first.
+//
 // Reports errors via observability system.
 func (fs *FileStorage) Save(key string, data []byte) error {
 	// Ensure base directory exists
@@ -99,7 +147,36 @@ func (fs *FileStorage) Save(key string, data []byte) error {
 
 	path := filepath.Join(fs.baseDir, key)
 
-	err = os.WriteFile(path, data, 0644)
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	unlock, err := acquireFileLock(path + ".lock")
+	if err != nil {
+		fs.reportError("lock_failed", err, map[string]string{
+			"error_type": "file_lock",
+			"key":        key,
+			"path":       path + ".lock",
+		}, map[string]interface{}{
+			"base_dir": fs.baseDir,
+		})
+		return err
+	}
+	defer unlock()
+
+	if fs.backup {
+		if err := fs.backupFile(path); err != nil {
+			fs.reportError("backup_failed", err, map[string]string{
+				"error_type": "file_backup",
+				"key":        key,
+				"path":       path + ".bak",
+			}, map[string]interface{}{
+				"base_dir": fs.baseDir,
+			})
+			return err
+		}
+	}
+
+	err = writeFileAtomic(path, data, 0644)
 	if err != nil {
 		fs.reportError("save_failed", err, map[string]string{
 			"error_type": "file_write",
@@ -116,6 +193,76 @@ func (fs *FileStorage) Save(key string, data []byte) error {
 	return nil
 }
 
+// backupFile copies the current contents of path to path.bak.
+// A missing file is not an error (there is nothing to back up yet).
+func (fs *FileStorage) backupFile(path string) error {
+	current, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return writeFileAtomic(path+".bak", current, 0644)
+}
+
+// writeFileAtomic writes data to a temporary file in the target directory,
+// syncs it, and renames it over path. Readers see either the old or the new
+// contents, never a partial write.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+
+	// Remove the temp file on any failure
+	committed := false
+	defer func() {
+		if !committed {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	committed = true
+	return nil
+}
+
+// acquireFileLock opens (creating if needed) the lock file at path and takes
+// an exclusive advisory lock on it. The returned function releases the lock.
+func acquireFileLock(path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return func() {
+		_ = unlockFile(f)
+		_ = f.Close()
+	}, nil
+}
+
 // reportError reports storage errors to the observability system.
 // Follows ZERO TOLERANCE policy - never silent failures.
 func (fs *FileStorage) reportError(operation string, err error, tags map[string]string, extra map[string]interface{}) {
@@ -143,3 +290,283 @@ func (fs *FileStorage) reportError(operation string, err error, tags map[string]
 
 	reporter.ReportError(err, ctx)
 }
+
+// MemoryStorage implements Storage in memory.
+//
+// MemoryStorage is the recommended storage for unit-testing composables that
+// persist state (UseLocalStorage, UsePersistedRef): it never touches disk,
+// behaves like FileStorage for missing keys, and can be inspected directly.
+//
+// MemoryStorage is thread-safe and can be used concurrently.
+//
+// Example:
+//
+//	storage := NewMemoryStorage()
+//	count := UseLocalStorage(ctx, "count", 0, storage)
+//	count.Set(5)
+//
+//	data, _ := storage.Load("count") // []byte("5")
+type MemoryStorage struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+}
+
+// NewMemoryStorage creates a new, empty MemoryStorage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		data: make(map[string][]byte),
+	}
+}
+
+// Load retrieves a copy of the data stored for key.
+// Returns os.ErrNotExist if the key has not been saved.
+func (ms *MemoryStorage) Load(key string) ([]byte, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	data, ok := ms.data[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// Save stores a copy of data for key.
+func (ms *MemoryStorage) Save(key string, data []byte) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.data[key] = append([]byte(nil), data...)
+	return nil
+}
+
+// Delete removes the data stored for key. Deleting a missing key is a no-op.
+func (ms *MemoryStorage) Delete(key string) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	delete(ms.data, key)
+}
+
+// Keys returns the keys currently stored, in no particular order.
+func (ms *MemoryStorage) Keys() []string {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	keys := make([]string, 0, len(ms.data))
+	for k := range ms.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// EncryptedStorage wraps another Storage and encrypts values with AES-GCM.
+//
+// Each Save uses a fresh random nonce, and the storage key is bound to the
+// ciphertext as additional authenticated data, so values can't be swapped
+// between keys undetected. Tampered data or a wrong encryption key causes
+// Load to return ErrDecryptionFailed, which is also reported via
+// observability: after a key rotation, values still sealed with the old key
+// fail loudly instead of decoding to garbage (UseLocalStorage then falls back
+// to its initial value).
+//
+// EncryptedStorage is thread-safe if the wrapped Storage is.
+//
+// Example:
+//
+//	key := loadKeyFromKeyring() // 32 bytes for AES-256
+//	secure, err := NewEncryptedStorage(NewFileStorage(configDir), key)
+//	if err != nil {
+//	    return err
+//	}
+//	token := UseLocalStorage(ctx, "apiToken", "", secure)
+type EncryptedStorage struct {
+	inner Storage
+	aead  cipher.AEAD
+}
+
+// NewEncryptedStorage creates an EncryptedStorage that encrypts values with
+// the given AES key before delegating to inner.
+//
+// The key must be 16, 24, or 32 bytes long to select AES-128, AES-192, or
+// AES-256. Returns ErrInvalidEncryptionKey otherwise.
+func NewEncryptedStorage(inner Storage, key []byte) (*EncryptedStorage, error) {
+	if inner == nil {
+		return nil, fmt.Errorf("encrypted storage requires an underlying storage")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, ErrInvalidEncryptionKey
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AES-GCM cipher: %w", err)
+	}
+
+	return &EncryptedStorage{
+		inner: inner,
+		aead:  aead,
+	}, nil
+}
+
+// Load retrieves and decrypts the data stored for key.
+// Errors from the wrapped storage (including os.ErrNotExist) are returned
+// unchanged; undecryptable data returns ErrDecryptionFailed.
+func (es *EncryptedStorage) Load(key string) ([]byte, error) {
+	sealed, err := es.inner.Load(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := es.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		es.reportDecryptionFailure(key, "truncated", len(sealed))
+		return nil, ErrDecryptionFailed
+	}
+
+	data, err := es.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], []byte(key))
+	if err != nil {
+		// Wrong key (e.g., rotated), tampered data, or data moved between keys
+		es.reportDecryptionFailure(key, "authentication", len(sealed))
+		return nil, ErrDecryptionFailed
+	}
+	return data, nil
+}
+
+// reportDecryptionFailure reports data for key that could not be decrypted.
+func (es *EncryptedStorage) reportDecryptionFailure(key, reason string, size int) {
+	reportComposableStorageError("EncryptedStorage", "decrypt_failed", ErrDecryptionFailed, map[string]string{
+		"error_type": "decryption",
+		"key":        key,
+		"reason":     reason,
+	}, map[string]interface{}{
+		"data_size": size,
+	})
+}
+
+// Save encrypts data and stores it in the wrapped storage.
+// The stored format is the random nonce followed by the sealed ciphertext.
+func (es *EncryptedStorage) Save(key string, data []byte) error {
+	nonce := make([]byte, es.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := es.aead.Seal(nonce, nonce, data, []byte(key))
+	return es.inner.Save(key, sealed)
+}
+
+// DefaultBatchWindow is the default time BatchStorage collects writes before
+// flushing them.
+const DefaultBatchWindow = 100 * time.Millisecond
+
+// BatchStorage wraps a Storage and coalesces writes: Save only records the
+// latest data for a key, and every key saved within the batch window is
+// written to the wrapped storage together in one flush. Use it when several
+// persisted settings change at once (e.g., "reset to defaults") to avoid a
+// burst of disk writes.
+//
+// Loads see pending writes, so values read back immediately are current.
+// Saves never fail; errors from the wrapped storage surface when the batch
+// flushes and are reported via observability (and returned by Flush).
+// UseLocalStorage flushes a BatchStorage when its component unmounts; call
+// Flush before the program exits so no pending write is lost.
+//
+// BatchStorage is thread-safe and can be used concurrently.
+//
+// Example:
+//
+//	storage := NewBatchStorage(NewFileStorage(configDir), DefaultBatchWindow)
+//	defer storage.Flush()
+//
+//	theme := UseLocalStorage(ctx, "theme", "dark", storage)
+//	fontSize := UseLocalStorage(ctx, "fontSize", 14, storage)
+type BatchStorage struct {
+	inner   Storage
+	window  time.Duration
+	mu      sync.Mutex
+	pending map[string][]byte
+	timer   *time.Timer
+	flushMu sync.Mutex // Keeps flushes in order
+}
+
+// NewBatchStorage creates a BatchStorage that flushes writes to inner window
+// after the first unflushed Save. A window of 0 or less uses
+// DefaultBatchWindow.
+func NewBatchStorage(inner Storage, window time.Duration) *BatchStorage {
+	if window <= 0 {
+		window = DefaultBatchWindow
+	}
+	return &BatchStorage{
+		inner:   inner,
+		window:  window,
+		pending: make(map[string][]byte),
+	}
+}
+
+// Load returns the pending data for key if it has not been flushed yet,
+// and otherwise loads it from the wrapped storage.
+func (bs *BatchStorage) Load(key string) ([]byte, error) {
+	bs.mu.Lock()
+	data, ok := bs.pending[key]
+	bs.mu.Unlock()
+
+	if ok {
+		return append([]byte(nil), data...), nil
+	}
+	return bs.inner.Load(key)
+}
+
+// Save records data for key, to be written by the next flush. It replaces
+// any earlier pending data for the same key.
+func (bs *BatchStorage) Save(key string, data []byte) error {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+
+	bs.pending[key] = append([]byte(nil), data...)
+	if bs.timer == nil {
+		bs.timer = time.AfterFunc(bs.window, func() {
+			_ = bs.Flush()
+		})
+	}
+	return nil
+}
+
+// Flush writes every pending key to the wrapped storage now, in key order.
+// Keys that fail to save are reported via observability and dropped; the
+// returned error joins their errors.
+func (bs *BatchStorage) Flush() error {
+	bs.flushMu.Lock()
+	defer bs.flushMu.Unlock()
+
+	bs.mu.Lock()
+	if bs.timer != nil {
+		bs.timer.Stop()
+		bs.timer = nil
+	}
+	batch := bs.pending
+	bs.pending = make(map[string][]byte)
+	bs.mu.Unlock()
+
+	keys := make([]string, 0, len(batch))
+	for key := range batch {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		if err := bs.inner.Save(key, batch[key]); err != nil {
+			reportComposableStorageError("BatchStorage", "flush_failed", err, map[string]string{
+				"error_type": "storage_save",
+				"key":        key,
+			}, map[string]interface{}{
+				"batch_size": len(batch),
+			})
+			errs = append(errs, fmt.Errorf("flush %s: %w", key, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package composables
+
+import "os"
+
+// lockFile is a no-op on platforms without flock. Writes remain atomic, and
+// saves are still serialized within a single process.
+func lockFile(_ *os.File) error {
+	return nil
+}
+
+// unlockFile is a no-op on platforms without flock.
+func unlockFile(_ *os.File) error {
+	return nil
+}
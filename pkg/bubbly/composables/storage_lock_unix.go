@@ -0,0 +1,23 @@
+//go:build unix
+
+package composables
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile takes an exclusive flock on f, blocking until it is available.
+func lockFile(f *os.File) error {
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			return err
+		}
+	}
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,362 @@
+package composables
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// TestMemoryStorage tests the in-memory Storage implementation
+func TestMemoryStorage(t *testing.T) {
+	var _ Storage = (*MemoryStorage)(nil)
+
+	storage := NewMemoryStorage()
+
+	_, err := storage.Load("missing")
+	assert.True(t, os.IsNotExist(err), "missing keys return os.ErrNotExist")
+
+	data := []byte(`{"a":1}`)
+	require.NoError(t, storage.Save("k", data))
+	data[0] = 'X' // caller mutation must not leak into storage
+
+	loaded, err := storage.Load("k")
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1}`, string(loaded))
+
+	loaded[0] = 'Y'
+	again, _ := storage.Load("k")
+	assert.Equal(t, `{"a":1}`, string(again), "returned data is a copy")
+
+	assert.Equal(t, []string{"k"}, storage.Keys())
+	storage.Delete("k")
+	_, err = storage.Load("k")
+	assert.True(t, os.IsNotExist(err))
+}
+
+// TestMemoryStorage_WithUseLocalStorage tests MemoryStorage as a UseLocalStorage backend
+func TestMemoryStorage_WithUseLocalStorage(t *testing.T) {
+	storage := NewMemoryStorage()
+	ctx := createTestContext()
+
+	count := UseLocalStorage(ctx, "count", 0, storage)
+	count.Set(5)
+
+	data, err := storage.Load("count")
+	require.NoError(t, err)
+	assert.Equal(t, "5", string(data))
+
+	reloaded := UseLocalStorage(ctx, "count", 0, storage)
+	assert.Equal(t, 5, reloaded.Get())
+}
+
+// TestNewEncryptedStorage_KeyValidation tests AES key length validation
+func TestNewEncryptedStorage_KeyValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyLen  int
+		wantErr error
+	}{
+		{name: "AES-128", keyLen: 16},
+		{name: "AES-192", keyLen: 24},
+		{name: "AES-256", keyLen: 32},
+		{name: "too short", keyLen: 8, wantErr: ErrInvalidEncryptionKey},
+		{name: "empty", keyLen: 0, wantErr: ErrInvalidEncryptionKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, err := NewEncryptedStorage(NewMemoryStorage(), make([]byte, tt.keyLen))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, storage)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, storage)
+		})
+	}
+
+	_, err := NewEncryptedStorage(nil, make([]byte, 32))
+	assert.Error(t, err)
+}
+
+// TestEncryptedStorage_RoundTrip tests that values are encrypted at rest and decrypted on load
+func TestEncryptedStorage_RoundTrip(t *testing.T) {
+	var _ Storage = (*EncryptedStorage)(nil)
+
+	inner := NewMemoryStorage()
+	key := bytes.Repeat([]byte{7}, 32)
+	storage, err := NewEncryptedStorage(inner, key)
+	require.NoError(t, err)
+
+	secret := []byte(`"hunter2"`)
+	require.NoError(t, storage.Save("token", secret))
+
+	raw, err := inner.Load("token")
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(raw, []byte("hunter2")), "plaintext must not be stored")
+
+	loaded, err := storage.Load("token")
+	require.NoError(t, err)
+	assert.Equal(t, secret, loaded)
+
+	// Fresh nonce per save
+	require.NoError(t, storage.Save("token", secret))
+	raw2, _ := inner.Load("token")
+	assert.NotEqual(t, raw, raw2)
+}
+
+// TestEncryptedStorage_LoadFailures tests missing keys, wrong keys, tampering, and key swapping
+func TestEncryptedStorage_LoadFailures(t *testing.T) {
+	inner := NewMemoryStorage()
+	storage, err := NewEncryptedStorage(inner, bytes.Repeat([]byte{1}, 16))
+	require.NoError(t, err)
+	require.NoError(t, storage.Save("a", []byte("alpha")))
+
+	t.Run("missing key passes through not exist", func(t *testing.T) {
+		_, err := storage.Load("missing")
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		other, err := NewEncryptedStorage(inner, bytes.Repeat([]byte{2}, 16))
+		require.NoError(t, err)
+		_, err = other.Load("a")
+		assert.ErrorIs(t, err, ErrDecryptionFailed)
+	})
+
+	t.Run("tampered data", func(t *testing.T) {
+		raw, _ := inner.Load("a")
+		raw[len(raw)-1] ^= 0xff
+		require.NoError(t, inner.Save("tampered", raw))
+		_, err := storage.Load("tampered")
+		assert.ErrorIs(t, err, ErrDecryptionFailed)
+	})
+
+	t.Run("value moved to another key", func(t *testing.T) {
+		raw, _ := inner.Load("a")
+		require.NoError(t, inner.Save("b", raw))
+		_, err := storage.Load("b")
+		assert.ErrorIs(t, err, ErrDecryptionFailed)
+	})
+
+	t.Run("truncated data", func(t *testing.T) {
+		require.NoError(t, inner.Save("short", []byte{1, 2}))
+		_, err := storage.Load("short")
+		assert.ErrorIs(t, err, ErrDecryptionFailed)
+	})
+}
+
+// TestEncryptedStorage_ReportsDecryptionFailure tests that undecryptable
+// data, such as values sealed before a key rotation, is reported
+func TestEncryptedStorage_ReportsDecryptionFailure(t *testing.T) {
+	var reported []*observability.ErrorContext
+	observability.SetErrorReporter(&testStorageErrorReporter{
+		onError: func(_ error, ctx *observability.ErrorContext) {
+			reported = append(reported, ctx)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	inner := NewMemoryStorage()
+	oldKey, err := NewEncryptedStorage(inner, bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+	newKey, err := NewEncryptedStorage(inner, bytes.Repeat([]byte{2}, 32))
+	require.NoError(t, err)
+	require.NoError(t, oldKey.Save("token", []byte(`"secret"`)))
+
+	// UseLocalStorage falls back to the initial value
+	token := UseLocalStorage(createTestContext(), "token", "none", newKey)
+	assert.Equal(t, "none", token.Get())
+
+	require.NotEmpty(t, reported)
+	decrypt := reported[0]
+	assert.Equal(t, "EncryptedStorage", decrypt.ComponentName)
+	assert.Equal(t, "decrypt_failed", decrypt.EventName)
+	assert.Equal(t, "decryption", decrypt.Tags["error_type"])
+	assert.Equal(t, "authentication", decrypt.Tags["reason"])
+	assert.Equal(t, "token", decrypt.Tags["key"])
+
+	// Missing keys are not failures
+	reported = nil
+	_, err = newKey.Load("missing")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.Empty(t, reported)
+}
+
+// TestFileStorage_AtomicSave tests that saves replace files without leaving temp files
+func TestFileStorage_AtomicSave(t *testing.T) {
+	dir := t.TempDir()
+	storage := NewFileStorage(dir)
+
+	require.NoError(t, storage.Save("settings", []byte("v1")))
+	require.NoError(t, storage.Save("settings", []byte("v2")))
+
+	loaded, err := storage.Load("settings")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(loaded))
+
+	info, err := os.Stat(filepath.Join(dir, "settings"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	temps, err := filepath.Glob(filepath.Join(dir, ".settings.tmp-*"))
+	require.NoError(t, err)
+	assert.Empty(t, temps, "temp files are renamed or removed")
+
+	_, err = storage.LoadBackup("settings")
+	assert.True(t, os.IsNotExist(err), "no backup without WithBackup")
+}
+
+// TestFileStorage_WithBackup tests backup-on-write
+func TestFileStorage_WithBackup(t *testing.T) {
+	storage := NewFileStorage(t.TempDir(), WithBackup())
+
+	require.NoError(t, storage.Save("k", []byte("first")))
+	_, err := storage.LoadBackup("k")
+	assert.True(t, os.IsNotExist(err), "first save has nothing to back up")
+
+	require.NoError(t, storage.Save("k", []byte("second")))
+	backup, err := storage.LoadBackup("k")
+	require.NoError(t, err)
+	assert.Equal(t, "first", string(backup))
+
+	require.NoError(t, storage.Save("k", []byte("third")))
+	backup, err = storage.LoadBackup("k")
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(backup))
+
+	current, err := storage.Load("k")
+	require.NoError(t, err)
+	assert.Equal(t, "third", string(current))
+}
+
+// TestFileStorage_ConcurrentSaves tests that concurrent writers never produce torn files
+func TestFileStorage_ConcurrentSaves(t *testing.T) {
+	dir := t.TempDir()
+
+	// Separate instances simulate independent writers sharing a directory
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			storage := NewFileStorage(dir, WithBackup())
+			payload := bytes.Repeat([]byte(fmt.Sprintf("%d", i)), 4096)
+			for j := 0; j < 10; j++ {
+				assert.NoError(t, storage.Save("shared", payload))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	loaded, err := NewFileStorage(dir).Load("shared")
+	require.NoError(t, err)
+	require.Len(t, loaded, 4096)
+	assert.Equal(t, bytes.Repeat(loaded[:1], 4096), loaded, "file contains one writer's payload")
+
+	temps, _ := filepath.Glob(filepath.Join(dir, ".*.tmp-*"))
+	assert.Empty(t, temps)
+}
+
+// TestBatchStorage_CoalescesWrites tests that saves within the window are
+// flushed together, once per key
+func TestBatchStorage_CoalescesWrites(t *testing.T) {
+	inner := newPersistTestStorage()
+	batch := NewBatchStorage(inner, 20*time.Millisecond)
+
+	require.NoError(t, batch.Save("theme", []byte(`"light"`)))
+	require.NoError(t, batch.Save("theme", []byte(`"dark"`)))
+	require.NoError(t, batch.Save("fontSize", []byte(`14`)))
+
+	// Pending values are visible before the flush
+	data, err := batch.Load("theme")
+	require.NoError(t, err)
+	assert.Equal(t, `"dark"`, string(data))
+	_, saves := inner.get("theme")
+	assert.Equal(t, 0, saves)
+
+	assert.Eventually(t, func() bool {
+		_, saves := inner.get("theme")
+		return saves == 2
+	}, time.Second, time.Millisecond)
+
+	theme, _ := inner.get("theme")
+	fontSize, _ := inner.get("fontSize")
+	assert.Equal(t, `"dark"`, theme)
+	assert.Equal(t, `14`, fontSize)
+}
+
+// TestBatchStorage_Flush tests explicit flushes and flush failures
+func TestBatchStorage_Flush(t *testing.T) {
+	t.Run("writes pending keys immediately", func(t *testing.T) {
+		inner := newPersistTestStorage()
+		batch := NewBatchStorage(inner, time.Hour)
+
+		require.NoError(t, batch.Save("a", []byte(`1`)))
+		require.NoError(t, batch.Flush())
+
+		value, saves := inner.get("a")
+		assert.Equal(t, `1`, value)
+		assert.Equal(t, 1, saves)
+
+		// Nothing left to write
+		require.NoError(t, batch.Flush())
+		_, saves = inner.get("a")
+		assert.Equal(t, 1, saves)
+	})
+
+	t.Run("returns and reports save errors", func(t *testing.T) {
+		var reported []string
+		observability.SetErrorReporter(&testStorageErrorReporter{
+			onError: func(_ error, ctx *observability.ErrorContext) {
+				reported = append(reported, ctx.EventName)
+			},
+		})
+		defer observability.SetErrorReporter(nil)
+
+		errDisk := errors.New("disk full")
+		batch := NewBatchStorage(&mockFailingSaveStorage{saveErr: errDisk}, time.Hour)
+
+		require.NoError(t, batch.Save("a", []byte(`1`)))
+		assert.ErrorIs(t, batch.Flush(), errDisk)
+		assert.Equal(t, []string{"flush_failed"}, reported)
+	})
+}
+
+// TestBatchStorage_UseLocalStorage tests that several settings changing
+// together are written in one batch, flushed on unmount
+func TestBatchStorage_UseLocalStorage(t *testing.T) {
+	inner := newPersistTestStorage()
+	batch := NewBatchStorage(inner, time.Hour)
+	ctx := bubbly.NewTestContext()
+
+	theme := UseLocalStorage(ctx, "theme", "dark", batch)
+	fontSize := UseLocalStorage(ctx, "fontSize", 14, batch)
+
+	theme.Set("light")
+	fontSize.Set(16)
+	fontSize.Set(18)
+	_, saves := inner.get("fontSize")
+	assert.Equal(t, 0, saves)
+
+	bubbly.TriggerUnmount(ctx)
+
+	// One write per key, not per change
+	value, saves := inner.get("fontSize")
+	assert.Equal(t, `18`, value)
+	assert.Equal(t, 2, saves)
+	value, _ = inner.get("theme")
+	assert.Equal(t, `"light"`, value)
+}
@@ -1,10 +1,14 @@
 package composables
 
 import (
+	"context"
+	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
 	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
 )
 
 // UseAsyncReturn is the return type for the UseAsync composable.
@@ -15,13 +19,17 @@ import (
 //   - Data: Reactive reference to the fetched data (nil until fetch succeeds)
 //   - Loading: Reactive boolean indicating if fetch is in progress
 //   - Error: Reactive reference to any error that occurred during fetch
+//   - IsReverting: Reactive boolean set while a failed optimistic update rolls back
 //   - Execute: Function to trigger the async operation
+//   - Retry: Function to trigger the async operation with retries and backoff
+//   - ExecuteOptimistic: Function to run a mutation with an optimistic result
+//   - Cancel: Function to cancel the in-flight operation
 //   - Reset: Function to clear all state back to initial values
 //
 // Example:
 //
-//	async := UseAsync(ctx, func() (*User, error) {
-//	    return fetchUser()
+//	async := UseAsync(ctx, func(c context.Context) (*User, error) {
+//	    return fetchUser(c)
 //	})
 //
 //	// Trigger fetch on mount
@@ -47,14 +55,48 @@ type UseAsyncReturn[T any] struct {
 	Error *bubbly.Ref[error]
 
 	// Execute triggers the async operation.
-	// Can be called multiple times. Each call starts a new fetch operation.
+	// Can be called multiple times. Each call cancels the previous
+	// operation, if still running, and starts a new one.
 	// Sets Loading to true, clears Error, executes fetcher in a goroutine,
 	// and updates Data/Error/Loading when complete.
 	Execute func()
 
-	// Reset clears all state back to initial values.
+	// Retry triggers the async operation like Execute, but tries the fetcher
+	// up to attempts times (at least once) until it succeeds. It waits
+	// backoff before the first retry and doubles the wait for each further
+	// retry. Loading stays true across attempts, and only the last error is
+	// stored in Error.
+	//
+	// Like Execute, it cancels any in-flight operation and is cancelled by
+	// Cancel, Reset, a newer Execute, or unmounting, including while waiting
+	// between attempts.
+	Retry func(attempts int, backoff time.Duration)
+
+	// IsReverting is true while a failed or cancelled optimistic update
+	// restores the previous Data, so watchers of Data can tell a rollback
+	// from a regular update.
+	IsReverting *bubbly.Ref[bool]
+
+	// ExecuteOptimistic sets Data to optimistic immediately, then runs
+	// mutate in a goroutine like Execute. On success, Data is set to the
+	// returned value (or kept at optimistic if mutate returns nil). On
+	// failure, Data reverts to its value from before the call, Error is set,
+	// and the failure is reported via observability.
+	//
+	// It cancels any in-flight operation, like Execute. If the optimistic
+	// operation is itself cancelled with Cancel or by unmounting, Data is
+	// reverted as well.
+	ExecuteOptimistic func(optimistic T, mutate func(context.Context) (*T, error))
+
+	// Cancel cancels the in-flight operation, if any: its context is
+	// cancelled, its result is discarded, and Loading is set to false.
+	// Data and Error keep their values from the last completed operation,
+	// except that an optimistic value is reverted.
+	Cancel func()
+
+	// Reset cancels the in-flight operation, if any, and clears all state
+	// back to initial values.
 	// Sets Data to nil, Loading to false, and Error to nil.
-	// Does not cancel any in-flight operations.
 	Reset func()
 }
 
@@ -66,12 +108,18 @@ type UseAsyncReturn[T any] struct {
 // State updates (Data, Loading, Error) are performed on the reactive refs,
 // triggering reactivity throughout the component tree.
 //
+// The fetcher receives a context.Context that is cancelled when the
+// operation is superseded by a new Execute(), cancelled with Cancel() or
+// Reset(), or when the component unmounts. Pass it to network and database
+// calls so slow work stops early.
+//
 // UseAsync is type-safe using Go generics. The type parameter T specifies
 // the type of data being fetched.
 //
 // Parameters:
 //   - ctx: The component context (required for all composables)
-//   - fetcher: Async function that returns data or an error
+//   - fetcher: Async function that returns data or an error; it should
+//     stop when its context is cancelled
 //
 // Returns:
 //   - UseAsyncReturn[T]: Struct with reactive state and control functions
@@ -79,8 +127,8 @@ type UseAsyncReturn[T any] struct {
 // Example - Basic Usage:
 //
 //	Setup(func(ctx *Context) {
-//	    userData := UseAsync(ctx, func() (*User, error) {
-//	        return fetchUserFromAPI()
+//	    userData := UseAsync(ctx, func(c context.Context) (*User, error) {
+//	        return fetchUserFromAPI(c)
 //	    })
 //
 //	    // Trigger fetch when component mounts
@@ -125,20 +173,59 @@ type UseAsyncReturn[T any] struct {
 //	    })
 //	})
 //
-// Concurrency:
+// Example - Optimistic mutation:
 //
-// Multiple concurrent Execute() calls are safe. Each call spawns a new goroutine
-// and updates the shared reactive state. The last operation to complete will
-// set the final state values.
+//	Setup(func(ctx *Context) {
+//	    todo := UseAsync(ctx, func(c context.Context) (*Todo, error) {
+//	        return api.GetTodo(c, id)
+//	    })
 //
-// Note: UseAsync does not cancel in-flight operations. If you need cancellation,
-// consider using context.Context in your fetcher function.
+//	    ctx.On("toggle", func(_ interface{}) {
+//	        updated := *todo.Data.GetTyped()
+//	        updated.Done = !updated.Done
+//	        // Show the change now; revert if the server rejects it
+//	        todo.ExecuteOptimistic(updated, func(c context.Context) (*Todo, error) {
+//	            return api.SaveTodo(c, updated)
+//	        })
+//	    })
+//	})
+//
+// Example - Retry with backoff:
+//
+//	Setup(func(ctx *Context) {
+//	    feed := UseAsync(ctx, fetchFeed)
+//
+//	    ctx.OnMounted(func() {
+//	        // Up to 3 tries, waiting 500ms then 1s between them
+//	        feed.Retry(3, 500*time.Millisecond)
+//	    })
+//	})
+//
+// Example - Cancel on navigation:
+//
+//	Setup(func(ctx *Context) {
+//	    search := UseAsync(ctx, func(c context.Context) (*Results, error) {
+//	        return api.Search(c, query.GetTyped())
+//	    })
+//
+//	    ctx.On("back", func(_ interface{}) {
+//	        search.Cancel() // Stop the slow search; Data is left untouched
+//	    })
+//	})
+//
+// Concurrency:
+//
+// Multiple concurrent Execute() calls are safe. Only the most recent
+// operation updates the reactive state: when an operation is cancelled or
+// superseded before it finishes, its result is discarded instead of being
+// written to Data, Error, or Loading. An operation that finishes at the same
+// moment it is cancelled may still be committed.
 //
 // Performance:
 //
-// UseAsync creates three Ref instances and two closure functions. The overhead
+// UseAsync creates four Ref instances and six closure functions. The overhead
 // is minimal (< 1μs) and well within the performance target for composables.
-func UseAsync[T any](ctx *bubbly.Context, fetcher func() (*T, error)) UseAsyncReturn[T] {
+func UseAsync[T any](ctx *bubbly.Context, fetcher func(context.Context) (*T, error)) UseAsyncReturn[T] {
 	// Record metrics if monitoring is enabled
 	start := time.Now()
 	defer func() {
@@ -150,23 +237,83 @@ func UseAsync[T any](ctx *bubbly.Context, fetcher func() (*T, error)) UseAsyncRe
 	loading := bubbly.NewRef(false)
 	errorRef := bubbly.NewRef[error](nil)
 
-	// Execute function: triggers the async operation
-	execute := func() {
+	isReverting := bubbly.NewRef(false)
+
+	// Track the in-flight operation so it can be cancelled and so results
+	// of cancelled or superseded operations are discarded
+	var (
+		mu         sync.Mutex
+		generation uint64
+		cancelRun  context.CancelFunc
+		revertRun  func() // Restores Data if the in-flight run was optimistic
+	)
+
+	// stop cancels the in-flight operation and returns whether there was
+	// one, plus its revert function, if any; callers must hold mu
+	stop := func() (bool, func()) {
+		generation++
+		if cancelRun == nil {
+			return false, nil
+		}
+		cancelRun()
+		revert := revertRun
+		cancelRun, revertRun = nil, nil
+		return true, revert
+	}
+
+	// finish marks run as done and reports whether it is still the most
+	// recent operation
+	finish := func(run uint64) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if run != generation {
+			return false
+		}
+		cancelRun, revertRun = nil, nil
+		return true
+	}
+
+	// revertTo restores Data to previous, flagging the rollback
+	revertTo := func(previous *T) {
+		isReverting.Set(true)
+		data.Set(previous)
+		isReverting.Set(false)
+	}
+
+	// launch runs fn in a goroutine, committing its result via onSuccess or
+	// onError unless it is cancelled or superseded first
+	launch := func(
+		fn func(context.Context) (*T, error),
+		revert func(),
+		onSuccess func(*T),
+		onError func(error),
+	) {
+		runCtx, cancel := context.WithCancel(context.Background())
+
+		mu.Lock()
+		stop()
+		run := generation
+		cancelRun, revertRun = cancel, revert
+		mu.Unlock()
+
 		// Set loading state
 		loading.Set(true)
 		errorRef.Set(nil)
 
-		// Execute fetcher in goroutine
 		go func() {
-			result, err := fetcher()
+			defer cancel()
+			result, err := fn(runCtx)
+
+			// Discard results of cancelled or superseded operations
+			if runCtx.Err() != nil || !finish(run) {
+				return
+			}
 
 			// Update state based on result
 			if err != nil {
-				errorRef.Set(err)
-				data.Set(nil)
+				onError(err)
 			} else {
-				data.Set(result)
-				errorRef.Set(nil)
+				onSuccess(result)
 			}
 
 			// Clear loading state
@@ -174,19 +321,133 @@ func UseAsync[T any](ctx *bubbly.Context, fetcher func() (*T, error)) UseAsyncRe
 		}()
 	}
 
+	// Commit the result of Execute and Retry
+	onFetched := func(result *T) {
+		data.Set(result)
+		errorRef.Set(nil)
+	}
+	onFetchFailed := func(err error) {
+		errorRef.Set(err)
+		data.Set(nil)
+	}
+
+	// Execute function: triggers the async operation
+	execute := func() {
+		launch(fetcher, nil, onFetched, onFetchFailed)
+	}
+
+	// Retry function: triggers the async operation, retrying failures
+	retry := func(attempts int, backoff time.Duration) {
+		launch(retryFetch(fetcher, attempts, backoff), nil, onFetched, onFetchFailed)
+	}
+
+	// ExecuteOptimistic function: applies the expected result, then mutates
+	executeOptimistic := func(optimistic T, mutate func(context.Context) (*T, error)) {
+		previous := data.GetTyped()
+		data.Set(&optimistic)
+
+		launch(mutate, func() { revertTo(previous) },
+			func(result *T) {
+				if result != nil {
+					data.Set(result)
+				}
+			},
+			func(err error) {
+				revertTo(previous)
+				errorRef.Set(err)
+				reportOptimisticFailure(err)
+			},
+		)
+	}
+
+	// Cancel function: stops the in-flight operation
+	cancel := func() {
+		mu.Lock()
+		stopped, revert := stop()
+		mu.Unlock()
+
+		if revert != nil {
+			revert()
+		}
+		if stopped {
+			loading.Set(false)
+		}
+	}
+
 	// Reset function: clears all state
 	reset := func() {
+		mu.Lock()
+		stop()
+		mu.Unlock()
+
 		data.Set(nil)
 		loading.Set(false)
 		errorRef.Set(nil)
 	}
 
+	// Cancel in-flight work when the component unmounts
+	if ctx != nil {
+		ctx.OnUnmounted(cancel)
+	}
+
 	// Return the composable interface
 	return UseAsyncReturn[T]{
-		Data:    data,
-		Loading: loading,
-		Error:   errorRef,
-		Execute: execute,
-		Reset:   reset,
+		Data:              data,
+		Loading:           loading,
+		Error:             errorRef,
+		IsReverting:       isReverting,
+		Execute:           execute,
+		Retry:             retry,
+		ExecuteOptimistic: executeOptimistic,
+		Cancel:            cancel,
+		Reset:             reset,
+	}
+}
+
+// retryFetch wraps fetch to try it up to attempts times, with exponential
+// backoff starting at backoff, stopping early when ctx is cancelled.
+func retryFetch[T any](
+	fetch func(context.Context) (*T, error),
+	attempts int,
+	backoff time.Duration,
+) func(context.Context) (*T, error) {
+	attempts = max(attempts, 1)
+	return func(ctx context.Context) (*T, error) {
+		delay := backoff
+		for attempt := 1; ; attempt++ {
+			result, err := fetch(ctx)
+			if err == nil || attempt >= attempts || ctx.Err() != nil {
+				return result, err
+			}
+
+			if delay > 0 {
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, ctx.Err()
+				case <-timer.C:
+				}
+				delay *= 2
+			}
+		}
+	}
+}
+
+// reportOptimisticFailure reports a rolled-back optimistic update to the
+// observability system.
+func reportOptimisticFailure(err error) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
 	}
+	reporter.ReportError(err, &observability.ErrorContext{
+		ComponentName: "UseAsync",
+		EventName:     "ExecuteOptimistic",
+		Timestamp:     time.Now(),
+		StackTrace:    debug.Stack(),
+		Tags: map[string]string{
+			"error_type": "optimistic_rollback",
+		},
+	})
 }
@@ -1,6 +1,7 @@
 package composables
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -14,10 +15,10 @@ import (
 // TestUseAsync_ExecuteTriggersFetch verifies that calling Execute triggers the fetcher function
 func TestUseAsync_ExecuteTriggersFetch(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	var mu sync.Mutex
 	fetchCalled := false
-	fetcher := func() (*string, error) {
+	fetcher := func(_ context.Context) (*string, error) {
 		mu.Lock()
 		fetchCalled = true
 		mu.Unlock()
@@ -42,8 +43,8 @@ func TestUseAsync_ExecuteTriggersFetch(t *testing.T) {
 // TestUseAsync_LoadingStateManaged verifies loading state transitions correctly
 func TestUseAsync_LoadingStateManaged(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
-	fetcher := func() (*int, error) {
+	ctx := bubbly.NewTestContext()
+	fetcher := func(_ context.Context) (*int, error) {
 		time.Sleep(20 * time.Millisecond)
 		result := 42
 		return &result, nil
@@ -71,9 +72,9 @@ func TestUseAsync_LoadingStateManaged(t *testing.T) {
 // TestUseAsync_DataPopulatedOnSuccess verifies data is set when fetch succeeds
 func TestUseAsync_DataPopulatedOnSuccess(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	expected := "success data"
-	fetcher := func() (*string, error) {
+	fetcher := func(_ context.Context) (*string, error) {
 		return &expected, nil
 	}
 
@@ -93,9 +94,9 @@ func TestUseAsync_DataPopulatedOnSuccess(t *testing.T) {
 // TestUseAsync_ErrorSetOnFailure verifies error is set when fetch fails
 func TestUseAsync_ErrorSetOnFailure(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	expectedError := errors.New("fetch failed")
-	fetcher := func() (*string, error) {
+	fetcher := func(_ context.Context) (*string, error) {
 		return nil, expectedError
 	}
 
@@ -116,9 +117,9 @@ func TestUseAsync_ErrorSetOnFailure(t *testing.T) {
 // TestUseAsync_ResetClearsState verifies Reset clears all state
 func TestUseAsync_ResetClearsState(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	data := "test data"
-	fetcher := func() (*string, error) {
+	fetcher := func(_ context.Context) (*string, error) {
 		return &data, nil
 	}
 
@@ -141,11 +142,11 @@ func TestUseAsync_ResetClearsState(t *testing.T) {
 // TestUseAsync_ConcurrentExecutions verifies concurrent Execute calls are handled safely
 func TestUseAsync_ConcurrentExecutions(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	var mu sync.Mutex
 	callCount := 0
 
-	fetcher := func() (*int, error) {
+	fetcher := func(_ context.Context) (*int, error) {
 		mu.Lock()
 		callCount++
 		mu.Unlock()
@@ -188,9 +189,9 @@ func TestUseAsync_TypeSafety(t *testing.T) {
 		{
 			name: "int type",
 			testFunc: func(t *testing.T) {
-				ctx := &bubbly.Context{}
+				ctx := bubbly.NewTestContext()
 				expected := 123
-				fetcher := func() (*int, error) {
+				fetcher := func(_ context.Context) (*int, error) {
 					return &expected, nil
 				}
 
@@ -204,9 +205,9 @@ func TestUseAsync_TypeSafety(t *testing.T) {
 		{
 			name: "string type",
 			testFunc: func(t *testing.T) {
-				ctx := &bubbly.Context{}
+				ctx := bubbly.NewTestContext()
 				expected := "hello"
-				fetcher := func() (*string, error) {
+				fetcher := func(_ context.Context) (*string, error) {
 					return &expected, nil
 				}
 
@@ -225,9 +226,9 @@ func TestUseAsync_TypeSafety(t *testing.T) {
 					Age  int
 				}
 
-				ctx := &bubbly.Context{}
+				ctx := bubbly.NewTestContext()
 				expected := User{Name: "Alice", Age: 30}
-				fetcher := func() (*User, error) {
+				fetcher := func(_ context.Context) (*User, error) {
 					return &expected, nil
 				}
 
@@ -248,8 +249,8 @@ func TestUseAsync_TypeSafety(t *testing.T) {
 // TestUseAsync_InitialState verifies initial state is correct
 func TestUseAsync_InitialState(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
-	fetcher := func() (*string, error) {
+	ctx := bubbly.NewTestContext()
+	fetcher := func(_ context.Context) (*string, error) {
 		result := "data"
 		return &result, nil
 	}
@@ -268,9 +269,9 @@ func TestUseAsync_InitialState(t *testing.T) {
 // TestUseAsync_ErrorClearedOnRetry verifies error is cleared when retrying after failure
 func TestUseAsync_ErrorClearedOnRetry(t *testing.T) {
 	// Arrange
-	ctx := &bubbly.Context{}
+	ctx := bubbly.NewTestContext()
 	shouldFail := true
-	fetcher := func() (*string, error) {
+	fetcher := func(_ context.Context) (*string, error) {
 		if shouldFail {
 			return nil, errors.New("first attempt failed")
 		}
@@ -294,3 +295,265 @@ func TestUseAsync_ErrorClearedOnRetry(t *testing.T) {
 	assert.Nil(t, async.Error.GetTyped(), "Error should be cleared on successful retry")
 	assert.NotNil(t, async.Data.GetTyped(), "Data should be set on successful retry")
 }
+
+// TestUseAsync_CancelStopsFetch verifies Cancel cancels the fetcher's context
+// and discards its result
+func TestUseAsync_CancelStopsFetch(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	fetcher := func(c context.Context) (*string, error) {
+		close(started)
+		<-c.Done()
+		close(cancelled)
+		result := "stale"
+		return &result, nil
+	}
+
+	async := UseAsync(ctx, fetcher)
+	async.Execute()
+	<-started
+
+	async.Cancel()
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("fetcher context was not cancelled")
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	assert.False(t, async.Loading.GetTyped(), "Loading should be false after Cancel")
+	assert.Nil(t, async.Data.GetTyped(), "Cancelled result should be discarded")
+	assert.Nil(t, async.Error.GetTyped(), "Cancellation should not set Error")
+}
+
+// TestUseAsync_ExecuteSupersedesPrevious verifies a new Execute cancels the
+// previous operation so its slower result never overwrites the newer one
+func TestUseAsync_ExecuteSupersedesPrevious(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	firstStarted := make(chan struct{})
+	var mu sync.Mutex
+	calls := 0
+	fetcher := func(_ context.Context) (*int, error) {
+		mu.Lock()
+		calls++
+		call := calls
+		mu.Unlock()
+
+		if call == 1 {
+			// Slow first call ignores cancellation and returns late
+			close(firstStarted)
+			time.Sleep(50 * time.Millisecond)
+		}
+		return &call, nil
+	}
+
+	async := UseAsync(ctx, fetcher)
+	async.Execute()
+	<-firstStarted
+	async.Execute()
+
+	time.Sleep(100 * time.Millisecond)
+
+	data := async.Data.GetTyped()
+	if assert.NotNil(t, data) {
+		assert.Equal(t, 2, *data, "Superseded result should be discarded")
+	}
+	assert.False(t, async.Loading.GetTyped())
+}
+
+// TestUseAsync_UnmountCancels verifies in-flight work is cancelled on unmount
+func TestUseAsync_UnmountCancels(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	cancelled := make(chan struct{})
+	fetcher := func(c context.Context) (*string, error) {
+		<-c.Done()
+		close(cancelled)
+		return nil, c.Err()
+	}
+
+	async := UseAsync(ctx, fetcher)
+	async.Execute()
+	bubbly.TriggerUnmount(ctx)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("fetcher context was not cancelled on unmount")
+	}
+	time.Sleep(20 * time.Millisecond)
+	assert.Nil(t, async.Error.GetTyped(), "Cancellation error should be discarded")
+}
+
+// TestUseAsync_ExecuteOptimistic verifies optimistic values are applied
+// immediately and replaced or reverted when the mutation settles
+func TestUseAsync_ExecuteOptimistic(t *testing.T) {
+	tests := []struct {
+		name          string
+		mutateResult  *int
+		mutateErr     error
+		expectedValue int
+		expectError   bool
+	}{
+		{
+			name:          "success keeps server result",
+			mutateResult:  intPtr(11),
+			expectedValue: 11,
+		},
+		{
+			name:          "success with nil result keeps optimistic value",
+			expectedValue: 10,
+		},
+		{
+			name:          "failure reverts to previous value",
+			mutateErr:     errors.New("rejected"),
+			expectedValue: 1,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			async := UseAsync(bubbly.NewTestContext(), func(context.Context) (*int, error) {
+				return intPtr(1), nil
+			})
+			async.Execute()
+			time.Sleep(20 * time.Millisecond)
+
+			release := make(chan struct{})
+			var reverted []bool
+			bubbly.Watch(async.IsReverting, func(newVal, _ bool) {
+				reverted = append(reverted, newVal)
+			})
+
+			async.ExecuteOptimistic(10, func(context.Context) (*int, error) {
+				<-release
+				return tt.mutateResult, tt.mutateErr
+			})
+
+			assert.Equal(t, 10, *async.Data.GetTyped(), "Optimistic value should apply immediately")
+			assert.True(t, async.Loading.GetTyped())
+
+			close(release)
+			time.Sleep(20 * time.Millisecond)
+
+			assert.Equal(t, tt.expectedValue, *async.Data.GetTyped())
+			assert.False(t, async.Loading.GetTyped())
+			assert.False(t, async.IsReverting.GetTyped())
+			if tt.expectError {
+				assert.Equal(t, tt.mutateErr, async.Error.GetTyped())
+				assert.Equal(t, []bool{true, false}, reverted, "IsReverting should be set during rollback")
+			} else {
+				assert.Nil(t, async.Error.GetTyped())
+				assert.Empty(t, reverted)
+			}
+		})
+	}
+}
+
+// TestUseAsync_CancelRevertsOptimistic verifies cancelling an optimistic
+// mutation restores the previous value
+func TestUseAsync_CancelRevertsOptimistic(t *testing.T) {
+	async := UseAsync(bubbly.NewTestContext(), func(context.Context) (*string, error) {
+		return nil, nil
+	})
+
+	started := make(chan struct{})
+	async.ExecuteOptimistic("draft", func(c context.Context) (*string, error) {
+		close(started)
+		<-c.Done()
+		return nil, c.Err()
+	})
+	<-started
+	assert.Equal(t, "draft", *async.Data.GetTyped())
+
+	async.Cancel()
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Nil(t, async.Data.GetTyped(), "Cancel should revert the optimistic value")
+	assert.Nil(t, async.Error.GetTyped(), "Cancellation should not set Error")
+	assert.False(t, async.Loading.GetTyped())
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// TestUseAsync_Retry verifies Retry tries the fetcher until it succeeds or
+// runs out of attempts
+func TestUseAsync_Retry(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	tests := []struct {
+		name          string
+		failures      int
+		attempts      int
+		expectedCalls int
+		expectData    bool
+	}{
+		{name: "succeeds after retries", failures: 2, attempts: 3, expectedCalls: 3, expectData: true},
+		{name: "gives up after attempts", failures: 5, attempts: 3, expectedCalls: 3, expectData: false},
+		{name: "first try succeeds", failures: 0, attempts: 3, expectedCalls: 1, expectData: true},
+		{name: "non-positive attempts tries once", failures: 5, attempts: 0, expectedCalls: 1, expectData: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := 0
+			async := UseAsync(bubbly.NewTestContext(), func(context.Context) (*string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				if calls <= tt.failures {
+					return nil, errFlaky
+				}
+				result := "data"
+				return &result, nil
+			})
+
+			async.Retry(tt.attempts, time.Millisecond)
+
+			assert.Eventually(t, func() bool { return !async.Loading.GetTyped() }, time.Second, time.Millisecond)
+			mu.Lock()
+			assert.Equal(t, tt.expectedCalls, calls)
+			mu.Unlock()
+			if tt.expectData {
+				assert.Equal(t, "data", *async.Data.GetTyped())
+				assert.NoError(t, async.Error.GetTyped())
+			} else {
+				assert.Nil(t, async.Data.GetTyped())
+				assert.ErrorIs(t, async.Error.GetTyped(), errFlaky)
+			}
+		})
+	}
+}
+
+// TestUseAsync_CancelDuringRetryBackoff verifies Cancel stops retries while
+// waiting between attempts
+func TestUseAsync_CancelDuringRetryBackoff(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	async := UseAsync(bubbly.NewTestContext(), func(context.Context) (*string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return nil, errors.New("down")
+	})
+
+	async.Retry(5, time.Hour)
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls == 1
+	}, time.Second, time.Millisecond)
+
+	async.Cancel()
+
+	assert.False(t, async.Loading.GetTyped())
+	assert.NoError(t, async.Error.GetTyped(), "a cancelled retry should not store an error")
+	mu.Lock()
+	assert.Equal(t, 1, calls)
+	mu.Unlock()
+}
@@ -0,0 +1,238 @@
+package composables
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// SaveState is the state of an auto-saved form.
+type SaveState string
+
+const (
+	// SaveIdle means no save has run since the form was created or reset.
+	SaveIdle SaveState = "idle"
+
+	// SaveSaving means a save is in progress.
+	SaveSaving SaveState = "saving"
+
+	// SaveSaved means the last save succeeded.
+	SaveSaved SaveState = "saved"
+
+	// SaveError means the last save failed; see AutoSaveReturn.Error.
+	SaveError SaveState = "error"
+)
+
+// AutoSaveReturn is the return value of UseAutoSave.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type AutoSaveReturn struct {
+	// State is the current save state, for "Saving…" / "Saved ✓" indicators.
+	State *bubbly.Ref[SaveState]
+
+	// Error holds the error of the last failed save, or nil.
+	Error *bubbly.Ref[error]
+
+	// LastSaved is the time of the last successful save (zero if none).
+	// Pair it with UseRelativeTime for "saved 2m ago" labels.
+	LastSaved *bubbly.Ref[time.Time]
+
+	// Flush saves pending changes immediately instead of waiting for the
+	// debounce delay. It is a no-op when nothing is pending.
+	Flush func()
+}
+
+// UseAutoSave saves a form automatically a short while after the user
+// stops editing it.
+//
+// Every change to form.Values while the form is dirty restarts a debounce
+// timer; when it fires, saveFn is called in a goroutine with the current
+// values. Invalid forms are not saved. Changes made while a save is running
+// are saved once it finishes, so saves never overlap.
+//
+// Failed saves set State to SaveError and Error to the error, and are
+// reported via observability. The next change retries.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - form: The form to save, as returned by UseForm
+//   - saveFn: Persists the values; its context is cancelled on unmount
+//   - delay: The quiet period after the last change before saving
+//
+// Returns:
+//   - AutoSaveReturn: State, Error, and LastSaved refs plus Flush
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    form := composables.UseForm(ctx, Note{}, validateNote)
+//	    autoSave := composables.UseAutoSave(ctx, form,
+//	        func(c context.Context, note Note) error {
+//	            return api.SaveNote(c, note)
+//	        },
+//	        time.Second,
+//	    )
+//
+//	    ctx.Expose("saveState", autoSave.State)
+//	})
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    switch ctx.Get("saveState").(*bubbly.Ref[composables.SaveState]).GetTyped() {
+//	    case composables.SaveSaving:
+//	        return "Saving…"
+//	    case composables.SaveSaved:
+//	        return "Saved ✓"
+//	    case composables.SaveError:
+//	        return "Save failed"
+//	    }
+//	    return ""
+//	})
+//
+// Cleanup:
+//
+// On unmount, the pending debounce timer is stopped and an in-flight save's
+// context is cancelled. Call Flush from OnBeforeUnmount to keep unsaved edits.
+func UseAutoSave[T any](
+	ctx *bubbly.Context,
+	form UseFormReturn[T],
+	saveFn func(context.Context, T) error,
+	delay time.Duration,
+) AutoSaveReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseAutoSave", time.Since(start))
+	}()
+
+	state := bubbly.NewRef(SaveIdle)
+	errorRef := bubbly.NewRef[error](nil)
+	lastSaved := bubbly.NewRef(time.Time{})
+
+	var (
+		mu        sync.Mutex
+		timer     *time.Timer
+		pending   bool               // Changes not yet saved
+		saving    bool               // A save is in flight
+		cancelRun context.CancelFunc // Cancels the in-flight save
+		stopped   bool               // Owning component unmounted
+	)
+
+	// save runs saveFn until no changes are pending
+	var save func()
+	save = func() {
+		mu.Lock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		if !pending || saving || stopped {
+			mu.Unlock()
+			return
+		}
+		pending = false
+		if !form.IsDirty.GetTyped() || !form.IsValid.GetTyped() {
+			mu.Unlock()
+			return
+		}
+		runCtx, cancel := context.WithCancel(context.Background())
+		saving = true
+		cancelRun = cancel
+		mu.Unlock()
+
+		values := form.Values.GetTyped()
+		state.Set(SaveSaving)
+
+		go func() {
+			defer cancel()
+			err := saveFn(runCtx, values)
+
+			mu.Lock()
+			saving = false
+			cancelRun = nil
+			again := pending
+			mu.Unlock()
+
+			if runCtx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				errorRef.Set(err)
+				state.Set(SaveError)
+				reportAutoSaveError(err)
+			} else {
+				errorRef.Set(nil)
+				lastSaved.Set(time.Now())
+				state.Set(SaveSaved)
+			}
+
+			// Save changes made while this save was running
+			if again {
+				save()
+			}
+		}()
+	}
+
+	// Restart the debounce timer on every change
+	stopWatch := bubbly.Watch(form.Values, func(_, _ T) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		pending = true
+		if saving {
+			return // Saved again when the current save finishes
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(delay, save)
+	})
+
+	// Register cleanup on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(func() {
+			stopWatch()
+
+			mu.Lock()
+			stopped = true
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+			}
+			if cancelRun != nil {
+				cancelRun()
+			}
+			mu.Unlock()
+		})
+	}
+
+	return AutoSaveReturn{
+		State:     state,
+		Error:     errorRef,
+		LastSaved: lastSaved,
+		Flush:     save,
+	}
+}
+
+// reportAutoSaveError reports a failed auto-save to the observability system.
+func reportAutoSaveError(err error) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+	reporter.ReportError(err, &observability.ErrorContext{
+		ComponentName: "UseAutoSave",
+		EventName:     "save",
+		Timestamp:     time.Now(),
+		StackTrace:    debug.Stack(),
+		Tags: map[string]string{
+			"error_type": "save_failed",
+		},
+	})
+}
@@ -0,0 +1,198 @@
+package composables
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+type noteForm struct {
+	Title string
+	Body  string
+}
+
+func validateNoteForm(n noteForm) map[string]string {
+	errors := make(map[string]string)
+	if n.Title == "invalid" {
+		errors["Title"] = "Invalid title"
+	}
+	return errors
+}
+
+// recordingSaver records saved values and fails with err when set.
+type recordingSaver struct {
+	mu    sync.Mutex
+	saved []noteForm
+	err   error
+}
+
+func (s *recordingSaver) save(_ context.Context, n noteForm) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.saved = append(s.saved, n)
+	return s.err
+}
+
+func (s *recordingSaver) calls() []noteForm {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]noteForm(nil), s.saved...)
+}
+
+// TestUseAutoSave_DebouncesChanges verifies a burst of edits is saved once
+func TestUseAutoSave_DebouncesChanges(t *testing.T) {
+	saver := &recordingSaver{}
+	form := UseForm(createTestContext(), noteForm{}, validateNoteForm)
+	autoSave := UseAutoSave(createTestContext(), form, saver.save, 20*time.Millisecond)
+
+	assert.Equal(t, SaveIdle, autoSave.State.GetTyped())
+
+	form.SetField("Title", "a")
+	form.SetField("Title", "ab")
+	form.SetField("Body", "hello")
+
+	require.Eventually(t, func() bool {
+		return autoSave.State.GetTyped() == SaveSaved
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, []noteForm{{Title: "ab", Body: "hello"}}, saver.calls())
+	assert.Nil(t, autoSave.Error.GetTyped())
+	assert.False(t, autoSave.LastSaved.GetTyped().IsZero())
+}
+
+// TestUseAutoSave_SkipsInvalidOrClean verifies invalid and reset forms are not saved
+func TestUseAutoSave_SkipsInvalidOrClean(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(form UseFormReturn[noteForm])
+	}{
+		{
+			name: "invalid form",
+			edit: func(form UseFormReturn[noteForm]) { form.SetField("Title", "invalid") },
+		},
+		{
+			name: "form reset before delay",
+			edit: func(form UseFormReturn[noteForm]) {
+				form.SetField("Title", "draft")
+				form.Reset()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saver := &recordingSaver{}
+			form := UseForm(createTestContext(), noteForm{}, validateNoteForm)
+			autoSave := UseAutoSave(createTestContext(), form, saver.save, 10*time.Millisecond)
+
+			tt.edit(form)
+			time.Sleep(40 * time.Millisecond)
+
+			assert.Empty(t, saver.calls())
+			assert.Equal(t, SaveIdle, autoSave.State.GetTyped())
+		})
+	}
+}
+
+// TestUseAutoSave_ErrorReported verifies failed saves set the error state
+// and are reported via observability
+func TestUseAutoSave_ErrorReported(t *testing.T) {
+	var reported atomic.Pointer[observability.ErrorContext]
+	observability.SetErrorReporter(&testErrorReporter{
+		onError: func(_ error, ctx *observability.ErrorContext) {
+			reported.Store(ctx)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	saveErr := errors.New("disk full")
+	saver := &recordingSaver{err: saveErr}
+	form := UseForm(createTestContext(), noteForm{}, validateNoteForm)
+	autoSave := UseAutoSave(createTestContext(), form, saver.save, 10*time.Millisecond)
+
+	form.SetField("Title", "draft")
+
+	require.Eventually(t, func() bool {
+		return autoSave.State.GetTyped() == SaveError
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, saveErr, autoSave.Error.GetTyped())
+	if ctx := reported.Load(); assert.NotNil(t, ctx, "Save error should be reported") {
+		assert.Equal(t, "UseAutoSave", ctx.ComponentName)
+	}
+	assert.True(t, autoSave.LastSaved.GetTyped().IsZero())
+}
+
+// TestUseAutoSave_ChangesDuringSave verifies edits made while saving are
+// saved after the running save, without overlapping saves
+func TestUseAutoSave_ChangesDuringSave(t *testing.T) {
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	var running, overlapped atomic.Bool
+	saver := &recordingSaver{}
+
+	form := UseForm(createTestContext(), noteForm{}, validateNoteForm)
+	autoSave := UseAutoSave(createTestContext(), form, func(c context.Context, n noteForm) error {
+		if !running.CompareAndSwap(false, true) {
+			overlapped.Store(true)
+		}
+		defer running.Store(false)
+		started <- struct{}{}
+		<-release
+		return saver.save(c, n)
+	}, 5*time.Millisecond)
+
+	form.SetField("Title", "first")
+	<-started
+	assert.Equal(t, SaveSaving, autoSave.State.GetTyped())
+
+	form.SetField("Title", "second")
+	close(release)
+
+	require.Eventually(t, func() bool {
+		return len(saver.calls()) == 2
+	}, time.Second, 5*time.Millisecond)
+
+	assert.Equal(t, "first", saver.calls()[0].Title)
+	assert.Equal(t, "second", saver.calls()[1].Title)
+	assert.False(t, overlapped.Load(), "Saves should not overlap")
+}
+
+// TestUseAutoSave_Flush verifies Flush saves pending changes immediately
+func TestUseAutoSave_Flush(t *testing.T) {
+	saver := &recordingSaver{}
+	form := UseForm(createTestContext(), noteForm{}, validateNoteForm)
+	autoSave := UseAutoSave(createTestContext(), form, saver.save, time.Hour)
+
+	autoSave.Flush() // Nothing pending
+	form.SetField("Title", "draft")
+	autoSave.Flush()
+
+	require.Eventually(t, func() bool {
+		return autoSave.State.GetTyped() == SaveSaved
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []noteForm{{Title: "draft"}}, saver.calls())
+}
+
+// TestUseAutoSave_UnmountStopsPendingSave verifies no save runs after unmount
+func TestUseAutoSave_UnmountStopsPendingSave(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	saver := &recordingSaver{}
+	form := UseForm(ctx, noteForm{}, validateNoteForm)
+	UseAutoSave(ctx, form, saver.save, 10*time.Millisecond)
+
+	form.SetField("Title", "draft")
+	bubbly.TriggerUnmount(ctx)
+	time.Sleep(40 * time.Millisecond)
+
+	assert.Empty(t, saver.calls())
+}
@@ -0,0 +1,356 @@
+package composables
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState string
+
+const (
+	// CircuitClosed lets calls through and records their outcomes.
+	CircuitClosed CircuitState = "closed"
+
+	// CircuitOpen fails calls immediately with ErrCircuitOpen.
+	CircuitOpen CircuitState = "open"
+
+	// CircuitHalfOpen lets a single trial call through; its outcome
+	// closes or re-opens the circuit.
+	CircuitHalfOpen CircuitState = "half-open"
+)
+
+// Default configuration values
+const (
+	// DefaultCircuitFailureThreshold is the default number of failures within
+	// the window that opens the circuit.
+	DefaultCircuitFailureThreshold = 5
+
+	// DefaultCircuitWindow is the default number of recent calls considered.
+	DefaultCircuitWindow = 10
+
+	// DefaultCircuitCooldown is the default time the circuit stays open
+	// before a trial call is allowed.
+	DefaultCircuitCooldown = 30 * time.Second
+)
+
+// circuitBreakerConfig holds configuration for UseCircuitBreaker.
+type circuitBreakerConfig struct {
+	failureThreshold int
+	window           int
+	cooldown         time.Duration
+	retries          int
+	retryBackoff     time.Duration
+}
+
+// CircuitBreakerOption configures UseCircuitBreaker.
+type CircuitBreakerOption func(*circuitBreakerConfig)
+
+// WithFailureThreshold opens the circuit once failures out of the last
+// window calls reach threshold. For example, 5 out of 10 opens the circuit
+// at a 50% failure rate.
+//
+// Values <= 0 are ignored. Default: 5 out of 10.
+//
+// Example:
+//
+//	breaker := UseCircuitBreaker(ctx, fetch, WithFailureThreshold(3, 5))
+func WithFailureThreshold(threshold, window int) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		if threshold > 0 {
+			c.failureThreshold = threshold
+		}
+		if window > 0 {
+			c.window = window
+		}
+	}
+}
+
+// WithCooldown sets how long the circuit stays open before it half-opens
+// and lets a trial call through.
+//
+// Values <= 0 are ignored. Default: 30 seconds.
+func WithCooldown(d time.Duration) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		if d > 0 {
+			c.cooldown = d
+		}
+	}
+}
+
+// WithRetry retries each failed call up to attempts more times before its
+// failure is recorded, waiting backoff before the first retry and doubling
+// the wait each time. Retries stop early when the call's context is done.
+//
+// Default: no retries.
+//
+// Example:
+//
+//	// Up to 3 tries per call, waiting 100ms then 200ms
+//	breaker := UseCircuitBreaker(ctx, fetch, WithRetry(2, 100*time.Millisecond))
+func WithRetry(attempts int, backoff time.Duration) CircuitBreakerOption {
+	return func(c *circuitBreakerConfig) {
+		c.retries = max(0, attempts)
+		c.retryBackoff = max(0, backoff)
+	}
+}
+
+// CircuitBreakerReturn is the return value of UseCircuitBreaker.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type CircuitBreakerReturn[T any] struct {
+	// State is the current circuit state. Watch it or expose it to the
+	// template to show a "service unavailable" message while open.
+	State *bubbly.Ref[CircuitState]
+
+	// Failures is the number of failures among the recent calls.
+	Failures *bubbly.Ref[int]
+
+	fn     func(context.Context) (T, error)
+	config circuitBreakerConfig
+
+	// mu protects the fields below
+	mu       sync.Mutex
+	state    CircuitState
+	outcomes []bool // Recent call outcomes, true for failure, oldest first
+	probing  bool   // A half-open trial call is in flight
+	timer    *time.Timer
+	stopped  bool // Owning component unmounted
+}
+
+// Call runs the wrapped operation through the circuit breaker.
+//
+// While the circuit is open, or while a half-open trial call is in flight,
+// Call returns ErrCircuitOpen without running the operation. Otherwise the
+// operation runs (with retries, if configured) and its outcome is recorded.
+// Failures caused by ctx being cancelled are not recorded.
+//
+// Call blocks until the operation finishes. It composes with UseAsync:
+//
+//	users := UseAsync(ctx, func(c context.Context) (*[]User, error) {
+//	    return breaker.Call(c)
+//	})
+func (b *CircuitBreakerReturn[T]) Call(ctx context.Context) (T, error) {
+	var zero T
+
+	b.mu.Lock()
+	switch {
+	case b.state == CircuitOpen, b.state == CircuitHalfOpen && b.probing:
+		b.mu.Unlock()
+		return zero, ErrCircuitOpen
+	case b.state == CircuitHalfOpen:
+		b.probing = true
+	}
+	b.mu.Unlock()
+
+	result, err := b.run(ctx)
+
+	if err != nil && ctx.Err() != nil {
+		// Cancelled by the caller: not the backend's fault
+		b.mu.Lock()
+		b.probing = false
+		b.mu.Unlock()
+		return result, err
+	}
+
+	b.record(err != nil)
+	return result, err
+}
+
+// Reset closes the circuit and forgets recorded outcomes.
+func (b *CircuitBreakerReturn[T]) Reset() {
+	b.mu.Lock()
+	b.stopTimerLocked()
+	b.state = CircuitClosed
+	b.outcomes = nil
+	b.probing = false
+	b.mu.Unlock()
+
+	b.State.Set(CircuitClosed)
+	b.Failures.Set(0)
+}
+
+// run calls the operation, retrying failures as configured.
+func (b *CircuitBreakerReturn[T]) run(ctx context.Context) (T, error) {
+	backoff := b.config.retryBackoff
+	result, err := b.fn(ctx)
+	for attempt := 0; err != nil && attempt < b.config.retries; attempt++ {
+		select {
+		case <-ctx.Done():
+			return result, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		result, err = b.fn(ctx)
+	}
+	return result, err
+}
+
+// record adds a call outcome and moves the circuit to its next state.
+func (b *CircuitBreakerReturn[T]) record(failed bool) {
+	b.mu.Lock()
+	wasProbe := b.probing
+	b.probing = false
+
+	switch {
+	case b.state == CircuitOpen:
+		// Reset or another trial changed the state while this call ran
+	case wasProbe && failed:
+		b.openLocked()
+	case wasProbe:
+		b.state = CircuitClosed
+		b.outcomes = nil
+	default:
+		b.outcomes = append(b.outcomes, failed)
+		if len(b.outcomes) > b.config.window {
+			b.outcomes = b.outcomes[len(b.outcomes)-b.config.window:]
+		}
+		if b.state == CircuitClosed && b.failuresLocked() >= b.config.failureThreshold {
+			b.openLocked()
+		}
+	}
+
+	state, failures := b.state, b.failuresLocked()
+	b.mu.Unlock()
+
+	// Update refs outside lock to avoid deadlock with Watch
+	b.State.Set(state)
+	b.Failures.Set(failures)
+}
+
+// openLocked opens the circuit and schedules the half-open transition.
+// The caller must hold b.mu.
+func (b *CircuitBreakerReturn[T]) openLocked() {
+	b.state = CircuitOpen
+	b.stopTimerLocked()
+	if b.stopped {
+		return
+	}
+	b.timer = time.AfterFunc(b.config.cooldown, b.halfOpen)
+}
+
+// halfOpen moves an open circuit to half-open after the cooldown.
+func (b *CircuitBreakerReturn[T]) halfOpen() {
+	b.mu.Lock()
+	if b.state != CircuitOpen || b.stopped {
+		b.mu.Unlock()
+		return
+	}
+	b.state = CircuitHalfOpen
+	b.timer = nil
+	b.mu.Unlock()
+
+	b.State.Set(CircuitHalfOpen)
+}
+
+// failuresLocked counts failures among the recorded outcomes.
+// The caller must hold b.mu.
+func (b *CircuitBreakerReturn[T]) failuresLocked() int {
+	failures := 0
+	for _, failed := range b.outcomes {
+		if failed {
+			failures++
+		}
+	}
+	return failures
+}
+
+// stopTimerLocked cancels a pending half-open transition.
+// The caller must hold b.mu.
+func (b *CircuitBreakerReturn[T]) stopTimerLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+}
+
+// UseCircuitBreaker wraps a flaky operation so the UI stops calling a
+// failing backend.
+//
+// The breaker starts closed and records the outcome of each call. Once
+// failures among the recent calls reach the threshold, the circuit opens
+// and Call fails fast with ErrCircuitOpen. After the cooldown, the circuit
+// half-opens and lets one trial call through: success closes the circuit,
+// failure opens it again for another cooldown.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - fn: The operation to protect; it receives the context passed to Call
+//   - opts: Optional configuration (WithFailureThreshold, WithCooldown, WithRetry)
+//
+// Returns:
+//   - *CircuitBreakerReturn[T]: Breaker with State and Failures refs and
+//     Call/Reset methods
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    breaker := composables.UseCircuitBreaker(ctx,
+//	        func(c context.Context) (*Status, error) {
+//	            return api.FetchStatus(c)
+//	        },
+//	        composables.WithFailureThreshold(3, 5),
+//	        composables.WithCooldown(10*time.Second),
+//	        composables.WithRetry(2, 200*time.Millisecond),
+//	    )
+//	    status := composables.UseAsync(ctx, breaker.Call)
+//
+//	    ctx.On("refresh", func(_ interface{}) {
+//	        status.Execute()
+//	    })
+//
+//	    ctx.Expose("circuit", breaker.State)
+//	})
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    if ctx.Get("circuit").(*bubbly.Ref[composables.CircuitState]).GetTyped() == composables.CircuitOpen {
+//	        return "Service unavailable, retrying shortly"
+//	    }
+//	    ...
+//	})
+//
+// Cleanup:
+//
+// The pending half-open transition is stopped when the component unmounts.
+func UseCircuitBreaker[T any](ctx *bubbly.Context, fn func(context.Context) (T, error), opts ...CircuitBreakerOption) *CircuitBreakerReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseCircuitBreaker", time.Since(start))
+	}()
+
+	config := circuitBreakerConfig{
+		failureThreshold: DefaultCircuitFailureThreshold,
+		window:           DefaultCircuitWindow,
+		cooldown:         DefaultCircuitCooldown,
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	config.window = max(config.window, config.failureThreshold)
+
+	breaker := &CircuitBreakerReturn[T]{
+		State:    bubbly.NewRef(CircuitClosed),
+		Failures: bubbly.NewRef(0),
+		fn:       fn,
+		config:   config,
+		state:    CircuitClosed,
+	}
+
+	// Register cleanup on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(func() {
+			breaker.mu.Lock()
+			breaker.stopped = true
+			breaker.stopTimerLocked()
+			breaker.mu.Unlock()
+		})
+	}
+
+	return breaker
+}
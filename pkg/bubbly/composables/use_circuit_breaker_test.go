@@ -0,0 +1,272 @@
+package composables
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+var errBackend = errors.New("backend unavailable")
+
+// flakyOp returns an operation that fails while *failing is true and
+// counts its calls.
+func flakyOp(failing *atomic.Bool, calls *atomic.Int32) func(context.Context) (string, error) {
+	return func(context.Context) (string, error) {
+		calls.Add(1)
+		if failing.Load() {
+			return "", errBackend
+		}
+		return "ok", nil
+	}
+}
+
+// TestUseCircuitBreaker_InitialState verifies the breaker starts closed
+func TestUseCircuitBreaker_InitialState(t *testing.T) {
+	breaker := UseCircuitBreaker(createTestContext(), func(context.Context) (int, error) {
+		return 42, nil
+	})
+
+	assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+	assert.Equal(t, 0, breaker.Failures.GetTyped())
+
+	result, err := breaker.Call(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 42, result)
+}
+
+// TestUseCircuitBreaker_OpensAtThreshold verifies failures within the
+// window open the circuit and later calls fail fast
+func TestUseCircuitBreaker_OpensAtThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		outcomes   []bool // true for failure
+		threshold  int
+		window     int
+		expectOpen bool
+	}{
+		{
+			name:       "consecutive failures reach threshold",
+			outcomes:   []bool{true, true, true},
+			threshold:  3,
+			window:     5,
+			expectOpen: true,
+		},
+		{
+			name:       "mixed outcomes reach threshold within window",
+			outcomes:   []bool{true, false, true, false, true},
+			threshold:  3,
+			window:     5,
+			expectOpen: true,
+		},
+		{
+			name:       "old failures slide out of window",
+			outcomes:   []bool{true, true, false, false, false, false, true},
+			threshold:  3,
+			window:     5,
+			expectOpen: false,
+		},
+		{
+			name:       "below threshold stays closed",
+			outcomes:   []bool{true, false, true},
+			threshold:  3,
+			window:     5,
+			expectOpen: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var failing atomic.Bool
+			var calls atomic.Int32
+			breaker := UseCircuitBreaker(createTestContext(), flakyOp(&failing, &calls),
+				WithFailureThreshold(tt.threshold, tt.window),
+				WithCooldown(time.Hour),
+			)
+
+			for _, fail := range tt.outcomes {
+				failing.Store(fail)
+				_, _ = breaker.Call(context.Background())
+			}
+
+			if !tt.expectOpen {
+				assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+				return
+			}
+
+			assert.Equal(t, CircuitOpen, breaker.State.GetTyped())
+			before := calls.Load()
+			_, err := breaker.Call(context.Background())
+			assert.ErrorIs(t, err, ErrCircuitOpen)
+			assert.Equal(t, before, calls.Load(), "Open circuit should not run the operation")
+		})
+	}
+}
+
+// TestUseCircuitBreaker_HalfOpenTrial verifies the circuit half-opens after
+// the cooldown and the trial call decides the next state
+func TestUseCircuitBreaker_HalfOpenTrial(t *testing.T) {
+	tests := []struct {
+		name          string
+		trialFails    bool
+		expectedState CircuitState
+	}{
+		{name: "successful trial closes circuit", trialFails: false, expectedState: CircuitClosed},
+		{name: "failed trial re-opens circuit", trialFails: true, expectedState: CircuitOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var failing atomic.Bool
+			var calls atomic.Int32
+			breaker := UseCircuitBreaker(createTestContext(), flakyOp(&failing, &calls),
+				WithFailureThreshold(1, 1),
+				WithCooldown(20*time.Millisecond),
+			)
+
+			failing.Store(true)
+			_, _ = breaker.Call(context.Background())
+			require.Equal(t, CircuitOpen, breaker.State.GetTyped())
+
+			assert.Eventually(t, func() bool {
+				return breaker.State.GetTyped() == CircuitHalfOpen
+			}, time.Second, 5*time.Millisecond)
+
+			failing.Store(tt.trialFails)
+			_, _ = breaker.Call(context.Background())
+			assert.Equal(t, tt.expectedState, breaker.State.GetTyped())
+			if tt.expectedState == CircuitClosed {
+				assert.Equal(t, 0, breaker.Failures.GetTyped(), "Closing should forget old failures")
+			}
+		})
+	}
+}
+
+// TestUseCircuitBreaker_HalfOpenAllowsSingleTrial verifies concurrent calls
+// fail fast while the trial call is in flight
+func TestUseCircuitBreaker_HalfOpenAllowsSingleTrial(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	breaker := UseCircuitBreaker(createTestContext(), func(context.Context) (string, error) {
+		if calls.Add(1) == 1 {
+			return "", errBackend
+		}
+		<-release
+		return "ok", nil
+	}, WithFailureThreshold(1, 1), WithCooldown(10*time.Millisecond))
+
+	_, _ = breaker.Call(context.Background())
+	require.Eventually(t, func() bool {
+		return breaker.State.GetTyped() == CircuitHalfOpen
+	}, time.Second, 5*time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = breaker.Call(context.Background())
+	}()
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+
+	_, err := breaker.Call(context.Background())
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	close(release)
+	<-done
+	assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+}
+
+// TestUseCircuitBreaker_Retry verifies failed calls are retried before the
+// failure is recorded
+func TestUseCircuitBreaker_Retry(t *testing.T) {
+	var calls atomic.Int32
+	breaker := UseCircuitBreaker(createTestContext(), func(context.Context) (string, error) {
+		if calls.Add(1) < 3 {
+			return "", errBackend
+		}
+		return "ok", nil
+	}, WithRetry(2, time.Millisecond), WithFailureThreshold(1, 1))
+
+	result, err := breaker.Call(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", result)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+}
+
+// TestUseCircuitBreaker_CancelledCallNotRecorded verifies caller
+// cancellation does not count against the backend
+func TestUseCircuitBreaker_CancelledCallNotRecorded(t *testing.T) {
+	breaker := UseCircuitBreaker(createTestContext(), func(c context.Context) (string, error) {
+		<-c.Done()
+		return "", c.Err()
+	}, WithFailureThreshold(1, 1))
+
+	callCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := breaker.Call(callCtx)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+	assert.Equal(t, 0, breaker.Failures.GetTyped())
+}
+
+// TestUseCircuitBreaker_Reset verifies Reset closes an open circuit
+func TestUseCircuitBreaker_Reset(t *testing.T) {
+	var failing atomic.Bool
+	var calls atomic.Int32
+	failing.Store(true)
+	breaker := UseCircuitBreaker(createTestContext(), flakyOp(&failing, &calls),
+		WithFailureThreshold(1, 1), WithCooldown(time.Hour))
+
+	_, _ = breaker.Call(context.Background())
+	require.Equal(t, CircuitOpen, breaker.State.GetTyped())
+
+	breaker.Reset()
+
+	assert.Equal(t, CircuitClosed, breaker.State.GetTyped())
+	assert.Equal(t, 0, breaker.Failures.GetTyped())
+	failing.Store(false)
+	_, err := breaker.Call(context.Background())
+	assert.NoError(t, err)
+}
+
+// TestUseCircuitBreaker_UnmountStopsCooldown verifies the circuit does not
+// half-open after the component unmounts
+func TestUseCircuitBreaker_UnmountStopsCooldown(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	breaker := UseCircuitBreaker(ctx, func(context.Context) (string, error) {
+		return "", errBackend
+	}, WithFailureThreshold(1, 1), WithCooldown(10*time.Millisecond))
+
+	_, _ = breaker.Call(context.Background())
+	require.Equal(t, CircuitOpen, breaker.State.GetTyped())
+
+	bubbly.TriggerUnmount(ctx)
+	time.Sleep(30 * time.Millisecond)
+
+	assert.Equal(t, CircuitOpen, breaker.State.GetTyped())
+}
+
+// TestUseCircuitBreaker_WithUseAsync verifies Call works as a UseAsync fetcher
+func TestUseCircuitBreaker_WithUseAsync(t *testing.T) {
+	breaker := UseCircuitBreaker(createTestContext(), func(context.Context) (*string, error) {
+		return nil, errBackend
+	}, WithFailureThreshold(1, 1), WithCooldown(time.Hour))
+	async := UseAsync(createTestContext(), breaker.Call)
+
+	async.Execute()
+	assert.Eventually(t, func() bool { return !async.Loading.GetTyped() }, time.Second, time.Millisecond)
+	assert.ErrorIs(t, async.Error.GetTyped(), errBackend)
+
+	async.Execute()
+	assert.Eventually(t, func() bool {
+		return errors.Is(async.Error.GetTyped(), ErrCircuitOpen)
+	}, time.Second, time.Millisecond)
+}
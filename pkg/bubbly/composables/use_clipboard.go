@@ -0,0 +1,220 @@
+package composables
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/atotto/clipboard"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultCopiedDuration is how long UseClipboard's Copied flag stays true
+// after a copy.
+const DefaultCopiedDuration = 2 * time.Second
+
+// ClipboardBackend reads and writes a clipboard. UseClipboard uses the
+// system clipboard by default; provide another backend with
+// WithClipboardBackend, for example in tests or to use OSC 52 over SSH.
+type ClipboardBackend interface {
+	// WriteAll replaces the clipboard contents with text.
+	WriteAll(text string) error
+
+	// ReadAll returns the clipboard contents.
+	ReadAll() (string, error)
+}
+
+// systemClipboard is the OS clipboard (pbcopy, xclip/xsel/wl-clipboard,
+// or the Windows clipboard).
+type systemClipboard struct{}
+
+func (systemClipboard) WriteAll(text string) error { return clipboard.WriteAll(text) }
+
+func (systemClipboard) ReadAll() (string, error) { return clipboard.ReadAll() }
+
+// MemoryClipboard is a ClipboardBackend holding the clipboard in memory,
+// shared only within the process. UseClipboard falls back to it when no
+// system clipboard is available (e.g., in CI or a headless container), and
+// it is convenient in tests.
+//
+// The zero value is an empty clipboard ready to use.
+type MemoryClipboard struct {
+	mu   sync.Mutex
+	text string
+}
+
+// WriteAll replaces the clipboard contents with text.
+func (m *MemoryClipboard) WriteAll(text string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.text = text
+	return nil
+}
+
+// ReadAll returns the clipboard contents.
+func (m *MemoryClipboard) ReadAll() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.text, nil
+}
+
+// fallbackClipboard is the process-wide clipboard used when the system
+// clipboard is unsupported, so copies and pastes still pair up across
+// components.
+var fallbackClipboard = &MemoryClipboard{}
+
+// defaultClipboardBackend returns the system clipboard, or the in-memory
+// fallback if the platform has no supported clipboard tool.
+func defaultClipboardBackend() ClipboardBackend {
+	if clipboard.Unsupported {
+		return fallbackClipboard
+	}
+	return systemClipboard{}
+}
+
+// clipboardConfig holds configuration for UseClipboard.
+type clipboardConfig struct {
+	backend        ClipboardBackend
+	copiedDuration time.Duration
+	history        *ClipboardHistoryReturn
+}
+
+// ClipboardOption configures UseClipboard.
+type ClipboardOption func(*clipboardConfig)
+
+// WithClipboardBackend sets the clipboard UseClipboard reads and writes.
+// Default: the system clipboard, or an in-memory fallback if unsupported.
+func WithClipboardBackend(backend ClipboardBackend) ClipboardOption {
+	return func(c *clipboardConfig) {
+		if backend != nil {
+			c.backend = backend
+		}
+	}
+}
+
+// WithCopiedDuration sets how long Copied stays true after a copy.
+// Default: DefaultCopiedDuration.
+func WithCopiedDuration(d time.Duration) ClipboardOption {
+	return func(c *clipboardConfig) {
+		if d > 0 {
+			c.copiedDuration = d
+		}
+	}
+}
+
+// WithClipboardHistory records every successful copy in history (see
+// UseClipboardHistory), subject to the history's exclusions.
+func WithClipboardHistory(history *ClipboardHistoryReturn) ClipboardOption {
+	return func(c *clipboardConfig) {
+		c.history = history
+	}
+}
+
+// ClipboardReturn is the return value of UseClipboard.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type ClipboardReturn struct {
+	// Copied is true for a short while after a successful Copy, for
+	// "Copied!" feedback. Each copy restarts the period.
+	Copied *bubbly.Ref[bool]
+
+	backend ClipboardBackend
+	history *ClipboardHistoryReturn
+	reset   *TimeoutReturn
+}
+
+// Copy writes text to the clipboard. On success, Copied becomes true until
+// the copied duration has passed.
+//
+// Example:
+//
+//	if err := clip.Copy(token); err != nil {
+//	    status.Set("Copy failed: " + err.Error())
+//	}
+func (c *ClipboardReturn) Copy(text string) error {
+	if err := c.backend.WriteAll(text); err != nil {
+		return fmt.Errorf("clipboard copy: %w", err)
+	}
+	if c.history != nil {
+		c.history.Record(text)
+	}
+	c.Copied.Set(true)
+	c.reset.Reset()
+	return nil
+}
+
+// Read returns the clipboard contents.
+func (c *ClipboardReturn) Read() (string, error) {
+	text, err := c.backend.ReadAll()
+	if err != nil {
+		return "", fmt.Errorf("clipboard read: %w", err)
+	}
+	return text, nil
+}
+
+// UseClipboard provides copy and paste with the system clipboard, plus a
+// Copied flag for brief "Copied!" feedback.
+//
+// When the platform has no supported clipboard tool (for example, Linux
+// without xclip, xsel, or wl-clipboard, as is common in CI), UseClipboard
+// degrades to an in-process clipboard instead of failing: copies and pastes
+// still work within the app, but do not reach other programs.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - opts: Optional configuration (WithClipboardBackend,
+//     WithCopiedDuration, WithClipboardHistory)
+//
+// Returns:
+//   - *ClipboardReturn: The Copied flag with Copy and Read methods
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    clip := composables.UseClipboard(ctx)
+//	    ctx.Expose("copied", clip.Copied)
+//
+//	    ctx.On("copyURL", func(_ interface{}) {
+//	        _ = clip.Copy(url.GetTyped())
+//	    })
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    if ctx.Get("copied").(*bubbly.Ref[bool]).GetTyped() {
+//	        return "Copied!"
+//	    }
+//	    return "Press y to copy the URL"
+//	})
+//
+// Cleanup:
+//
+// The timer resetting Copied is stopped when the component unmounts.
+func UseClipboard(ctx *bubbly.Context, opts ...ClipboardOption) *ClipboardReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseClipboard", time.Since(start))
+	}()
+
+	config := clipboardConfig{copiedDuration: DefaultCopiedDuration}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.backend == nil {
+		config.backend = defaultClipboardBackend()
+	}
+
+	copied := bubbly.NewRef(false)
+	return &ClipboardReturn{
+		Copied:  copied,
+		backend: config.backend,
+		history: config.history,
+		reset: UseTimeout(ctx, func() {
+			copied.Set(false)
+		}, config.copiedDuration),
+	}
+}
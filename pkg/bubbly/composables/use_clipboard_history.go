@@ -0,0 +1,223 @@
+package composables
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultClipboardHistorySize is the history size UseClipboardHistory uses
+// when max is not positive.
+const DefaultClipboardHistorySize = 20
+
+// clipboardHistoryConfig holds configuration for UseClipboardHistory.
+type clipboardHistoryConfig struct {
+	exclude []func(text string) bool
+}
+
+// ClipboardHistoryOption configures UseClipboardHistory.
+type ClipboardHistoryOption func(*clipboardHistoryConfig)
+
+// WithClipboardExclude keeps values for which exclude returns true out of
+// the history, such as passwords or API tokens. Several exclusions may be
+// given; a value is excluded if any of them matches.
+//
+// Example:
+//
+//	history := UseClipboardHistory(ctx, 20,
+//	    WithClipboardExclude(func(text string) bool {
+//	        return strings.HasPrefix(text, "sk-")
+//	    }),
+//	)
+func WithClipboardExclude(exclude func(text string) bool) ClipboardHistoryOption {
+	return func(c *clipboardHistoryConfig) {
+		if exclude != nil {
+			c.exclude = append(c.exclude, exclude)
+		}
+	}
+}
+
+// ClipboardHistoryReturn is the return value of UseClipboardHistory.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type ClipboardHistoryReturn struct {
+	// Entries holds the recorded values, oldest first. Once full, recording
+	// drops the oldest value.
+	Entries *bubbly.RingRef[string]
+
+	// Recent holds the recorded values, most recent first, for rendering a
+	// picker. Its indices are the ones Paste accepts.
+	Recent *bubbly.Computed[[]string]
+
+	config clipboardHistoryConfig
+
+	// mu serializes changes to Entries
+	mu sync.Mutex
+}
+
+// Record adds a copied value to the history. Empty values, excluded values
+// (see WithClipboardExclude), and repeats of the most recent value are not
+// recorded. It reports whether the value was recorded.
+func (h *ClipboardHistoryReturn) Record(text string) bool {
+	if text == "" || h.excluded(text) {
+		return false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if entries := h.Entries.Items(); len(entries) > 0 && entries[len(entries)-1] == text {
+		return false
+	}
+
+	h.Entries.Push(text)
+	return true
+}
+
+// Paste returns the history entry at index, where 0 is the most recent
+// value (the order of Recent). It returns false if index is out of range.
+//
+// Example:
+//
+//	if text, ok := history.Paste(selected.GetTyped()); ok {
+//	    input.Value.Set(input.Value.GetTyped() + text)
+//	}
+func (h *ClipboardHistoryReturn) Paste(index int) (string, bool) {
+	entries := h.Entries.Items()
+	if index < 0 || index >= len(entries) {
+		return "", false
+	}
+	return entries[len(entries)-1-index], true
+}
+
+// Clear removes every entry from the history.
+func (h *ClipboardHistoryReturn) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.Entries.Clear()
+}
+
+// ClearMatching removes the entries for which match returns true, keeping
+// the order of the others, and reports how many were removed. Use it to
+// purge values found to be sensitive after they were recorded.
+//
+// Example:
+//
+//	history.ClearMatching(func(text string) bool { return text == revokedToken })
+func (h *ClipboardHistoryReturn) ClearMatching(match func(text string) bool) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	entries := h.Entries.Items()
+	kept := make([]string, 0, len(entries))
+	for _, text := range entries {
+		if !match(text) {
+			kept = append(kept, text)
+		}
+	}
+	removed := len(entries) - len(kept)
+	if removed > 0 {
+		h.Entries.Clear()
+		h.Entries.Push(kept...)
+	}
+	return removed
+}
+
+// excluded reports whether text is kept out of the history.
+func (h *ClipboardHistoryReturn) excluded(text string) bool {
+	for _, exclude := range h.config.exclude {
+		if exclude(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// UseClipboardHistory keeps a bounded, reactive history of copied values,
+// for "paste from history" pickers in terminal tools such as snippet
+// managers.
+//
+// Values are added with Record when the app copies them. Values that must
+// never linger (passwords, tokens) are kept out with WithClipboardExclude,
+// or simply not recorded. Clear wipes the history (e.g., when a vault
+// locks) and ClearMatching purges selected entries.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - max: The number of entries kept; DefaultClipboardHistorySize if not positive
+//   - opts: Optional configuration
+//
+// Returns:
+//   - *ClipboardHistoryReturn: The history with Record, Paste, and Clear methods
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    history := composables.UseClipboardHistory(ctx, 10)
+//
+//	    ctx.On("copy", func(data interface{}) {
+//	        text := data.(string)
+//	        writeToClipboard(text)
+//	        history.Record(text)
+//	    })
+//
+//	    ctx.On("pasteFromHistory", func(data interface{}) {
+//	        if text, ok := history.Paste(data.(int)); ok {
+//	            ctx.Emit("paste", text)
+//	        }
+//	    })
+//
+//	    ctx.Expose("history", history.Recent)
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    var lines []string
+//	    for i, text := range ctx.Get("history").(*bubbly.Computed[[]string]).GetTyped() {
+//	        lines = append(lines, fmt.Sprintf("%d. %s", i+1, text))
+//	    }
+//	    return strings.Join(lines, "\n")
+//	})
+//
+// Cleanup:
+//
+// The history is cleared when the component unmounts, so copied values do
+// not outlive the component.
+func UseClipboardHistory(ctx *bubbly.Context, max int, opts ...ClipboardHistoryOption) *ClipboardHistoryReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseClipboardHistory", time.Since(start))
+	}()
+
+	if max <= 0 {
+		max = DefaultClipboardHistorySize
+	}
+	var config clipboardHistoryConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	entries := bubbly.NewRingRef[string](max)
+	history := &ClipboardHistoryReturn{
+		Entries: entries,
+		Recent: bubbly.NewComputed(func() []string {
+			items := entries.Items()
+			recent := make([]string, len(items))
+			for i, text := range items {
+				recent[len(items)-1-i] = text
+			}
+			return recent
+		}),
+		config: config,
+	}
+
+	// Register cleanup on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(history.Clear)
+	}
+
+	return history
+}
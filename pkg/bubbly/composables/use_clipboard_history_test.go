@@ -0,0 +1,114 @@
+package composables
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestUseClipboardHistory_Record tests which values are recorded
+func TestUseClipboardHistory_Record(t *testing.T) {
+	isToken := func(text string) bool { return strings.HasPrefix(text, "sk-") }
+
+	tests := []struct {
+		name     string
+		max      int
+		values   []string
+		expected []string
+	}{
+		{name: "most recent first", max: 5, values: []string{"a", "b", "c"}, expected: []string{"c", "b", "a"}},
+		{name: "oldest dropped when full", max: 2, values: []string{"a", "b", "c"}, expected: []string{"c", "b"}},
+		{name: "empty values skipped", max: 5, values: []string{"a", ""}, expected: []string{"a"}},
+		{name: "repeat of latest skipped", max: 5, values: []string{"a", "a", "b", "a"}, expected: []string{"a", "b", "a"}},
+		{name: "excluded values skipped", max: 5, values: []string{"a", "sk-secret", "b"}, expected: []string{"b", "a"}},
+		{name: "default size", max: 0, values: []string{"a"}, expected: []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := UseClipboardHistory(createTestContext(), tt.max, WithClipboardExclude(isToken))
+
+			for _, value := range tt.values {
+				history.Record(value)
+			}
+
+			assert.Equal(t, tt.expected, history.Recent.GetTyped())
+		})
+	}
+}
+
+// TestUseClipboardHistory_DefaultSize tests a non-positive max uses the default
+func TestUseClipboardHistory_DefaultSize(t *testing.T) {
+	history := UseClipboardHistory(createTestContext(), -1)
+
+	assert.Equal(t, DefaultClipboardHistorySize, history.Entries.Cap())
+}
+
+// TestUseClipboardHistory_Paste tests pasting by Recent index
+func TestUseClipboardHistory_Paste(t *testing.T) {
+	history := UseClipboardHistory(createTestContext(), 5)
+	history.Record("first")
+	history.Record("second")
+
+	tests := []struct {
+		index      int
+		expected   string
+		expectedOK bool
+	}{
+		{index: 0, expected: "second", expectedOK: true},
+		{index: 1, expected: "first", expectedOK: true},
+		{index: 2, expectedOK: false},
+		{index: -1, expectedOK: false},
+	}
+
+	for _, tt := range tests {
+		text, ok := history.Paste(tt.index)
+		assert.Equal(t, tt.expectedOK, ok, "index %d", tt.index)
+		assert.Equal(t, tt.expected, text, "index %d", tt.index)
+	}
+}
+
+// TestUseClipboardHistory_Clear tests clearing all or selected entries
+func TestUseClipboardHistory_Clear(t *testing.T) {
+	history := UseClipboardHistory(createTestContext(), 5)
+	history.Record("a")
+	history.Record("password")
+	history.Record("b")
+
+	removed := history.ClearMatching(func(text string) bool { return text == "password" })
+
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, []string{"b", "a"}, history.Recent.GetTyped())
+	assert.Equal(t, 0, history.ClearMatching(func(string) bool { return false }))
+
+	history.Clear()
+	assert.Empty(t, history.Recent.GetTyped())
+}
+
+// TestUseClipboardHistory_Reactive tests watchers see recorded values
+func TestUseClipboardHistory_Reactive(t *testing.T) {
+	history := UseClipboardHistory(createTestContext(), 5)
+	var seen [][]string
+	bubbly.Watch(history.Entries, func(newVal, _ []string) { seen = append(seen, newVal) })
+
+	history.Record("a")
+	history.Record("b")
+
+	assert.Equal(t, [][]string{{"a"}, {"a", "b"}}, seen)
+}
+
+// TestUseClipboardHistory_UnmountClears tests the history is wiped on unmount
+func TestUseClipboardHistory_UnmountClears(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	history := UseClipboardHistory(ctx, 5)
+	history.Record("secret")
+	require.Equal(t, 1, history.Entries.Len())
+
+	bubbly.TriggerUnmount(ctx)
+
+	assert.Equal(t, 0, history.Entries.Len())
+}
@@ -0,0 +1,97 @@
+package composables
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// failingClipboard is a ClipboardBackend whose operations fail.
+type failingClipboard struct{}
+
+var errClipboardDown = errors.New("clipboard down")
+
+func (failingClipboard) WriteAll(string) error    { return errClipboardDown }
+func (failingClipboard) ReadAll() (string, error) { return "", errClipboardDown }
+
+// TestUseClipboard_CopyRead tests copying and reading through the backend
+func TestUseClipboard_CopyRead(t *testing.T) {
+	backend := &MemoryClipboard{}
+	clip := UseClipboard(createTestContext(), WithClipboardBackend(backend))
+
+	require.NoError(t, clip.Copy("https://example.com"))
+
+	text, err := clip.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com", text)
+	assert.True(t, clip.Copied.GetTyped())
+}
+
+// TestUseClipboard_CopiedResets tests Copied turns off after the duration
+func TestUseClipboard_CopiedResets(t *testing.T) {
+	clip := UseClipboard(createTestContext(),
+		WithClipboardBackend(&MemoryClipboard{}),
+		WithCopiedDuration(30*time.Millisecond))
+
+	require.NoError(t, clip.Copy("first"))
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, clip.Copy("second"))
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, clip.Copied.GetTyped(), "a new copy should restart the period")
+
+	assert.Eventually(t, func() bool { return !clip.Copied.GetTyped() }, time.Second, 5*time.Millisecond)
+}
+
+// TestUseClipboard_Errors tests backend failures are returned
+func TestUseClipboard_Errors(t *testing.T) {
+	clip := UseClipboard(createTestContext(), WithClipboardBackend(failingClipboard{}))
+
+	err := clip.Copy("secret")
+	assert.ErrorIs(t, err, errClipboardDown)
+	assert.False(t, clip.Copied.GetTyped(), "failed copies should not report success")
+
+	_, err = clip.Read()
+	assert.ErrorIs(t, err, errClipboardDown)
+}
+
+// TestUseClipboard_History tests successful copies are recorded
+func TestUseClipboard_History(t *testing.T) {
+	history := UseClipboardHistory(createTestContext(), 5,
+		WithClipboardExclude(func(text string) bool { return text == "password" }))
+	clip := UseClipboard(createTestContext(),
+		WithClipboardBackend(&MemoryClipboard{}),
+		WithClipboardHistory(history))
+
+	require.NoError(t, clip.Copy("one"))
+	require.NoError(t, clip.Copy("password"))
+	require.NoError(t, clip.Copy("two"))
+
+	assert.Equal(t, []string{"two", "one"}, history.Recent.GetTyped())
+}
+
+// TestUseClipboard_Unmount tests Copied is not reset after unmount
+func TestUseClipboard_Unmount(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	clip := UseClipboard(ctx,
+		WithClipboardBackend(&MemoryClipboard{}),
+		WithCopiedDuration(10*time.Millisecond))
+
+	require.NoError(t, clip.Copy("text"))
+	bubbly.TriggerUnmount(ctx)
+	time.Sleep(30 * time.Millisecond)
+
+	assert.True(t, clip.Copied.GetTyped(), "the reset timer should be stopped on unmount")
+}
+
+// TestUseClipboard_DefaultBackend tests a backend is always available
+func TestUseClipboard_DefaultBackend(t *testing.T) {
+	clip := UseClipboard(nil)
+
+	assert.NotNil(t, clip.backend)
+	assert.NotNil(t, clip.Copied)
+}
@@ -15,6 +15,7 @@ type counterConfig struct {
 	step   int
 	hasMin bool
 	hasMax bool
+	wrap   bool
 }
 
 // CounterOption configures UseCounter.
@@ -64,6 +65,23 @@ func WithStep(step int) CounterOption {
 	}
 }
 
+// WithCounterWrap makes Increment, Decrement, IncrementBy, and DecrementBy
+// wrap around the bounds instead of clamping: stepping past the maximum
+// continues from the minimum, and vice versa, as in a carousel or a
+// circular menu. It requires both WithMin and WithMax; without them the
+// counter clamps as usual. Set and the initial value still clamp.
+//
+// Example:
+//
+//	slide := UseCounter(ctx, 0, WithMin(0), WithMax(2), WithCounterWrap())
+//	slide.Decrement()  // Now 2
+//	slide.Increment()  // Now 0
+func WithCounterWrap() CounterOption {
+	return func(c *counterConfig) {
+		c.wrap = true
+	}
+}
+
 // CounterReturn is the return value of UseCounter.
 // It provides reactive counter state management with methods for
 // incrementing, decrementing, and controlling the value within optional bounds.
@@ -78,6 +96,17 @@ type CounterReturn struct {
 	initial int
 }
 
+// move returns the counter value moved by delta, wrapping around the bounds
+// if WithCounterWrap is set and clamping otherwise.
+func (c *CounterReturn) move(delta int) int {
+	value := c.Count.GetTyped() + delta
+	if !c.config.wrap || !c.config.hasMin || !c.config.hasMax || c.config.max < c.config.min {
+		return c.clamp(value)
+	}
+	size := c.config.max - c.config.min + 1
+	return c.config.min + ((value-c.config.min)%size+size)%size
+}
+
 // clamp constrains value to configured bounds.
 func (c *CounterReturn) clamp(value int) int {
 	if c.config.hasMin && value < c.config.min {
@@ -89,7 +118,7 @@ func (c *CounterReturn) clamp(value int) int {
 	return value
 }
 
-// Increment increases count by step (respects max).
+// Increment increases count by step (respects max, or wraps with WithCounterWrap).
 // If no step is configured, increments by 1.
 //
 // Example:
@@ -98,12 +127,10 @@ func (c *CounterReturn) clamp(value int) int {
 //	counter.Increment()  // Now 1
 //	counter.Increment()  // Now 2
 func (c *CounterReturn) Increment() {
-	current := c.Count.GetTyped()
-	newValue := c.clamp(current + c.config.step)
-	c.Count.Set(newValue)
+	c.Count.Set(c.move(c.config.step))
 }
 
-// Decrement decreases count by step (respects min).
+// Decrement decreases count by step (respects min, or wraps with WithCounterWrap).
 // If no step is configured, decrements by 1.
 //
 // Example:
@@ -112,12 +139,10 @@ func (c *CounterReturn) Increment() {
 //	counter.Decrement()  // Now 9
 //	counter.Decrement()  // Now 8
 func (c *CounterReturn) Decrement() {
-	current := c.Count.GetTyped()
-	newValue := c.clamp(current - c.config.step)
-	c.Count.Set(newValue)
+	c.Count.Set(c.move(-c.config.step))
 }
 
-// IncrementBy increases count by n (respects max).
+// IncrementBy increases count by n (respects max, or wraps with WithCounterWrap).
 // This ignores the configured step and uses the provided value directly.
 //
 // Example:
@@ -126,12 +151,10 @@ func (c *CounterReturn) Decrement() {
 //	counter.IncrementBy(10)  // Now 10
 //	counter.IncrementBy(5)   // Now 15
 func (c *CounterReturn) IncrementBy(n int) {
-	current := c.Count.GetTyped()
-	newValue := c.clamp(current + n)
-	c.Count.Set(newValue)
+	c.Count.Set(c.move(n))
 }
 
-// DecrementBy decreases count by n (respects min).
+// DecrementBy decreases count by n (respects min, or wraps with WithCounterWrap).
 // This ignores the configured step and uses the provided value directly.
 //
 // Example:
@@ -140,9 +163,7 @@ func (c *CounterReturn) IncrementBy(n int) {
 //	counter.DecrementBy(5)   // Now 15
 //	counter.DecrementBy(10)  // Now 5
 func (c *CounterReturn) DecrementBy(n int) {
-	current := c.Count.GetTyped()
-	newValue := c.clamp(current - n)
-	c.Count.Set(newValue)
+	c.Count.Set(c.move(-n))
 }
 
 // Set sets the count to a specific value (clamped to bounds).
@@ -185,7 +206,7 @@ func (c *CounterReturn) Reset() {
 // Parameters:
 //   - ctx: The component context (required for all composables)
 //   - initial: The initial counter value
-//   - opts: Optional configuration (WithMin, WithMax, WithStep)
+//   - opts: Optional configuration (WithMin, WithMax, WithStep, WithCounterWrap)
 //
 // Returns:
 //   - *CounterReturn: A struct containing the reactive counter value and methods
@@ -423,3 +423,74 @@ func TestUseCounter_DecrementByWithBounds(t *testing.T) {
 	counter.DecrementBy(50)
 	assert.Equal(t, 0, counter.Count.GetTyped(), "DecrementBy should clamp to min")
 }
+
+// TestUseCounter_Wrap tests WithCounterWrap wraps around the bounds
+func TestUseCounter_Wrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  int
+		opts     []CounterOption
+		action   func(c *CounterReturn)
+		expected int
+	}{
+		{
+			name:     "increment past max wraps to min",
+			initial:  2,
+			opts:     []CounterOption{WithMin(0), WithMax(2), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.Increment() },
+			expected: 0,
+		},
+		{
+			name:     "decrement past min wraps to max",
+			initial:  0,
+			opts:     []CounterOption{WithMin(0), WithMax(2), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.Decrement() },
+			expected: 2,
+		},
+		{
+			name:     "step wraps by remainder",
+			initial:  8,
+			opts:     []CounterOption{WithMin(1), WithMax(10), WithStep(5), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.Increment() },
+			expected: 3,
+		},
+		{
+			name:     "large jumps wrap several times",
+			initial:  0,
+			opts:     []CounterOption{WithMin(0), WithMax(9), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.DecrementBy(23) },
+			expected: 7,
+		},
+		{
+			name:     "within bounds moves normally",
+			initial:  1,
+			opts:     []CounterOption{WithMin(0), WithMax(9), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.IncrementBy(3) },
+			expected: 4,
+		},
+		{
+			name:     "set still clamps",
+			initial:  0,
+			opts:     []CounterOption{WithMin(0), WithMax(9), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.Set(12) },
+			expected: 9,
+		},
+		{
+			name:     "without both bounds clamps",
+			initial:  5,
+			opts:     []CounterOption{WithMax(5), WithCounterWrap()},
+			action:   func(c *CounterReturn) { c.Increment() },
+			expected: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := UseCounter(createTestContext(), tt.initial, tt.opts...)
+
+			tt.action(counter)
+
+			assert.Equal(t, tt.expected, counter.Count.GetTyped())
+		})
+	}
+}
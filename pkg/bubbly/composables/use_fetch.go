@@ -0,0 +1,364 @@
+package composables
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultFetchCacheSize is the number of keys the process-wide UseFetch
+// cache holds; the least recently used key is evicted beyond it.
+const DefaultFetchCacheSize = 256
+
+// fetchCall is an in-flight fetch shared by every caller of the same key.
+type fetchCall struct {
+	done  chan struct{}
+	value any
+	err   error
+
+	// invalidated is set (under fetchCache.mu) when the key is invalidated
+	// while the call is in flight: its result predates the invalidation, so
+	// it is not cached and later loads start a new call.
+	invalidated bool
+}
+
+// fetchEntry is a cached fetch result.
+type fetchEntry struct {
+	key       string
+	value     any
+	fetchedAt time.Time
+	element   *list.Element // Position in the LRU order
+}
+
+// fetchSubscriber is a mounted UseFetch, refetched when its key is
+// invalidated.
+type fetchSubscriber struct {
+	invalidate func()
+}
+
+// fetchCache is the process-wide cache behind UseFetch.
+type fetchCache struct {
+	mu          sync.Mutex
+	size        int
+	entries     map[string]*fetchEntry
+	order       *list.List // Front is most recently used
+	inflight    map[string]*fetchCall
+	subscribers map[string]map[*fetchSubscriber]struct{}
+}
+
+func newFetchCache(size int) *fetchCache {
+	return &fetchCache{
+		size:        size,
+		entries:     make(map[string]*fetchEntry),
+		order:       list.New(),
+		inflight:    make(map[string]*fetchCall),
+		subscribers: make(map[string]map[*fetchSubscriber]struct{}),
+	}
+}
+
+// globalFetchCache is shared by all UseFetch calls.
+var globalFetchCache = newFetchCache(DefaultFetchCacheSize)
+
+// peek returns the cached value for key and when it was fetched.
+func (c *fetchCache) peek(key string) (any, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	c.order.MoveToFront(entry.element)
+	return entry.value, entry.fetchedAt, true
+}
+
+// load fetches key, joining a fetch already in flight for it, and caches a
+// successful result. fetch runs detached from ctx, since other callers may
+// be waiting for it; cancelling ctx only stops this caller from waiting.
+func (c *fetchCache) load(ctx context.Context, key string, fetch func(context.Context) (any, error)) (any, error) {
+	c.mu.Lock()
+	call, ok := c.inflight[key]
+	if !ok {
+		call = &fetchCall{done: make(chan struct{})}
+		c.inflight[key] = call
+		go c.run(key, call, fetch)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.value, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// run executes a shared fetch and publishes its result.
+func (c *fetchCache) run(key string, call *fetchCall, fetch func(context.Context) (any, error)) {
+	call.value, call.err = fetch(context.Background())
+
+	c.mu.Lock()
+	if c.inflight[key] == call {
+		delete(c.inflight, key)
+	}
+	if call.err == nil && !call.invalidated {
+		c.store(key, call.value)
+	}
+	c.mu.Unlock()
+
+	close(call.done)
+}
+
+// store caches value for key, evicting the least recently used key if the
+// cache is full. The caller must hold c.mu.
+func (c *fetchCache) store(key string, value any) {
+	if entry, ok := c.entries[key]; ok {
+		entry.value, entry.fetchedAt = value, time.Now()
+		c.order.MoveToFront(entry.element)
+		return
+	}
+
+	entry := &fetchEntry{key: key, value: value, fetchedAt: time.Now()}
+	entry.element = c.order.PushFront(entry)
+	c.entries[key] = entry
+
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*fetchEntry).key)
+	}
+}
+
+// subscribe registers a mounted UseFetch for invalidation and returns the
+// function removing it.
+func (c *fetchCache) subscribe(key string, sub *fetchSubscriber) func() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.subscribers[key] == nil {
+		c.subscribers[key] = make(map[*fetchSubscriber]struct{})
+	}
+	c.subscribers[key][sub] = struct{}{}
+
+	return func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		delete(c.subscribers[key], sub)
+		if len(c.subscribers[key]) == 0 {
+			delete(c.subscribers, key)
+		}
+	}
+}
+
+// invalidate drops the cached value for key, detaches any fetch of it in
+// flight, and returns its subscribers.
+func (c *fetchCache) invalidate(key string) []*fetchSubscriber {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		c.order.Remove(entry.element)
+		delete(c.entries, key)
+	}
+	if call, ok := c.inflight[key]; ok {
+		call.invalidated = true
+		delete(c.inflight, key)
+	}
+	subs := make([]*fetchSubscriber, 0, len(c.subscribers[key]))
+	for sub := range c.subscribers[key] {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// InvalidateFetch drops the cached result for key, so the next UseFetch for
+// it fetches again, and refetches it in every mounted UseFetch using it
+// (their Data stays visible, marked stale, until the new result arrives).
+// A fetch of key already in flight is not joined by these refetches, since
+// it may have started before the mutation, and its result is not cached.
+// Call it after a mutation that changes what key returns.
+//
+// Example:
+//
+//	ctx.On("save", func(_ interface{}) {
+//	    if err := api.SaveTodo(todo); err == nil {
+//	        composables.InvalidateFetch("todos")
+//	    }
+//	})
+func InvalidateFetch(key string) {
+	for _, sub := range globalFetchCache.invalidate(key) {
+		sub.invalidate()
+	}
+}
+
+// fetchConfig holds configuration for UseFetch.
+type fetchConfig struct {
+	staleTime time.Duration
+}
+
+// FetchOption configures UseFetch.
+type FetchOption func(*fetchConfig)
+
+// WithStaleTime sets how long a cached result counts as fresh. A fresh
+// result is shown without fetching again; an older one is shown as stale
+// while it is refreshed in the background. Default: 0 (always revalidate).
+func WithStaleTime(d time.Duration) FetchOption {
+	return func(c *fetchConfig) {
+		if d > 0 {
+			c.staleTime = d
+		}
+	}
+}
+
+// FetchReturn is the return value of UseFetch.
+type FetchReturn[T any] struct {
+	// Data holds the latest result for the key: a cached one at first, then
+	// the fetched one. It is nil until a result is available, and keeps the
+	// last result when a fetch fails.
+	Data *bubbly.Ref[*T]
+
+	// Loading is true while a fetch is in progress, including background
+	// revalidation of a stale result.
+	Loading *bubbly.Ref[bool]
+
+	// Error holds the error of the last fetch, or nil.
+	Error *bubbly.Ref[error]
+
+	// IsStale is true while Data is a cached result older than the stale
+	// time (see WithStaleTime) that has not been refreshed yet.
+	IsStale *bubbly.Ref[bool]
+
+	// Refetch fetches the key again, ignoring the stale time. If another
+	// fetch of the key is in flight anywhere in the app, it waits for that
+	// one instead of starting another.
+	Refetch func()
+}
+
+// UseFetch fetches data by key through a process-wide cache, for API-backed
+// TUIs. It is built on UseAsync and adds three things:
+//
+//   - Caching: results are cached by key across components and re-executes.
+//     The cache is bounded (DefaultFetchCacheSize keys, least recently used
+//     evicted first).
+//   - Stale-while-revalidate: a cached result is shown immediately and, if
+//     older than the stale time, refreshed in the background.
+//   - Deduplication: concurrent fetches of the same key share one call to
+//     fetch.
+//
+// The fetch starts when UseFetch is called (unless a fresh result is
+// cached). Use a key that identifies everything the fetch depends on, such
+// as "user:42" or "search?q="+query.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - key: The cache key
+//   - fetch: Fetches the data; its context is not cancelled when one
+//     caller gives up, since the result may be shared
+//   - opts: Optional configuration (WithStaleTime)
+//
+// Returns:
+//   - FetchReturn[T]: Data, Loading, Error, and IsStale refs plus Refetch
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    user := composables.UseFetch(ctx, "user:"+id,
+//	        func(c context.Context) (User, error) { return api.GetUser(c, id) },
+//	        composables.WithStaleTime(30*time.Second),
+//	    )
+//	    ctx.Expose("user", user.Data)
+//	    ctx.Expose("stale", user.IsStale)
+//	    ctx.On("refresh", func(_ interface{}) { user.Refetch() })
+//	})
+//
+// Cleanup:
+//
+// An in-flight fetch is abandoned (its result is still cached) and the
+// component stops receiving invalidations when it unmounts.
+func UseFetch[T any](
+	ctx *bubbly.Context,
+	key string,
+	fetch func(context.Context) (T, error),
+	opts ...FetchOption,
+) FetchReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseFetch", time.Since(start))
+	}()
+
+	var config fetchConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	shared := func(c context.Context) (any, error) {
+		return fetch(c)
+	}
+	async := UseAsync(ctx, func(c context.Context) (*T, error) {
+		value, err := globalFetchCache.load(c, key, shared)
+		if err != nil {
+			return nil, err
+		}
+		result, ok := value.(T)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q holds %T, not %T", ErrFetchTypeMismatch, key, value, result)
+		}
+		return &result, nil
+	})
+
+	data := bubbly.NewRef[*T](nil)
+	isStale := bubbly.NewRef(false)
+
+	// Keep the last result when a fetch fails (UseAsync clears its Data)
+	stopWatch := bubbly.Watch(async.Data, func(result, _ *T) {
+		if result != nil {
+			data.Set(result)
+			isStale.Set(false)
+		}
+	})
+
+	// Show a cached result right away, fetching only if it is stale
+	fresh := false
+	if value, fetchedAt, ok := globalFetchCache.peek(key); ok {
+		// A result of another type is not shown; the fetch reports it
+		if cached, ok := value.(T); ok {
+			data.Set(&cached)
+			fresh = time.Since(fetchedAt) < config.staleTime
+			isStale.Set(!fresh)
+		}
+	}
+	if !fresh {
+		async.Execute()
+	}
+
+	// Refetch when the key is invalidated, until unmount
+	if ctx != nil {
+		unsubscribe := globalFetchCache.subscribe(key, &fetchSubscriber{
+			invalidate: func() {
+				if data.GetTyped() != nil {
+					isStale.Set(true)
+				}
+				async.Execute()
+			},
+		})
+		ctx.OnUnmounted(func() {
+			unsubscribe()
+			stopWatch()
+		})
+	}
+
+	return FetchReturn[T]{
+		Data:    data,
+		Loading: async.Loading,
+		Error:   async.Error,
+		IsStale: isStale,
+		Refetch: async.Execute,
+	}
+}
@@ -0,0 +1,288 @@
+package composables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// fetchCounter returns a fetch function returning "<key>-<n>" for the n-th
+// call, and a counter of its calls.
+func fetchCounter(key string) (func(context.Context) (string, error), *atomic.Int32) {
+	var calls atomic.Int32
+	return func(context.Context) (string, error) {
+		n := calls.Add(1)
+		return fmt.Sprintf("%s-%d", key, n), nil
+	}, &calls
+}
+
+// fetchTestKeys makes test keys unique across runs sharing the global cache.
+var fetchTestKeys atomic.Int32
+
+// fetchTestKey returns a cache key unique to this test run.
+func fetchTestKey(t *testing.T) string {
+	return fmt.Sprintf("%s#%d", t.Name(), fetchTestKeys.Add(1))
+}
+
+// waitFetched waits until fetch has finished loading.
+func waitFetched[T any](t *testing.T, fetch FetchReturn[T]) {
+	t.Helper()
+	assert.Eventually(t, func() bool { return !fetch.Loading.GetTyped() }, time.Second, time.Millisecond)
+}
+
+func TestUseFetch_FetchesOnCreation(t *testing.T) {
+	key := fetchTestKey(t)
+	fn, calls := fetchCounter(key)
+
+	fetch := UseFetch(createTestContext(), key, fn)
+	waitFetched(t, fetch)
+
+	require.NotNil(t, fetch.Data.GetTyped())
+	assert.Equal(t, key+"-1", *fetch.Data.GetTyped())
+	assert.NoError(t, fetch.Error.GetTyped())
+	assert.False(t, fetch.IsStale.GetTyped())
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestUseFetch_StaleTime(t *testing.T) {
+	tests := []struct {
+		name          string
+		staleTime     time.Duration
+		expectedCalls int32
+		expectStale   bool
+	}{
+		{name: "fresh result is reused", staleTime: time.Hour, expectedCalls: 1, expectStale: false},
+		{name: "stale result is revalidated", staleTime: 0, expectedCalls: 2, expectStale: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := fetchTestKey(t)
+			fn, calls := fetchCounter(key)
+
+			first := UseFetch(createTestContext(), key, fn, WithStaleTime(tt.staleTime))
+			waitFetched(t, first)
+
+			second := UseFetch(createTestContext(), key, fn, WithStaleTime(tt.staleTime))
+
+			// The cached result is shown immediately
+			require.NotNil(t, second.Data.GetTyped())
+			assert.Equal(t, key+"-1", *second.Data.GetTyped())
+			assert.Equal(t, tt.expectStale, second.IsStale.GetTyped())
+
+			waitFetched(t, second)
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+			assert.False(t, second.IsStale.GetTyped())
+			assert.Equal(t, fmt.Sprintf("%s-%d", key, tt.expectedCalls), *second.Data.GetTyped())
+		})
+	}
+}
+
+func TestUseFetch_DeduplicatesConcurrentFetches(t *testing.T) {
+	key := fetchTestKey(t)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	fn := func(context.Context) (string, error) {
+		calls.Add(1)
+		<-release
+		return "shared", nil
+	}
+
+	fetches := make([]FetchReturn[string], 5)
+	for i := range fetches {
+		fetches[i] = UseFetch(createTestContext(), key, fn)
+	}
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	close(release)
+
+	for _, fetch := range fetches {
+		waitFetched(t, fetch)
+		require.NotNil(t, fetch.Data.GetTyped())
+		assert.Equal(t, "shared", *fetch.Data.GetTyped())
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestUseFetch_ErrorKeepsStaleData(t *testing.T) {
+	key := fetchTestKey(t)
+	errDown := errors.New("server down")
+	var fail atomic.Bool
+	fn := func(context.Context) (string, error) {
+		if fail.Load() {
+			return "", errDown
+		}
+		return "ok", nil
+	}
+
+	fetch := UseFetch(createTestContext(), key, fn)
+	waitFetched(t, fetch)
+
+	fail.Store(true)
+	fetch.Refetch()
+	waitFetched(t, fetch)
+
+	assert.ErrorIs(t, fetch.Error.GetTyped(), errDown)
+	require.NotNil(t, fetch.Data.GetTyped())
+	assert.Equal(t, "ok", *fetch.Data.GetTyped())
+}
+
+func TestUseFetch_Refetch(t *testing.T) {
+	key := fetchTestKey(t)
+	fn, calls := fetchCounter(key)
+
+	fetch := UseFetch(createTestContext(), key, fn, WithStaleTime(time.Hour))
+	waitFetched(t, fetch)
+
+	fetch.Refetch()
+	assert.Eventually(t, func() bool {
+		data := fetch.Data.GetTyped()
+		return data != nil && *data == key+"-2"
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestInvalidateFetch(t *testing.T) {
+	key := fetchTestKey(t)
+	fn, calls := fetchCounter(key)
+
+	ctx := bubbly.NewTestContext()
+	fetch := UseFetch(ctx, key, fn, WithStaleTime(time.Hour))
+	waitFetched(t, fetch)
+
+	InvalidateFetch(key)
+	assert.Eventually(t, func() bool {
+		data := fetch.Data.GetTyped()
+		return data != nil && *data == key+"-2"
+	}, time.Second, time.Millisecond)
+	assert.False(t, fetch.IsStale.GetTyped())
+
+	// After unmount, invalidation no longer refetches
+	bubbly.TriggerUnmount(ctx)
+	InvalidateFetch(key)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+
+	// The cache entry was dropped, so a new UseFetch fetches
+	next := UseFetch(createTestContext(), key, fn, WithStaleTime(time.Hour))
+	assert.Nil(t, next.Data.GetTyped())
+	waitFetched(t, next)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestFetchCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newFetchCache(2)
+	load := func(key string) {
+		_, err := cache.load(context.Background(), key, func(context.Context) (any, error) {
+			return key, nil
+		})
+		require.NoError(t, err)
+	}
+
+	load("a")
+	load("b")
+	_, _, _ = cache.peek("a") // "b" is now least recently used
+	load("c")
+
+	_, _, okA := cache.peek("a")
+	_, _, okB := cache.peek("b")
+	_, _, okC := cache.peek("c")
+	assert.True(t, okA)
+	assert.False(t, okB)
+	assert.True(t, okC)
+}
+
+func TestFetchCache_CancelledWaiterStopsWaiting(t *testing.T) {
+	cache := newFetchCache(2)
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	var err error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err = cache.load(ctx, "slow", func(context.Context) (any, error) {
+			<-release
+			return "done", nil
+		})
+	}()
+
+	cancel()
+	wg.Wait()
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFetchCache_InvalidateDetachesInflight(t *testing.T) {
+	cache := newFetchCache(2)
+	release := make(chan struct{})
+	stale := make(chan any, 1)
+	go func() {
+		value, _ := cache.load(context.Background(), "todos", func(context.Context) (any, error) {
+			<-release
+			return "before mutation", nil
+		})
+		stale <- value
+	}()
+	assert.Eventually(t, func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return cache.inflight["todos"] != nil
+	}, time.Second, time.Millisecond)
+
+	cache.invalidate("todos")
+
+	// The refetch starts a new call instead of joining the old one
+	value, err := cache.load(context.Background(), "todos", func(context.Context) (any, error) {
+		return "after mutation", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "after mutation", value)
+
+	// The old call still answers its waiters but is not cached
+	close(release)
+	assert.Equal(t, "before mutation", <-stale)
+	cached, _, ok := cache.peek("todos")
+	assert.True(t, ok)
+	assert.Equal(t, "after mutation", cached)
+}
+
+func TestUseFetch_TypeMismatch(t *testing.T) {
+	key := fetchTestKey(t)
+	fn, _ := fetchCounter(key)
+	text := UseFetch(createTestContext(), key, fn)
+	waitFetched(t, text)
+
+	// An int UseFetch of the same key skips the cached string
+	var number FetchReturn[int]
+	assert.NotPanics(t, func() {
+		number = UseFetch(createTestContext(), key, func(context.Context) (int, error) {
+			return 42, nil
+		})
+	})
+	assert.Nil(t, number.Data.GetTyped(), "a cached result of another type is not shown")
+	waitFetched(t, number)
+
+	// A string UseFetch joining an int fetch in flight gets an error
+	call := &fetchCall{done: make(chan struct{}), value: 42}
+	close(call.done)
+	globalFetchCache.mu.Lock()
+	globalFetchCache.inflight[key] = call
+	globalFetchCache.mu.Unlock()
+	defer globalFetchCache.invalidate(key)
+
+	text.Refetch()
+	assert.Eventually(t, func() bool { return text.Error.GetTyped() != nil }, time.Second, time.Millisecond)
+	assert.ErrorIs(t, text.Error.GetTyped(), ErrFetchTypeMismatch)
+	require.NotNil(t, text.Data.GetTyped())
+	assert.Equal(t, key+"-1", *text.Data.GetTyped())
+}
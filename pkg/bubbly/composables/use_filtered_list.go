@@ -0,0 +1,199 @@
+package composables
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// FilteredListReturn is the return value of UseFilteredList.
+// It packages the filter/search/sort-over-a-slice pattern into a single
+// reactive pipeline whose output is exposed as the Items computed value.
+//
+// Pipeline stages are applied in a fixed order regardless of the order in
+// which they were added: filters, then searches, then sort.
+//
+// Thread Safety:
+// All methods are thread-safe. Stages are usually configured once in Setup,
+// but adding a stage later simply recomputes Items.
+type FilteredListReturn[T any] struct {
+	// Items is the filtered, searched, and sorted view of the source slice.
+	// It recomputes automatically when the source, a search query, or any
+	// Ref read inside a predicate changes. The source slice is never modified.
+	Items *bubbly.Computed[[]T]
+
+	source   *bubbly.Ref[[]T]
+	filters  []func(T) bool
+	searches []listSearch[T]
+	less     func(a, b T) bool
+
+	// mu protects the pipeline stages
+	mu sync.Mutex
+}
+
+// listSearch is a case-insensitive substring match of query against field.
+type listSearch[T any] struct {
+	field func(T) string
+	query *bubbly.Ref[string]
+}
+
+// Filter adds a predicate stage. Only items for which pred returns true are
+// kept. Multiple filters are combined with AND.
+//
+// Example:
+//
+//	list.Filter(func(p Product) bool { return p.InStock })
+func (l *FilteredListReturn[T]) Filter(pred func(T) bool) *FilteredListReturn[T] {
+	if pred == nil {
+		return l
+	}
+
+	l.mu.Lock()
+	l.filters = append(l.filters, pred)
+	l.mu.Unlock()
+
+	l.Items.Invalidate()
+	return l
+}
+
+// Sort orders the result using less (a stable sort, so equal items keep
+// their source order). Calling Sort again replaces the previous ordering;
+// passing nil removes it.
+//
+// Example:
+//
+//	list.Sort(func(a, b Product) bool { return a.Price < b.Price })
+func (l *FilteredListReturn[T]) Sort(less func(a, b T) bool) *FilteredListReturn[T] {
+	l.mu.Lock()
+	l.less = less
+	l.mu.Unlock()
+
+	l.Items.Invalidate()
+	return l
+}
+
+// Search adds a case-insensitive substring search stage. The text returned
+// by field is matched against the current value of query; an empty (or
+// whitespace-only) query matches everything. Multiple searches are
+// combined with AND. To search several fields at once, return them joined
+// from a single extractor.
+//
+// Example:
+//
+//	query := bubbly.NewRef("")
+//	list.Search(func(p Product) string { return p.Name + " " + p.Category }, query)
+func (l *FilteredListReturn[T]) Search(field func(T) string, query *bubbly.Ref[string]) *FilteredListReturn[T] {
+	if field == nil || query == nil {
+		return l
+	}
+
+	l.mu.Lock()
+	l.searches = append(l.searches, listSearch[T]{field: field, query: query})
+	l.mu.Unlock()
+
+	l.Items.Invalidate()
+	return l
+}
+
+// compute runs the pipeline against the current source value.
+// Called from within Items, so every Ref read here is tracked.
+func (l *FilteredListReturn[T]) compute() []T {
+	l.mu.Lock()
+	filters := append([]func(T) bool(nil), l.filters...)
+	searches := append([]listSearch[T](nil), l.searches...)
+	less := l.less
+	l.mu.Unlock()
+
+	items := l.source.GetTyped()
+
+	// Resolve queries up front so each is tracked even for empty sources
+	queries := make([]string, len(searches))
+	for i, s := range searches {
+		queries[i] = strings.ToLower(strings.TrimSpace(s.query.GetTyped()))
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if matchesFilteredList(item, filters, searches, queries) {
+			result = append(result, item)
+		}
+	}
+
+	if less != nil {
+		sort.SliceStable(result, func(i, j int) bool {
+			return less(result[i], result[j])
+		})
+	}
+
+	return result
+}
+
+// matchesFilteredList reports whether item passes every filter and search.
+func matchesFilteredList[T any](item T, filters []func(T) bool, searches []listSearch[T], queries []string) bool {
+	for _, pred := range filters {
+		if !pred(item) {
+			return false
+		}
+	}
+	for i, s := range searches {
+		if queries[i] == "" {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(s.field(item)), queries[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// UseFilteredList creates a reactive filter/search/sort pipeline over a
+// source slice Ref. Stages are added with the chainable Filter, Search, and
+// Sort methods; the result is available as the Items computed value.
+//
+// This replaces the hand-written "filtered items" Computed common in list
+// UIs: the source Ref stays the single source of truth, and Items always
+// reflects it with the configured stages applied.
+//
+// Parameters:
+//   - ctx: The component context (may be nil outside components)
+//   - source: The Ref holding the full, unfiltered slice
+//
+// Returns:
+//   - *FilteredListReturn[T]: The pipeline, with Items as its output
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    products := bubbly.NewRef(allProducts)
+//	    query := bubbly.NewRef("")
+//
+//	    visible := composables.UseFilteredList(ctx, products).
+//	        Filter(func(p Product) bool { return p.InStock }).
+//	        Search(func(p Product) string { return p.Name }, query).
+//	        Sort(func(a, b Product) bool { return a.Price < b.Price })
+//
+//	    ctx.Expose("query", query)
+//	    ctx.Expose("visibleProducts", visible.Items)
+//	})
+func UseFilteredList[T any](ctx *bubbly.Context, source *bubbly.Ref[[]T]) *FilteredListReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseFilteredList", time.Since(start))
+	}()
+
+	if source == nil {
+		source = bubbly.NewRef[[]T](nil)
+	}
+
+	list := &FilteredListReturn[T]{
+		source: source,
+	}
+	list.Items = bubbly.NewComputed(list.compute)
+
+	return list
+}
@@ -0,0 +1,132 @@
+package composables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+type filteredListItem struct {
+	Name  string
+	Price int
+	Stock bool
+}
+
+func filteredListNames(items []filteredListItem) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+	return names
+}
+
+// TestUseFilteredList_Pipeline tests the filter, search, and sort stages
+func TestUseFilteredList_Pipeline(t *testing.T) {
+	inStock := func(i filteredListItem) bool { return i.Stock }
+	byPrice := func(a, b filteredListItem) bool { return a.Price < b.Price }
+	name := func(i filteredListItem) string { return i.Name }
+
+	tests := []struct {
+		name     string
+		query    string
+		build    func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string])
+		expected []string
+	}{
+		{
+			name:     "no stages returns source",
+			build:    func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string]) {},
+			expected: []string{"Keyboard", "Mouse", "Monitor", "Cable"},
+		},
+		{
+			name:     "filter",
+			build:    func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string]) { l.Filter(inStock) },
+			expected: []string{"Keyboard", "Monitor", "Cable"},
+		},
+		{
+			name:     "sort is stable",
+			build:    func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string]) { l.Sort(byPrice) },
+			expected: []string{"Cable", "Mouse", "Keyboard", "Monitor"},
+		},
+		{
+			name:  "search is case-insensitive",
+			query: "MO",
+			build: func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string]) {
+				l.Search(name, q)
+			},
+			expected: []string{"Mouse", "Monitor"},
+		},
+		{
+			name:  "all stages combined",
+			query: "o",
+			build: func(l *FilteredListReturn[filteredListItem], q *bubbly.Ref[string]) {
+				l.Sort(byPrice).Filter(inStock).Search(name, q)
+			},
+			expected: []string{"Keyboard", "Monitor"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := bubbly.NewRef([]filteredListItem{
+				{Name: "Keyboard", Price: 50, Stock: true},
+				{Name: "Mouse", Price: 20, Stock: false},
+				{Name: "Monitor", Price: 200, Stock: true},
+				{Name: "Cable", Price: 5, Stock: true},
+			})
+			query := bubbly.NewRef(tt.query)
+
+			list := UseFilteredList(createTestContext(), source)
+			tt.build(list, query)
+
+			assert.Equal(t, tt.expected, filteredListNames(list.Items.GetTyped()))
+			assert.Len(t, source.GetTyped(), 4, "source is not modified")
+		})
+	}
+}
+
+// TestUseFilteredList_Reactive tests recomputation when inputs change
+func TestUseFilteredList_Reactive(t *testing.T) {
+	source := bubbly.NewRef([]filteredListItem{
+		{Name: "alpha", Price: 3},
+		{Name: "beta", Price: 1},
+	})
+	query := bubbly.NewRef("")
+	maxPrice := bubbly.NewRef(10)
+
+	list := UseFilteredList(createTestContext(), source).
+		Filter(func(i filteredListItem) bool { return i.Price <= maxPrice.GetTyped() }).
+		Search(func(i filteredListItem) string { return i.Name }, query).
+		Sort(func(a, b filteredListItem) bool { return a.Price < b.Price })
+
+	assert.Equal(t, []string{"beta", "alpha"}, filteredListNames(list.Items.GetTyped()))
+
+	query.Set("ALP")
+	assert.Equal(t, []string{"alpha"}, filteredListNames(list.Items.GetTyped()))
+
+	query.Set("  ")
+	source.Set(append(source.GetTyped(), filteredListItem{Name: "gamma", Price: 2}))
+	assert.Equal(t, []string{"beta", "gamma", "alpha"}, filteredListNames(list.Items.GetTyped()))
+
+	maxPrice.Set(2)
+	assert.Equal(t, []string{"beta", "gamma"}, filteredListNames(list.Items.GetTyped()))
+
+	list.Sort(nil)
+	assert.Equal(t, []string{"beta", "gamma"}, filteredListNames(list.Items.GetTyped()))
+
+	// Watchers on Items observe changes
+	var observed []string
+	cleanup := bubbly.Watch(list.Items, func(newVal, _ []filteredListItem) {
+		observed = filteredListNames(newVal)
+	})
+	defer cleanup()
+	maxPrice.Set(5)
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, observed)
+}
+
+// TestUseFilteredList_NilInputs tests nil source and stage arguments
+func TestUseFilteredList_NilInputs(t *testing.T) {
+	list := UseFilteredList[int](nil, nil).Filter(nil).Search(nil, nil)
+	assert.Empty(t, list.Items.GetTyped())
+}
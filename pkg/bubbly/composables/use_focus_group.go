@@ -0,0 +1,223 @@
+package composables
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// focusGroupConfig holds the settings of a focus group.
+type focusGroupConfig struct {
+	initial   string
+	keyEvents bool
+}
+
+// FocusGroupOption configures UseFocusGroup.
+type FocusGroupOption func(*focusGroupConfig)
+
+// WithInitialFocus focuses the named field first instead of the first
+// field. Unknown names are ignored.
+func WithInitialFocus(name string) FocusGroupOption {
+	return func(c *focusGroupConfig) {
+		c.initial = name
+	}
+}
+
+// WithFocusKeyEvents registers handlers for FocusNextEvent and
+// FocusPrevEvent on the owning component, so Tab/Shift+Tab only need key
+// bindings:
+//
+//	.WithKeyBinding("tab", composables.FocusNextEvent, "Next field").
+//	WithKeyBinding("shift+tab", composables.FocusPrevEvent, "Previous field")
+//
+// Use it for one focus group per component; UseFocusManager handles the
+// same events.
+func WithFocusKeyEvents() FocusGroupOption {
+	return func(c *focusGroupConfig) {
+		c.keyEvents = true
+	}
+}
+
+// FocusGroupReturn is the return value of UseFocusGroup.
+// It tracks which named field of a form or wizard step has focus.
+type FocusGroupReturn struct {
+	// Current is the name of the focused field ("" when every field is
+	// disabled).
+	Current *bubbly.Ref[string]
+
+	mu       sync.Mutex
+	fields   []string
+	disabled map[string]bool
+}
+
+// Next moves focus to the next enabled field, wrapping at the end.
+//
+// Example:
+//
+//	ctx.On("fieldDone", func(_ interface{}) {
+//	    focus.Next()
+//	})
+func (f *FocusGroupReturn) Next() {
+	f.step(1)
+}
+
+// Previous moves focus to the previous enabled field, wrapping at the start.
+func (f *FocusGroupReturn) Previous() {
+	f.step(-1)
+}
+
+// Focus moves focus to the named field.
+// It is a no-op if the field is unknown or disabled.
+//
+// Example:
+//
+//	ctx.On("editEmail", func(_ interface{}) {
+//	    focus.Focus("email")
+//	})
+func (f *FocusGroupReturn) Focus(name string) {
+	f.mu.Lock()
+	allowed := slices.Contains(f.fields, name) && !f.disabled[name]
+	f.mu.Unlock()
+
+	if allowed {
+		f.Current.Set(name)
+	}
+}
+
+// IsFocused returns true if the named field currently has focus.
+//
+// Example:
+//
+//	if focus.IsFocused("email") {
+//	    // Render with focused styling
+//	}
+func (f *FocusGroupReturn) IsFocused(name string) bool {
+	return f.Current.GetTyped() == name
+}
+
+// SetDisabled enables or disables the named field. Disabled fields are
+// skipped by Next and Previous and cannot be focused. Disabling the focused
+// field moves focus to the next enabled one; enabling a field while none
+// is focused focuses it.
+//
+// Example:
+//
+//	bubbly.Watch(sameAsBilling, func(same, _ bool) {
+//	    focus.SetDisabled("shippingAddress", same)
+//	})
+func (f *FocusGroupReturn) SetDisabled(name string, disabled bool) {
+	f.mu.Lock()
+	if !slices.Contains(f.fields, name) || f.disabled[name] == disabled {
+		f.mu.Unlock()
+		return
+	}
+	f.disabled[name] = disabled
+	f.mu.Unlock()
+
+	current := f.Current.GetTyped()
+	switch {
+	case disabled && current == name:
+		f.step(1)
+	case !disabled && current == "":
+		f.Current.Set(name)
+	}
+}
+
+// IsDisabled returns true if the named field is disabled.
+func (f *FocusGroupReturn) IsDisabled(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.disabled[name]
+}
+
+// step moves focus delta positions, skipping disabled fields. Focus is
+// cleared if every field is disabled.
+func (f *FocusGroupReturn) step(delta int) {
+	f.mu.Lock()
+	idx := slices.Index(f.fields, f.Current.GetTyped())
+	if idx < 0 && delta < 0 {
+		idx = 0 // Previous with nothing focused starts from the last field
+	}
+	next := ""
+	for i := 1; i <= len(f.fields); i++ {
+		candidate := f.fields[((idx+i*delta)%len(f.fields)+len(f.fields))%len(f.fields)]
+		if !f.disabled[candidate] {
+			next = candidate
+			break
+		}
+	}
+	f.mu.Unlock()
+
+	f.Current.Set(next)
+}
+
+// UseFocusGroup creates a focus group that cycles through named fields,
+// such as the inputs of a form or the controls of a wizard step.
+//
+// Unlike UseFocus, fields can be disabled and are then skipped, and unlike
+// UseFocusManager, the group works with names rather than components, so
+// it fits forms that render their fields in a single template.
+//
+// Parameters:
+//   - ctx: The component context (required for all composables)
+//   - fields: The field names in focus order (must not be empty)
+//   - opts: Optional settings (WithInitialFocus, WithFocusKeyEvents)
+//
+// Returns:
+//   - *FocusGroupReturn: A struct containing the focused field and methods
+//
+// Panics:
+//   - If fields is empty
+//
+// Example:
+//
+//	NewComponent("SignupForm").
+//	    WithKeyBinding("tab", composables.FocusNextEvent, "Next field").
+//	    WithKeyBinding("shift+tab", composables.FocusPrevEvent, "Previous field").
+//	    Setup(func(ctx *bubbly.Context) {
+//	        focus := composables.UseFocusGroup(ctx,
+//	            []string{"name", "email", "password", "submit"},
+//	            composables.WithFocusKeyEvents())
+//	        ctx.Expose("focus", focus)
+//	    }).
+//	    Template(func(ctx bubbly.RenderContext) string {
+//	        focus := ctx.Get("focus").(*composables.FocusGroupReturn)
+//	        return renderField("Name", focus.IsFocused("name")) // ...
+//	    })
+func UseFocusGroup(ctx *bubbly.Context, fields []string, opts ...FocusGroupOption) *FocusGroupReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseFocusGroup", time.Since(start))
+	}()
+
+	if len(fields) == 0 {
+		panic("UseFocusGroup: fields must not be empty")
+	}
+
+	var config focusGroupConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	initial := fields[0]
+	if slices.Contains(fields, config.initial) {
+		initial = config.initial
+	}
+
+	f := &FocusGroupReturn{
+		Current:  bubbly.NewRef(initial),
+		fields:   slices.Clone(fields),
+		disabled: make(map[string]bool),
+	}
+
+	if ctx != nil && config.keyEvents {
+		ctx.On(FocusNextEvent, func(_ interface{}) { f.Next() })
+		ctx.On(FocusPrevEvent, func(_ interface{}) { f.Previous() })
+	}
+
+	return f
+}
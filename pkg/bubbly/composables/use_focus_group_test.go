@@ -0,0 +1,125 @@
+package composables
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestUseFocusGroup_Cycling tests Next and Previous wrap and skip disabled fields
+func TestUseFocusGroup_Cycling(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []FocusGroupOption
+		disabled []string
+		moves    []int // 1 for Next, -1 for Previous
+		expected []string
+	}{
+		{
+			name:     "next wraps",
+			moves:    []int{1, 1, 1},
+			expected: []string{"email", "password", "name"},
+		},
+		{
+			name:     "previous wraps",
+			moves:    []int{-1, -1},
+			expected: []string{"password", "email"},
+		},
+		{
+			name:     "skips disabled fields",
+			disabled: []string{"email"},
+			moves:    []int{1, 1, -1},
+			expected: []string{"password", "name", "password"},
+		},
+		{
+			name:     "initial focus",
+			opts:     []FocusGroupOption{WithInitialFocus("password")},
+			moves:    []int{1},
+			expected: []string{"name"},
+		},
+		{
+			name:     "unknown initial focus ignored",
+			opts:     []FocusGroupOption{WithInitialFocus("phone")},
+			moves:    []int{1},
+			expected: []string{"email"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			focus := UseFocusGroup(createTestContext(), []string{"name", "email", "password"}, tt.opts...)
+			for _, name := range tt.disabled {
+				focus.SetDisabled(name, true)
+			}
+
+			for i, move := range tt.moves {
+				if move > 0 {
+					focus.Next()
+				} else {
+					focus.Previous()
+				}
+				assert.Equal(t, tt.expected[i], focus.Current.GetTyped(), "after move %d", i+1)
+			}
+		})
+	}
+}
+
+// TestUseFocusGroup_Focus tests direct focus and disabled fields
+func TestUseFocusGroup_Focus(t *testing.T) {
+	focus := UseFocusGroup(createTestContext(), []string{"name", "email", "submit"})
+	assert.True(t, focus.IsFocused("name"))
+
+	focus.Focus("submit")
+	assert.True(t, focus.IsFocused("submit"))
+
+	focus.Focus("unknown")
+	assert.True(t, focus.IsFocused("submit"), "unknown field ignored")
+
+	focus.SetDisabled("email", true)
+	assert.True(t, focus.IsDisabled("email"))
+	focus.Focus("email")
+	assert.True(t, focus.IsFocused("submit"), "disabled field cannot be focused")
+
+	focus.SetDisabled("submit", true)
+	assert.True(t, focus.IsFocused("name"), "disabling the focused field moves focus on")
+
+	focus.SetDisabled("name", true)
+	assert.Equal(t, "", focus.Current.GetTyped(), "nothing left to focus")
+	focus.Next()
+	assert.Equal(t, "", focus.Current.GetTyped())
+
+	focus.SetDisabled("email", false)
+	assert.True(t, focus.IsFocused("email"), "enabled field takes focus when none has it")
+}
+
+// TestUseFocusGroup_EmptyFieldsPanics tests that empty fields panic
+func TestUseFocusGroup_EmptyFieldsPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "UseFocusGroup: fields must not be empty", func() {
+		UseFocusGroup(createTestContext(), nil)
+	})
+}
+
+// TestUseFocusGroup_KeyEvents tests Tab/Shift+Tab wiring through key bindings
+func TestUseFocusGroup_KeyEvents(t *testing.T) {
+	var focus *FocusGroupReturn
+	form, err := bubbly.NewComponent("Form").
+		WithKeyBinding("tab", FocusNextEvent, "Next field").
+		WithKeyBinding("shift+tab", FocusPrevEvent, "Previous field").
+		Setup(func(ctx *bubbly.Context) {
+			focus = UseFocusGroup(ctx, []string{"a", "b", "c"}, WithFocusKeyEvents())
+		}).
+		Template(func(ctx bubbly.RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	form.Init()
+
+	form.Update(tea.KeyMsg{Type: tea.KeyTab})
+	assert.True(t, focus.IsFocused("b"))
+	form.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	form.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	assert.True(t, focus.IsFocused("c"))
+}
@@ -0,0 +1,388 @@
+package composables
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// Focus manager event names.
+//
+// UseFocusManager registers handlers for these events on the owning component,
+// so Tab/Shift+Tab cycling only needs key bindings:
+//
+//	.WithKeyBinding("tab", composables.FocusNextEvent, "Next field").
+//	WithKeyBinding("shift+tab", composables.FocusPrevEvent, "Previous field")
+const (
+	// FocusNextEvent moves focus to the next focusable component.
+	FocusNextEvent = "focusNext"
+
+	// FocusPrevEvent moves focus to the previous focusable component.
+	FocusPrevEvent = "focusPrev"
+)
+
+// focusManagerProvideKey is the provide/inject key for sharing a focus manager
+// with descendant components.
+const focusManagerProvideKey = "composables:focusManager"
+
+// focusEntry tracks a single registered focus target.
+type focusEntry struct {
+	component bubbly.Component
+	focused   *bubbly.Ref[bool]
+}
+
+// FocusManagerReturn is the return value of UseFocusManager.
+// It maintains a focus order across components in a tree, exposes a focused
+// Ref per component, and supports focus traps for modal regions.
+type FocusManagerReturn struct {
+	// Current is the ID of the focused component ("" when nothing is focused).
+	Current *bubbly.Ref[string]
+
+	mu      sync.Mutex
+	entries []*focusEntry
+	traps   [][]string // stack of trapped component ID sets
+	saved   []string   // focus to restore when each trap is released
+}
+
+// Register adds a component to the end of the focus order and returns its
+// focused Ref. Registering the same component twice returns the existing Ref.
+//
+// The first registered component receives focus automatically.
+//
+// Example:
+//
+//	nameFocused := fm.Register(nameInput)
+//	ctx.Expose("nameFocused", nameFocused)
+func (fm *FocusManagerReturn) Register(comp bubbly.Component) *bubbly.Ref[bool] {
+	fm.mu.Lock()
+	if e := fm.findLocked(comp.ID()); e != nil {
+		fm.mu.Unlock()
+		return e.focused
+	}
+	entry := &focusEntry{component: comp, focused: bubbly.NewRef(false)}
+	fm.entries = append(fm.entries, entry)
+	first := len(fm.entries) == 1
+	fm.mu.Unlock()
+
+	if first && fm.Current.GetTyped() == "" {
+		fm.focusID(comp.ID())
+	}
+	return entry.focused
+}
+
+// Collect walks the component tree rooted at root in depth-first order and
+// registers every component marked with ComponentBuilder.Focusable().
+//
+// Example:
+//
+//	fm.Collect(ctx.Children()...)
+func (fm *FocusManagerReturn) Collect(roots ...bubbly.Component) {
+	for _, root := range roots {
+		fm.collect(root)
+	}
+}
+
+// collect recursively registers focusable components.
+func (fm *FocusManagerReturn) collect(c bubbly.Component) {
+	if c == nil {
+		return
+	}
+	if bubbly.IsFocusable(c) {
+		fm.Register(c)
+	}
+	if parent, ok := c.(interface{ Children() []bubbly.Component }); ok {
+		for _, child := range parent.Children() {
+			fm.collect(child)
+		}
+	}
+}
+
+// Unregister removes a component from the focus order and from any focus
+// trap. If it was focused, focus moves to the component that followed it in
+// the active order.
+func (fm *FocusManagerReturn) Unregister(comp bubbly.Component) {
+	id := comp.ID()
+	wasFocused := fm.Current.GetTyped() == id
+
+	fm.mu.Lock()
+	idx := -1
+	for i, e := range fm.entries {
+		if e.component.ID() == id {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		fm.mu.Unlock()
+		return
+	}
+
+	// Position in the active order, which differs from idx while trapped
+	pos := -1
+	for i, o := range fm.orderLocked() {
+		if o == id {
+			pos = i
+			break
+		}
+	}
+
+	fm.entries = append(fm.entries[:idx], fm.entries[idx+1:]...)
+	for i, trap := range fm.traps {
+		fm.traps[i] = slices.DeleteFunc(trap, func(o string) bool { return o == id })
+	}
+	for i, saved := range fm.saved {
+		if saved == id {
+			fm.saved[i] = ""
+		}
+	}
+
+	next := ""
+	if wasFocused {
+		order := fm.orderLocked()
+		if len(order) > 0 {
+			next = order[max(pos, 0)%len(order)]
+		}
+	}
+	fm.mu.Unlock()
+
+	if wasFocused {
+		fm.focusID(next)
+	}
+}
+
+// Focus moves focus to the given component.
+// It is a no-op if the component isn't registered or lies outside the active trap.
+func (fm *FocusManagerReturn) Focus(comp bubbly.Component) {
+	id := comp.ID()
+	fm.mu.Lock()
+	allowed := false
+	for _, o := range fm.orderLocked() {
+		if o == id {
+			allowed = true
+			break
+		}
+	}
+	fm.mu.Unlock()
+
+	if allowed {
+		fm.focusID(id)
+	}
+}
+
+// Blur clears focus so that no component is focused.
+func (fm *FocusManagerReturn) Blur() {
+	fm.focusID("")
+}
+
+// IsFocused returns true if the given component currently has focus.
+func (fm *FocusManagerReturn) IsFocused(comp bubbly.Component) bool {
+	return fm.Current.GetTyped() == comp.ID()
+}
+
+// FocusedRef returns the focused Ref for a registered component, or nil.
+func (fm *FocusManagerReturn) FocusedRef(comp bubbly.Component) *bubbly.Ref[bool] {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	if e := fm.findLocked(comp.ID()); e != nil {
+		return e.focused
+	}
+	return nil
+}
+
+// Next moves focus to the next component in order, wrapping at the end.
+// When a trap is active, only trapped components are visited.
+func (fm *FocusManagerReturn) Next() {
+	fm.step(1)
+}
+
+// Previous moves focus to the previous component in order, wrapping at the start.
+// When a trap is active, only trapped components are visited.
+func (fm *FocusManagerReturn) Previous() {
+	fm.step(-1)
+}
+
+// Trap restricts focus cycling to the given components, typically the
+// contents of a modal. Focus moves to the first trapped component.
+//
+// Traps nest: the most recent trap is active. The returned release function
+// pops the trap and restores the focus that was active before it. Calling
+// release more than once is safe.
+//
+// Example:
+//
+//	release := fm.Trap(confirmButton, cancelButton)
+//	defer release()
+func (fm *FocusManagerReturn) Trap(comps ...bubbly.Component) (release func()) {
+	ids := make([]string, 0, len(comps))
+	for _, c := range comps {
+		ids = append(ids, c.ID())
+	}
+
+	fm.mu.Lock()
+	fm.traps = append(fm.traps, ids)
+	fm.saved = append(fm.saved, fm.Current.GetTyped())
+	depth := len(fm.traps)
+	fm.mu.Unlock()
+
+	if len(ids) > 0 {
+		fm.focusID(ids[0])
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			fm.mu.Lock()
+			if len(fm.traps) < depth {
+				fm.mu.Unlock()
+				return
+			}
+			restore := fm.saved[depth-1]
+			fm.traps = fm.traps[:depth-1]
+			fm.saved = fm.saved[:depth-1]
+			fm.mu.Unlock()
+			fm.focusID(restore)
+		})
+	}
+}
+
+// IsTrapped returns true if a focus trap is currently active.
+func (fm *FocusManagerReturn) IsTrapped() bool {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+	return len(fm.traps) > 0
+}
+
+// step moves focus by delta positions within the active order.
+func (fm *FocusManagerReturn) step(delta int) {
+	fm.mu.Lock()
+	order := fm.orderLocked()
+	fm.mu.Unlock()
+
+	if len(order) == 0 {
+		return
+	}
+
+	current := fm.Current.GetTyped()
+	idx := -1
+	for i, id := range order {
+		if id == current {
+			idx = i
+			break
+		}
+	}
+
+	var next int
+	switch {
+	case idx < 0 && delta > 0:
+		next = 0
+	case idx < 0:
+		next = len(order) - 1
+	default:
+		next = (idx + delta + len(order)) % len(order)
+	}
+	fm.focusID(order[next])
+}
+
+// orderLocked returns the active focus order (trap-restricted if a trap is set).
+// Must be called with fm.mu held.
+func (fm *FocusManagerReturn) orderLocked() []string {
+	if len(fm.traps) > 0 {
+		trap := fm.traps[len(fm.traps)-1]
+		order := make([]string, len(trap))
+		copy(order, trap)
+		return order
+	}
+	order := make([]string, len(fm.entries))
+	for i, e := range fm.entries {
+		order[i] = e.component.ID()
+	}
+	return order
+}
+
+// findLocked returns the entry for the given ID. Must be called with fm.mu held.
+func (fm *FocusManagerReturn) findLocked(id string) *focusEntry {
+	for _, e := range fm.entries {
+		if e.component.ID() == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// focusID sets Current and updates each entry's focused Ref.
+func (fm *FocusManagerReturn) focusID(id string) {
+	fm.mu.Lock()
+	entries := make([]*focusEntry, len(fm.entries))
+	copy(entries, fm.entries)
+	fm.mu.Unlock()
+
+	fm.Current.Set(id)
+	for _, e := range entries {
+		want := e.component.ID() == id
+		if e.focused.GetTyped() != want {
+			e.focused.Set(want)
+		}
+	}
+}
+
+// UseFocusManager creates a focus manager that tracks focus across the
+// components of a tree.
+//
+// Unlike UseFocus, which cycles through arbitrary values, the focus manager
+// works with components directly: each registered component gets its own
+// focused Ref, and focus traps restrict Tab cycling to a region such as a
+// modal dialog.
+//
+// The manager is provided to descendants, which can retrieve it with
+// InjectFocusManager. Handlers for FocusNextEvent and FocusPrevEvent are
+// registered on the owning component.
+//
+// Example:
+//
+//	NewComponent("Form").
+//	    WithKeyBinding("tab", composables.FocusNextEvent, "Next field").
+//	    WithKeyBinding("shift+tab", composables.FocusPrevEvent, "Previous field").
+//	    Setup(func(ctx *bubbly.Context) {
+//	        fm := composables.UseFocusManager(ctx)
+//	        ctx.ExposeComponent("name", nameInput)   // built with .Focusable()
+//	        ctx.ExposeComponent("email", emailInput) // built with .Focusable()
+//	        fm.Collect(ctx.Children()...)
+//	        ctx.Expose("focus", fm)
+//	    })
+func UseFocusManager(ctx *bubbly.Context) *FocusManagerReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseFocusManager", time.Since(start))
+	}()
+
+	fm := &FocusManagerReturn{
+		Current: bubbly.NewRef(""),
+	}
+
+	if ctx != nil {
+		ctx.On(FocusNextEvent, func(_ interface{}) { fm.Next() })
+		ctx.On(FocusPrevEvent, func(_ interface{}) { fm.Previous() })
+		ctx.Provide(focusManagerProvideKey, fm)
+	}
+
+	return fm
+}
+
+// InjectFocusManager returns the nearest ancestor's focus manager, or nil
+// if no ancestor called UseFocusManager.
+//
+// Example:
+//
+//	if fm := composables.InjectFocusManager(ctx); fm != nil {
+//	    ctx.Expose("focused", fm.Current)
+//	}
+func InjectFocusManager(ctx *bubbly.Context) *FocusManagerReturn {
+	if fm, ok := ctx.Inject(focusManagerProvideKey, nil).(*FocusManagerReturn); ok {
+		return fm
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package composables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// newFocusTestComponent builds a minimal component for focus tests.
+func newFocusTestComponent(t *testing.T, name string, focusable bool) bubbly.Component {
+	t.Helper()
+	builder := bubbly.NewComponent(name).
+		Template(func(ctx bubbly.RenderContext) string { return name })
+	if focusable {
+		builder = builder.Focusable()
+	}
+	comp, err := builder.Build()
+	require.NoError(t, err)
+	return comp
+}
+
+// TestUseFocusManager_RegisterFocusesFirst tests that the first registration gets focus
+func TestUseFocusManager_RegisterFocusesFirst(t *testing.T) {
+	ctx := createTestContext()
+	fm := UseFocusManager(ctx)
+
+	a := newFocusTestComponent(t, "A", true)
+	b := newFocusTestComponent(t, "B", true)
+
+	aFocused := fm.Register(a)
+	bFocused := fm.Register(b)
+
+	assert.Equal(t, a.ID(), fm.Current.GetTyped())
+	assert.True(t, aFocused.GetTyped())
+	assert.False(t, bFocused.GetTyped())
+	assert.Same(t, aFocused, fm.Register(a), "re-registering returns existing ref")
+}
+
+// TestUseFocusManager_NextPreviousWrap tests Tab/Shift+Tab cycling with wraparound
+func TestUseFocusManager_NextPreviousWrap(t *testing.T) {
+	ctx := createTestContext()
+	fm := UseFocusManager(ctx)
+
+	a := newFocusTestComponent(t, "A", true)
+	b := newFocusTestComponent(t, "B", true)
+	c := newFocusTestComponent(t, "C", true)
+	fm.Register(a)
+	fm.Register(b)
+	cFocused := fm.Register(c)
+
+	fm.Next()
+	assert.True(t, fm.IsFocused(b))
+	fm.Next()
+	assert.True(t, fm.IsFocused(c))
+	assert.True(t, cFocused.GetTyped())
+	fm.Next()
+	assert.True(t, fm.IsFocused(a), "Next should wrap to first")
+	fm.Previous()
+	assert.True(t, fm.IsFocused(c), "Previous should wrap to last")
+}
+
+// TestUseFocusManager_Trap tests that traps restrict cycling and restore focus
+func TestUseFocusManager_Trap(t *testing.T) {
+	ctx := createTestContext()
+	fm := UseFocusManager(ctx)
+
+	a := newFocusTestComponent(t, "A", true)
+	ok := newFocusTestComponent(t, "OK", true)
+	cancel := newFocusTestComponent(t, "Cancel", true)
+	fm.Register(a)
+	fm.Register(ok)
+	fm.Register(cancel)
+
+	release := fm.Trap(ok, cancel)
+	assert.True(t, fm.IsTrapped())
+	assert.True(t, fm.IsFocused(ok))
+
+	fm.Next()
+	assert.True(t, fm.IsFocused(cancel))
+	fm.Next()
+	assert.True(t, fm.IsFocused(ok), "focus stays inside trap")
+
+	fm.Focus(a)
+	assert.True(t, fm.IsFocused(ok), "focusing outside the trap is ignored")
+
+	release()
+	release() // idempotent
+	assert.False(t, fm.IsTrapped())
+	assert.True(t, fm.IsFocused(a), "focus restored after release")
+}
+
+// TestUseFocusManager_Collect tests that only focusable components are collected
+func TestUseFocusManager_Collect(t *testing.T) {
+	a := newFocusTestComponent(t, "A", true)
+	skip := newFocusTestComponent(t, "Label", false)
+	b := newFocusTestComponent(t, "B", true)
+
+	var fm *FocusManagerReturn
+	parent, err := bubbly.NewComponent("Parent").
+		Children(a, skip, b).
+		Setup(func(ctx *bubbly.Context) {
+			fm = UseFocusManager(ctx)
+			fm.Collect(ctx.Children()...)
+		}).
+		Template(func(ctx bubbly.RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	assert.NotNil(t, fm.FocusedRef(a))
+	assert.Nil(t, fm.FocusedRef(skip))
+	assert.NotNil(t, fm.FocusedRef(b))
+
+	parent.Emit(FocusNextEvent, nil)
+	assert.True(t, fm.IsFocused(b), "focusNext event advances focus")
+	parent.Emit(FocusPrevEvent, nil)
+	assert.True(t, fm.IsFocused(a), "focusPrev event moves focus back")
+}
+
+// TestUseFocusManager_UnregisterMovesFocus tests that removing the focused component moves focus
+func TestUseFocusManager_UnregisterMovesFocus(t *testing.T) {
+	ctx := createTestContext()
+	fm := UseFocusManager(ctx)
+
+	a := newFocusTestComponent(t, "A", true)
+	b := newFocusTestComponent(t, "B", true)
+	fm.Register(a)
+	fm.Register(b)
+
+	fm.Unregister(a)
+	assert.True(t, fm.IsFocused(b))
+	assert.Nil(t, fm.FocusedRef(a))
+
+	fm.Blur()
+	assert.Equal(t, "", fm.Current.GetTyped())
+}
+
+// TestUseFocusManager_UnregisterWhileTrapped tests unregistering a trapped component
+func TestUseFocusManager_UnregisterWhileTrapped(t *testing.T) {
+	ctx := createTestContext()
+	fm := UseFocusManager(ctx)
+
+	a := newFocusTestComponent(t, "A", true)
+	b := newFocusTestComponent(t, "B", true)
+	c := newFocusTestComponent(t, "C", true)
+	d := newFocusTestComponent(t, "D", true)
+	for _, comp := range []bubbly.Component{a, b, c, d} {
+		fm.Register(comp)
+	}
+
+	release := fm.Trap(c, d, b)
+	defer release()
+	fm.Focus(d)
+
+	// D is entries[3] but trap position 1; its successor is B, not C or A
+	fm.Unregister(d)
+	assert.True(t, fm.IsFocused(b), "focus moves to the next trapped component")
+
+	fm.Next()
+	assert.True(t, fm.IsFocused(c), "removed component is pruned from the trap")
+	fm.Next()
+	assert.True(t, fm.IsFocused(b))
+}
@@ -1,9 +1,13 @@
 package composables
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
@@ -22,9 +26,15 @@ import (
 //   - Touched: Reactive map tracking which fields have been modified
 //   - IsValid: Computed boolean indicating if form has no validation errors
 //   - IsDirty: Computed boolean indicating if any fields have been touched
+//   - Validating: Reactive map of fields whose async validators are running
 //   - Submit: Function to validate and submit the form
 //   - Reset: Function to reset form to initial state
+//   - ResetTo: Function to reset form to new values that become its baseline
+//   - DirtyFields, IsFieldDirty: Functions reporting fields changed from the baseline
 //   - SetField: Function to update a specific field by name
+//   - AddItem, RemoveItem, SetItem: Functions to edit slice (array) fields
+//   - ItemErrors: Function to get the validation errors of one array item
+//   - AddFieldValidator, AddAsyncFieldValidator: Functions to add per-field validators
 //
 // Example:
 //
@@ -76,14 +86,39 @@ type UseFormReturn[T any] struct {
 	// Automatically updates when Touched changes.
 	IsDirty *bubbly.Computed[bool]
 
+	// Validating marks the fields whose async validators are running, for
+	// showing a "checking..." hint next to them.
+	Validating *bubbly.Ref[map[string]bool]
+
 	// Submit validates the form and updates the Errors map.
 	// If validation passes (no errors), the form is considered submitted.
 	Submit func()
 
 	// Reset clears all form state back to initial values.
-	// Resets Values, Errors, and Touched to their initial state.
+	// Resets Values, Errors, and Touched to their initial state, or to the
+	// values given to the last ResetTo.
 	Reset func()
 
+	// ResetTo resets the form to values and makes them its baseline: Errors
+	// and Touched are cleared, later Resets return to values, and
+	// DirtyFields compares against them. Call it after a successful save so
+	// the form is clean at the saved state.
+	//
+	// Example:
+	//   if err := api.Save(form.Values.GetTyped()); err == nil {
+	//       form.ResetTo(form.Values.GetTyped())
+	//   }
+	ResetTo func(values T)
+
+	// DirtyFields returns the fields whose current value differs from the
+	// baseline (the initial values, or those of the last ResetTo), keyed by
+	// field name. Unlike Touched, a field set back to its baseline value is
+	// not dirty. Values are compared with reflect.DeepEqual.
+	DirtyFields func() map[string]bool
+
+	// IsFieldDirty reports whether the named field differs from the baseline.
+	IsFieldDirty func(field string) bool
+
 	// SetField updates a specific field by name using reflection.
 	// Automatically marks the field as touched and triggers validation.
 	//
@@ -95,6 +130,83 @@ type UseFormReturn[T any] struct {
 	//   form.SetField("Email", "new@example.com")
 	//   form.SetField("Age", 25)
 	SetField func(field string, value interface{})
+
+	// AddItem appends item to the slice field named field, such as an
+	// "add another phone number" group. A nil item appends the zero value
+	// of the element type. Marks the field as touched and triggers validation.
+	//
+	// Example:
+	//   form.AddItem("Phones", "")
+	AddItem func(field string, item interface{})
+
+	// RemoveItem removes the item at index from the slice field named field.
+	// Marks the field as touched and triggers validation.
+	RemoveItem func(field string, index int)
+
+	// SetItem replaces the item at index in the slice field named field.
+	// Marks the field as touched and triggers validation.
+	SetItem func(field string, index int, item interface{})
+
+	// ItemErrors returns the validation errors of one item of a slice field.
+	// Errors keyed FormItemKey(field, index) are returned under "", and
+	// errors keyed FormItemKey(field, index)+".Sub" are returned under "Sub".
+	//
+	// Example:
+	//   // Validate set errors["Phones[1]"] = "Invalid phone number"
+	//   form.ItemErrors("Phones", 1) // map[string]string{"": "Invalid phone number"}
+	ItemErrors func(field string, index int) map[string]string
+
+	// AddFieldValidator adds a validator for one field. It runs whenever
+	// validation runs (on SetField and Submit), but only once the field has
+	// been touched, so errors appear as the user types without flagging
+	// fields they have not reached yet. It returns an error message, or ""
+	// if the value is valid. A field's validators run in the order added;
+	// the first message wins, over any message from the form's validate
+	// function for the same field.
+	//
+	// Example:
+	//   form.AddFieldValidator("Email", func(value any) string {
+	//       if !strings.Contains(value.(string), "@") {
+	//           return "Invalid email address"
+	//       }
+	//       return ""
+	//   })
+	AddFieldValidator func(field string, fn func(value any) string)
+
+	// AddAsyncFieldValidator adds a slow validator for one field, such as a
+	// username-availability check. It runs in a goroutine each time the
+	// field is set, with Validating marking the field until it finishes. A
+	// non-nil error becomes the field's error message. Its context is
+	// cancelled when the field is set again (only the latest result is
+	// used), on Reset, and on unmount.
+	//
+	// Example:
+	//   form.AddAsyncFieldValidator("Username", func(ctx context.Context, value any) error {
+	//       if taken, err := api.UsernameTaken(ctx, value.(string)); err != nil || taken {
+	//           return errors.New("Username is not available")
+	//       }
+	//       return nil
+	//   })
+	AddAsyncFieldValidator func(field string, fn func(ctx context.Context, value any) error)
+}
+
+// FormItemKey returns the Errors key for the item at index of the slice
+// field named field, e.g. "Phones[1]". Append ".Field" to key errors of a
+// field within a struct item, e.g. FormItemKey("Contacts", 0) + ".Email".
+//
+// Example:
+//
+//	form := UseForm(ctx, Profile{}, func(p Profile) map[string]string {
+//	    errors := make(map[string]string)
+//	    for i, phone := range p.Phones {
+//	        if !validPhone(phone) {
+//	            errors[FormItemKey("Phones", i)] = "Invalid phone number"
+//	        }
+//	    }
+//	    return errors
+//	})
+func FormItemKey(field string, index int) string {
+	return field + "[" + strconv.Itoa(index) + "]"
 }
 
 // UseForm creates a composable for comprehensive form state management with validation.
@@ -107,6 +219,10 @@ type UseFormReturn[T any] struct {
 //   - SetField is called (validates after each field update)
 //   - Submit is called (validates entire form)
 //
+// Per-field validators added with AddFieldValidator and
+// AddAsyncFieldValidator run for touched fields only, and their errors are
+// merged into Errors with those of validate.
+//
 // Parameters:
 //   - ctx: The component context (required for all composables)
 //   - initial: The initial form data struct
@@ -200,14 +316,44 @@ type UseFormReturn[T any] struct {
 //	form.SetField("Email", "test@example.com")  // ✓ Correct
 //	form.SetField("email", "test@example.com")  // ✗ Wrong - not found
 //
+// Example - Array Fields:
+//
+//	type Profile struct {
+//	    Name   string
+//	    Phones []string
+//	}
+//
+//	Setup(func(ctx *Context) {
+//	    form := UseForm(ctx, Profile{}, func(p Profile) map[string]string {
+//	        errors := make(map[string]string)
+//	        for i, phone := range p.Phones {
+//	            if phone == "" {
+//	                errors[FormItemKey("Phones", i)] = "Phone number is required"
+//	            }
+//	        }
+//	        return errors
+//	    })
+//
+//	    ctx.On("addPhone", func(_ interface{}) {
+//	        form.AddItem("Phones", "") // Appends an empty phone number
+//	    })
+//	    ctx.On("removePhone", func(data interface{}) {
+//	        form.RemoveItem("Phones", data.(int))
+//	    })
+//	})
+//
+// Array fields are copied on every change, so Values watchers always see
+// distinct old and new slices.
+//
 // Performance:
 //
-// UseForm creates three Ref instances, two Computed values, and three closure functions.
+// UseForm creates five Ref instances, two Computed values, and one closure per function field.
 // SetField uses reflection which has some overhead, but is well within acceptable limits
 // for form interactions (< 1μs per field update).
 //
 // Validation runs on every SetField call and Submit call. For expensive validation,
-// consider debouncing field updates or validating only on Submit.
+// consider debouncing field updates, validating only on Submit, or moving it
+// to an async field validator.
 func UseForm[T any](
 	ctx *bubbly.Context,
 	initial T,
@@ -222,6 +368,9 @@ func UseForm[T any](
 	// Create reactive state for form values
 	values := bubbly.NewRef(initial)
 
+	// The values Reset returns to and dirty fields are compared against
+	baseline := bubbly.NewRef(initial)
+
 	// Create reactive state for validation errors
 	errors := bubbly.NewRef(make(map[string]string))
 
@@ -238,11 +387,177 @@ func UseForm[T any](
 		return len(touched.GetTyped()) > 0
 	})
 
-	// Helper: Run validation and update errors
-	runValidation := func() {
+	// Per-field validation state (async results arrive from goroutines)
+	validating := bubbly.NewRef(make(map[string]bool))
+	var (
+		validationMu      sync.Mutex
+		fieldValidators   = make(map[string][]func(value any) string)
+		asyncValidators   = make(map[string][]func(ctx context.Context, value any) error)
+		asyncErrors       = make(map[string]string)
+		asyncCancels      = make(map[string]context.CancelFunc)
+		validationVersion uint64 // Incremented by every change to the state above
+	)
+
+	// validateAndMerge runs validate and the field validators of touched
+	// fields, and updates errors with the merged result. With recheck set,
+	// it runs once more if the form changed meanwhile (e.g., an async result
+	// or a SetField from another goroutine), so it never leaves errors from
+	// an older state.
+	var validateAndMerge func(recheck bool)
+	validateAndMerge = func(recheck bool) {
+		validationMu.Lock()
+		validationVersion++
+		version := validationVersion
+		validationMu.Unlock()
+
 		currentValues := values.GetTyped()
 		validationErrors := validate(currentValues)
-		errors.Set(validationErrors)
+		merged := make(map[string]string, len(validationErrors))
+		for field, message := range validationErrors {
+			merged[field] = message
+		}
+
+		validationMu.Lock()
+		validators := make(map[string][]func(value any) string)
+		for field := range touched.GetTyped() {
+			if message := asyncErrors[field]; message != "" {
+				merged[field] = message
+			}
+			if len(fieldValidators[field]) > 0 {
+				validators[field] = fieldValidators[field]
+			}
+		}
+		validationMu.Unlock()
+
+		for field, fns := range validators {
+			value, ok := formFieldValue(currentValues, field)
+			if !ok {
+				continue
+			}
+			for _, fn := range fns {
+				if message := fn(value); message != "" {
+					merged[field] = message
+					break
+				}
+			}
+		}
+		errors.Set(merged)
+
+		validationMu.Lock()
+		stale := validationVersion != version
+		validationMu.Unlock()
+		if stale && recheck {
+			validateAndMerge(false)
+		}
+	}
+
+	// Helper: Run validation and update errors
+	runValidation := func() {
+		validateAndMerge(true)
+	}
+
+	// publishValidating sets Validating to the fields with running async
+	// validators. The caller must not hold validationMu.
+	var publishValidating func()
+	publishValidating = func() {
+		validationMu.Lock()
+		version := validationVersion
+		snapshot := make(map[string]bool, len(asyncCancels))
+		for field := range asyncCancels {
+			snapshot[field] = true
+		}
+		validationMu.Unlock()
+
+		validating.Set(snapshot)
+
+		// Another goroutine may have published an older snapshot meanwhile
+		validationMu.Lock()
+		stale := validationVersion != version
+		validationMu.Unlock()
+		if stale {
+			publishValidating()
+		}
+	}
+
+	// validateFieldAsync starts the async validators of a field that was
+	// just set, cancelling any still running for its previous value
+	validateFieldAsync := func(field string) {
+		value, _ := formFieldValue(values.GetTyped(), field)
+
+		validationMu.Lock()
+		validators := asyncValidators[field]
+		if len(validators) == 0 {
+			validationMu.Unlock()
+			return
+		}
+		if cancel := asyncCancels[field]; cancel != nil {
+			cancel()
+		}
+		runCtx, cancel := context.WithCancel(context.Background())
+		asyncCancels[field] = cancel
+		delete(asyncErrors, field)
+		validationVersion++
+		validationMu.Unlock()
+
+		publishValidating()
+
+		go func() {
+			var message string
+			for _, fn := range validators {
+				if err := fn(runCtx, value); err != nil {
+					message = err.Error()
+					break
+				}
+			}
+
+			validationMu.Lock()
+			if runCtx.Err() != nil {
+				// Superseded by a newer value, a Reset, or unmount
+				validationMu.Unlock()
+				return
+			}
+			cancel()
+			delete(asyncCancels, field)
+			if message != "" {
+				asyncErrors[field] = message
+			}
+			validationVersion++
+			validationMu.Unlock()
+
+			publishValidating()
+			runValidation()
+		}()
+	}
+
+	// cancelAsyncValidation stops every running async validator and drops
+	// their results
+	cancelAsyncValidation := func() {
+		validationMu.Lock()
+		for field, cancel := range asyncCancels {
+			cancel()
+			delete(asyncCancels, field)
+		}
+		clear(asyncErrors)
+		validationVersion++
+		validationMu.Unlock()
+
+		publishValidating()
+	}
+
+	// AddFieldValidator: Register a synchronous field validator
+	addFieldValidator := func(field string, fn func(value any) string) {
+		validationMu.Lock()
+		defer validationMu.Unlock()
+
+		fieldValidators[field] = append(fieldValidators[field], fn)
+	}
+
+	// AddAsyncFieldValidator: Register an asynchronous field validator
+	addAsyncFieldValidator := func(field string, fn func(ctx context.Context, value any) error) {
+		validationMu.Lock()
+		defer validationMu.Unlock()
+
+		asyncValidators[field] = append(asyncValidators[field], fn)
 	}
 
 	// Submit: Validate form
@@ -250,13 +565,55 @@ func UseForm[T any](
 		runValidation()
 	}
 
-	// Reset: Clear all state back to initial
-	reset := func() {
-		values.Set(initial)
+	// ResetTo: Clear all state back to new baseline values
+	resetTo := func(newValues T) {
+		cancelAsyncValidation()
+		baseline.Set(newValues)
+		values.Set(newValues)
 		errors.Set(make(map[string]string))
 		touched.Set(make(map[string]bool))
 	}
 
+	// Reset: Clear all state back to the baseline
+	reset := func() {
+		resetTo(baseline.GetTyped())
+	}
+
+	// DirtyFields: Compare each field with the baseline
+	dirtyFields := func() map[string]bool {
+		dirty := make(map[string]bool)
+		current := reflect.ValueOf(values.GetTyped())
+		base := reflect.ValueOf(baseline.GetTyped())
+		if current.Kind() != reflect.Struct {
+			return dirty
+		}
+		for i := 0; i < current.NumField(); i++ {
+			field := current.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if !reflect.DeepEqual(current.Field(i).Interface(), base.Field(i).Interface()) {
+				dirty[field.Name] = true
+			}
+		}
+		return dirty
+	}
+
+	// IsFieldDirty: Compare one field with the baseline
+	isFieldDirty := func(field string) bool {
+		current, ok := formFieldValue(values.GetTyped(), field)
+		if !ok {
+			return false
+		}
+		base, _ := formFieldValue(baseline.GetTyped(), field)
+		return !reflect.DeepEqual(current, base)
+	}
+
+	// Abandon async validation on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(cancelAsyncValidation)
+	}
+
 	// SetField: Update a specific field by name using reflection
 	setField := func(field string, value interface{}) {
 		// Get current form values
@@ -368,18 +725,202 @@ func UseForm[T any](
 		touched.Set(touchedMap)
 
 		// Run validation
+		validateFieldAsync(field)
 		runValidation()
 	}
 
+	// updateSliceField applies update to a copy of the slice field named
+	// field, then marks it touched and validates
+	updateSliceField := func(op, field string, update func(items reflect.Value) (reflect.Value, error)) {
+		currentValues := values.GetTyped()
+		v := reflect.ValueOf(&currentValues).Elem()
+
+		var fieldValue reflect.Value
+		if v.Kind() == reflect.Struct {
+			fieldValue = v.FieldByName(field)
+		}
+
+		var err error
+		errorType := "invalid_field"
+		switch {
+		case !fieldValue.IsValid():
+			err = fmt.Errorf("UseForm.%s: field '%s' does not exist on type %T", op, field, currentValues)
+		case !fieldValue.CanSet():
+			errorType = "unexported_field"
+			err = fmt.Errorf("UseForm.%s: field '%s' is not settable (unexported field)", op, field)
+		case fieldValue.Kind() != reflect.Slice:
+			errorType = "not_a_slice"
+			err = fmt.Errorf("UseForm.%s: field '%s' is not a slice (got %v)", op, field, fieldValue.Type())
+		}
+
+		if err == nil {
+			var updated reflect.Value
+			if updated, err = update(fieldValue); err == nil {
+				fieldValue.Set(updated)
+			} else {
+				errorType = "invalid_item"
+				err = fmt.Errorf("UseForm.%s: field '%s': %w", op, field, err)
+			}
+		}
+
+		if err != nil {
+			reportFormError(op, field, errorType, err, currentValues)
+			return
+		}
+
+		// Update the values ref with modified struct
+		values.Set(currentValues)
+
+		// Mark field as touched
+		touchedMap := touched.GetTyped()
+		touchedMap[field] = true
+		touched.Set(touchedMap)
+
+		// Run validation
+		validateFieldAsync(field)
+		runValidation()
+	}
+
+	// itemValue converts item to the element type of items; nil yields the zero value
+	itemValue := func(items reflect.Value, item interface{}) (reflect.Value, error) {
+		elemType := items.Type().Elem()
+		if item == nil {
+			return reflect.Zero(elemType), nil
+		}
+		value := reflect.ValueOf(item)
+		if !value.Type().AssignableTo(elemType) {
+			return reflect.Value{}, fmt.Errorf("type mismatch: expected %v, got %v", elemType, value.Type())
+		}
+		return value, nil
+	}
+
+	// checkIndex reports whether index is within items
+	checkIndex := func(items reflect.Value, index int) error {
+		if index < 0 || index >= items.Len() {
+			return fmt.Errorf("index %d out of range [0, %d)", index, items.Len())
+		}
+		return nil
+	}
+
+	// AddItem: Append an item to a slice field
+	addItem := func(field string, item interface{}) {
+		updateSliceField("AddItem", field, func(items reflect.Value) (reflect.Value, error) {
+			value, err := itemValue(items, item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			updated := copySlice(items, items.Len()+1)
+			updated.Index(items.Len()).Set(value)
+			return updated, nil
+		})
+	}
+
+	// RemoveItem: Remove an item from a slice field
+	removeItem := func(field string, index int) {
+		updateSliceField("RemoveItem", field, func(items reflect.Value) (reflect.Value, error) {
+			if err := checkIndex(items, index); err != nil {
+				return reflect.Value{}, err
+			}
+			updated := reflect.MakeSlice(items.Type(), 0, items.Len()-1)
+			updated = reflect.AppendSlice(updated, items.Slice(0, index))
+			return reflect.AppendSlice(updated, items.Slice(index+1, items.Len())), nil
+		})
+	}
+
+	// SetItem: Replace an item of a slice field
+	setItem := func(field string, index int, item interface{}) {
+		updateSliceField("SetItem", field, func(items reflect.Value) (reflect.Value, error) {
+			if err := checkIndex(items, index); err != nil {
+				return reflect.Value{}, err
+			}
+			value, err := itemValue(items, item)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			updated := copySlice(items, items.Len())
+			updated.Index(index).Set(value)
+			return updated, nil
+		})
+	}
+
+	// ItemErrors: Collect the errors of one slice item
+	itemErrors := func(field string, index int) map[string]string {
+		key := FormItemKey(field, index)
+		result := make(map[string]string)
+		for errKey, message := range errors.GetTyped() {
+			if errKey == key {
+				result[""] = message
+			} else if sub, ok := strings.CutPrefix(errKey, key+"."); ok {
+				result[sub] = message
+			}
+		}
+		return result
+	}
+
 	// Return the composable interface
 	return UseFormReturn[T]{
-		Values:   values,
-		Errors:   errors,
-		Touched:  touched,
-		IsValid:  isValid,
-		IsDirty:  isDirty,
-		Submit:   submit,
-		Reset:    reset,
-		SetField: setField,
+		Values:     values,
+		Errors:     errors,
+		Touched:    touched,
+		IsValid:    isValid,
+		IsDirty:    isDirty,
+		Submit:     submit,
+		Reset:      reset,
+		ResetTo:    resetTo,
+		SetField:   setField,
+		AddItem:    addItem,
+		RemoveItem: removeItem,
+		SetItem:    setItem,
+		ItemErrors: itemErrors,
+		Validating: validating,
+
+		DirtyFields:            dirtyFields,
+		IsFieldDirty:           isFieldDirty,
+		AddFieldValidator:      addFieldValidator,
+		AddAsyncFieldValidator: addAsyncFieldValidator,
 	}
 }
+
+// formFieldValue returns the value of the field named field of form, if
+// form is a struct with such an exported field.
+func formFieldValue(form interface{}, field string) (interface{}, bool) {
+	v := reflect.ValueOf(form)
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+	fieldValue := v.FieldByName(field)
+	if !fieldValue.IsValid() || !fieldValue.CanInterface() {
+		return nil, false
+	}
+	return fieldValue.Interface(), true
+}
+
+// copySlice returns a new slice of the given length holding the items of
+// items, so edits never touch the backing array of earlier form values.
+func copySlice(items reflect.Value, length int) reflect.Value {
+	updated := reflect.MakeSlice(items.Type(), length, length)
+	reflect.Copy(updated, items)
+	return updated
+}
+
+// reportFormError reports a failed array field operation to the
+// observability system.
+func reportFormError(op, field, errorType string, err error, formValues interface{}) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+	reporter.ReportError(err, &observability.ErrorContext{
+		ComponentName: "UseForm",
+		EventName:     op,
+		Timestamp:     time.Now(),
+		StackTrace:    debug.Stack(),
+		Tags: map[string]string{
+			"error_type": errorType,
+			"field_name": field,
+		},
+		Extra: map[string]interface{}{
+			"form_type": fmt.Sprintf("%T", formValues),
+		},
+	})
+}
@@ -1,11 +1,15 @@
 package composables
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
 	"github.com/newbpydev/bubblyui/pkg/bubbly/composables/reflectcache"
 	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
 )
@@ -531,3 +535,358 @@ func TestUseForm_SetField_UnexportedField_NoErrorReporter(t *testing.T) {
 	form.SetField("Public", "updated")
 	assert.Equal(t, "updated", form.Values.GetTyped().Public)
 }
+
+// Test form struct with an array field
+type ProfileForm struct {
+	Name   string
+	Phones []string
+}
+
+// Validator function for ProfileForm with per-item errors
+func validateProfileForm(f ProfileForm) map[string]string {
+	errors := make(map[string]string)
+	for i, phone := range f.Phones {
+		if phone == "" {
+			errors[FormItemKey("Phones", i)] = "Phone is required"
+		}
+	}
+	return errors
+}
+
+func TestFormItemKey(t *testing.T) {
+	assert.Equal(t, "Phones[0]", FormItemKey("Phones", 0))
+	assert.Equal(t, "Contacts[12]", FormItemKey("Contacts", 12))
+}
+
+func TestUseForm_ArrayFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  []string
+		edit     func(form UseFormReturn[ProfileForm])
+		expected []string
+	}{
+		{
+			name:     "AddItem appends item",
+			initial:  []string{"555-0100"},
+			edit:     func(form UseFormReturn[ProfileForm]) { form.AddItem("Phones", "555-0101") },
+			expected: []string{"555-0100", "555-0101"},
+		},
+		{
+			name:     "AddItem with nil appends zero value",
+			initial:  nil,
+			edit:     func(form UseFormReturn[ProfileForm]) { form.AddItem("Phones", nil) },
+			expected: []string{""},
+		},
+		{
+			name:     "RemoveItem removes item at index",
+			initial:  []string{"a", "b", "c"},
+			edit:     func(form UseFormReturn[ProfileForm]) { form.RemoveItem("Phones", 1) },
+			expected: []string{"a", "c"},
+		},
+		{
+			name:     "SetItem replaces item at index",
+			initial:  []string{"a", "b"},
+			edit:     func(form UseFormReturn[ProfileForm]) { form.SetItem("Phones", 0, "z") },
+			expected: []string{"z", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initial := ProfileForm{Phones: tt.initial}
+			form := UseForm(createTestContext(), initial, validateProfileForm)
+
+			tt.edit(form)
+
+			assert.Equal(t, tt.expected, form.Values.GetTyped().Phones)
+			assert.True(t, form.Touched.GetTyped()["Phones"], "Array field should be marked touched")
+			assert.Equal(t, tt.initial, initial.Phones, "Initial slice should not be modified")
+		})
+	}
+}
+
+func TestUseForm_ArrayFields_TriggerReactivity(t *testing.T) {
+	form := UseForm(createTestContext(), ProfileForm{}, validateProfileForm)
+
+	var changes int
+	bubbly.Watch(form.Values, func(newVal, oldVal ProfileForm) {
+		changes++
+		assert.NotEqual(t, len(newVal.Phones), len(oldVal.Phones))
+	})
+
+	form.AddItem("Phones", "555-0100")
+	form.AddItem("Phones", "555-0101")
+	form.RemoveItem("Phones", 0)
+
+	assert.Equal(t, 3, changes)
+}
+
+func TestUseForm_ArrayFields_PerItemValidation(t *testing.T) {
+	form := UseForm(createTestContext(), ProfileForm{}, validateProfileForm)
+
+	form.AddItem("Phones", "555-0100")
+	form.AddItem("Phones", "")
+
+	assert.False(t, form.IsValid.GetTyped())
+	assert.Empty(t, form.ItemErrors("Phones", 0))
+	assert.Equal(t, map[string]string{"": "Phone is required"}, form.ItemErrors("Phones", 1))
+
+	form.SetItem("Phones", 1, "555-0101")
+
+	assert.True(t, form.IsValid.GetTyped())
+	assert.Empty(t, form.ItemErrors("Phones", 1))
+}
+
+func TestUseForm_ItemErrors_SubFields(t *testing.T) {
+	type Contact struct {
+		Email string
+	}
+	type ContactsForm struct {
+		Contacts []Contact
+	}
+
+	form := UseForm(createTestContext(), ContactsForm{}, func(f ContactsForm) map[string]string {
+		errors := make(map[string]string)
+		for i, contact := range f.Contacts {
+			if contact.Email == "" {
+				errors[FormItemKey("Contacts", i)+".Email"] = "Email is required"
+			}
+		}
+		return errors
+	})
+
+	form.AddItem("Contacts", Contact{})
+
+	assert.Equal(t, map[string]string{"Email": "Email is required"}, form.ItemErrors("Contacts", 0))
+}
+
+func TestUseForm_ArrayFields_ReportErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		edit         func(form UseFormReturn[ProfileForm])
+		expectedOp   string
+		expectedType string
+	}{
+		{
+			name:         "missing field",
+			edit:         func(form UseFormReturn[ProfileForm]) { form.AddItem("Emails", "x") },
+			expectedOp:   "AddItem",
+			expectedType: "invalid_field",
+		},
+		{
+			name:         "non-slice field",
+			edit:         func(form UseFormReturn[ProfileForm]) { form.AddItem("Name", "x") },
+			expectedOp:   "AddItem",
+			expectedType: "not_a_slice",
+		},
+		{
+			name:         "item type mismatch",
+			edit:         func(form UseFormReturn[ProfileForm]) { form.AddItem("Phones", 42) },
+			expectedOp:   "AddItem",
+			expectedType: "invalid_item",
+		},
+		{
+			name:         "index out of range",
+			edit:         func(form UseFormReturn[ProfileForm]) { form.RemoveItem("Phones", 5) },
+			expectedOp:   "RemoveItem",
+			expectedType: "invalid_item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedContext *observability.ErrorContext
+			observability.SetErrorReporter(&testErrorReporter{
+				onError: func(_ error, ctx *observability.ErrorContext) {
+					capturedContext = ctx
+				},
+			})
+			defer observability.SetErrorReporter(nil)
+
+			initial := ProfileForm{Phones: []string{"555-0100"}}
+			form := UseForm(createTestContext(), initial, validateProfileForm)
+
+			tt.edit(form)
+
+			if assert.NotNil(t, capturedContext, "Error should be reported") {
+				assert.Equal(t, "UseForm", capturedContext.ComponentName)
+				assert.Equal(t, tt.expectedOp, capturedContext.EventName)
+				assert.Equal(t, tt.expectedType, capturedContext.Tags["error_type"])
+			}
+			assert.Equal(t, initial, form.Values.GetTyped(), "Values should be unchanged")
+			assert.False(t, form.IsDirty.GetTyped())
+		})
+	}
+}
+
+// noFormErrors is a form validator that accepts everything.
+func noFormErrors(TestForm) map[string]string {
+	return map[string]string{}
+}
+
+func TestUseForm_FieldValidator(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{}, noFormErrors)
+	form.AddFieldValidator("Email", func(value any) string {
+		if value.(string) == "" {
+			return "Email is required"
+		}
+		return ""
+	})
+	form.AddFieldValidator("Email", func(value any) string {
+		if len(value.(string)) < 5 {
+			return "Email is too short"
+		}
+		return ""
+	})
+
+	// Untouched fields are not validated
+	form.SetField("Age", 30)
+	assert.Empty(t, form.Errors.GetTyped())
+
+	// The first failing validator wins
+	form.SetField("Email", "")
+	assert.Equal(t, "Email is required", form.Errors.GetTyped()["Email"])
+	form.SetField("Email", "a@b")
+	assert.Equal(t, "Email is too short", form.Errors.GetTyped()["Email"])
+	form.SetField("Email", "user@example.com")
+	assert.Empty(t, form.Errors.GetTyped())
+}
+
+func TestUseForm_FieldValidatorMergesWithValidate(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{}, validateTestForm)
+	form.AddFieldValidator("Age", func(value any) string {
+		if value.(int) > 150 {
+			return "Age is not realistic"
+		}
+		return ""
+	})
+
+	form.SetField("Age", 200)
+	form.Submit()
+
+	errors := form.Errors.GetTyped()
+	assert.Equal(t, "Email is required", errors["Email"])
+	assert.Equal(t, "Password must be at least 8 characters", errors["Password"])
+	assert.Equal(t, "Age is not realistic", errors["Age"])
+	assert.False(t, form.IsValid.GetTyped())
+}
+
+func TestUseForm_AsyncFieldValidator(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{}, noFormErrors)
+	release := make(chan struct{})
+	form.AddAsyncFieldValidator("Email", func(_ context.Context, value any) error {
+		<-release
+		if value.(string) == "taken@example.com" {
+			return errors.New("Email is already registered")
+		}
+		return nil
+	})
+
+	form.SetField("Email", "taken@example.com")
+	assert.True(t, form.Validating.GetTyped()["Email"])
+	assert.Empty(t, form.Errors.GetTyped())
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return !form.Validating.GetTyped()["Email"]
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, "Email is already registered", form.Errors.GetTyped()["Email"])
+
+	// A new value clears the async error once its check passes
+	form.SetField("Email", "free@example.com")
+	assert.Eventually(t, func() bool {
+		return !form.Validating.GetTyped()["Email"] && len(form.Errors.GetTyped()) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestUseForm_AsyncFieldValidatorSuperseded(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{}, noFormErrors)
+	var cancelled atomic.Int32
+	form.AddAsyncFieldValidator("Email", func(ctx context.Context, value any) error {
+		if value.(string) == "slow" {
+			<-ctx.Done()
+			cancelled.Add(1)
+			return errors.New("stale result")
+		}
+		return nil
+	})
+
+	form.SetField("Email", "slow")
+	form.SetField("Email", "fast")
+
+	assert.Eventually(t, func() bool {
+		return cancelled.Load() == 1 && !form.Validating.GetTyped()["Email"]
+	}, time.Second, time.Millisecond)
+	assert.Empty(t, form.Errors.GetTyped())
+}
+
+func TestUseForm_AsyncFieldValidatorCancelledOnReset(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	form := UseForm(ctx, TestForm{}, noFormErrors)
+	started := make(chan struct{})
+	form.AddAsyncFieldValidator("Email", func(ctx context.Context, _ any) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	form.SetField("Email", "user@example.com")
+	<-started
+	form.Reset()
+
+	assert.Empty(t, form.Validating.GetTyped())
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, form.Errors.GetTyped())
+}
+
+func TestUseForm_ResetTo(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{Email: "old@example.com"}, validateTestForm)
+
+	form.SetField("Email", "")
+	assert.NotEmpty(t, form.Errors.GetTyped())
+
+	saved := TestForm{Email: "saved@example.com", Password: "password123", Age: 30}
+	form.ResetTo(saved)
+
+	assert.Equal(t, saved, form.Values.GetTyped())
+	assert.Empty(t, form.Errors.GetTyped())
+	assert.False(t, form.IsDirty.GetTyped())
+	assert.Empty(t, form.DirtyFields())
+
+	// Reset returns to the new baseline, not the initial values
+	form.SetField("Age", 31)
+	form.Reset()
+	assert.Equal(t, saved, form.Values.GetTyped())
+}
+
+func TestUseForm_DirtyFields(t *testing.T) {
+	form := UseForm(createTestContext(), TestForm{Email: "a@example.com", Age: 20}, validateTestForm)
+
+	tests := []struct {
+		name     string
+		field    string
+		value    interface{}
+		expected map[string]bool
+	}{
+		{name: "changed field is dirty", field: "Email", value: "b@example.com", expected: map[string]bool{"Email": true}},
+		{name: "second field", field: "Age", value: 21, expected: map[string]bool{"Email": true, "Age": true}},
+		{name: "back to baseline is clean", field: "Email", value: "a@example.com", expected: map[string]bool{"Age": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form.SetField(tt.field, tt.value)
+			assert.Equal(t, tt.expected, form.DirtyFields())
+			for _, field := range []string{"Email", "Password", "Age"} {
+				assert.Equal(t, tt.expected[field], form.IsFieldDirty(field), field)
+			}
+		})
+	}
+
+	// After ResetTo, dirtiness is relative to the new baseline
+	form.ResetTo(form.Values.GetTyped())
+	assert.Empty(t, form.DirtyFields())
+	form.SetField("Age", 20)
+	assert.True(t, form.IsFieldDirty("Age"))
+	assert.False(t, form.IsFieldDirty("Missing"))
+}
@@ -0,0 +1,320 @@
+package composables
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// i18nProvideKey is the provide/inject key for sharing translations with
+// descendant components.
+const i18nProvideKey = "composables:i18n"
+
+// Messages maps message keys to translated text for one locale.
+//
+// Text may contain {name} placeholders, filled from T's arguments. Plural
+// forms are stored under the key plus a plural category suffix:
+//
+//	Messages{
+//	    "greeting":      "Hello, {name}!",
+//	    "files.zero":    "No files",
+//	    "files.one":     "{count} file",
+//	    "files.other":   "{count} files",
+//	}
+type Messages map[string]string
+
+// Catalog maps locale names (e.g., "en", "fr") to their messages.
+type Catalog map[string]Messages
+
+// i18nConfig holds configuration for ProvideI18n.
+type i18nConfig struct {
+	fallbackLocale string
+	pluralRules    map[string]func(count int) string
+}
+
+// I18nOption configures ProvideI18n.
+type I18nOption func(*i18nConfig)
+
+// WithFallbackLocale sets the locale whose messages are used when the
+// current locale lacks a key. Default: none.
+//
+// Example:
+//
+//	i18n := ProvideI18n(ctx, catalog, "de", WithFallbackLocale("en"))
+func WithFallbackLocale(locale string) I18nOption {
+	return func(c *i18nConfig) {
+		c.fallbackLocale = locale
+	}
+}
+
+// WithPluralRule sets how a count maps to a plural category ("zero", "one",
+// "two", "few", "many", or "other") for locale. The default rule returns
+// "one" for 1 and "other" otherwise, which suits English and many other
+// languages.
+//
+// Example:
+//
+//	// French treats 0 and 1 as singular
+//	WithPluralRule("fr", func(n int) string {
+//	    if n == 0 || n == 1 {
+//	        return "one"
+//	    }
+//	    return "other"
+//	})
+func WithPluralRule(locale string, rule func(count int) string) I18nOption {
+	return func(c *i18nConfig) {
+		c.pluralRules[locale] = rule
+	}
+}
+
+// I18nReturn is the return value of ProvideI18n and UseI18n.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type I18nReturn struct {
+	// Locale is the current locale. Set it to switch language; components
+	// that render translations re-render with the new locale.
+	Locale *bubbly.Ref[string]
+
+	catalog Catalog
+	config  i18nConfig
+
+	// reported holds "locale\x00key" entries already reported as missing
+	reported sync.Map
+}
+
+// T returns the translation of key in the current locale.
+//
+// args are name/value pairs filling {name} placeholders, like log/slog
+// attributes. A "count" argument selects a plural form: T looks up
+// key+".zero" for a count of 0, then key+"."+category for the locale's
+// plural rule, then key+".other", then key itself.
+//
+// Keys missing from both the current and fallback locales render as the
+// key and are reported via observability once per locale.
+//
+// Example:
+//
+//	i18n.T("greeting", "name", "Ada") // "Hello, Ada!"
+//	i18n.T("files", "count", 3)       // "3 files"
+func (i *I18nReturn) T(key string, args ...any) string {
+	locale := i.Locale.GetTyped()
+	params := i18nParams(args)
+
+	candidates := []string{key}
+	if count, ok := i18nCount(params["count"]); ok {
+		candidates = i.pluralCandidates(locale, key, count)
+	}
+
+	message, ok := i.lookup(locale, candidates)
+	if !ok {
+		i.reportMissing(locale, key)
+		message = key
+	}
+	return i18nInterpolate(message, params)
+}
+
+// Locales returns the locales in the catalog, sorted.
+func (i *I18nReturn) Locales() []string {
+	locales := make([]string, 0, len(i.catalog))
+	for locale := range i.catalog {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// pluralCandidates lists the keys to try for a counted message.
+func (i *I18nReturn) pluralCandidates(locale, key string, count int) []string {
+	rule := i.config.pluralRules[locale]
+	if rule == nil {
+		rule = defaultPluralRule
+	}
+
+	candidates := make([]string, 0, 4)
+	if count == 0 {
+		candidates = append(candidates, key+".zero")
+	}
+	return append(candidates, key+"."+rule(count), key+".other", key)
+}
+
+// lookup returns the first candidate found in locale, then in the
+// fallback locale.
+func (i *I18nReturn) lookup(locale string, candidates []string) (string, bool) {
+	for _, loc := range []string{locale, i.config.fallbackLocale} {
+		messages, ok := i.catalog[loc]
+		if !ok {
+			continue
+		}
+		for _, candidate := range candidates {
+			if message, ok := messages[candidate]; ok {
+				return message, true
+			}
+		}
+	}
+	return "", false
+}
+
+// reportMissing reports a missing translation once per locale and key.
+func (i *I18nReturn) reportMissing(locale, key string) {
+	if _, seen := i.reported.LoadOrStore(locale+"\x00"+key, true); seen {
+		return
+	}
+
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+	reporter.ReportError(
+		fmt.Errorf("UseI18n: missing translation for key %q in locale %q", key, locale),
+		&observability.ErrorContext{
+			ComponentName: "UseI18n",
+			EventName:     "T",
+			Timestamp:     time.Now(),
+			StackTrace:    debug.Stack(),
+			Tags: map[string]string{
+				"error_type": "missing_translation",
+				"key":        key,
+				"locale":     locale,
+			},
+		},
+	)
+}
+
+// defaultPluralRule is the plural rule for locales without their own.
+func defaultPluralRule(count int) string {
+	if count == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// i18nParams converts name/value pairs to a map. Pairs with non-string
+// names and a trailing unpaired value are ignored.
+func i18nParams(args []any) map[string]any {
+	params := make(map[string]any, len(args)/2)
+	for n := 0; n+1 < len(args); n += 2 {
+		if name, ok := args[n].(string); ok {
+			params[name] = args[n+1]
+		}
+	}
+	return params
+}
+
+// i18nCount converts an integer argument to a count.
+func i18nCount(value any) (int, bool) {
+	switch n := value.(type) {
+	case int:
+		return n, true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), true
+	case uint:
+		return int(n), true
+	case uint32:
+		return int(n), true
+	case uint64:
+		return int(n), true
+	}
+	return 0, false
+}
+
+// i18nInterpolate replaces {name} placeholders with their parameter values.
+// Unknown placeholders are left as is.
+func i18nInterpolate(message string, params map[string]any) string {
+	if len(params) == 0 || !strings.Contains(message, "{") {
+		return message
+	}
+	pairs := make([]string, 0, len(params)*2)
+	for name, value := range params {
+		pairs = append(pairs, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
+
+// newI18n creates translations for catalog starting at locale.
+func newI18n(catalog Catalog, locale string, opts []I18nOption) *I18nReturn {
+	config := i18nConfig{
+		pluralRules: make(map[string]func(count int) string),
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if catalog == nil {
+		catalog = Catalog{}
+	}
+	return &I18nReturn{
+		Locale:  bubbly.NewRef(locale),
+		catalog: catalog,
+		config:  config,
+	}
+}
+
+// ProvideI18n creates translations for catalog, starting at locale, and
+// provides them to this component and its descendants, which retrieve them
+// with UseI18n. Call it in the root component's Setup.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    i18n := composables.ProvideI18n(ctx, composables.Catalog{
+//	        "en": {"greeting": "Hello, {name}!"},
+//	        "es": {"greeting": "¡Hola, {name}!"},
+//	    }, "en", composables.WithFallbackLocale("en"))
+//
+//	    ctx.On("language", func(data interface{}) {
+//	        i18n.Locale.Set(data.(string))
+//	    })
+//	})
+func ProvideI18n(ctx *bubbly.Context, catalog Catalog, locale string, opts ...I18nOption) *I18nReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("ProvideI18n", time.Since(start))
+	}()
+
+	i18n := newI18n(catalog, locale, opts)
+	if ctx != nil {
+		ctx.Provide(i18nProvideKey, i18n)
+	}
+	return i18n
+}
+
+// UseI18n returns the translations provided by the nearest ProvideI18n in
+// this component or its ancestors.
+//
+// Without a provider, it returns translations with an empty catalog, so T
+// renders keys as is (and reports them as missing).
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    i18n := composables.UseI18n(ctx)
+//	    ctx.Expose("i18n", i18n)
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    i18n := ctx.Get("i18n").(*composables.I18nReturn)
+//	    return i18n.T("files", "count", 3)
+//	})
+func UseI18n(ctx *bubbly.Context) *I18nReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseI18n", time.Since(start))
+	}()
+
+	if ctx != nil {
+		if i18n, ok := ctx.Inject(i18nProvideKey, nil).(*I18nReturn); ok {
+			return i18n
+		}
+	}
+	return newI18n(nil, "", nil)
+}
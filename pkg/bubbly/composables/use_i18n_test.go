@@ -0,0 +1,126 @@
+package composables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+var testCatalog = Catalog{
+	"en": {
+		"greeting":    "Hello, {name}!",
+		"files.zero":  "No files",
+		"files.one":   "{count} file",
+		"files.other": "{count} files",
+		"only.en":     "English only",
+	},
+	"fr": {
+		"greeting":    "Bonjour, {name} !",
+		"files.one":   "{count} fichier",
+		"files.other": "{count} fichiers",
+	},
+}
+
+func TestUseI18n_T(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		key      string
+		args     []any
+		expected string
+	}{
+		{name: "interpolation", locale: "en", key: "greeting", args: []any{"name", "Ada"}, expected: "Hello, Ada!"},
+		{name: "other locale", locale: "fr", key: "greeting", args: []any{"name", "Ada"}, expected: "Bonjour, Ada !"},
+		{name: "plural one", locale: "en", key: "files", args: []any{"count", 1}, expected: "1 file"},
+		{name: "plural other", locale: "en", key: "files", args: []any{"count", 5}, expected: "5 files"},
+		{name: "plural zero", locale: "en", key: "files", args: []any{"count", 0}, expected: "No files"},
+		{name: "custom plural rule", locale: "fr", key: "files", args: []any{"count", 0}, expected: "0 fichier"},
+		{name: "fallback locale", locale: "fr", key: "only.en", expected: "English only"},
+		{name: "missing key falls back to key", locale: "en", key: "nope", expected: "nope"},
+		{name: "unknown placeholder kept", locale: "en", key: "greeting", expected: "Hello, {name}!"},
+		{name: "unpaired argument ignored", locale: "en", key: "greeting", args: []any{"name"}, expected: "Hello, {name}!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i18n := ProvideI18n(createTestContext(), testCatalog, tt.locale,
+				WithFallbackLocale("en"),
+				WithPluralRule("fr", func(n int) string {
+					if n == 0 || n == 1 {
+						return "one"
+					}
+					return "other"
+				}),
+			)
+
+			assert.Equal(t, tt.expected, i18n.T(tt.key, tt.args...))
+		})
+	}
+}
+
+func TestUseI18n_LocaleSwitch(t *testing.T) {
+	i18n := ProvideI18n(createTestContext(), testCatalog, "en")
+
+	greeting := bubbly.NewComputed(func() string {
+		return i18n.T("greeting", "name", "Ada")
+	})
+	assert.Equal(t, "Hello, Ada!", greeting.GetTyped())
+
+	i18n.Locale.Set("fr")
+
+	assert.Equal(t, "Bonjour, Ada !", greeting.GetTyped(), "Switching locale should update dependents")
+}
+
+func TestUseI18n_InjectsFromAncestor(t *testing.T) {
+	parent := bubbly.NewTestContext()
+	child := bubbly.NewTestContext()
+	bubbly.SetParent(child, parent)
+
+	provided := ProvideI18n(parent, testCatalog, "fr")
+	injected := UseI18n(child)
+
+	require.Same(t, provided, injected)
+	assert.Equal(t, "Bonjour, Ada !", injected.T("greeting", "name", "Ada"))
+}
+
+func TestUseI18n_NoProvider(t *testing.T) {
+	i18n := UseI18n(bubbly.NewTestContext())
+
+	require.NotNil(t, i18n)
+	assert.Equal(t, "greeting", i18n.T("greeting"))
+	assert.Empty(t, i18n.Locales())
+}
+
+func TestUseI18n_MissingKeyReportedOnce(t *testing.T) {
+	var reports []*observability.ErrorContext
+	observability.SetErrorReporter(&testErrorReporter{
+		onError: func(_ error, ctx *observability.ErrorContext) {
+			reports = append(reports, ctx)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	i18n := ProvideI18n(createTestContext(), testCatalog, "en")
+
+	i18n.T("missing")
+	i18n.T("missing")
+	i18n.Locale.Set("fr")
+	i18n.T("missing")
+
+	require.Len(t, reports, 2, "Missing keys should be reported once per locale")
+	assert.Equal(t, "UseI18n", reports[0].ComponentName)
+	assert.Equal(t, "missing_translation", reports[0].Tags["error_type"])
+	assert.Equal(t, "missing", reports[0].Tags["key"])
+	assert.Equal(t, "en", reports[0].Tags["locale"])
+	assert.Equal(t, "fr", reports[1].Tags["locale"])
+}
+
+func TestUseI18n_Locales(t *testing.T) {
+	i18n := ProvideI18n(createTestContext(), testCatalog, "en")
+
+	assert.Equal(t, []string{"en", "fr"}, i18n.Locales())
+}
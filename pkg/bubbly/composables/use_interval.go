@@ -9,7 +9,8 @@ import (
 )
 
 // IntervalReturn is the return value of UseInterval.
-// It provides periodic execution management with start/stop/toggle/reset controls.
+// It provides periodic execution management with start/stop/toggle/reset
+// controls, and pause/resume aliases.
 //
 // The interval uses an internal goroutine with time.Ticker for timing.
 // The goroutine is properly cleaned up when Stop() is called or when the
@@ -40,10 +41,14 @@ type IntervalReturn struct {
 
 	// running tracks if goroutine is active (internal, not same as IsRunning ref)
 	running bool
+
+	// disposed is set when the component unmounts; Start is a no-op afterwards
+	disposed bool
 }
 
 // Start begins the interval.
-// If the interval is already running, this is a no-op.
+// If the interval is already running, or the component has unmounted, this
+// is a no-op.
 // The callback will be executed after each duration interval.
 //
 // Example:
@@ -56,8 +61,8 @@ func (i *IntervalReturn) Start() {
 	i.mu.Lock()
 	defer i.mu.Unlock()
 
-	if i.running {
-		return // Already running
+	if i.running || i.disposed {
+		return // Already running, or the component is gone
 	}
 
 	i.running = true
@@ -76,11 +81,11 @@ func (i *IntervalReturn) Start() {
 		for {
 			select {
 			case <-ticker.C:
-				// Check if we're still running before executing callback
-				// This prevents race condition where Stop() is called but
-				// callback is already scheduled to execute
+				// Check this run is still the active one before executing
+				// callback. This prevents races where Stop() (or Stop() then
+				// Start()) is called while a tick is already pending
 				i.mu.Lock()
-				stillRunning := i.running
+				stillRunning := i.running && i.stopChan == stopChan
 				i.mu.Unlock()
 
 				if stillRunning {
@@ -106,6 +111,11 @@ func (i *IntervalReturn) Stop() {
 	i.mu.Lock()
 	defer i.mu.Unlock()
 
+	i.stopLocked()
+}
+
+// stopLocked stops the interval. Must be called with i.mu held.
+func (i *IntervalReturn) stopLocked() {
 	if !i.running {
 		return // Already stopped
 	}
@@ -128,6 +138,30 @@ func (i *IntervalReturn) Stop() {
 	}
 }
 
+// dispose stops the interval for good when the component unmounts.
+// Marking it disposed in the same critical section as stopping it ensures a
+// concurrent Start cannot restart the ticker in between.
+func (i *IntervalReturn) dispose() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.disposed = true
+	i.stopLocked()
+}
+
+// Pause stops the interval; it is Stop under the name used by VueUse's
+// useIntervalFn. Pausing a paused interval is a no-op.
+func (i *IntervalReturn) Pause() {
+	i.Stop()
+}
+
+// Resume restarts a paused interval; it is Start under the name used by
+// VueUse's useIntervalFn. Resuming a running interval, or one whose
+// component has unmounted, is a no-op.
+func (i *IntervalReturn) Resume() {
+	i.Start()
+}
+
 // Toggle starts if stopped, stops if running.
 // This is a convenience method for toggling the interval state.
 //
@@ -223,7 +257,9 @@ func (i *IntervalReturn) Reset() {
 //
 // Cleanup:
 //
-// The interval is automatically stopped when the component unmounts.
+// The interval is automatically stopped when the component unmounts, and
+// cannot be started again afterwards, so no tick fires after unmount. A
+// callback for a tick that fired just before unmount may still be running.
 // You can also manually stop it by calling Stop().
 func UseInterval(ctx *bubbly.Context, callback func(), duration time.Duration) *IntervalReturn {
 	// Record metrics if monitoring is enabled
@@ -246,9 +282,7 @@ func UseInterval(ctx *bubbly.Context, callback func(), duration time.Duration) *
 
 	// Register cleanup on unmount
 	if ctx != nil {
-		ctx.OnUnmounted(func() {
-			interval.Stop()
-		})
+		ctx.OnUnmounted(interval.dispose)
 	}
 
 	return interval
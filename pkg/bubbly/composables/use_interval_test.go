@@ -177,6 +177,74 @@ func TestUseInterval_CleanupOnUnmount(t *testing.T) {
 	assert.False(t, interval.IsRunning.GetTyped(), "interval should be stopped after unmount")
 }
 
+// TestUseInterval_NoRestartAfterUnmount verifies the interval cannot be
+// started or resumed once the component has unmounted.
+func TestUseInterval_NoRestartAfterUnmount(t *testing.T) {
+	var counter int32
+	ctx := bubbly.NewTestContext()
+	interval := UseInterval(ctx, func() {
+		atomic.AddInt32(&counter, 1)
+	}, 5*time.Millisecond)
+
+	bubbly.TriggerUnmount(ctx)
+	interval.Start()
+	interval.Resume()
+	interval.Toggle()
+	time.Sleep(30 * time.Millisecond)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter), "callback should never run after unmount")
+	assert.False(t, interval.IsRunning.GetTyped())
+}
+
+// TestUseInterval_UnmountRacingStart verifies a Start running concurrently
+// with unmount never leaves the interval running.
+func TestUseInterval_UnmountRacingStart(t *testing.T) {
+	for n := 0; n < 100; n++ {
+		ctx := bubbly.NewTestContext()
+		interval := UseInterval(ctx, func() {}, time.Millisecond)
+		interval.Start()
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			bubbly.TriggerUnmount(ctx)
+		}()
+		go func() {
+			defer wg.Done()
+			interval.Start()
+		}()
+		wg.Wait()
+
+		require.False(t, interval.IsRunning.GetTyped(), "interval running after unmount (iteration %d)", n)
+	}
+}
+
+// TestUseInterval_PauseResume verifies Pause and Resume are idempotent
+// aliases of Stop and Start.
+func TestUseInterval_PauseResume(t *testing.T) {
+	var counter int32
+	interval := UseInterval(createTestContext(), func() {
+		atomic.AddInt32(&counter, 1)
+	}, 5*time.Millisecond)
+
+	interval.Resume()
+	interval.Resume()
+	assert.True(t, interval.IsRunning.GetTyped())
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&counter) > 0 }, time.Second, time.Millisecond)
+
+	interval.Pause()
+	interval.Pause()
+	assert.False(t, interval.IsRunning.GetTyped())
+	paused := atomic.LoadInt32(&counter)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, paused, atomic.LoadInt32(&counter), "callback should not run while paused")
+
+	interval.Resume()
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&counter) > paused }, time.Second, time.Millisecond)
+	interval.Pause()
+}
+
 // TestUseInterval_NegativeDuration_Panics verifies that negative duration causes panic.
 func TestUseInterval_NegativeDuration_Panics(t *testing.T) {
 	ctx := createTestContext()
@@ -11,6 +11,54 @@ import (
 	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
 )
 
+// StorageMigration upgrades a stored value by one schema version. It receives
+// the JSON of the value as stored at the previous version and returns its
+// JSON at the next one.
+type StorageMigration func(raw json.RawMessage) (json.RawMessage, error)
+
+// localStorageConfig holds configuration for UseLocalStorage.
+type localStorageConfig struct {
+	migrations []StorageMigration
+}
+
+// LocalStorageOption configures UseLocalStorage.
+type LocalStorageOption func(*localStorageConfig)
+
+// Migrate adds a schema migration to UseLocalStorage. Each Migrate option
+// is one version step: the first upgrades version 0 (values stored before
+// any migration existed) to 1, the second 1 to 2, and so on, so new
+// migrations must always be appended.
+//
+// With migrations, values are stored in a versioned envelope
+// ({"$version": N, "value": ...}). On load, only the migrations after the
+// stored version run, so each applies exactly once to a given value. If a
+// migration fails, the failure is reported via observability and the
+// initial value is used.
+//
+// Example:
+//
+//	// v1 stored "theme" as a bool; v2 stores a name
+//	settings := UseLocalStorage(ctx, "settings", defaults, storage,
+//	    Migrate(func(raw json.RawMessage) (json.RawMessage, error) {
+//	        var old struct{ Dark bool }
+//	        if err := json.Unmarshal(raw, &old); err != nil {
+//	            return nil, err
+//	        }
+//	        theme := "light"
+//	        if old.Dark {
+//	            theme = "dark"
+//	        }
+//	        return json.Marshal(Settings{Theme: theme})
+//	    }),
+//	)
+func Migrate(fn StorageMigration) LocalStorageOption {
+	return func(c *localStorageConfig) {
+		if fn != nil {
+			c.migrations = append(c.migrations, fn)
+		}
+	}
+}
+
 // UseLocalStorage creates a reactive state that persists to storage.
 // Values are automatically loaded from storage on creation and saved on every change.
 //
@@ -28,6 +76,7 @@ import (
 //   - key: The storage key (used as filename in FileStorage)
 //   - initial: The initial/default value if storage doesn't exist
 //   - storage: The storage implementation (use NewFileStorage for file-based storage)
+//   - opts: Optional configuration (Migrate)
 //
 // Returns:
 //   - UseStateReturn[T]: Same interface as UseState, with automatic persistence
@@ -86,22 +135,138 @@ import (
 //   - Save errors: Continues execution, reports error via observability
 //   - JSON errors: Uses initial value, reports error via observability
 //   - Storage unavailable: Uses initial value, reports error via observability
+//   - Migration errors: Uses initial value, reports error via observability
 //
 // Performance:
 //   - Load: One file read on creation
 //   - Save: One file write per value change (not debounced)
-//   - Consider using UseDebounce if values change frequently
-func UseLocalStorage[T any](ctx *bubbly.Context, key string, initial T, storage Storage) UseStateReturn[T] {
+//   - Wrap the storage with NewBatchStorage to coalesce writes of several keys
+//     changing together; it is flushed when the component unmounts
+func UseLocalStorage[T any](ctx *bubbly.Context, key string, initial T, storage Storage, opts ...LocalStorageOption) UseStateReturn[T] {
+	var config localStorageConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+	codec := storageCodec{migrations: config.migrations}
+
 	// Try to load existing value from storage
+	loadedValue := loadStorageValue("UseLocalStorage", key, initial, storage, codec)
+
+	// Create the underlying reactive reference with loaded/initial value
+	value := bubbly.NewRef(loadedValue)
+
+	// Watch for changes and save to storage
+	// Create a watcher that monitors the value
+	bubbly.Watch(value, func(newVal, _ T) {
+		saveStorageValue("UseLocalStorage", key, newVal, storage, codec)
+	})
+
+	// Write out batched changes when the component goes away
+	if batch, ok := storage.(*BatchStorage); ok && ctx != nil {
+		ctx.OnUnmounted(func() {
+			_ = batch.Flush()
+		})
+	}
+
+	// Return the same interface as UseState
+	return UseStateReturn[T]{
+		Value: value,
+		Set: func(v T) {
+			value.Set(v)
+		},
+		Get: func() T {
+			return value.GetTyped()
+		},
+	}
+}
+
+// storageEnvelope is the stored form of a value with schema migrations.
+type storageEnvelope struct {
+	Version int             `json:"$version"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// storageCodec converts between a value's JSON and its stored form. Without
+// migrations the two are the same; with migrations the value is wrapped in a
+// versioned storageEnvelope.
+type storageCodec struct {
+	migrations []StorageMigration
+}
+
+// encode returns the stored form of a value's JSON.
+func (c storageCodec) encode(data []byte) ([]byte, error) {
+	if len(c.migrations) == 0 {
+		return data, nil
+	}
+	return json.Marshal(storageEnvelope{Version: len(c.migrations), Value: data})
+}
+
+// decode returns the current-version JSON of stored data, running the
+// migrations after its stored version. Data that is not an envelope was
+// stored before migrations were added and is version 0. It also returns the
+// version the failing migration started from, if any.
+func (c storageCodec) decode(data []byte) (json.RawMessage, int, error) {
+	if len(c.migrations) == 0 {
+		return data, 0, nil
+	}
+
+	version, raw := 0, json.RawMessage(data)
+	var envelope storageEnvelope
+	if isStorageEnvelope(data) && json.Unmarshal(data, &envelope) == nil {
+		version, raw = envelope.Version, envelope.Value
+	}
+
+	// Values from a newer schema are used as they are
+	for ; version < len(c.migrations); version++ {
+		migrated, err := c.migrations[version](raw)
+		if err != nil {
+			return nil, version, err
+		}
+		raw = migrated
+	}
+	return raw, version, nil
+}
+
+// isStorageEnvelope reports whether data is a JSON object holding exactly
+// the fields of a storageEnvelope.
+func isStorageEnvelope(data []byte) bool {
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil || len(fields) != 2 {
+		return false
+	}
+	_, hasVersion := fields["$version"]
+	_, hasValue := fields["value"]
+	return hasVersion && hasValue
+}
+
+// loadStorageValue loads and unmarshals the value stored under key, falling
+// back to initial when the key is missing or unreadable. Failures other than
+// "not found" are reported via observability on behalf of the named composable.
+func loadStorageValue[T any](composable, key string, initial T, storage Storage, codec storageCodec) T {
 	loadedValue := initial
 	data, err := storage.Load(key)
 
 	if err == nil {
+		// Upgrade the stored value to the current schema
+		raw, version, err := codec.decode(data)
+		if err != nil {
+			reportComposableStorageError(composable, "migration_failed", err, map[string]string{
+				"error_type":   "migration",
+				"key":          key,
+				"from_version": fmt.Sprintf("%d", version),
+			}, map[string]interface{}{
+				"data_sample": truncateData(data, 100),
+				"data_size":   len(data),
+			})
+			return initial
+		}
+		data = raw
+
 		// Storage exists, try to unmarshal
 		var loaded T
 		if err := json.Unmarshal(data, &loaded); err != nil {
 			// JSON unmarshal failed - use initial value and report error
-			reportStorageError("unmarshal_failed", err, map[string]string{
+			reportComposableStorageError(composable, "unmarshal_failed", err, map[string]string{
 				"error_type": "json_unmarshal",
 				"key":        key,
 			}, map[string]interface{}{
@@ -114,72 +279,67 @@ func UseLocalStorage[T any](ctx *bubbly.Context, key string, initial T, storage
 		}
 	} else if !os.IsNotExist(err) {
 		// Load failed for reason other than "not found" - report error
-		reportStorageError("load_failed", err, map[string]string{
+		reportComposableStorageError(composable, "load_failed", err, map[string]string{
 			"error_type": "storage_load",
 			"key":        key,
 		}, map[string]interface{}{})
 	}
 	// If err is os.ErrNotExist, that's expected - just use initial value
 
-	// Create the underlying reactive reference with loaded/initial value
-	value := bubbly.NewRef(loadedValue)
-
-	// Watch for changes and save to storage
-	// Create a watcher that monitors the value
-	bubbly.Watch(value, func(newVal, _ T) {
-		// Marshal to JSON
-		data, err := json.Marshal(newVal)
-		if err != nil {
-			reportStorageError("marshal_failed", err, map[string]string{
-				"error_type": "json_marshal",
-				"key":        key,
-			}, map[string]interface{}{
-				"value_type": getTypeName(newVal),
-			})
-			return
-		}
+	return loadedValue
+}
 
-		// Save to storage
-		if err := storage.Save(key, data); err != nil {
-			reportStorageError("save_failed", err, map[string]string{
-				"error_type": "storage_save",
-				"key":        key,
-			}, map[string]interface{}{
-				"data_size": len(data),
-			})
-			return
-		}
-	})
+// saveStorageValue marshals value to JSON and saves it under key.
+// Failures are reported via observability on behalf of the named composable.
+// Returns true if the value was saved.
+func saveStorageValue[T any](composable, key string, value T, storage Storage, codec storageCodec) bool {
+	// Marshal to JSON, wrapped in the versioned envelope if migrating
+	data, err := json.Marshal(value)
+	if err == nil {
+		data, err = codec.encode(data)
+	}
+	if err != nil {
+		reportComposableStorageError(composable, "marshal_failed", err, map[string]string{
+			"error_type": "json_marshal",
+			"key":        key,
+		}, map[string]interface{}{
+			"value_type": getTypeName(value),
+		})
+		return false
+	}
 
-	// Return the same interface as UseState
-	return UseStateReturn[T]{
-		Value: value,
-		Set: func(v T) {
-			value.Set(v)
-		},
-		Get: func() T {
-			return value.GetTyped()
-		},
+	// Save to storage
+	if err := storage.Save(key, data); err != nil {
+		reportComposableStorageError(composable, "save_failed", err, map[string]string{
+			"error_type": "storage_save",
+			"key":        key,
+		}, map[string]interface{}{
+			"data_size": len(data),
+		})
+		return false
 	}
+
+	return true
 }
 
-// reportStorageError reports storage-related errors to the observability system.
+// reportComposableStorageError reports storage errors for the named composable
+// to the observability system.
 // Follows ZERO TOLERANCE policy - never silent failures.
-func reportStorageError(operation string, err error, tags map[string]string, extra map[string]interface{}) {
+func reportComposableStorageError(composable, operation string, err error, tags map[string]string, extra map[string]interface{}) {
 	reporter := observability.GetErrorReporter()
 	if reporter == nil {
 		return
 	}
 
 	// Add common tags
-	tags["component"] = "UseLocalStorage"
+	tags["component"] = composable
 	tags["operation"] = operation
 
 	// Add common extra data
 	extra["error_message"] = err.Error()
 
 	ctx := &observability.ErrorContext{
-		ComponentName: "UseLocalStorage",
+		ComponentName: composable,
 		ComponentID:   "composable",
 		EventName:     operation,
 		Timestamp:     time.Now(),
@@ -29,17 +29,17 @@ func (r *testLocalStorageErrorReporter) Flush(timeout time.Duration) error {
 	return nil
 }
 
-// TestReportStorageError_WithReporter tests error reporting with a configured reporter
-func TestReportStorageError_WithReporter(t *testing.T) {
+// TestReportComposableStorageError_WithReporter tests error reporting with a configured reporter
+func TestReportComposableStorageError_WithReporter(t *testing.T) {
 	// Arrange
 	reportedError := false
 	reporter := &testLocalStorageErrorReporter{
 		onError: func(err error, ctx *observability.ErrorContext) {
 			reportedError = true
-			assert.Equal(t, "UseLocalStorage", ctx.ComponentName)
-			assert.Equal(t, "Load", ctx.EventName)
-			assert.Equal(t, "UseLocalStorage", ctx.Tags["component"])
-			assert.Equal(t, "Load", ctx.Tags["operation"])
+			assert.Equal(t, "BatchStorage", ctx.ComponentName)
+			assert.Equal(t, "flush_failed", ctx.EventName)
+			assert.Equal(t, "BatchStorage", ctx.Tags["component"])
+			assert.Equal(t, "flush_failed", ctx.Tags["operation"])
 			assert.Equal(t, "test error", ctx.Extra["error_message"])
 		},
 	}
@@ -50,14 +50,14 @@ func TestReportStorageError_WithReporter(t *testing.T) {
 	err := errors.New("test error")
 	tags := map[string]string{"key": "value"}
 	extra := map[string]interface{}{"data": "test"}
-	reportStorageError("Load", err, tags, extra)
+	reportComposableStorageError("BatchStorage", "flush_failed", err, tags, extra)
 
 	// Assert
 	assert.True(t, reportedError, "Error should have been reported")
 }
 
-// TestReportStorageError_WithoutReporter tests that function handles nil reporter gracefully
-func TestReportStorageError_WithoutReporter(t *testing.T) {
+// TestReportComposableStorageError_WithoutReporter tests that function handles nil reporter gracefully
+func TestReportComposableStorageError_WithoutReporter(t *testing.T) {
 	// Arrange
 	observability.SetErrorReporter(nil)
 
@@ -65,7 +65,7 @@ func TestReportStorageError_WithoutReporter(t *testing.T) {
 	err := errors.New("test error")
 	tags := map[string]string{}
 	extra := map[string]interface{}{}
-	reportStorageError("Save", err, tags, extra)
+	reportComposableStorageError("UseLocalStorage", "save_failed", err, tags, extra)
 }
 
 // TestTruncateData_ShortData tests truncation with data shorter than max length
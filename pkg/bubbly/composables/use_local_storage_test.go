@@ -2,6 +2,7 @@ package composables
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"testing"
 	"time"
@@ -94,8 +95,7 @@ func TestUseLocalStorage_JSONSerialization(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			tempDir := t.TempDir()
-			storage := NewFileStorage(tempDir)
+			storage := NewMemoryStorage()
 			key := "test-" + tt.name
 
 			ctx := createTestContext()
@@ -137,8 +137,7 @@ func TestUseLocalStorage_JSONSerialization(t *testing.T) {
 // TestUseLocalStorage_Deserialization tests that loaded values match written values
 func TestUseLocalStorage_Deserialization(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 	key := "test-roundtrip"
 
 	ctx := createTestContext()
@@ -187,8 +186,7 @@ func TestUseLocalStorage_StorageUnavailable(t *testing.T) {
 // TestUseLocalStorage_TypeSafety tests that different types work independently
 func TestUseLocalStorage_TypeSafety(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 
 	ctx := createTestContext()
 
@@ -210,8 +208,7 @@ func TestUseLocalStorage_TypeSafety(t *testing.T) {
 // TestUseLocalStorage_InitialValueWhenNoStorage tests using initial value when no storage exists
 func TestUseLocalStorage_InitialValueWhenNoStorage(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 	key := "nonexistent-key"
 
 	ctx := createTestContext()
@@ -226,8 +223,7 @@ func TestUseLocalStorage_InitialValueWhenNoStorage(t *testing.T) {
 // TestUseLocalStorage_InvalidJSON tests handling of invalid JSON in storage
 func TestUseLocalStorage_InvalidJSON(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 	key := "invalid-json"
 
 	// Write invalid JSON to storage
@@ -246,8 +242,7 @@ func TestUseLocalStorage_InvalidJSON(t *testing.T) {
 // TestUseLocalStorage_MultipleInstances tests that multiple instances are independent
 func TestUseLocalStorage_MultipleInstances(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 
 	ctx1 := createTestContext()
 	ctx2 := createTestContext()
@@ -330,8 +325,7 @@ type mockUnmarshalableValue struct {
 // TestUseLocalStorage_MarshalError_ReportsError tests that marshal errors are reported
 func TestUseLocalStorage_MarshalError_ReportsError(t *testing.T) {
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 
 	ctx := createTestContext()
 
@@ -402,8 +396,7 @@ func TestUseLocalStorage_MarshalError_WithErrorReporter(t *testing.T) {
 	defer observability.SetErrorReporter(nil)
 
 	// Arrange
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 
 	ctx := createTestContext()
 
@@ -439,8 +432,7 @@ func TestUseLocalStorage_UnmarshalError_WithErrorReporter(t *testing.T) {
 	defer observability.SetErrorReporter(nil)
 
 	// Arrange - storage with invalid JSON
-	tempDir := t.TempDir()
-	storage := NewFileStorage(tempDir)
+	storage := NewMemoryStorage()
 	key := "bad-json"
 
 	// Pre-populate with invalid JSON
@@ -501,3 +493,93 @@ func TestUseLocalStorage_LoadError_WithErrorReporter(t *testing.T) {
 }
 
 // testStorageErrorReporter is defined in storage_coverage_test.go
+
+// TestUseLocalStorage_Migrate tests schema migrations on load
+func TestUseLocalStorage_Migrate(t *testing.T) {
+	type settings struct {
+		Theme string `json:"theme"`
+		Size  int    `json:"size"`
+	}
+
+	// v0 -> v1: {"dark": bool} becomes {"theme": name}
+	toV1 := func(raw json.RawMessage) (json.RawMessage, error) {
+		var old struct {
+			Dark bool `json:"dark"`
+		}
+		if err := json.Unmarshal(raw, &old); err != nil {
+			return nil, err
+		}
+		theme := "light"
+		if old.Dark {
+			theme = "dark"
+		}
+		return json.Marshal(map[string]string{"theme": theme})
+	}
+	// v1 -> v2: adds a default size
+	toV2 := func(raw json.RawMessage) (json.RawMessage, error) {
+		var s settings
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		s.Size = 12
+		return json.Marshal(s)
+	}
+
+	tests := []struct {
+		name     string
+		stored   string
+		expected settings
+	}{
+		{name: "legacy value runs all migrations", stored: `{"dark":true}`, expected: settings{Theme: "dark", Size: 12}},
+		{name: "v1 value runs remaining migration", stored: `{"$version":1,"value":{"theme":"light"}}`, expected: settings{Theme: "light", Size: 12}},
+		{name: "current value runs no migration", stored: `{"$version":2,"value":{"theme":"dark","size":20}}`, expected: settings{Theme: "dark", Size: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := NewMemoryStorage()
+			require.NoError(t, storage.Save("settings", []byte(tt.stored)))
+
+			state := UseLocalStorage(createTestContext(), "settings", settings{}, storage,
+				Migrate(toV1), Migrate(toV2))
+			assert.Equal(t, tt.expected, state.Get())
+
+			// Saves are stored in the current version's envelope
+			state.Set(settings{Theme: "blue", Size: 10})
+			data, err := storage.Load("settings")
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"$version":2,"value":{"theme":"blue","size":10}}`, string(data))
+
+			// Reloading is idempotent
+			reloaded := UseLocalStorage(createTestContext(), "settings", settings{}, storage,
+				Migrate(toV1), Migrate(toV2))
+			assert.Equal(t, settings{Theme: "blue", Size: 10}, reloaded.Get())
+		})
+	}
+}
+
+// TestUseLocalStorage_MigrateFailure tests that a failed migration is
+// reported and falls back to the initial value
+func TestUseLocalStorage_MigrateFailure(t *testing.T) {
+	var capturedContext *observability.ErrorContext
+	observability.SetErrorReporter(&testStorageErrorReporter{
+		onError: func(_ error, ctx *observability.ErrorContext) {
+			capturedContext = ctx
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	storage := NewMemoryStorage()
+	require.NoError(t, storage.Save("name", []byte(`"old"`)))
+
+	state := UseLocalStorage(createTestContext(), "name", "default", storage,
+		Migrate(func(json.RawMessage) (json.RawMessage, error) {
+			return nil, errors.New("unsupported format")
+		}))
+
+	assert.Equal(t, "default", state.Get())
+	require.NotNil(t, capturedContext)
+	assert.Equal(t, "migration_failed", capturedContext.EventName)
+	assert.Equal(t, "migration", capturedContext.Tags["error_type"])
+	assert.Equal(t, "0", capturedContext.Tags["from_version"])
+}
@@ -0,0 +1,210 @@
+package composables
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// PaginationReturn is the return value of UsePagination.
+// It splits a reactive slice into fixed-size pages and exposes the current
+// page window as a computed value.
+//
+// Pages are 1-based: the first page is 1 and the last is TotalPages. An
+// empty slice has a single (empty) page, so CurrentPage is always valid.
+//
+// Thread Safety:
+// All methods are thread-safe and can be called concurrently.
+type PaginationReturn[T any] struct {
+	// CurrentPage is the 1-based page number.
+	// It is clamped automatically when the item count shrinks.
+	CurrentPage *bubbly.Ref[int]
+
+	// TotalPages is the number of pages (computed, at least 1).
+	TotalPages *bubbly.Computed[int]
+
+	// PageItems is the slice of items on the current page (computed).
+	PageItems *bubbly.Computed[[]T]
+
+	// HasNext indicates whether a page follows the current one (computed).
+	HasNext *bubbly.Computed[bool]
+
+	// HasPrev indicates whether a page precedes the current one (computed).
+	HasPrev *bubbly.Computed[bool]
+
+	// pageSize is the number of items per page
+	pageSize int
+
+	// mu protects page navigation
+	mu sync.Mutex
+}
+
+// Next moves to the next page. Does nothing on the last page.
+//
+// Example:
+//
+//	pager := UsePagination(ctx, items, 10)
+//	pager.Next() // CurrentPage: 1 -> 2
+func (p *PaginationReturn[T]) Next() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.CurrentPage.GetTyped()
+	if current < p.TotalPages.GetTyped() {
+		p.CurrentPage.Set(current + 1)
+	}
+}
+
+// Prev moves to the previous page. Does nothing on the first page.
+//
+// Example:
+//
+//	pager.Prev() // CurrentPage: 2 -> 1
+func (p *PaginationReturn[T]) Prev() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current := p.CurrentPage.GetTyped()
+	if current > 1 {
+		p.CurrentPage.Set(current - 1)
+	}
+}
+
+// GoTo moves to the given 1-based page, clamped to [1, TotalPages].
+//
+// Example:
+//
+//	pager.GoTo(3)   // CurrentPage: 3
+//	pager.GoTo(999) // CurrentPage: last page
+func (p *PaginationReturn[T]) GoTo(page int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	page = clampPage(page, p.TotalPages.GetTyped())
+	if page != p.CurrentPage.GetTyped() {
+		p.CurrentPage.Set(page)
+	}
+}
+
+// PageSize returns the number of items per page.
+func (p *PaginationReturn[T]) PageSize() int {
+	return p.pageSize
+}
+
+// clampPage restricts page to [1, total].
+func clampPage(page, total int) int {
+	if page > total {
+		page = total
+	}
+	if page < 1 {
+		page = 1
+	}
+	return page
+}
+
+// UsePagination creates a paginated view of a reactive slice.
+//
+// PageItems always holds the window for CurrentPage and recomputes when
+// either the items or the page change. When the items shrink so that
+// CurrentPage no longer exists, CurrentPage is clamped to the new last page.
+// Combine with UseFilteredList to paginate a filtered result.
+//
+// Parameters:
+//   - ctx: The component context (may be nil outside components; no cleanup on unmount)
+//   - items: The Ref holding the full slice
+//   - pageSize: Items per page (values < 1 are treated as 1)
+//
+// Returns:
+//   - *PaginationReturn[T]: Page state, the page window, and navigation methods
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    items := bubbly.NewRef(loadRows())
+//	    pager := composables.UsePagination(ctx, items, 20)
+//
+//	    ctx.On("nextPage", func(_ interface{}) { pager.Next() })
+//	    ctx.On("prevPage", func(_ interface{}) { pager.Prev() })
+//
+//	    ctx.Expose("rows", pager.PageItems)
+//	    ctx.Expose("page", pager.CurrentPage)
+//	    ctx.Expose("pages", pager.TotalPages)
+//	})
+func UsePagination[T any](ctx *bubbly.Context, items *bubbly.Ref[[]T], pageSize int) *PaginationReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UsePagination", time.Since(start))
+	}()
+
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	if items == nil {
+		items = bubbly.NewRef[[]T](nil)
+	}
+
+	currentPage := bubbly.NewRef(1)
+
+	totalPages := bubbly.NewComputed(func() int {
+		count := len(items.GetTyped())
+		if count == 0 {
+			return 1
+		}
+		return (count + pageSize - 1) / pageSize
+	})
+
+	pageItems := bubbly.NewComputed(func() []T {
+		all := items.GetTyped()
+		page := clampPage(currentPage.GetTyped(), totalPages.GetTyped())
+
+		start := (page - 1) * pageSize
+		if start >= len(all) {
+			return []T{}
+		}
+		end := start + pageSize
+		if end > len(all) {
+			end = len(all)
+		}
+
+		window := make([]T, end-start)
+		copy(window, all[start:end])
+		return window
+	})
+
+	hasNext := bubbly.NewComputed(func() bool {
+		return currentPage.GetTyped() < totalPages.GetTyped()
+	})
+
+	hasPrev := bubbly.NewComputed(func() bool {
+		return currentPage.GetTyped() > 1
+	})
+
+	pagination := &PaginationReturn[T]{
+		CurrentPage: currentPage,
+		TotalPages:  totalPages,
+		PageItems:   pageItems,
+		HasNext:     hasNext,
+		HasPrev:     hasPrev,
+		pageSize:    pageSize,
+	}
+
+	// Clamp the current page when the data shrinks
+	cleanup := bubbly.Watch(items, func(_, _ []T) {
+		pagination.mu.Lock()
+		defer pagination.mu.Unlock()
+
+		page := currentPage.GetTyped()
+		if clamped := clampPage(page, totalPages.GetTyped()); clamped != page {
+			currentPage.Set(clamped)
+		}
+	})
+
+	if ctx != nil {
+		ctx.OnUnmounted(cleanup)
+	}
+
+	return pagination
+}
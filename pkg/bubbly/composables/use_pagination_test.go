@@ -0,0 +1,118 @@
+package composables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+func paginationItems(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i + 1
+	}
+	return items
+}
+
+// TestUsePagination_Window tests page math and the current page window
+func TestUsePagination_Window(t *testing.T) {
+	tests := []struct {
+		name       string
+		count      int
+		pageSize   int
+		goTo       int
+		wantPage   int
+		wantTotal  int
+		wantItems  []int
+		wantHasNxt bool
+		wantHasPrv bool
+	}{
+		{"empty has one page", 0, 5, 1, 1, 1, []int{}, false, false},
+		{"first page", 12, 5, 1, 1, 3, []int{1, 2, 3, 4, 5}, true, false},
+		{"middle page", 12, 5, 2, 2, 3, []int{6, 7, 8, 9, 10}, true, true},
+		{"partial last page", 12, 5, 3, 3, 3, []int{11, 12}, false, true},
+		{"goto clamps high", 12, 5, 99, 3, 3, []int{11, 12}, false, true},
+		{"goto clamps low", 12, 5, -4, 1, 3, []int{1, 2, 3, 4, 5}, true, false},
+		{"page size below one", 3, 0, 2, 2, 3, []int{2}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := bubbly.NewRef(paginationItems(tt.count))
+			pager := UsePagination(createTestContext(), items, tt.pageSize)
+
+			pager.GoTo(tt.goTo)
+
+			assert.Equal(t, tt.wantPage, pager.CurrentPage.GetTyped())
+			assert.Equal(t, tt.wantTotal, pager.TotalPages.GetTyped())
+			assert.Equal(t, tt.wantItems, pager.PageItems.GetTyped())
+			assert.Equal(t, tt.wantHasNxt, pager.HasNext.GetTyped())
+			assert.Equal(t, tt.wantHasPrv, pager.HasPrev.GetTyped())
+		})
+	}
+}
+
+// TestUsePagination_NextPrev tests navigation bounds
+func TestUsePagination_NextPrev(t *testing.T) {
+	pager := UsePagination(createTestContext(), bubbly.NewRef(paginationItems(4)), 2)
+	assert.Equal(t, 2, pager.PageSize())
+
+	pager.Prev()
+	assert.Equal(t, 1, pager.CurrentPage.GetTyped(), "Prev on first page is a no-op")
+
+	pager.Next()
+	assert.Equal(t, 2, pager.CurrentPage.GetTyped())
+	assert.Equal(t, []int{3, 4}, pager.PageItems.GetTyped())
+
+	pager.Next()
+	assert.Equal(t, 2, pager.CurrentPage.GetTyped(), "Next on last page is a no-op")
+
+	pager.Prev()
+	assert.Equal(t, []int{1, 2}, pager.PageItems.GetTyped())
+}
+
+// TestUsePagination_ClampOnShrink tests that the page follows shrinking data
+func TestUsePagination_ClampOnShrink(t *testing.T) {
+	items := bubbly.NewRef(paginationItems(30))
+	pager := UsePagination(createTestContext(), items, 10)
+
+	pager.GoTo(3)
+	assert.Equal(t, []int{21, 22, 23, 24, 25, 26, 27, 28, 29, 30}, pager.PageItems.GetTyped())
+
+	items.Set(paginationItems(12))
+	assert.Equal(t, 2, pager.CurrentPage.GetTyped())
+	assert.Equal(t, []int{11, 12}, pager.PageItems.GetTyped())
+	assert.False(t, pager.HasNext.GetTyped())
+
+	items.Set(nil)
+	assert.Equal(t, 1, pager.CurrentPage.GetTyped())
+	assert.Empty(t, pager.PageItems.GetTyped())
+
+	// Growth keeps the current page
+	items.Set(paginationItems(50))
+	pager.GoTo(4)
+	items.Set(paginationItems(60))
+	assert.Equal(t, 4, pager.CurrentPage.GetTyped())
+}
+
+// TestUsePagination_WithFilteredList tests paginating a filtered result
+func TestUsePagination_WithFilteredList(t *testing.T) {
+	ctx := createTestContext()
+	source := bubbly.NewRef(paginationItems(10))
+	even := UseFilteredList(ctx, source).Filter(func(n int) bool { return n%2 == 0 })
+
+	// Mirror the filtered output into a Ref, as a component would
+	filtered := bubbly.NewRef(even.Items.GetTyped())
+	cleanup := bubbly.Watch(even.Items, func(newVal, _ []int) { filtered.Set(newVal) })
+	defer cleanup()
+
+	pager := UsePagination(ctx, filtered, 2)
+	pager.GoTo(3)
+	assert.Equal(t, []int{10}, pager.PageItems.GetTyped())
+
+	source.Set(paginationItems(4))
+	assert.Equal(t, 1, pager.CurrentPage.GetTyped())
+	assert.Equal(t, []int{2, 4}, pager.PageItems.GetTyped())
+}
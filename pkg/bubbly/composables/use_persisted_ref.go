@@ -0,0 +1,135 @@
+package composables
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultPersistDelay is the default debounce delay before a UsePersistedRef
+// change is written to storage.
+const DefaultPersistDelay = 250 * time.Millisecond
+
+// PersistOption configures UsePersistedRef behavior.
+type PersistOption func(*persistConfig)
+
+// persistConfig holds configuration for UsePersistedRef.
+type persistConfig struct {
+	delay time.Duration
+}
+
+// WithPersistDelay sets the debounce delay before writes reach storage.
+// A delay of 0 writes synchronously on every Set.
+//
+// Example:
+//
+//	theme := UsePersistedRef(ctx, "theme", "dark", storage, WithPersistDelay(time.Second))
+func WithPersistDelay(delay time.Duration) PersistOption {
+	return func(cfg *persistConfig) {
+		if delay >= 0 {
+			cfg.delay = delay
+		}
+	}
+}
+
+// UsePersistedRef creates a Ref whose value is loaded from storage on creation
+// and written back, debounced, whenever it changes.
+//
+// It is the one-line form of the "settings that auto-save" pattern: the
+// returned value is a plain *bubbly.Ref[T] that can be exposed, watched, and
+// bound like any other Ref. Rapid updates are coalesced so only the latest
+// value is written once the delay elapses (DefaultPersistDelay unless
+// WithPersistDelay is given). A pending write is flushed when the component
+// unmounts, so the last change is never lost.
+//
+// Values are serialized as JSON, so T must be JSON-serializable. Load and
+// write failures are reported via the observability system; a failed load
+// falls back to initial.
+//
+// Parameters:
+//   - ctx: The component context (may be nil outside components; no flush on unmount)
+//   - key: The storage key
+//   - initial: The value to use when nothing is stored yet
+//   - storage: The storage backend (e.g., NewFileStorage)
+//   - opts: Optional configuration (WithPersistDelay)
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    storage := composables.NewFileStorage(configDir)
+//	    fontSize := composables.UsePersistedRef(ctx, "fontSize", 14, storage)
+//
+//	    ctx.On("bigger", func(_ interface{}) {
+//	        fontSize.Set(fontSize.GetTyped() + 1) // Saved automatically
+//	    })
+//	    ctx.Expose("fontSize", fontSize)
+//	})
+func UsePersistedRef[T any](ctx *bubbly.Context, key string, initial T, storage Storage, opts ...PersistOption) *bubbly.Ref[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UsePersistedRef", time.Since(start))
+	}()
+
+	cfg := persistConfig{delay: DefaultPersistDelay}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	value := bubbly.NewRef(loadStorageValue("UsePersistedRef", key, initial, storage, storageCodec{}))
+
+	// Debounced writer (protected by mutex for thread safety)
+	var mu sync.Mutex
+	var timer *time.Timer
+	pending := false
+
+	write := func() {
+		mu.Lock()
+		if !pending {
+			mu.Unlock()
+			return
+		}
+		pending = false
+		mu.Unlock()
+
+		saveStorageValue("UsePersistedRef", key, value.GetTyped(), storage, storageCodec{})
+	}
+
+	cleanup := bubbly.Watch(value, func(_, _ T) {
+		if cfg.delay == 0 {
+			mu.Lock()
+			pending = true
+			mu.Unlock()
+			write()
+			return
+		}
+
+		mu.Lock()
+		pending = true
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(cfg.delay, write)
+		mu.Unlock()
+	})
+
+	// Flush the pending write and stop watching on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(func() {
+			cleanup()
+
+			mu.Lock()
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+			}
+			mu.Unlock()
+
+			write()
+		})
+	}
+
+	return value
+}
@@ -0,0 +1,153 @@
+package composables
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// persistTestStorage is a thread-safe storage that counts saves.
+type persistTestStorage struct {
+	mu      sync.Mutex
+	data    map[string][]byte
+	saves   int
+	saveErr error
+}
+
+func newPersistTestStorage() *persistTestStorage {
+	return &persistTestStorage{data: make(map[string][]byte)}
+}
+
+func (s *persistTestStorage) Load(key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.data[key]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return data, nil
+}
+
+func (s *persistTestStorage) Save(key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	s.saves++
+	s.data[key] = data
+	return nil
+}
+
+func (s *persistTestStorage) get(key string) (string, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return string(s.data[key]), s.saves
+}
+
+// TestUsePersistedRef_LoadsInitial tests loading from storage and falling back to initial
+func TestUsePersistedRef_LoadsInitial(t *testing.T) {
+	storage := newPersistTestStorage()
+	storage.data["size"] = []byte("18")
+
+	ctx := createTestContext()
+	assert.Equal(t, 18, UsePersistedRef(ctx, "size", 14, storage).GetTyped())
+	assert.Equal(t, "dark", UsePersistedRef(ctx, "theme", "dark", storage).GetTyped())
+
+	storage.data["broken"] = []byte("{not json")
+	assert.Equal(t, 1, UsePersistedRef(ctx, "broken", 1, storage).GetTyped(), "invalid data falls back to initial")
+}
+
+// TestUsePersistedRef_DebouncesWrites tests that rapid sets coalesce into one write
+func TestUsePersistedRef_DebouncesWrites(t *testing.T) {
+	storage := newPersistTestStorage()
+	ref := UsePersistedRef(createTestContext(), "count", 0, storage, WithPersistDelay(20*time.Millisecond))
+
+	ref.Set(1)
+	ref.Set(2)
+	ref.Set(3)
+
+	_, saves := storage.get("count")
+	assert.Equal(t, 0, saves, "nothing written before the delay")
+
+	assert.Eventually(t, func() bool {
+		data, _ := storage.get("count")
+		return data == "3"
+	}, time.Second, 5*time.Millisecond)
+
+	_, saves = storage.get("count")
+	assert.Equal(t, 1, saves, "writes coalesced")
+}
+
+// TestUsePersistedRef_ZeroDelayWritesImmediately tests synchronous writes
+func TestUsePersistedRef_ZeroDelayWritesImmediately(t *testing.T) {
+	storage := newPersistTestStorage()
+	ref := UsePersistedRef(createTestContext(), "name", "", storage, WithPersistDelay(0))
+
+	ref.Set("ada")
+	data, saves := storage.get("name")
+	assert.Equal(t, `"ada"`, data)
+	assert.Equal(t, 1, saves)
+}
+
+// TestUsePersistedRef_FlushesOnUnmount tests that a pending write is flushed on unmount
+func TestUsePersistedRef_FlushesOnUnmount(t *testing.T) {
+	storage := newPersistTestStorage()
+	var ref *bubbly.Ref[int]
+
+	comp, err := bubbly.NewComponent("Settings").
+		Setup(func(ctx *bubbly.Context) {
+			ref = UsePersistedRef(ctx, "volume", 5, storage, WithPersistDelay(time.Hour))
+		}).
+		Template(func(ctx bubbly.RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	comp.View()
+
+	ref.Set(7)
+	_, saves := storage.get("volume")
+	assert.Equal(t, 0, saves)
+
+	if u, ok := comp.(interface{ Unmount() }); ok {
+		u.Unmount()
+	}
+
+	data, saves := storage.get("volume")
+	assert.Equal(t, "7", data)
+	assert.Equal(t, 1, saves)
+
+	ref.Set(9)
+	_, saves = storage.get("volume")
+	assert.Equal(t, 1, saves, "no writes after unmount")
+}
+
+// TestUsePersistedRef_ReportsWriteErrors tests observability reporting on write failure
+func TestUsePersistedRef_ReportsWriteErrors(t *testing.T) {
+	storage := newPersistTestStorage()
+	storage.saveErr = errors.New("disk full")
+
+	var reported *observability.ErrorContext
+	observability.SetErrorReporter(&testStorageErrorReporter{
+		onError: func(err error, ctx *observability.ErrorContext) {
+			reported = ctx
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	ref := UsePersistedRef(createTestContext(), "k", 0, storage, WithPersistDelay(0))
+	ref.Set(1)
+
+	require.NotNil(t, reported)
+	assert.Equal(t, "UsePersistedRef", reported.Tags["component"])
+	assert.Equal(t, "save_failed", reported.Tags["operation"])
+	assert.Equal(t, "k", reported.Tags["key"])
+}
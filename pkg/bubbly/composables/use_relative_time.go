@@ -0,0 +1,180 @@
+package composables
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// defaultRelativeTimeInterval is how often UseRelativeTime refreshes its label.
+const defaultRelativeTimeInterval = 30 * time.Second
+
+// relativeTimeConfig holds configuration options for UseRelativeTime.
+type relativeTimeConfig struct {
+	interval time.Duration
+	format   func(elapsed time.Duration) string
+	clock    func() time.Time
+}
+
+// RelativeTimeOption configures UseRelativeTime.
+type RelativeTimeOption func(*relativeTimeConfig)
+
+// WithRelativeTimeInterval sets how often the label is refreshed.
+// The default is 30 seconds. Non-positive values are ignored.
+//
+// Example:
+//
+//	label := UseRelativeTime(ctx, updatedAt,
+//	    WithRelativeTimeInterval(time.Second),
+//	)
+func WithRelativeTimeInterval(d time.Duration) RelativeTimeOption {
+	return func(c *relativeTimeConfig) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WithRelativeTimeFormat replaces FormatRelativeTime, e.g. for localized
+// labels. The function receives the elapsed time since the timestamp, which
+// is negative for timestamps in the future.
+//
+// Example:
+//
+//	label := UseRelativeTime(ctx, updatedAt,
+//	    WithRelativeTimeFormat(func(elapsed time.Duration) string {
+//	        if elapsed < time.Minute {
+//	            return "à l'instant"
+//	        }
+//	        return fmt.Sprintf("il y a %d min", int(elapsed.Minutes()))
+//	    }),
+//	)
+func WithRelativeTimeFormat(fn func(elapsed time.Duration) string) RelativeTimeOption {
+	return func(c *relativeTimeConfig) {
+		if fn != nil {
+			c.format = fn
+		}
+	}
+}
+
+// WithRelativeTimeClock sets the function used to read the current time.
+// The default is time.Now; tests can supply a fixed clock.
+func WithRelativeTimeClock(now func() time.Time) RelativeTimeOption {
+	return func(c *relativeTimeConfig) {
+		if now != nil {
+			c.clock = now
+		}
+	}
+}
+
+// FormatRelativeTime formats the time elapsed since a timestamp as a short
+// English label, as used by UseRelativeTime by default.
+//
+// Labels are "just now" under a minute, then whole minutes ("5m ago"),
+// hours ("3h ago"), days ("2d ago"), months of 30 days ("4mo ago"), and
+// years of 365 days ("1y ago"). Negative durations describe the future
+// ("in 5m").
+func FormatRelativeTime(elapsed time.Duration) string {
+	future := elapsed < 0
+	if future {
+		elapsed = -elapsed
+	}
+	if elapsed < time.Minute {
+		return "just now"
+	}
+
+	const (
+		day   = 24 * time.Hour
+		month = 30 * day
+		year  = 365 * day
+	)
+
+	var amount string
+	switch {
+	case elapsed < time.Hour:
+		amount = fmt.Sprintf("%dm", elapsed/time.Minute)
+	case elapsed < day:
+		amount = fmt.Sprintf("%dh", elapsed/time.Hour)
+	case elapsed < month:
+		amount = fmt.Sprintf("%dd", elapsed/day)
+	case elapsed < year:
+		amount = fmt.Sprintf("%dmo", elapsed/month)
+	default:
+		amount = fmt.Sprintf("%dy", elapsed/year)
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
+
+// UseRelativeTime returns a reactive label describing a timestamp relative
+// to now, such as "just now" or "5m ago".
+//
+// The label recomputes when the timestamp ref changes and on a timer (via
+// UseInterval), so it stays current without manual ticking. A zero
+// timestamp produces an empty label.
+//
+// Parameters:
+//   - ctx: The component context (required for lifecycle management)
+//   - t: The timestamp to describe
+//   - opts: Optional refresh interval, formatter, and clock
+//
+// Returns:
+//   - *bubbly.Computed[string]: The current label
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    lastSync := bubbly.NewRef(time.Now())
+//	    ctx.Expose("lastSync", composables.UseRelativeTime(ctx, lastSync))
+//	})
+//
+// Template usage:
+//
+//	label := ctx.Get("lastSync").(*bubbly.Computed[string])
+//	return "Synced " + label.GetTyped()
+//
+// Cleanup:
+//
+// The refresh timer stops when the component unmounts. Without a component
+// context (ctx is nil) the timer runs for the life of the program.
+func UseRelativeTime(ctx *bubbly.Context, t *bubbly.Ref[time.Time], opts ...RelativeTimeOption) *bubbly.Computed[string] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseRelativeTime", time.Since(start))
+	}()
+
+	// Apply options
+	config := &relativeTimeConfig{
+		interval: defaultRelativeTimeInterval,
+		format:   FormatRelativeTime,
+		clock:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	if t == nil {
+		t = bubbly.NewRef(time.Time{})
+	}
+
+	// now is refreshed by the interval, invalidating the label
+	now := bubbly.NewRef(config.clock())
+	UseInterval(ctx, func() {
+		now.Set(config.clock())
+	}, config.interval).Start()
+
+	return bubbly.NewComputed(func() string {
+		timestamp := t.GetTyped()
+		current := now.GetTyped()
+		if timestamp.IsZero() {
+			return ""
+		}
+		return config.format(current.Sub(timestamp))
+	})
+}
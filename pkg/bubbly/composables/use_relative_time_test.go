@@ -0,0 +1,97 @@
+package composables
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// fakeClock is a settable clock for UseRelativeTime tests.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestFormatRelativeTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		elapsed  time.Duration
+		expected string
+	}{
+		{"zero", 0, "just now"},
+		{"seconds", 59 * time.Second, "just now"},
+		{"minutes", 5*time.Minute + 30*time.Second, "5m ago"},
+		{"hours", 3 * time.Hour, "3h ago"},
+		{"days", 49 * time.Hour, "2d ago"},
+		{"months", 65 * 24 * time.Hour, "2mo ago"},
+		{"years", 400 * 24 * time.Hour, "1y ago"},
+		{"near future", -30 * time.Second, "just now"},
+		{"future", -5 * time.Minute, "in 5m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatRelativeTime(tt.elapsed))
+		})
+	}
+}
+
+func TestUseRelativeTime_TracksTimestamp(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	stamp := bubbly.NewRef(clock.Now().Add(-10 * time.Minute))
+
+	label := UseRelativeTime(nil, stamp, WithRelativeTimeClock(clock.Now))
+	assert.Equal(t, "10m ago", label.GetTyped())
+
+	stamp.Set(clock.Now())
+	assert.Equal(t, "just now", label.GetTyped())
+
+	stamp.Set(time.Time{})
+	assert.Equal(t, "", label.GetTyped(), "zero timestamp has no label")
+}
+
+func TestUseRelativeTime_RefreshesOnInterval(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	stamp := bubbly.NewRef(clock.Now())
+
+	label := UseRelativeTime(nil, stamp,
+		WithRelativeTimeClock(clock.Now),
+		WithRelativeTimeInterval(10*time.Millisecond),
+	)
+	assert.Equal(t, "just now", label.GetTyped())
+
+	clock.Advance(2 * time.Hour)
+	assert.Eventually(t, func() bool {
+		return label.GetTyped() == "2h ago"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestUseRelativeTime_CustomFormat(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+	stamp := bubbly.NewRef(clock.Now().Add(-3 * time.Minute))
+
+	label := UseRelativeTime(nil, stamp,
+		WithRelativeTimeClock(clock.Now),
+		WithRelativeTimeFormat(func(elapsed time.Duration) string {
+			return "vor " + elapsed.String()
+		}),
+	)
+
+	assert.Equal(t, "vor 3m0s", label.GetTyped())
+}
@@ -0,0 +1,212 @@
+package composables
+
+import (
+	"strings"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultSearchDelay is the default debounce delay of UseSearchableList.
+const DefaultSearchDelay = 150 * time.Millisecond
+
+// MatchFunc reports whether item matches query and, if so, which parts of
+// the item's displayed text matched, as [start, end) byte ranges. The query
+// passed is trimmed and never empty.
+type MatchFunc[T any] func(item T, query string) (ranges [][2]int, ok bool)
+
+// SearchMatch is an item that matched the search query.
+type SearchMatch[T any] struct {
+	// Item is the matching item.
+	Item T
+
+	// Index is the item's index in the source slice.
+	Index int
+
+	// Ranges are the matched [start, end) byte ranges of the item's
+	// displayed text. Empty when the query is empty.
+	Ranges [][2]int
+}
+
+// searchableListConfig holds configuration for UseSearchableList.
+type searchableListConfig struct {
+	delay time.Duration
+}
+
+// SearchableListOption configures UseSearchableList.
+type SearchableListOption func(*searchableListConfig)
+
+// WithSearchDelay sets how long the query must stay unchanged before the
+// list is filtered again. Zero filters on every keystroke.
+// Default: DefaultSearchDelay.
+//
+// Example:
+//
+//	search := UseSearchableList(ctx, items, match, WithSearchDelay(300*time.Millisecond))
+func WithSearchDelay(delay time.Duration) SearchableListOption {
+	return func(c *searchableListConfig) {
+		c.delay = delay
+	}
+}
+
+// SearchableListReturn is the return value of UseSearchableList.
+type SearchableListReturn[T any] struct {
+	// Query is the search text. Bind it to an Input's Value.
+	Query *bubbly.Ref[string]
+
+	// Matches are the matching items in source order with their match
+	// ranges. Updates after Query has been unchanged for the search delay.
+	Matches *bubbly.Ref[[]SearchMatch[T]]
+
+	// Items are the matching items, for a List's Items. Like Matches, it
+	// also updates when the source slice changes.
+	Items *bubbly.Ref[[]T]
+}
+
+// Highlights returns the match ranges of the item at index in Items. Its
+// signature fits ListProps.Highlights, so matches can be emphasized with:
+//
+//	Highlights: search.Highlights
+func (s *SearchableListReturn[T]) Highlights(_ T, index int) [][2]int {
+	matches := s.Matches.GetTyped()
+	if index < 0 || index >= len(matches) {
+		return nil
+	}
+	return matches[index].Ranges
+}
+
+// MatchSubstring returns a MatchFunc matching items whose text contains the
+// query, ignoring case. Every occurrence is returned as a range, so text
+// should return the text the list displays for the item.
+//
+// Example:
+//
+//	match := composables.MatchSubstring(func(p Product) string { return p.Name })
+func MatchSubstring[T any](text func(T) string) MatchFunc[T] {
+	return func(item T, query string) ([][2]int, bool) {
+		haystack := strings.ToLower(text(item))
+		needle := strings.ToLower(query)
+
+		var ranges [][2]int
+		for offset := 0; ; {
+			i := strings.Index(haystack[offset:], needle)
+			if i < 0 {
+				break
+			}
+			start := offset + i
+			ranges = append(ranges, [2]int{start, start + len(needle)})
+			offset = start + len(needle)
+		}
+		return ranges, len(ranges) > 0
+	}
+}
+
+// UseSearchableList filters a list as the user types a search query.
+//
+// Filtering is debounced: Matches and Items update once Query has been
+// unchanged for the search delay (see WithSearchDelay), so large lists stay
+// responsive while typing. An empty or whitespace-only query matches every
+// item. The source slice is never modified.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - items: The Ref holding the full, unfiltered slice
+//   - match: Decides whether an item matches and which text matched;
+//     see MatchSubstring
+//   - opts: Optional configuration
+//
+// Returns:
+//   - *SearchableListReturn[T]: The Query ref and the filtered results
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    products := bubbly.NewRef(allProducts)
+//	    search := composables.UseSearchableList(ctx, products,
+//	        composables.MatchSubstring(func(p Product) string { return p.Name }))
+//
+//	    input := components.Input(components.InputProps{Value: search.Query})
+//	    list := components.List(components.ListProps[Product]{
+//	        Items:      search.Items,
+//	        RenderItem: func(p Product, _ int) string { return p.Name },
+//	        Highlights: search.Highlights,
+//	    })
+//	})
+//
+// Cleanup:
+//
+// The watchers and debounce timer are stopped when the component unmounts.
+func UseSearchableList[T any](
+	ctx *bubbly.Context,
+	items *bubbly.Ref[[]T],
+	match MatchFunc[T],
+	opts ...SearchableListOption,
+) *SearchableListReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseSearchableList", time.Since(start))
+	}()
+
+	config := searchableListConfig{delay: DefaultSearchDelay}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if items == nil {
+		items = bubbly.NewRef[[]T](nil)
+	}
+
+	query := bubbly.NewRef("")
+	activeQuery := query
+	if config.delay > 0 {
+		activeQuery = UseDebounce(ctx, query, config.delay)
+	}
+
+	matches := bubbly.NewRef[[]SearchMatch[T]](nil)
+	results := bubbly.NewRef[[]T](nil)
+
+	refresh := func() {
+		found := searchList(items.GetTyped(), strings.TrimSpace(activeQuery.GetTyped()), match)
+		foundItems := make([]T, len(found))
+		for i, m := range found {
+			foundItems[i] = m.Item
+		}
+		matches.Set(found)
+		results.Set(foundItems)
+	}
+	refresh()
+
+	stopItems := bubbly.Watch(items, func(_, _ []T) { refresh() })
+	stopQuery := bubbly.Watch(activeQuery, func(_, _ string) { refresh() })
+
+	// Register cleanup on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(func() {
+			stopItems()
+			stopQuery()
+		})
+	}
+
+	return &SearchableListReturn[T]{
+		Query:   query,
+		Matches: matches,
+		Items:   results,
+	}
+}
+
+// searchList returns the items matching query in source order. An empty
+// query matches every item.
+func searchList[T any](source []T, query string, match MatchFunc[T]) []SearchMatch[T] {
+	result := make([]SearchMatch[T], 0, len(source))
+	for i, item := range source {
+		if query == "" || match == nil {
+			result = append(result, SearchMatch[T]{Item: item, Index: i})
+			continue
+		}
+		if ranges, ok := match(item, query); ok {
+			result = append(result, SearchMatch[T]{Item: item, Index: i, Ranges: ranges})
+		}
+	}
+	return result
+}
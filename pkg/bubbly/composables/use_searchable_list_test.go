@@ -0,0 +1,124 @@
+package composables
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+var searchFruits = []string{"Apple", "Banana", "Cherry", "Pineapple"}
+
+func matchFruit() MatchFunc[string] {
+	return MatchSubstring(func(s string) string { return s })
+}
+
+// TestMatchSubstring tests case-insensitive matching with every occurrence
+func TestMatchSubstring(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		query          string
+		expectedOK     bool
+		expectedRanges [][2]int
+	}{
+		{name: "prefix", text: "Apple", query: "ap", expectedOK: true, expectedRanges: [][2]int{{0, 2}}},
+		{name: "ignores case", text: "Pineapple", query: "APP", expectedOK: true, expectedRanges: [][2]int{{4, 7}}},
+		{name: "every occurrence", text: "Banana", query: "an", expectedOK: true, expectedRanges: [][2]int{{1, 3}, {3, 5}}},
+		{name: "no match", text: "Cherry", query: "x", expectedOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranges, ok := matchFruit()(tt.text, tt.query)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedRanges, ranges)
+		})
+	}
+}
+
+// TestUseSearchableList_Filtering tests filtering and match ranges
+func TestUseSearchableList_Filtering(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{name: "empty query matches all", query: "", expected: searchFruits},
+		{name: "whitespace query matches all", query: "  ", expected: searchFruits},
+		{name: "substring", query: "apple", expected: []string{"Apple", "Pineapple"}},
+		{name: "query is trimmed", query: " cherry ", expected: []string{"Cherry"}},
+		{name: "no matches", query: "kiwi", expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			search := UseSearchableList(createTestContext(), bubbly.NewRef(searchFruits), matchFruit(),
+				WithSearchDelay(0))
+
+			search.Query.Set(tt.query)
+
+			assert.Equal(t, tt.expected, search.Items.GetTyped())
+			assert.Len(t, search.Matches.GetTyped(), len(tt.expected))
+		})
+	}
+}
+
+// TestUseSearchableList_Matches tests source indices and highlight ranges
+func TestUseSearchableList_Matches(t *testing.T) {
+	search := UseSearchableList(createTestContext(), bubbly.NewRef(searchFruits), matchFruit(),
+		WithSearchDelay(0))
+
+	assert.Nil(t, search.Highlights("Apple", 0), "Empty query should highlight nothing")
+
+	search.Query.Set("apple")
+
+	matches := search.Matches.GetTyped()
+	assert.Equal(t, 0, matches[0].Index)
+	assert.Equal(t, 3, matches[1].Index)
+	assert.Equal(t, [][2]int{{0, 5}}, search.Highlights("Apple", 0))
+	assert.Equal(t, [][2]int{{4, 9}}, search.Highlights("Pineapple", 1))
+	assert.Nil(t, search.Highlights("", 5), "Out of range index should highlight nothing")
+}
+
+// TestUseSearchableList_Debounced tests the query is applied after the delay
+func TestUseSearchableList_Debounced(t *testing.T) {
+	search := UseSearchableList(createTestContext(), bubbly.NewRef(searchFruits), matchFruit(),
+		WithSearchDelay(20*time.Millisecond))
+
+	search.Query.Set("b")
+	search.Query.Set("ba")
+	assert.Equal(t, searchFruits, search.Items.GetTyped(), "Filtering should wait for the delay")
+
+	assert.Eventually(t, func() bool {
+		return len(search.Items.GetTyped()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"Banana"}, search.Items.GetTyped())
+}
+
+// TestUseSearchableList_SourceChanges tests results follow the source slice
+func TestUseSearchableList_SourceChanges(t *testing.T) {
+	items := bubbly.NewRef(searchFruits)
+	search := UseSearchableList(createTestContext(), items, matchFruit(), WithSearchDelay(0))
+	search.Query.Set("berry")
+	assert.Empty(t, search.Items.GetTyped())
+
+	items.Set(append([]string{"Blueberry"}, searchFruits...))
+
+	assert.Equal(t, []string{"Blueberry"}, search.Items.GetTyped())
+}
+
+// TestUseSearchableList_Unmount tests watchers stop on unmount
+func TestUseSearchableList_Unmount(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	items := bubbly.NewRef(searchFruits)
+	search := UseSearchableList(ctx, items, matchFruit(), WithSearchDelay(0))
+
+	bubbly.TriggerUnmount(ctx)
+	items.Set([]string{"Kiwi"})
+
+	assert.Equal(t, searchFruits, search.Items.GetTyped())
+}
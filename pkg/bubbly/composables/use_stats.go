@@ -0,0 +1,157 @@
+package composables
+
+import (
+	"sort"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// Number is the set of numeric types Sum can total.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// StatsReturn is the return value of UseStats.
+//
+// Thread Safety:
+// StatsReturn is safe for concurrent use.
+type StatsReturn[T any] struct {
+	// Values maps each aggregate name to its current value. It recomputes
+	// automatically when the source slice, or any Ref read inside a
+	// reducer, changes.
+	Values *bubbly.Computed[map[string]any]
+
+	names []string
+}
+
+// Get returns the current value of the named aggregate, or nil if there is
+// no reducer with that name.
+//
+// Example:
+//
+//	done := stats.Get("done").(int)
+func (s *StatsReturn[T]) Get(name string) any {
+	return s.Values.GetTyped()[name]
+}
+
+// Names returns the aggregate names in sorted order.
+func (s *StatsReturn[T]) Names() []string {
+	return append([]string(nil), s.names...)
+}
+
+// Stat returns the current value of the named aggregate as a V.
+// It returns V's zero value if the aggregate is missing or of another type.
+//
+// Example:
+//
+//	total := composables.Stat[float64](stats, "revenue")
+func Stat[V, T any](s *StatsReturn[T], name string) V {
+	v, _ := s.Get(name).(V)
+	return v
+}
+
+// Count returns a UseStats reducer counting the items for which pred
+// returns true. A nil pred counts all items. The aggregate is an int.
+//
+// Example:
+//
+//	"done": composables.Count(func(t Todo) bool { return t.Done })
+func Count[T any](pred func(T) bool) func([]T) any {
+	return func(items []T) any {
+		if pred == nil {
+			return len(items)
+		}
+		n := 0
+		for _, item := range items {
+			if pred(item) {
+				n++
+			}
+		}
+		return n
+	}
+}
+
+// Sum returns a UseStats reducer totalling extract over all items.
+// The aggregate has extract's result type N.
+//
+// Example:
+//
+//	"revenue": composables.Sum(func(o Order) float64 { return o.Total })
+func Sum[T any, N Number](extract func(T) N) func([]T) any {
+	return func(items []T) any {
+		var total N
+		for _, item := range items {
+			total += extract(item)
+		}
+		return total
+	}
+}
+
+// UseStats derives named aggregates (counts, totals, averages, ...) from a
+// slice Ref, replacing the per-app "completedCount"/"pendingCount" Computeds
+// commonly written by hand.
+//
+// Every reducer runs against the current slice whenever it changes, and the
+// results are published together in Values, so templates always see a
+// consistent set of aggregates. Reducers with a nil function are ignored.
+//
+// Parameters:
+//   - ctx: The component context (may be nil outside components)
+//   - items: The Ref holding the slice to aggregate
+//   - reducers: The aggregates to compute, by name
+//
+// Returns:
+//   - *StatsReturn[T]: The aggregates, with Values as the reactive map
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    todos := bubbly.NewRef([]Todo{})
+//
+//	    stats := composables.UseStats(ctx, todos, map[string]func([]Todo) any{
+//	        "total":   composables.Count[Todo](nil),
+//	        "done":    composables.Count(func(t Todo) bool { return t.Done }),
+//	        "pending": composables.Count(func(t Todo) bool { return !t.Done }),
+//	        "points":  composables.Sum(func(t Todo) int { return t.Points }),
+//	    })
+//
+//	    ctx.Expose("stats", stats.Values)
+//	})
+func UseStats[T any](ctx *bubbly.Context, items *bubbly.Ref[[]T], reducers map[string]func([]T) any) *StatsReturn[T] {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseStats", time.Since(start))
+	}()
+
+	if items == nil {
+		items = bubbly.NewRef[[]T](nil)
+	}
+
+	names := make([]string, 0, len(reducers))
+	fns := make(map[string]func([]T) any, len(reducers))
+	for name, reduce := range reducers {
+		if reduce == nil {
+			continue
+		}
+		names = append(names, name)
+		fns[name] = reduce
+	}
+	sort.Strings(names)
+
+	stats := &StatsReturn[T]{names: names}
+	stats.Values = bubbly.NewComputed(func() map[string]any {
+		current := items.GetTyped()
+		values := make(map[string]any, len(names))
+		for _, name := range names {
+			values[name] = fns[name](current)
+		}
+		return values
+	})
+
+	return stats
+}
@@ -0,0 +1,88 @@
+package composables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+type statsTodo struct {
+	Done   bool
+	Points int
+}
+
+func statsTestReducers() map[string]func([]statsTodo) any {
+	return map[string]func([]statsTodo) any{
+		"total":   Count[statsTodo](nil),
+		"done":    Count(func(t statsTodo) bool { return t.Done }),
+		"pending": Count(func(t statsTodo) bool { return !t.Done }),
+		"points":  Sum(func(t statsTodo) int { return t.Points }),
+		"ignored": nil,
+	}
+}
+
+// TestUseStats_Aggregates tests the built-in reducers over various inputs
+func TestUseStats_Aggregates(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []statsTodo
+		expected map[string]any
+	}{
+		{
+			name:     "empty",
+			items:    nil,
+			expected: map[string]any{"total": 0, "done": 0, "pending": 0, "points": 0},
+		},
+		{
+			name: "mixed",
+			items: []statsTodo{
+				{Done: true, Points: 3},
+				{Done: false, Points: 5},
+				{Done: true, Points: 1},
+			},
+			expected: map[string]any{"total": 3, "done": 2, "pending": 1, "points": 9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := UseStats(nil, bubbly.NewRef(tt.items), statsTestReducers())
+
+			assert.Equal(t, tt.expected, stats.Values.GetTyped())
+			assert.Equal(t, []string{"done", "pending", "points", "total"}, stats.Names())
+		})
+	}
+}
+
+// TestUseStats_Reactive tests that aggregates follow the source slice
+func TestUseStats_Reactive(t *testing.T) {
+	todos := bubbly.NewRef([]statsTodo{{Done: false, Points: 2}})
+	stats := UseStats(nil, todos, statsTestReducers())
+
+	assert.Equal(t, 0, Stat[int](stats, "done"))
+
+	todos.Set([]statsTodo{{Done: true, Points: 2}, {Done: false, Points: 4}})
+
+	assert.Equal(t, 1, Stat[int](stats, "done"))
+	assert.Equal(t, 6, stats.Get("points"))
+}
+
+// TestUseStats_Stat tests typed access
+func TestUseStats_Stat(t *testing.T) {
+	stats := UseStats(nil, bubbly.NewRef([]float64{1.5, 2.5}), map[string]func([]float64) any{
+		"sum": Sum(func(f float64) float64 { return f }),
+	})
+
+	assert.Equal(t, 4.0, Stat[float64](stats, "sum"))
+	assert.Equal(t, 0, Stat[int](stats, "sum"), "wrong type should return zero")
+	assert.Nil(t, stats.Get("missing"))
+	assert.Equal(t, 0.0, Stat[float64](stats, "missing"))
+}
+
+// TestUseStats_NilItems tests that a nil Ref is treated as an empty slice
+func TestUseStats_NilItems(t *testing.T) {
+	stats := UseStats(nil, nil, map[string]func([]int) any{"n": Count[int](nil)})
+	assert.Equal(t, 0, stats.Get("n"))
+}
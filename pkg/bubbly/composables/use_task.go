@@ -0,0 +1,207 @@
+package composables
+
+import (
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// TaskState is the state of a background task run by UseTask.
+type TaskState string
+
+const (
+	// TaskIdle means the task has not been run yet.
+	TaskIdle TaskState = "idle"
+
+	// TaskRunning means the task is running.
+	TaskRunning TaskState = "running"
+
+	// TaskDone means the last run finished successfully.
+	TaskDone TaskState = "done"
+
+	// TaskFailed means the last run returned an error; see TaskReturn.Error.
+	TaskFailed TaskState = "failed"
+)
+
+// TaskReportFunc reports the progress of a task: the fraction completed,
+// from 0 to 1 (clamped), and a status message such as "Downloading 3/10".
+type TaskReportFunc func(progress float64, status string)
+
+// TaskReturn is the return value of UseTask.
+type TaskReturn struct {
+	// State is the task's current state.
+	State *bubbly.Ref[TaskState]
+
+	// Progress is the last reported fraction completed, from 0 to 1.
+	// It is set to 1 when the task finishes successfully.
+	Progress *bubbly.Ref[float64]
+
+	// Status is the last reported status message.
+	Status *bubbly.Ref[string]
+
+	// Error holds the error of the last failed run, or nil.
+	Error *bubbly.Ref[error]
+
+	// Run starts the task in a goroutine, resetting Progress, Status, and
+	// Error. It is a no-op while the task is running, including from
+	// watchers notified of the final State.
+	Run func()
+}
+
+// UseTask runs a multi-stage background job and exposes its progress.
+//
+// fn runs in a goroutine each time Run is called. It calls report as it
+// makes progress; each call updates Progress and Status, so a progress bar
+// and status line bound to them re-render. When fn returns, State becomes
+// TaskDone or TaskFailed (with Error set, and the error reported via
+// observability).
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - fn: The job; report may be called from any goroutine
+//
+// Returns:
+//   - TaskReturn: State, Progress, Status, and Error refs plus Run
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    task := composables.UseTask(ctx, func(report composables.TaskReportFunc) error {
+//	        for i, file := range files {
+//	            report(float64(i)/float64(len(files)), "Copying "+file)
+//	            if err := copyFile(file); err != nil {
+//	                return err
+//	            }
+//	        }
+//	        return nil
+//	    })
+//	    task.Run()
+//
+//	    spinner := components.Spinner(components.SpinnerProps{Active: true})
+//	    _ = ctx.ExposeComponent("spinner", spinner)
+//	    ctx.Expose("task", task)
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    task := ctx.Get("task").(composables.TaskReturn)
+//	    if task.State.GetTyped() != composables.TaskRunning {
+//	        return "Done"
+//	    }
+//	    spinner := ctx.Get("spinner").(bubbly.Component)
+//	    return fmt.Sprintf("%s %3.0f%% %s", spinner.View(),
+//	        task.Progress.GetTyped()*100, task.Status.GetTyped())
+//	})
+//
+// Cleanup:
+//
+// fn cannot be stopped, but after the component unmounts its reports and
+// result no longer update the refs.
+func UseTask(ctx *bubbly.Context, fn func(report TaskReportFunc) error) TaskReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseTask", time.Since(start))
+	}()
+
+	state := bubbly.NewRef(TaskIdle)
+	progress := bubbly.NewRef(0.0)
+	status := bubbly.NewRef("")
+	errorRef := bubbly.NewRef[error](nil)
+
+	var (
+		mu        sync.Mutex
+		running   bool
+		unmounted bool
+	)
+
+	// active reports whether results should still be published
+	active := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return !unmounted
+	}
+
+	report := func(value float64, message string) {
+		if !active() {
+			return
+		}
+		progress.Set(max(0, min(1, value)))
+		status.Set(message)
+	}
+
+	run := func() {
+		mu.Lock()
+		if running || unmounted {
+			mu.Unlock()
+			return
+		}
+		running = true
+		mu.Unlock()
+
+		progress.Set(0)
+		status.Set("")
+		errorRef.Set(nil)
+		state.Set(TaskRunning)
+
+		go func() {
+			err := fn(report)
+
+			// Publish the result before allowing another run, so a new
+			// run's state is never overwritten by this one
+			defer func() {
+				mu.Lock()
+				running = false
+				mu.Unlock()
+			}()
+
+			if !active() {
+				return
+			}
+			if err != nil {
+				errorRef.Set(err)
+				state.Set(TaskFailed)
+				reportTaskError(err)
+				return
+			}
+			progress.Set(1)
+			state.Set(TaskDone)
+		}()
+	}
+
+	// Register cleanup on unmount
+	if ctx != nil {
+		ctx.OnUnmounted(func() {
+			mu.Lock()
+			unmounted = true
+			mu.Unlock()
+		})
+	}
+
+	return TaskReturn{
+		State:    state,
+		Progress: progress,
+		Status:   status,
+		Error:    errorRef,
+		Run:      run,
+	}
+}
+
+// reportTaskError reports a failed task to the observability system.
+func reportTaskError(err error) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+	reporter.ReportError(err, &observability.ErrorContext{
+		ComponentName: "UseTask",
+		EventName:     "run",
+		Timestamp:     time.Now(),
+		StackTrace:    debug.Stack(),
+		Tags: map[string]string{
+			"error_type": "task_failed",
+		},
+	})
+}
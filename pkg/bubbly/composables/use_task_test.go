@@ -0,0 +1,138 @@
+package composables
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// TestUseTask_Progress tests reported progress reaches the refs
+func TestUseTask_Progress(t *testing.T) {
+	step := make(chan struct{})
+	task := UseTask(createTestContext(), func(report TaskReportFunc) error {
+		report(0.5, "Halfway")
+		<-step
+		report(1.5, "Clamped")
+		<-step
+		return nil
+	})
+	assert.Equal(t, TaskIdle, task.State.GetTyped())
+
+	task.Run()
+
+	assert.Equal(t, TaskRunning, task.State.GetTyped())
+	require.Eventually(t, func() bool { return task.Status.GetTyped() == "Halfway" }, time.Second, time.Millisecond)
+	assert.Equal(t, 0.5, task.Progress.GetTyped())
+
+	step <- struct{}{}
+	require.Eventually(t, func() bool { return task.Status.GetTyped() == "Clamped" }, time.Second, time.Millisecond)
+	assert.Equal(t, 1.0, task.Progress.GetTyped(), "Progress should be clamped to 1")
+
+	step <- struct{}{}
+	require.Eventually(t, func() bool { return task.State.GetTyped() == TaskDone }, time.Second, time.Millisecond)
+	assert.Nil(t, task.Error.GetTyped())
+}
+
+// TestUseTask_Outcome tests the final state of a run
+func TestUseTask_Outcome(t *testing.T) {
+	errCopy := errors.New("copy failed")
+
+	tests := []struct {
+		name             string
+		err              error
+		expectedState    TaskState
+		expectedProgress float64
+	}{
+		{name: "success completes progress", err: nil, expectedState: TaskDone, expectedProgress: 1},
+		{name: "failure keeps progress", err: errCopy, expectedState: TaskFailed, expectedProgress: 0.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := UseTask(createTestContext(), func(report TaskReportFunc) error {
+				report(0.3, "Working")
+				return tt.err
+			})
+
+			task.Run()
+
+			require.Eventually(t, func() bool {
+				return task.State.GetTyped() == tt.expectedState
+			}, time.Second, time.Millisecond)
+			assert.Equal(t, tt.err, task.Error.GetTyped())
+			assert.Equal(t, tt.expectedProgress, task.Progress.GetTyped())
+		})
+	}
+}
+
+// TestUseTask_ErrorReported tests failures are reported via observability
+func TestUseTask_ErrorReported(t *testing.T) {
+	var reported atomic.Pointer[observability.ErrorContext]
+	observability.SetErrorReporter(&testErrorReporter{
+		onError: func(_ error, ctx *observability.ErrorContext) {
+			reported.Store(ctx)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	task := UseTask(createTestContext(), func(TaskReportFunc) error {
+		return errors.New("boom")
+	})
+	task.Run()
+
+	require.Eventually(t, func() bool { return reported.Load() != nil }, time.Second, time.Millisecond)
+	assert.Equal(t, "UseTask", reported.Load().ComponentName)
+	assert.Equal(t, "task_failed", reported.Load().Tags["error_type"])
+}
+
+// TestUseTask_SingleRun tests Run is a no-op while running and restarts after
+func TestUseTask_SingleRun(t *testing.T) {
+	release := make(chan struct{})
+	var runs atomic.Int32
+	task := UseTask(createTestContext(), func(report TaskReportFunc) error {
+		runs.Add(1)
+		<-release
+		return nil
+	})
+
+	task.Run()
+	task.Run()
+	close(release)
+	require.Eventually(t, func() bool { return task.State.GetTyped() == TaskDone }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), runs.Load())
+
+	require.Eventually(t, func() bool {
+		task.Run() // Accepted once the first run has fully finished
+		return runs.Load() == 2
+	}, time.Second, time.Millisecond)
+}
+
+// TestUseTask_Unmount tests results after unmount are ignored
+func TestUseTask_Unmount(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	task := UseTask(ctx, func(report TaskReportFunc) error {
+		<-release
+		report(0.9, "Late")
+		close(finished)
+		return nil
+	})
+
+	task.Run()
+	bubbly.TriggerUnmount(ctx)
+	close(release)
+	<-finished
+	time.Sleep(10 * time.Millisecond)
+
+	assert.Equal(t, TaskRunning, task.State.GetTyped())
+	assert.Equal(t, 0.0, task.Progress.GetTyped())
+	assert.Equal(t, "", task.Status.GetTyped())
+}
@@ -41,10 +41,18 @@ type TimeoutReturn struct {
 
 	// pending tracks if timer is active (internal, synced with IsPending ref)
 	pending bool
+
+	// generation identifies the current timer, so a timer that fires while
+	// being cancelled or restarted does nothing
+	generation int
+
+	// disposed is set when the component unmounts; Start is a no-op afterwards
+	disposed bool
 }
 
 // Start begins the timeout.
-// If the timeout is already pending, this is a no-op.
+// If the timeout is already pending, or the component has unmounted, this is
+// a no-op.
 // The callback will be executed after the duration elapses.
 // If the timeout has already expired, Start() will reset IsExpired and start a new timeout.
 //
@@ -58,25 +66,33 @@ func (t *TimeoutReturn) Start() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.pending {
-		return // Already pending
+	if t.pending || t.disposed {
+		return // Already pending, or the component is gone
 	}
+	t.schedule()
+}
 
+// schedule starts a new timer. The caller must hold t.mu.
+func (t *TimeoutReturn) schedule() {
 	t.pending = true
+	t.generation++
 	t.IsPending.Set(true)
 	t.IsExpired.Set(false)
 
-	// Capture callback to avoid race on t.callback
+	// Capture callback and generation to avoid races with later runs
 	callback := t.callback
+	generation := t.generation
 
 	t.timer = time.AfterFunc(t.duration, func() {
 		t.mu.Lock()
-		// Check if still pending (might have been canceled)
-		if !t.pending {
+		// Check this run is still pending (it might have been canceled or
+		// restarted while the timer fired)
+		if !t.pending || t.generation != generation {
 			t.mu.Unlock()
 			return
 		}
 		t.pending = false
+		t.timer = nil
 		t.mu.Unlock()
 
 		// Update refs outside lock to avoid deadlock with Watch
@@ -102,6 +118,11 @@ func (t *TimeoutReturn) Cancel() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	t.cancelLocked()
+}
+
+// cancelLocked cancels the pending timeout. Must be called with t.mu held.
+func (t *TimeoutReturn) cancelLocked() {
 	if !t.pending {
 		return // Not pending, nothing to cancel
 	}
@@ -115,9 +136,21 @@ func (t *TimeoutReturn) Cancel() {
 	}
 }
 
-// Reset cancels any pending timeout and starts a new one.
-// This is useful for implementing debounce-like behavior or
-// restarting a timeout from the beginning.
+// dispose cancels the timeout for good when the component unmounts.
+// Marking it disposed in the same critical section as cancelling it ensures
+// a concurrent Start or Reset cannot schedule a new timer in between.
+func (t *TimeoutReturn) dispose() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.disposed = true
+	t.cancelLocked()
+}
+
+// Reset cancels any pending timeout and starts a new one, atomically, so the
+// old timer cannot fire in between. This is useful for implementing
+// debounce-like behavior or restarting a timeout from the beginning.
+// After the component has unmounted, it is a no-op.
 //
 // Example:
 //
@@ -125,8 +158,17 @@ func (t *TimeoutReturn) Cancel() {
 //	// ... user does something ...
 //	timeout.Reset() // Restarts the timeout from the beginning
 func (t *TimeoutReturn) Reset() {
-	t.Cancel()
-	t.Start()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.disposed {
+		return
+	}
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.schedule()
 }
 
 // UseTimeout creates a delayed execution composable.
@@ -199,8 +241,10 @@ func (t *TimeoutReturn) Reset() {
 //
 // Cleanup:
 //
-// The timeout is automatically canceled when the component unmounts.
-// You can also manually cancel it by calling Cancel().
+// The timeout is automatically canceled when the component unmounts, and
+// cannot be started or reset afterwards, so no timer fires after unmount.
+// A callback that was already running when the component unmounted may
+// still be finishing. You can also manually cancel it by calling Cancel().
 func UseTimeout(ctx *bubbly.Context, callback func(), duration time.Duration) *TimeoutReturn {
 	// Record metrics if monitoring is enabled
 	start := time.Now()
@@ -223,9 +267,7 @@ func UseTimeout(ctx *bubbly.Context, callback func(), duration time.Duration) *T
 
 	// Register cleanup on unmount
 	if ctx != nil {
-		ctx.OnUnmounted(func() {
-			timeout.Cancel()
-		})
+		ctx.OnUnmounted(timeout.dispose)
 	}
 
 	return timeout
@@ -177,6 +177,69 @@ func TestUseTimeout_CleanupOnUnmount(t *testing.T) {
 	assert.False(t, timeout.IsPending.GetTyped(), "timeout should not be pending after unmount")
 }
 
+// TestUseTimeout_NoRestartAfterUnmount verifies the timeout cannot be
+// started or reset once the component has unmounted.
+func TestUseTimeout_NoRestartAfterUnmount(t *testing.T) {
+	var counter int32
+	ctx := bubbly.NewTestContext()
+	timeout := UseTimeout(ctx, func() {
+		atomic.AddInt32(&counter, 1)
+	}, 5*time.Millisecond)
+
+	timeout.Start()
+	bubbly.TriggerUnmount(ctx)
+	timeout.Start()
+	timeout.Reset()
+	time.Sleep(30 * time.Millisecond)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter), "callback should never run after unmount")
+	assert.False(t, timeout.IsPending.GetTyped())
+}
+
+// TestUseTimeout_UnmountRacingReset verifies a Reset running concurrently
+// with unmount never leaves a timer pending.
+func TestUseTimeout_UnmountRacingReset(t *testing.T) {
+	for n := 0; n < 100; n++ {
+		ctx := bubbly.NewTestContext()
+		timeout := UseTimeout(ctx, func() {}, time.Hour)
+		timeout.Start()
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			bubbly.TriggerUnmount(ctx)
+		}()
+		go func() {
+			defer wg.Done()
+			timeout.Reset()
+		}()
+		wg.Wait()
+
+		require.False(t, timeout.IsPending.GetTyped(), "timer pending after unmount (iteration %d)", n)
+	}
+}
+
+// TestUseTimeout_ResetDelaysExpiry verifies Reset restarts the clock, so
+// repeated resets keep the callback from firing.
+func TestUseTimeout_ResetDelaysExpiry(t *testing.T) {
+	var counter int32
+	timeout := UseTimeout(createTestContext(), func() {
+		atomic.AddInt32(&counter, 1)
+	}, 40*time.Millisecond)
+
+	timeout.Start()
+	for range 4 {
+		time.Sleep(20 * time.Millisecond)
+		timeout.Reset()
+	}
+	assert.Equal(t, int32(0), atomic.LoadInt32(&counter), "each Reset should restart the delay")
+
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&counter) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&counter), "the timeout should fire exactly once")
+}
+
 // TestUseTimeout_NegativeDuration_Panics verifies that negative duration causes panic.
 func TestUseTimeout_NegativeDuration_Panics(t *testing.T) {
 	ctx := createTestContext()
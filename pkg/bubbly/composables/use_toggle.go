@@ -10,6 +10,12 @@ import (
 // ToggleReturn is the return value of UseToggle.
 // It provides reactive boolean state management with convenient methods
 // for toggling, setting, and controlling the value.
+//
+// Thread Safety:
+// All methods are safe to call from any goroutine, including from watchers
+// of Value. No lock is held while Value changes, so a watcher may call back
+// into the toggle. Toggle reads and then sets Value, so toggles racing on
+// different goroutines can coalesce into one flip.
 type ToggleReturn struct {
 	// Value is the current boolean value.
 	Value *bubbly.Ref[bool]
@@ -49,7 +55,7 @@ func (t *ToggleReturn) Set(val bool) {
 //	toggle := composables.UseToggle(ctx, false)
 //	toggle.On() // Now true (regardless of previous value)
 func (t *ToggleReturn) On() {
-	t.Value.Set(true)
+	t.Set(true)
 }
 
 // Off sets value to false.
@@ -60,7 +66,26 @@ func (t *ToggleReturn) On() {
 //	toggle := composables.UseToggle(ctx, true)
 //	toggle.Off() // Now false (regardless of previous value)
 func (t *ToggleReturn) Off() {
-	t.Value.Set(false)
+	t.Set(false)
+}
+
+// SetTrue sets value to true; it is the same as On. Use it in handlers that
+// must force a state rather than flip it.
+//
+// Example:
+//
+//	ctx.On("edit", func(_ interface{}) { inputMode.SetTrue() })
+func (t *ToggleReturn) SetTrue() {
+	t.Set(true)
+}
+
+// SetFalse sets value to false; it is the same as Off.
+//
+// Example:
+//
+//	ctx.On("escape", func(_ interface{}) { inputMode.SetFalse() }) // ESC always exits
+func (t *ToggleReturn) SetFalse() {
+	t.Set(false)
 }
 
 // UseToggle creates a boolean toggle composable.
@@ -1,7 +1,9 @@
 package composables
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -221,3 +223,78 @@ func TestUseToggle_ValueIsReactive(t *testing.T) {
 	toggle.Off()
 	assert.Equal(t, 4, changeCount, "Off should trigger watcher")
 }
+
+// TestUseToggle_SetTrueSetFalse tests the explicit setters force a state
+func TestUseToggle_SetTrueSetFalse(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  bool
+		action   func(t *ToggleReturn)
+		expected bool
+	}{
+		{name: "SetTrue from false", initial: false, action: (*ToggleReturn).SetTrue, expected: true},
+		{name: "SetTrue from true", initial: true, action: (*ToggleReturn).SetTrue, expected: true},
+		{name: "SetFalse from true", initial: true, action: (*ToggleReturn).SetFalse, expected: false},
+		{name: "SetFalse from false", initial: false, action: (*ToggleReturn).SetFalse, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toggle := UseToggle(createTestContext(), tt.initial)
+
+			tt.action(toggle)
+
+			assert.Equal(t, tt.expected, toggle.Value.GetTyped())
+		})
+	}
+}
+
+// TestUseToggle_ConcurrentToggle tests that toggles from many goroutines
+// are race-free and finish
+func TestUseToggle_ConcurrentToggle(t *testing.T) {
+	toggle := UseToggle(createTestContext(), false)
+
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if i%10 == 0 {
+				toggle.SetFalse()
+			} else {
+				toggle.Toggle()
+			}
+		}()
+	}
+	wg.Wait()
+
+	_ = toggle.Value.GetTyped()
+}
+
+// TestUseToggle_WatcherReentersToggle tests that a watcher of Value can
+// call back into the toggle without deadlocking
+func TestUseToggle_WatcherReentersToggle(t *testing.T) {
+	toggle := UseToggle(createTestContext(), false)
+
+	// Turning the toggle on is immediately vetoed by the watcher
+	cleanup := bubbly.Watch(toggle.Value, func(newVal, _ bool) {
+		if newVal {
+			toggle.SetFalse()
+		}
+	})
+	defer cleanup()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		toggle.Toggle()
+		toggle.Set(true)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watcher calling SetFalse during Toggle deadlocked")
+	}
+	assert.False(t, toggle.Value.GetTyped())
+}
@@ -0,0 +1,222 @@
+package composables
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+	"github.com/newbpydev/bubblyui/pkg/bubbly/monitoring"
+)
+
+// DefaultFrameInterval is the default time between animation frames of
+// UseTransition (about 30 frames per second, plenty for a terminal).
+const DefaultFrameInterval = 33 * time.Millisecond
+
+// EasingFunc maps the linear progress of a transition, from 0 to 1, to the
+// eased progress. It must return 0 for 0 and 1 for 1.
+type EasingFunc func(t float64) float64
+
+// EaseLinear progresses at a constant rate.
+func EaseLinear(t float64) float64 {
+	return t
+}
+
+// EaseInQuad starts slowly and accelerates.
+func EaseInQuad(t float64) float64 {
+	return t * t
+}
+
+// EaseOutQuad starts quickly and decelerates.
+func EaseOutQuad(t float64) float64 {
+	return t * (2 - t)
+}
+
+// EaseInOutQuad accelerates until halfway, then decelerates.
+func EaseInOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return -1 + (4-2*t)*t
+}
+
+// EaseOutCubic decelerates more strongly than EaseOutQuad; it suits
+// progress bars catching up with their value.
+func EaseOutCubic(t float64) float64 {
+	t--
+	return t*t*t + 1
+}
+
+// transitionConfig holds configuration for UseTransition.
+type transitionConfig struct {
+	frameInterval time.Duration
+	onComplete    func(value float64)
+}
+
+// TransitionOption configures UseTransition.
+type TransitionOption func(*transitionConfig)
+
+// WithFrameInterval sets the time between animation frames.
+// Default: DefaultFrameInterval.
+func WithFrameInterval(interval time.Duration) TransitionOption {
+	return func(c *transitionConfig) {
+		if interval > 0 {
+			c.frameInterval = interval
+		}
+	}
+}
+
+// WithOnTransitionEnd sets a callback run when the value reaches its
+// target. Transitions interrupted by a new target do not call it.
+//
+// Example:
+//
+//	UseTransition(ctx, progress, time.Second, EaseOutCubic,
+//	    WithOnTransitionEnd(func(value float64) {
+//	        if value >= 1 {
+//	            ctx.Emit("loaded", nil)
+//	        }
+//	    }),
+//	)
+func WithOnTransitionEnd(fn func(value float64)) TransitionOption {
+	return func(c *transitionConfig) {
+		c.onComplete = fn
+	}
+}
+
+// TransitionReturn is the return value of UseTransition.
+type TransitionReturn struct {
+	// Value is the animated value. Render it instead of the target.
+	Value *bubbly.Computed[float64]
+
+	// IsAnimating reports whether a transition is in progress.
+	IsAnimating *bubbly.Ref[bool]
+}
+
+// UseTransition animates a value toward target instead of letting it jump.
+//
+// Whenever target changes, Value moves from its current value to the new
+// target over duration, following easing, updated every frame by
+// UseInterval. A target change during a transition starts a new one from
+// the value reached so far, so motion stays continuous.
+//
+// Parameters:
+//   - ctx: The component context (may be nil in tests)
+//   - target: The value to animate toward
+//   - duration: How long each transition takes; 0 or less jumps instantly
+//   - easing: The easing curve (e.g., EaseOutCubic); nil means EaseLinear
+//   - opts: Optional configuration (WithFrameInterval, WithOnTransitionEnd)
+//
+// Returns:
+//   - *TransitionReturn: The animated Value and the IsAnimating flag
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    progress := bubbly.NewRef(0.0)
+//	    smooth := composables.UseTransition(ctx, progress, 300*time.Millisecond,
+//	        composables.EaseOutCubic)
+//
+//	    ctx.Expose("progress", smooth.Value)
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    value := ctx.Get("progress").(*bubbly.Computed[float64]).GetTyped()
+//	    filled := int(value * 40)
+//	    return strings.Repeat("█", filled) + strings.Repeat("░", 40-filled)
+//	})
+//
+// Cleanup:
+//
+// The frame interval and target watcher are stopped when the component
+// unmounts.
+func UseTransition(
+	ctx *bubbly.Context,
+	target *bubbly.Ref[float64],
+	duration time.Duration,
+	easing EasingFunc,
+	opts ...TransitionOption,
+) *TransitionReturn {
+	// Record metrics if monitoring is enabled
+	start := time.Now()
+	defer func() {
+		monitoring.GetGlobalMetrics().RecordComposableCreation("UseTransition", time.Since(start))
+	}()
+
+	config := transitionConfig{frameInterval: DefaultFrameInterval}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if easing == nil {
+		easing = EaseLinear
+	}
+
+	current := bubbly.NewRef(target.GetTyped())
+	animating := bubbly.NewRef(false)
+
+	var (
+		mu         sync.Mutex
+		from, to   float64
+		began      time.Time
+		generation int // Incremented by every new transition
+	)
+
+	var frames *IntervalReturn
+	frames = UseInterval(ctx, func() {
+		mu.Lock()
+		run := generation
+		progress := float64(time.Since(began)) / float64(duration)
+		startValue, endValue := from, to
+		done := progress >= 1
+		if done {
+			frames.Stop()
+		}
+		mu.Unlock()
+
+		if !done {
+			current.Set(startValue + (endValue-startValue)*easing(progress))
+			return
+		}
+
+		current.Set(endValue)
+		animating.Set(false)
+
+		// A new target may have arrived while finishing
+		mu.Lock()
+		restarted := generation != run
+		mu.Unlock()
+		if restarted {
+			animating.Set(true)
+			return
+		}
+		if config.onComplete != nil {
+			config.onComplete(endValue)
+		}
+	}, config.frameInterval)
+
+	stopWatch := bubbly.Watch(target, func(value, _ float64) {
+		if duration <= 0 {
+			current.Set(value)
+			if config.onComplete != nil {
+				config.onComplete(value)
+			}
+			return
+		}
+
+		mu.Lock()
+		from, to, began = current.GetTyped(), value, time.Now()
+		generation++
+		frames.Start()
+		mu.Unlock()
+
+		animating.Set(true)
+	})
+
+	// Register cleanup on unmount (UseInterval stops the frames itself)
+	if ctx != nil {
+		ctx.OnUnmounted(stopWatch)
+	}
+
+	return &TransitionReturn{
+		Value:       bubbly.NewComputed(current.GetTyped),
+		IsAnimating: animating,
+	}
+}
@@ -0,0 +1,114 @@
+package composables
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestEasingFunctions tests the built-in easing curves
+func TestEasingFunctions(t *testing.T) {
+	tests := []struct {
+		name   string
+		easing EasingFunc
+		half   float64
+	}{
+		{name: "linear", easing: EaseLinear, half: 0.5},
+		{name: "in quad", easing: EaseInQuad, half: 0.25},
+		{name: "out quad", easing: EaseOutQuad, half: 0.75},
+		{name: "in out quad", easing: EaseInOutQuad, half: 0.5},
+		{name: "out cubic", easing: EaseOutCubic, half: 0.875},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, 0, tt.easing(0), 1e-9)
+			assert.InDelta(t, 1, tt.easing(1), 1e-9)
+			assert.InDelta(t, tt.half, tt.easing(0.5), 1e-9)
+		})
+	}
+}
+
+// TestUseTransition_Animates tests the value moves gradually to the target
+func TestUseTransition_Animates(t *testing.T) {
+	target := bubbly.NewRef(0.0)
+	var completed atomic.Value
+	transition := UseTransition(createTestContext(), target, 80*time.Millisecond, EaseLinear,
+		WithFrameInterval(5*time.Millisecond),
+		WithOnTransitionEnd(func(value float64) { completed.Store(value) }),
+	)
+	assert.Equal(t, 0.0, transition.Value.GetTyped())
+	assert.False(t, transition.IsAnimating.GetTyped())
+
+	target.Set(100)
+	assert.True(t, transition.IsAnimating.GetTyped())
+
+	require.Eventually(t, func() bool {
+		value := transition.Value.GetTyped()
+		return value > 0 && value < 100
+	}, time.Second, time.Millisecond, "Value should pass through intermediate values")
+
+	require.Eventually(t, func() bool {
+		return !transition.IsAnimating.GetTyped()
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 100.0, transition.Value.GetTyped())
+	assert.Equal(t, 100.0, completed.Load())
+}
+
+// TestUseTransition_Retarget tests a new target continues from the current value
+func TestUseTransition_Retarget(t *testing.T) {
+	target := bubbly.NewRef(0.0)
+	var completions atomic.Int32
+	transition := UseTransition(createTestContext(), target, 60*time.Millisecond, EaseOutQuad,
+		WithFrameInterval(5*time.Millisecond),
+		WithOnTransitionEnd(func(float64) { completions.Add(1) }),
+	)
+
+	target.Set(100)
+	require.Eventually(t, func() bool {
+		return transition.Value.GetTyped() > 10
+	}, time.Second, time.Millisecond)
+
+	target.Set(-50)
+	reversed := transition.Value.GetTyped()
+	assert.Greater(t, reversed, 10.0, "Retargeting should not jump back")
+
+	require.Eventually(t, func() bool {
+		return !transition.IsAnimating.GetTyped()
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, -50.0, transition.Value.GetTyped())
+	assert.Equal(t, int32(1), completions.Load(), "Interrupted transitions should not complete")
+}
+
+// TestUseTransition_ZeroDuration tests non-positive durations jump instantly
+func TestUseTransition_ZeroDuration(t *testing.T) {
+	target := bubbly.NewRef(1.0)
+	var completed float64
+	transition := UseTransition(createTestContext(), target, 0, nil,
+		WithOnTransitionEnd(func(value float64) { completed = value }))
+
+	target.Set(42)
+
+	assert.Equal(t, 42.0, transition.Value.GetTyped())
+	assert.Equal(t, 42.0, completed)
+	assert.False(t, transition.IsAnimating.GetTyped())
+}
+
+// TestUseTransition_Unmount tests animation stops when the component unmounts
+func TestUseTransition_Unmount(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	target := bubbly.NewRef(0.0)
+	transition := UseTransition(ctx, target, time.Hour, EaseLinear,
+		WithFrameInterval(time.Millisecond))
+
+	bubbly.TriggerUnmount(ctx)
+	target.Set(100)
+	time.Sleep(20 * time.Millisecond)
+
+	assert.Equal(t, 0.0, transition.Value.GetTyped())
+}
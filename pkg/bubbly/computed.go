@@ -3,7 +3,9 @@ package bubbly
 import (
 	"fmt"
 	"reflect"
+	"runtime/debug"
 	"sync"
+	"time"
 )
 
 // Computed is a type-safe computed value that lazily evaluates a function and caches the result.
@@ -43,6 +45,74 @@ type Computed[T any] struct {
 	deps       []Dependency
 	dependents []Dependency
 	watchers   []*watcher[T] // Task 6.2: Support watching computed values
+
+	// name identifies the Computed in diagnostics (see WithComputedName)
+	name string
+
+	// Debounced recomputation (see WithRecomputeDebounce)
+	debounce      time.Duration
+	debounceTimer *time.Timer
+	recomputing   *Ref[bool]
+
+	// equal, when set, makes invalidation eager: the value is recomputed
+	// immediately and dependents are only invalidated if it changed (see Select)
+	equal func(a, b T) bool
+
+	// Panic recovery (see WithComputedFallback)
+	hasFallback bool
+	fallback    T
+	err         error
+	owner       *componentImpl
+}
+
+// ComputedOption configures a Computed value created with NewComputed.
+type ComputedOption func(*computedConfig)
+
+// computedConfig holds the settings applied by ComputedOptions.
+type computedConfig struct {
+	name        string
+	debounce    time.Duration
+	hasFallback bool
+	fallback    any
+	owner       *componentImpl
+}
+
+// WithComputedName names a Computed for diagnostics. The name is used in
+// dependency cycle errors (see CycleError) and dependency graph labels
+// instead of the Computed's memory address.
+//
+// Example:
+//
+//	total := NewComputed(sumItems, WithComputedName("cart.total"))
+func WithComputedName(name string) ComputedOption {
+	return func(cfg *computedConfig) {
+		cfg.name = name
+	}
+}
+
+// WithRecomputeDebounce delays recomputation until the dependencies have
+// stopped changing for d.
+//
+// While changes are settling, the Computed keeps serving its stale cached
+// value and Recomputing() reports true. Once d passes without another
+// change, the cache is invalidated: the next Get recomputes, dependents are
+// invalidated, and watchers are notified. Use it for expensive derivations
+// driven by rapidly-changing inputs, such as filtering a large list while
+// the user types.
+//
+// The first evaluation is never delayed.
+//
+// Example:
+//
+//	filtered := NewComputed(func() []Item {
+//	    return filterItems(items.GetTyped(), query.GetTyped())
+//	}, WithRecomputeDebounce(150*time.Millisecond))
+func WithRecomputeDebounce(d time.Duration) ComputedOption {
+	return func(cfg *computedConfig) {
+		if d > 0 {
+			cfg.debounce = d
+		}
+	}
 }
 
 // NewComputed creates a new computed value with the given computation function.
@@ -67,16 +137,52 @@ type Computed[T any] struct {
 //	quadrupled := NewComputed(func() int {
 //	    return doubled.GetTyped() * 2
 //	})
-func NewComputed[T any](fn func() T) *Computed[T] {
+//
+//	// Debounced recomputation (see WithRecomputeDebounce)
+//	results := NewComputed(search, WithRecomputeDebounce(200*time.Millisecond))
+func NewComputed[T any](fn func() T, opts ...ComputedOption) *Computed[T] {
 	// Validate compute function is not nil
 	if fn == nil {
 		panic(ErrNilComputeFn)
 	}
 
-	return &Computed[T]{
-		fn:    fn,
-		dirty: true, // Starts dirty to trigger initial computation
+	var cfg computedConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	c := &Computed[T]{
+		fn:       fn,
+		dirty:    true, // Starts dirty to trigger initial computation
+		name:     cfg.name,
+		debounce: cfg.debounce,
+		owner:    cfg.owner,
+	}
+	if cfg.hasFallback {
+		c.hasFallback = true
+		c.fallback = computedFallbackValue[T](cfg.fallback)
+	}
+	return c
+}
+
+// debugName returns the name set with WithComputedName, if any.
+func (c *Computed[T]) debugName() string {
+	return c.name
+}
+
+// Recomputing returns a Ref that is true while a debounced recomputation is
+// pending, i.e., while GetTyped serves a stale value. Bind it to a spinner
+// or "updating..." hint.
+//
+// It is always false for Computed values without WithRecomputeDebounce.
+func (c *Computed[T]) Recomputing() *Ref[bool] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.recomputing == nil {
+		c.recomputing = NewRef(c.debounceTimer != nil)
 	}
+	return c.recomputing
 }
 
 // Get returns the current value as any, implementing the Dependency interface.
@@ -122,6 +228,10 @@ func (c *Computed[T]) Get() any {
 func (c *Computed[T]) GetTyped() T {
 	// Track this Computed as a dependency if tracking is active
 	if globalTracker.IsTracking() {
+		// A cyclic re-entry would deadlock on c.mu below; fail with the cycle path instead
+		if err := globalTracker.checkCycle(c); err != nil {
+			panic(err)
+		}
 		globalTracker.Track(c)
 	}
 
@@ -144,8 +254,24 @@ func (c *Computed[T]) GetTyped() T {
 		return val
 	}
 
+	oldValue, result, hasWatchers := c.recomputeLocked()
+
+	// Task 6.2: Notify watchers if value changed
+	// Only notify if there are watchers and value actually changed
+	// Use reflect.DeepEqual for comparison to handle all types correctly
+	if hasWatchers && !reflect.DeepEqual(oldValue, result) {
+		c.notifyChange(result, oldValue)
+	}
+
+	return result
+}
+
+// recomputeLocked re-evaluates fn and updates the cache and dependencies.
+// It must be called with c.mu held and releases it, returning the previous
+// cached value, the new one, and whether any watchers were registered.
+func (c *Computed[T]) recomputeLocked() (oldValue, result T, hasWatchers bool) {
 	// Store old value for watcher notification (Task 6.2)
-	oldValue := c.cache
+	oldValue = c.cache
 
 	// Begin tracking dependencies for this computed value
 	err := globalTracker.BeginTracking(c)
@@ -157,10 +283,7 @@ func (c *Computed[T]) GetTyped() T {
 	}
 
 	// Evaluate function (will track accessed Refs/Computed values)
-	result := c.fn()
-
-	// End tracking and get collected dependencies
-	deps := globalTracker.EndTracking()
+	result, deps, panicErr := c.evaluate()
 
 	// Register this computed value with its dependencies
 	for _, dep := range deps {
@@ -171,24 +294,68 @@ func (c *Computed[T]) GetTyped() T {
 	c.cache = result
 	c.dirty = false
 	c.deps = deps
+	c.err = nil
+	if panicErr != nil {
+		c.err = panicErr
+	}
 
 	// Check if we have watchers before unlocking
-	hasWatchers := len(c.watchers) > 0
+	hasWatchers = len(c.watchers) > 0
 	c.mu.Unlock()
 
-	// Task 6.2: Notify watchers if value changed
-	// Only notify if there are watchers and value actually changed
-	// Use reflect.DeepEqual for comparison to handle all types correctly
-	if hasWatchers && !reflect.DeepEqual(oldValue, result) {
-		// Task 8.7: Notify framework hook BEFORE notifying watchers
-		// This maintains proper cascade order for dev tools tracking
-		computedID := fmt.Sprintf("computed-%p", c)
-		notifyHookComputedChange(computedID, oldValue, result)
-
-		c.notifyWatchers(result, oldValue)
+	if panicErr != nil {
+		c.reportPanic(panicErr)
 	}
 
-	return result
+	return oldValue, result, hasWatchers
+}
+
+// notifyChange reports a value change to framework hooks and watchers.
+func (c *Computed[T]) notifyChange(newVal, oldVal T) {
+	// Task 8.7: Notify framework hook BEFORE notifying watchers
+	// This maintains proper cascade order for dev tools tracking
+	computedID := fmt.Sprintf("computed-%p", c)
+	notifyHookComputedChange(computedID, oldVal, newVal)
+
+	c.notifyWatchers(newVal, oldVal)
+}
+
+// evaluate runs fn while tracking its dependencies and returns the result
+// with the collected dependencies. Must be called with c.mu held and
+// tracking begun. If fn panics (e.g., on a dependency cycle), tracking is
+// ended and c.mu released before the panic propagates, so the Computed
+// stays usable.
+//
+// With WithComputedFallback, a panic in fn (other than a dependency cycle)
+// is recovered instead: the fallback is returned with the dependencies read
+// before the panic, so the Computed recovers once they change, and with the
+// panic as a *ComputedPanicError.
+func (c *Computed[T]) evaluate() (result T, deps []Dependency, panicErr *ComputedPanicError) {
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		if c.hasFallback {
+			if r := recover(); r != nil {
+				if isComputedRecoverable(r) {
+					result, deps = c.fallback, globalTracker.EndTracking()
+					panicErr = &ComputedPanicError{Name: c.name, PanicValue: r, stack: debug.Stack()}
+					return
+				}
+				globalTracker.EndTracking()
+				c.mu.Unlock()
+				panic(r)
+			}
+		}
+		globalTracker.EndTracking()
+		c.mu.Unlock()
+	}()
+
+	result = c.fn()
+	deps = globalTracker.EndTracking()
+	completed = true
+	return result, deps, nil
 }
 
 // Invalidate marks this computed value as dirty, requiring recomputation on next Get().
@@ -199,23 +366,110 @@ func (c *Computed[T]) GetTyped() T {
 // to notify watchers of the value change. This ensures watchers are notified even if
 // no one explicitly calls Get() after invalidation.
 func (c *Computed[T]) Invalidate() {
+	if c.scheduleDebouncedInvalidate() {
+		return
+	}
+	c.invalidateNow()
+}
+
+// scheduleDebouncedInvalidate defers invalidation when WithRecomputeDebounce
+// is set and a cached value exists, restarting the debounce window on every
+// call. It reports whether invalidation was deferred.
+func (c *Computed[T]) scheduleDebouncedInvalidate() bool {
+	c.mu.Lock()
+	if c.debounce <= 0 || c.dirty {
+		c.mu.Unlock()
+		return false
+	}
+
+	started := c.debounceTimer == nil
+	if started {
+		c.debounceTimer = time.AfterFunc(c.debounce, c.flushDebouncedInvalidate)
+	} else {
+		c.debounceTimer.Reset(c.debounce)
+	}
+	recomputing := c.recomputing
+	c.mu.Unlock()
+
+	if started && recomputing != nil {
+		recomputing.Set(true)
+	}
+	return true
+}
+
+// flushDebouncedInvalidate runs when the debounce window settles.
+func (c *Computed[T]) flushDebouncedInvalidate() {
+	c.mu.Lock()
+	c.debounceTimer = nil
+	recomputing := c.recomputing
+	c.mu.Unlock()
+
+	c.invalidateNow()
+	if recomputing != nil {
+		recomputing.Set(false)
+	}
+}
+
+// invalidateNow marks the cache dirty and propagates the invalidation.
+func (c *Computed[T]) invalidateNow() {
+	if c.equal != nil && c.recheck() {
+		return
+	}
+
 	c.mu.Lock()
 	c.dirty = true
 	hasWatchers := len(c.watchers) > 0
+	c.mu.Unlock()
+
+	c.invalidateDependents()
+
+	// Task 6.2: If we have watchers, trigger recomputation to notify them
+	// This ensures watchers are called even if no one explicitly calls Get()
+	// Inside Batch, recompute once when the batch ends
+	if hasWatchers {
+		deferred := inBatch() && deferInBatch(c, struct{}{}, struct{}{}, func(_, _ struct{}) {
+			c.GetTyped()
+		})
+		if !deferred {
+			c.GetTyped()
+		}
+	}
+}
+
+// invalidateDependents invalidates everything that depends on c.
+func (c *Computed[T]) invalidateDependents() {
+	c.mu.RLock()
 	deps := make([]Dependency, len(c.dependents))
 	copy(deps, c.dependents)
-	c.mu.Unlock()
+	c.mu.RUnlock()
 
 	// Invalidate all dependents outside the lock
 	for _, dep := range deps {
 		dep.Invalidate()
 	}
+}
 
-	// Task 6.2: If we have watchers, trigger recomputation to notify them
-	// This ensures watchers are called even if no one explicitly calls Get()
+// recheck handles invalidation for a Computed with an equality check: it
+// recomputes the cached value right away and only propagates the
+// invalidation if the value changed. It reports false when there is no
+// cached value to compare against, leaving invalidation to the caller.
+func (c *Computed[T]) recheck() bool {
+	c.mu.Lock()
+	if c.dirty {
+		c.mu.Unlock()
+		return false
+	}
+
+	oldValue, result, hasWatchers := c.recomputeLocked()
+	if c.equal(oldValue, result) {
+		return true
+	}
+
+	c.invalidateDependents()
 	if hasWatchers {
-		c.GetTyped()
+		c.notifyChange(result, oldValue)
 	}
+	return true
 }
 
 // AddDependent registers another computed value that depends on this one.
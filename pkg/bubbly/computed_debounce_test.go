@@ -0,0 +1,64 @@
+package bubbly
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestComputed_WithRecomputeDebounce tests that recomputation waits for changes to settle
+func TestComputed_WithRecomputeDebounce(t *testing.T) {
+	query := NewRef("")
+	var runs atomic.Int32
+	filtered := NewComputed(func() string {
+		runs.Add(1)
+		return "results:" + query.GetTyped()
+	}, WithRecomputeDebounce(30*time.Millisecond))
+	recomputing := filtered.Recomputing()
+
+	assert.Equal(t, "results:", filtered.GetTyped(), "first evaluation is immediate")
+	assert.False(t, recomputing.GetTyped())
+
+	for _, q := range []string{"g", "go", "gop"} {
+		query.Set(q)
+		assert.Equal(t, "results:", filtered.GetTyped(), "serves stale value while typing")
+	}
+	assert.True(t, recomputing.GetTyped())
+	assert.Equal(t, int32(1), runs.Load())
+
+	require.Eventually(t, func() bool { return !recomputing.GetTyped() }, time.Second, time.Millisecond)
+	assert.Equal(t, "results:gop", filtered.GetTyped())
+	assert.Equal(t, int32(2), runs.Load(), "recomputed once for the burst")
+}
+
+// TestComputed_WithRecomputeDebounce_NotifiesDependents tests propagation after settling
+func TestComputed_WithRecomputeDebounce_NotifiesDependents(t *testing.T) {
+	count := NewRef(1)
+	doubled := NewComputed(func() int { return count.GetTyped() * 2 }, WithRecomputeDebounce(20*time.Millisecond))
+	label := NewComputed(func() int { return doubled.GetTyped() + 1 })
+
+	var seen atomic.Int32
+	cleanup := Watch(doubled, func(newVal, _ int) { seen.Store(int32(newVal)) })
+	defer cleanup()
+
+	assert.Equal(t, 3, label.GetTyped())
+	count.Set(5)
+	assert.Equal(t, 3, label.GetTyped(), "dependents are not invalidated until settled")
+
+	require.Eventually(t, func() bool { return seen.Load() == 10 }, time.Second, time.Millisecond)
+	assert.Equal(t, 11, label.GetTyped())
+}
+
+// TestComputed_Recomputing_WithoutDebounce tests the default behavior is unchanged
+func TestComputed_Recomputing_WithoutDebounce(t *testing.T) {
+	count := NewRef(1)
+	doubled := NewComputed(func() int { return count.GetTyped() * 2 }, WithRecomputeDebounce(0))
+
+	assert.Equal(t, 2, doubled.GetTyped())
+	count.Set(2)
+	assert.Equal(t, 4, doubled.GetTyped())
+	assert.False(t, doubled.Recomputing().GetTyped())
+}
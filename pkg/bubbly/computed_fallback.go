@@ -0,0 +1,132 @@
+package bubbly
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// ComputedPanicError is the error held by a Computed created with
+// WithComputedFallback whose function panicked (see Computed.Err).
+type ComputedPanicError struct {
+	// Name is the Computed's WithComputedName name, if any
+	Name string
+	// PanicValue is the value passed to panic()
+	PanicValue interface{}
+
+	stack []byte
+}
+
+// Error implements the error interface for ComputedPanicError.
+func (e *ComputedPanicError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("panic in computed value: %v", e.PanicValue)
+	}
+	return fmt.Sprintf("panic in computed value '%s': %v", e.Name, e.PanicValue)
+}
+
+// WithComputedFallback makes a Computed recover when its function panics
+// (e.g., on a bad type assertion), so one broken derived value degrades
+// instead of crashing the whole TUI.
+//
+// When the function panics, the panic is reported through the
+// observability system, the Computed's value becomes fallback, and Err
+// returns a *ComputedPanicError until an evaluation succeeds. Dependencies
+// read before the panic are still tracked, so a change to them triggers a
+// new attempt.
+//
+// Dependency cycles are programming errors and still panic.
+//
+// The type of fallback must match the Computed's type (a nil fallback
+// means the zero value); NewComputed panics otherwise.
+//
+// Example:
+//
+//	label := bubbly.NewComputed(func() string {
+//	    return data.GetTyped().(map[string]any)["label"].(string)
+//	}, bubbly.WithComputedFallback("—"))
+//
+//	// In the template
+//	if label.Err() != nil {
+//	    return "label unavailable"
+//	}
+func WithComputedFallback[T any](fallback T) ComputedOption {
+	return func(cfg *computedConfig) {
+		cfg.hasFallback = true
+		cfg.fallback = fallback
+	}
+}
+
+// Err returns the error of the Computed's latest evaluation: a
+// *ComputedPanicError if its function panicked and WithComputedFallback
+// recovered it, nil otherwise. Like GetTyped, it evaluates the Computed
+// first if its dependencies changed, so the error is current.
+func (c *Computed[T]) Err() error {
+	c.GetTyped()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.err
+}
+
+// computedFallbackValue converts the fallback given to WithComputedFallback
+// to the Computed's type.
+func computedFallbackValue[T any](fallback any) T {
+	var zero T
+	if fallback == nil {
+		return zero
+	}
+	value, ok := fallback.(T)
+	if !ok {
+		panic(fmt.Sprintf("bubbly: WithComputedFallback value of type %T used with Computed[%T]", fallback, zero))
+	}
+	return value
+}
+
+// isComputedRecoverable reports whether a panic raised while evaluating a
+// Computed may be replaced by its fallback. Dependency cycle and depth
+// errors are not, since they are programming errors.
+func isComputedRecoverable(r interface{}) bool {
+	err, ok := r.(error)
+	if !ok {
+		return true
+	}
+	return !errors.Is(err, ErrCircularDependency) && !errors.Is(err, ErrMaxDepthExceeded)
+}
+
+// reportPanic reports a recovered panic to the observability system, with
+// the owning component's context when the Computed was created by
+// Context.Computed.
+func (c *Computed[T]) reportPanic(err *ComputedPanicError) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+
+	componentName, componentID := "Computed", ""
+	if c.owner != nil {
+		componentName, componentID = c.owner.name, c.owner.id
+	}
+	name := c.name
+	if name == "" {
+		name = fmt.Sprintf("computed-%p", c)
+	}
+
+	reporter.ReportPanic(&observability.HandlerPanicError{
+		ComponentName: componentName,
+		EventName:     "computed:" + name,
+		PanicValue:    err.PanicValue,
+	}, &observability.ErrorContext{
+		ComponentName: componentName,
+		ComponentID:   componentID,
+		EventName:     "computed:" + name,
+		Timestamp:     time.Now(),
+		StackTrace:    err.stack,
+		Tags: map[string]string{
+			"error_type": "computed_panic",
+			"computed":   name,
+		},
+	})
+}
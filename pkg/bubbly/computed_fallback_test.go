@@ -0,0 +1,109 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+func TestWithComputedFallback_RecoversPanic(t *testing.T) {
+	data := NewRef[any](42)
+	label := NewComputed(func() string {
+		return data.GetTyped().(string)
+	}, WithComputedFallback("n/a"), WithComputedName("label"))
+
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "n/a", label.GetTyped())
+	})
+
+	var panicErr *ComputedPanicError
+	require.ErrorAs(t, label.Err(), &panicErr)
+	assert.Equal(t, "label", panicErr.Name)
+	assert.Contains(t, panicErr.Error(), "panic in computed value 'label'")
+
+	// The dependency read before the panic is tracked, so fixing it recovers
+	data.Set("ready")
+	assert.Equal(t, "ready", label.GetTyped())
+	assert.NoError(t, label.Err())
+}
+
+func TestWithComputedFallback_NotifiesWatchers(t *testing.T) {
+	divisor := NewRef(2)
+	ratio := NewComputed(func() int {
+		return 10 / divisor.GetTyped()
+	}, WithComputedFallback(-1))
+
+	var values []int
+	defer Watch(ratio, func(newVal, _ int) { values = append(values, newVal) })()
+	assert.Equal(t, 5, ratio.GetTyped())
+
+	divisor.Set(0)
+	divisor.Set(5)
+
+	assert.Equal(t, []int{-1, 2}, values)
+}
+
+func TestWithComputedFallback_Reports(t *testing.T) {
+	var reports []*observability.ErrorContext
+	var panics []*observability.HandlerPanicError
+	observability.SetErrorReporter(&mockErrorReporter{
+		reportPanicFn: func(err *observability.HandlerPanicError, ctx *observability.ErrorContext) {
+			panics = append(panics, err)
+			reports = append(reports, ctx)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	component := newComponentImpl("Dashboard")
+	ctx := &Context{component: component}
+	total := ctx.Computed(func() interface{} {
+		panic("bad data")
+	}, WithComputedFallback[interface{}](0), WithComputedName("total"))
+
+	assert.Equal(t, 0, total.GetTyped())
+	require.Len(t, reports, 1)
+	assert.Equal(t, "Dashboard", reports[0].ComponentName)
+	assert.Equal(t, component.id, reports[0].ComponentID)
+	assert.Equal(t, "computed:total", reports[0].EventName)
+	assert.Equal(t, "computed_panic", reports[0].Tags["error_type"])
+	assert.NotEmpty(t, reports[0].StackTrace)
+	assert.Equal(t, "bad data", panics[0].PanicValue)
+
+	// The cached fallback is not re-evaluated or re-reported
+	total.GetTyped()
+	assert.Len(t, reports, 1)
+}
+
+func TestWithComputedFallback_CyclesStillPanic(t *testing.T) {
+	var a, b *Computed[int]
+	a = NewComputed(func() int { return b.GetTyped() + 1 },
+		WithComputedFallback(0), WithComputedName("a"))
+	b = NewComputed(func() int { return a.GetTyped() + 1 },
+		WithComputedFallback(0), WithComputedName("b"))
+
+	defer func() {
+		err, ok := recover().(error)
+		require.True(t, ok)
+		assert.ErrorIs(t, err, ErrCircularDependency)
+	}()
+	a.GetTyped()
+}
+
+func TestComputed_PanicsWithoutFallback(t *testing.T) {
+	c := NewComputed(func() int { panic("boom") })
+
+	assert.PanicsWithValue(t, "boom", func() { c.GetTyped() })
+}
+
+func TestWithComputedFallback_Values(t *testing.T) {
+	assert.Panics(t, func() {
+		NewComputed(func() int { return 1 }, WithComputedFallback("wrong type"))
+	})
+
+	c := NewComputed(func() *int { panic("boom") }, WithComputedFallback[*int](nil))
+	assert.Nil(t, c.GetTyped())
+	assert.Error(t, c.Err())
+}
@@ -220,8 +220,14 @@ func (ctx *Context) Ref(value interface{}) *Ref[interface{}] {
 //	doubled := ctx.Computed(func() interface{} {
 //	    return count.GetTyped().(int) * 2
 //	})
-func (ctx *Context) Computed(fn func() interface{}) *Computed[interface{}] {
-	return NewComputed(fn)
+//
+// Options are applied as with NewComputed. Panics recovered with
+// WithComputedFallback are reported with this component's name and ID.
+func (ctx *Context) Computed(fn func() interface{}, opts ...ComputedOption) *Computed[interface{}] {
+	opts = append(opts, func(cfg *computedConfig) {
+		cfg.owner = ctx.component
+	})
+	return NewComputed(fn, opts...)
 }
 
 // Watch registers a callback that is called whenever the given Ref changes.
@@ -464,6 +470,40 @@ func (ctx *Context) OnUpdated(hook func(), deps ...Dependency) {
 	ctx.component.lifecycle.registerHook("updated", h)
 }
 
+// OnUpdatedValues is like OnUpdated with dependencies, but the hook receives
+// the dependency values from before and after the change, in the order the
+// dependencies were given. Use it for work that depends on what changed,
+// such as scrolling to an item that was just appended.
+//
+// Example:
+//
+//	items := ctx.Ref([]string{})
+//	ctx.OnUpdatedValues(func(prev, next []any) {
+//	    if len(next[0].([]string)) > len(prev[0].([]string)) {
+//	        list.ScrollToBottom()
+//	    }
+//	}, items)
+func (ctx *Context) OnUpdatedValues(hook func(prev, next []any), deps ...Dependency) {
+	if ctx.component.lifecycle == nil {
+		ctx.component.lifecycle = newLifecycleManager(ctx.component)
+	}
+
+	// Generate unique ID for this hook
+	id := hookIDCounter.Add(1)
+
+	// Get current number of hooks for order
+	order := len(ctx.component.lifecycle.hooks["updated"])
+
+	// Register the hook with the initial dependency values
+	ctx.component.lifecycle.registerHook("updated", lifecycleHook{
+		id:             fmt.Sprintf("hook-%d", id),
+		valuesCallback: hook,
+		dependencies:   deps,
+		lastValues:     dependencyValues(deps),
+		order:          order,
+	})
+}
+
 // OnUnmounted registers a hook that executes when the component is unmounted.
 // This is the place to perform cleanup operations.
 //
@@ -492,8 +532,9 @@ func (ctx *Context) OnUnmounted(hook func()) {
 	})
 }
 
-// OnBeforeUpdate registers a hook that executes before the component updates.
-// This is optional and used for advanced use cases.
+// OnBeforeUpdate registers a hook that executes at the start of each Update,
+// before the message is handled. Like OnUpdated, it does not run before the
+// component is mounted, and a panic in the hook is recovered and reported.
 //
 // Example:
 //
@@ -546,6 +587,62 @@ func (ctx *Context) OnBeforeUnmount(hook func()) {
 	})
 }
 
+// OnActivated registers a hook that executes each time a KeepAlive shows
+// the component, including the first time. Hooks also run for the
+// component's descendants.
+//
+// Example:
+//
+//	ctx.OnActivated(func() {
+//	    ticker.Reset(time.Second) // Resume work paused while hidden
+//	})
+func (ctx *Context) OnActivated(hook func()) {
+	if ctx.component.lifecycle == nil {
+		ctx.component.lifecycle = newLifecycleManager(ctx.component)
+	}
+
+	// Generate unique ID for this hook
+	id := hookIDCounter.Add(1)
+
+	// Get current number of hooks for order
+	order := len(ctx.component.lifecycle.hooks["activated"])
+
+	// Register the hook
+	ctx.component.lifecycle.registerHook("activated", lifecycleHook{
+		id:       fmt.Sprintf("hook-%d", id),
+		callback: hook,
+		order:    order,
+	})
+}
+
+// OnDeactivated registers a hook that executes each time a KeepAlive hides
+// the component while keeping its state. The component stays mounted, so
+// OnUnmounted does not run. Hooks also run for the component's descendants.
+//
+// Example:
+//
+//	ctx.OnDeactivated(func() {
+//	    ticker.Stop() // No need to tick while hidden
+//	})
+func (ctx *Context) OnDeactivated(hook func()) {
+	if ctx.component.lifecycle == nil {
+		ctx.component.lifecycle = newLifecycleManager(ctx.component)
+	}
+
+	// Generate unique ID for this hook
+	id := hookIDCounter.Add(1)
+
+	// Get current number of hooks for order
+	order := len(ctx.component.lifecycle.hooks["deactivated"])
+
+	// Register the hook
+	ctx.component.lifecycle.registerHook("deactivated", lifecycleHook{
+		id:       fmt.Sprintf("hook-%d", id),
+		callback: hook,
+		order:    order,
+	})
+}
+
 // OnCleanup registers a cleanup function that executes when the component unmounts.
 // Cleanup functions are executed in reverse order (LIFO).
 //
@@ -918,6 +1015,13 @@ func (ctx *Context) ExposeComponent(name string, comp Component) error {
 		return fmt.Errorf("cannot expose nil component")
 	}
 
+	// CRITICAL FIX 2: Establish parent-child relationship
+	// This enables DevTools to build accurate component tree. It happens
+	// before Init() so the child's Setup can Inject values this component provides.
+	if err := ctx.component.AddChild(comp); err != nil {
+		return fmt.Errorf("failed to add child component: %w", err)
+	}
+
 	// Auto-initialize if not already initialized
 	if !comp.IsInitialized() {
 		cmd := comp.Init()
@@ -928,13 +1032,28 @@ func (ctx *Context) ExposeComponent(name string, comp Component) error {
 		}
 	}
 
-	// CRITICAL FIX 2: Establish parent-child relationship
-	// This enables DevTools to build accurate component tree
-	if err := ctx.component.AddChild(comp); err != nil {
-		return fmt.Errorf("failed to add child component: %w", err)
-	}
-
 	// Expose to context using existing Expose method
 	ctx.Expose(name, comp)
 	return nil
 }
+
+// RemoveComponent detaches a child component previously added with
+// ExposeComponent, so it no longer receives messages from this component.
+// It is the counterpart used when swapping children at runtime (for example,
+// when a router changes screens).
+//
+// The child is not unmounted; call its Unmount method first if its cleanup
+// should run. Any state key the child was exposed under is left unchanged.
+//
+// Returns an error if comp is nil or is not a child of this component.
+//
+// Example:
+//
+//	ctx.On("swap", func(_ interface{}) {
+//	    _ = ctx.RemoveComponent(current)
+//	    current = CreateDetails()
+//	    _ = ctx.ExposeComponent("content", current)
+//	})
+func (ctx *Context) RemoveComponent(comp Component) error {
+	return ctx.component.RemoveChild(comp)
+}
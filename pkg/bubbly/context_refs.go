@@ -0,0 +1,114 @@
+package bubbly
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RefSet is a group of Refs created and exposed together by Context.Refs.
+//
+// Each Ref is a *Ref[interface{}] created with ctx.Ref, so it gets the same
+// template safety checks and auto-command integration as Refs created one by
+// one. Use RefSetGet and RefSetSet for typed access.
+//
+// RefSet is read-only after creation and safe for concurrent use.
+type RefSet struct {
+	refs map[string]*Ref[interface{}]
+}
+
+// Refs creates a Ref for each entry of initial and exposes it under its key,
+// replacing the usual create-then-expose pair for every piece of state.
+//
+// Refs are created in key order, so creation is deterministic. The returned
+// RefSet gives setup code access to the created Refs; templates read them
+// with ctx.Get as usual.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    state := ctx.Refs(map[string]interface{}{
+//	        "activeTab":    0,
+//	        "inputMode":    false,
+//	        "textValue":    "Hello World",
+//	        "modalVisible": false,
+//	    })
+//
+//	    ctx.On("nextTab", func(_ interface{}) {
+//	        bubbly.RefSetSet(state, "activeTab", bubbly.RefSetGet[int](state, "activeTab")+1)
+//	    })
+//	})
+func (ctx *Context) Refs(initial map[string]interface{}) *RefSet {
+	keys := make([]string, 0, len(initial))
+	for key := range initial {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	set := &RefSet{refs: make(map[string]*Ref[interface{}], len(initial))}
+	for _, key := range keys {
+		ref := ctx.Ref(initial[key])
+		ctx.Expose(key, ref)
+		set.refs[key] = ref
+	}
+	return set
+}
+
+// Get returns the Ref created for key, or nil if the set has no such key.
+func (s *RefSet) Get(key string) *Ref[interface{}] {
+	return s.refs[key]
+}
+
+// Keys returns the keys of the set in sorted order.
+func (s *RefSet) Keys() []string {
+	keys := make([]string, 0, len(s.refs))
+	for key := range s.refs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RefSetGet returns the current value of the Ref stored under key as a T.
+//
+// A nil value is returned as T's zero value. RefSetGet panics if the set
+// has no such key or the value is not a T; the message names the key and
+// both types.
+//
+// Example:
+//
+//	tab := bubbly.RefSetGet[int](state, "activeTab")
+func RefSetGet[T any](s *RefSet, key string) T {
+	value := s.mustRef(key).Get()
+	if value == nil {
+		var zero T
+		return zero
+	}
+	typed, ok := value.(T)
+	if !ok {
+		var zero T
+		panic(fmt.Sprintf("RefSet key %q holds %T, not %T", key, value, zero))
+	}
+	return typed
+}
+
+// RefSetSet sets the Ref stored under key to value, triggering watchers and
+// dependents like Ref.Set. It panics if the set has no such key.
+//
+// The type parameter documents and checks the value at the call site;
+// combine it with RefSetGet to keep reads and writes of a key consistent.
+//
+// Example:
+//
+//	bubbly.RefSetSet(state, "modalVisible", true)
+func RefSetSet[T any](s *RefSet, key string, value T) {
+	s.mustRef(key).Set(value)
+}
+
+// mustRef returns the Ref for key, panicking if it is missing.
+func (s *RefSet) mustRef(key string) *Ref[interface{}] {
+	ref, ok := s.refs[key]
+	if !ok {
+		panic(fmt.Sprintf("RefSet has no key %q", key))
+	}
+	return ref
+}
@@ -0,0 +1,106 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestContext_Refs tests that Context.Refs creates and exposes every entry
+func TestContext_Refs(t *testing.T) {
+	c := &componentImpl{
+		name:  "TestComponent",
+		state: make(map[string]interface{}),
+	}
+	ctx := &Context{component: c}
+
+	state := ctx.Refs(map[string]interface{}{
+		"count": 1,
+		"name":  "Alice",
+		"open":  false,
+	})
+
+	assert.Equal(t, []string{"count", "name", "open"}, state.Keys())
+	for _, key := range state.Keys() {
+		ref := state.Get(key)
+		require.NotNil(t, ref, key)
+		assert.Same(t, ref, ctx.Get(key), "%s should be exposed", key)
+	}
+	assert.Nil(t, state.Get("missing"))
+}
+
+// TestRefSetGet tests typed reads from a RefSet
+func TestRefSetGet(t *testing.T) {
+	c := &componentImpl{
+		name:  "TestComponent",
+		state: make(map[string]interface{}),
+	}
+	ctx := &Context{component: c}
+
+	state := ctx.Refs(map[string]interface{}{
+		"count": 42,
+		"err":   nil,
+	})
+
+	tests := []struct {
+		name      string
+		read      func() interface{}
+		expected  interface{}
+		wantPanic string
+	}{
+		{
+			name:     "matching type",
+			read:     func() interface{} { return RefSetGet[int](state, "count") },
+			expected: 42,
+		},
+		{
+			name:     "nil value returns zero",
+			read:     func() interface{} { return RefSetGet[error](state, "err") },
+			expected: nil,
+		},
+		{
+			name:      "wrong type panics with key and types",
+			read:      func() interface{} { return RefSetGet[string](state, "count") },
+			wantPanic: `RefSet key "count" holds int, not string`,
+		},
+		{
+			name:      "missing key panics",
+			read:      func() interface{} { return RefSetGet[int](state, "missing") },
+			wantPanic: `RefSet has no key "missing"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic != "" {
+				assert.PanicsWithValue(t, tt.wantPanic, func() { tt.read() })
+				return
+			}
+			assert.Equal(t, tt.expected, tt.read())
+		})
+	}
+}
+
+// TestRefSetSet tests that typed writes are reactive
+func TestRefSetSet(t *testing.T) {
+	c := &componentImpl{
+		name:  "TestComponent",
+		state: make(map[string]interface{}),
+	}
+	ctx := &Context{component: c}
+
+	state := ctx.Refs(map[string]interface{}{"count": 0})
+
+	var seen interface{}
+	cleanup := Watch(state.Get("count"), func(newVal, _ interface{}) {
+		seen = newVal
+	})
+	defer cleanup()
+
+	RefSetSet(state, "count", 5)
+
+	assert.Equal(t, 5, RefSetGet[int](state, "count"))
+	assert.Equal(t, 5, seen)
+	assert.Panics(t, func() { RefSetSet(state, "missing", 1) })
+}
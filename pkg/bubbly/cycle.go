@@ -0,0 +1,89 @@
+package bubbly
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CycleReport describes a circular dependency between reactive values.
+type CycleReport struct {
+	// Path lists the values in the cycle in evaluation order, starting and
+	// ending with the same value (e.g., ["a", "b", "c", "a"]). Computeds are
+	// identified by their WithComputedName name when set, other values by
+	// their DevTools ID (e.g., "computed-0xc000123456").
+	Path []string
+}
+
+// String formats the cycle as "a → b → c → a".
+func (r CycleReport) String() string {
+	return strings.Join(r.Path, " → ")
+}
+
+// CycleError is the error (and panic value) raised when evaluating a
+// Computed that depends on itself, directly or indirectly. It wraps
+// ErrCircularDependency, so errors.Is(err, ErrCircularDependency) holds.
+type CycleError struct {
+	CycleReport
+}
+
+// Error implements the error interface, including the full cycle path.
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrCircularDependency, e.CycleReport)
+}
+
+// Unwrap returns ErrCircularDependency.
+func (e *CycleError) Unwrap() error {
+	return ErrCircularDependency
+}
+
+// dependencyName returns a diagnostic name for dep.
+func dependencyName(dep Dependency) string {
+	if named, ok := dep.(interface{ debugName() string }); ok {
+		if name := named.debugName(); name != "" {
+			return name
+		}
+	}
+	if node, ok := dep.(graphNode); ok {
+		return node.graphInfo().id
+	}
+	if stringer, ok := dep.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%T", dep)
+}
+
+// DetectCycles evaluates each of values, reporting the first circular
+// dependency found instead of panicking. Use it as a preflight check, e.g.
+// in tests, for Computeds wired up dynamically.
+//
+// Evaluating a Computed caches its value, as a normal Get would. Panics
+// other than dependency cycles propagate.
+//
+// Example:
+//
+//	if report, found := bubbly.DetectCycles(total, tax, discount); found {
+//	    t.Fatalf("dependency cycle: %s", report)
+//	}
+func DetectCycles(values ...Dependency) (CycleReport, bool) {
+	for _, value := range values {
+		if err := evaluateForCycles(value); err != nil {
+			return err.CycleReport, true
+		}
+	}
+	return CycleReport{}, false
+}
+
+// evaluateForCycles reads value, recovering a dependency cycle panic.
+func evaluateForCycles(value Dependency) (cycleErr *CycleError) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, ok := r.(error)
+			if !ok || !errors.As(err, &cycleErr) {
+				panic(r)
+			}
+		}
+	}()
+	value.Get()
+	return nil
+}
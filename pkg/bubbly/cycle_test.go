@@ -0,0 +1,91 @@
+package bubbly
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCycleTestComputeds builds a → b → c → a, where c only reads a once
+// closeCycle is set.
+func newCycleTestComputeds(closeCycle *Ref[bool]) (a, b, c *Computed[int]) {
+	a = NewComputed(func() int { return b.GetTyped() + 1 }, WithComputedName("a"))
+	b = NewComputed(func() int { return c.GetTyped() + 1 }, WithComputedName("b"))
+	c = NewComputed(func() int {
+		if closeCycle.GetTyped() {
+			return a.GetTyped() + 1
+		}
+		return 0
+	}, WithComputedName("c"))
+	return a, b, c
+}
+
+// TestComputed_CycleErrorIncludesPath tests the panic value of a real cycle
+func TestComputed_CycleErrorIncludesPath(t *testing.T) {
+	a, _, _ := newCycleTestComputeds(NewRef(true))
+
+	var recovered interface{}
+	func() {
+		defer func() { recovered = recover() }()
+		a.GetTyped()
+	}()
+
+	err, ok := recovered.(error)
+	require.True(t, ok, "panics with an error instead of deadlocking")
+	assert.ErrorIs(t, err, ErrCircularDependency)
+
+	var cycleErr *CycleError
+	require.True(t, errors.As(err, &cycleErr))
+	assert.Equal(t, []string{"a", "b", "c", "a"}, cycleErr.Path)
+	assert.Equal(t, "circular dependency detected: a → b → c → a", err.Error())
+}
+
+// TestDetectCycles tests non-panicking preflight checks
+func TestDetectCycles(t *testing.T) {
+	closeCycle := NewRef(false)
+	a, b, c := newCycleTestComputeds(closeCycle)
+
+	report, found := DetectCycles(a, b, c)
+	assert.False(t, found)
+	assert.Empty(t, report.Path)
+	assert.Equal(t, 2, a.GetTyped())
+
+	closeCycle.Set(true)
+	report, found = DetectCycles(b)
+	require.True(t, found)
+	assert.Equal(t, "b → c → a → b", report.String())
+
+	// Failed evaluations leave the values usable
+	closeCycle.Set(false)
+	assert.Equal(t, 2, a.GetTyped())
+
+	_, found = DetectCycles()
+	assert.False(t, found)
+}
+
+// TestDetectCycles_OtherPanicsPropagate tests that unrelated panics are not swallowed
+func TestDetectCycles_OtherPanicsPropagate(t *testing.T) {
+	broken := NewComputed(func() int { panic("boom") })
+	assert.PanicsWithValue(t, "boom", func() { DetectCycles(broken) })
+}
+
+// TestDepTracker_CyclePathNames tests fallback names for unnamed dependencies
+func TestDepTracker_CyclePathNames(t *testing.T) {
+	dt := &DepTracker{}
+	ref := NewRef(0)
+	unnamed := NewComputed(func() int { return 0 })
+
+	require.NoError(t, dt.BeginTracking(ref))
+	require.NoError(t, dt.BeginTracking(unnamed))
+	err := dt.BeginTracking(ref)
+	dt.EndTracking()
+	dt.EndTracking()
+
+	var cycleErr *CycleError
+	require.True(t, errors.As(err, &cycleErr))
+	require.Len(t, cycleErr.Path, 3)
+	assert.Regexp(t, `^ref-0x`, cycleErr.Path[0])
+	assert.Regexp(t, `^computed-0x`, cycleErr.Path[1])
+}
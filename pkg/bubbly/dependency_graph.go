@@ -0,0 +1,298 @@
+package bubbly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DependencyNodeKind identifies the kind of a node in a ReactiveGraph.
+type DependencyNodeKind string
+
+const (
+	// NodeRef is a Ref.
+	NodeRef DependencyNodeKind = "ref"
+
+	// NodeComputed is a Computed value.
+	NodeComputed DependencyNodeKind = "computed"
+
+	// NodeWatcher is a Watch callback.
+	NodeWatcher DependencyNodeKind = "watcher"
+
+	// NodeEffect is a WatchEffect.
+	NodeEffect DependencyNodeKind = "effect"
+)
+
+// maxGraphValueLen caps the length of Ref values recorded in graph nodes.
+const maxGraphValueLen = 40
+
+// DependencyNode is a reactive value or observer in a ReactiveGraph.
+type DependencyNode struct {
+	// ID uniquely identifies the node. It matches the IDs reported to
+	// framework hooks (e.g., "ref-0xc000123456"), so nodes can be correlated
+	// with DevTools events.
+	ID string `json:"id"`
+
+	// Kind is the node kind.
+	Kind DependencyNodeKind `json:"kind"`
+
+	// Label is a human-readable name: "<Component>.<key>" for values found
+	// through ComponentDependencyGraph, the WithComputedName name for named
+	// Computeds, the ID otherwise.
+	Label string `json:"label"`
+
+	// Value is the current value of a Ref, formatted with %v and truncated.
+	// It is empty for other kinds, which are not evaluated.
+	Value string `json:"value,omitempty"`
+}
+
+// DependencyEdge connects a source to something that depends on it:
+// changes flow From -> To.
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ReactiveGraph is a serializable snapshot of reactive wiring: which Refs
+// and Computeds feed which Computeds, watchers, and effects.
+type ReactiveGraph struct {
+	Nodes []DependencyNode `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+}
+
+// graphNode is implemented by reactive types that can report their wiring.
+type graphNode interface {
+	graphInfo() graphNodeInfo
+}
+
+// graphNodeInfo is the wiring of a single reactive value.
+type graphNodeInfo struct {
+	id         string
+	kind       DependencyNodeKind
+	value      string
+	deps       []Dependency
+	dependents []Dependency
+	watcherIDs []string
+}
+
+// graphInfo implements graphNode.
+func (r *Ref[T]) graphInfo() graphNodeInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info := graphNodeInfo{
+		id:         fmt.Sprintf("ref-%p", r),
+		kind:       NodeRef,
+		value:      TruncateVisible(fmt.Sprintf("%v", r.value), maxGraphValueLen),
+		dependents: append([]Dependency(nil), r.dependents...),
+	}
+	for _, w := range r.watchers {
+		info.watcherIDs = append(info.watcherIDs, fmt.Sprintf("watch-%p", w))
+	}
+	return info
+}
+
+// graphInfo implements graphNode.
+func (c *Computed[T]) graphInfo() graphNodeInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	info := graphNodeInfo{
+		id:         fmt.Sprintf("computed-%p", c),
+		kind:       NodeComputed,
+		deps:       append([]Dependency(nil), c.deps...),
+		dependents: append([]Dependency(nil), c.dependents...),
+	}
+	for _, w := range c.watchers {
+		info.watcherIDs = append(info.watcherIDs, fmt.Sprintf("watch-%p", w))
+	}
+	return info
+}
+
+// graphInfo implements graphNode. All watchers of one effect share its node.
+func (iw *invalidationWatcher) graphInfo() graphNodeInfo {
+	return graphNodeInfo{
+		id:   fmt.Sprintf("effect-%p", iw.effect),
+		kind: NodeEffect,
+	}
+}
+
+// graphBuilder accumulates nodes and edges while walking the graph.
+type graphBuilder struct {
+	graph  ReactiveGraph
+	nodes  map[string]int // ID -> index in graph.Nodes
+	edges  map[DependencyEdge]bool
+	labels map[string]string
+}
+
+func newGraphBuilder() *graphBuilder {
+	return &graphBuilder{
+		graph:  ReactiveGraph{Nodes: []DependencyNode{}, Edges: []DependencyEdge{}},
+		nodes:  make(map[string]int),
+		edges:  make(map[DependencyEdge]bool),
+		labels: make(map[string]string),
+	}
+}
+
+// addNode records a node, returning false if it was already recorded.
+func (b *graphBuilder) addNode(node DependencyNode) bool {
+	if _, seen := b.nodes[node.ID]; seen {
+		return false
+	}
+	if node.Label == "" {
+		node.Label = node.ID
+	}
+	b.nodes[node.ID] = len(b.graph.Nodes)
+	b.graph.Nodes = append(b.graph.Nodes, node)
+	return true
+}
+
+func (b *graphBuilder) addEdge(from, to string) {
+	edge := DependencyEdge{From: from, To: to}
+	if !b.edges[edge] {
+		b.edges[edge] = true
+		b.graph.Edges = append(b.graph.Edges, edge)
+	}
+}
+
+// walk visits every node reachable from roots, upstream and downstream.
+func (b *graphBuilder) walk(roots []Dependency) {
+	queue := append([]Dependency(nil), roots...)
+	for len(queue) > 0 {
+		dep := queue[0]
+		queue = queue[1:]
+
+		node, ok := dep.(graphNode)
+		if !ok {
+			continue // Custom Dependency implementations can't be inspected
+		}
+		info := node.graphInfo()
+		label := b.labels[info.id]
+		if label == "" {
+			label = dependencyName(dep)
+		}
+		if !b.addNode(DependencyNode{ID: info.id, Kind: info.kind, Label: label, Value: info.value}) {
+			continue
+		}
+
+		for _, up := range info.deps {
+			if upNode, ok := up.(graphNode); ok {
+				b.addEdge(upNode.graphInfo().id, info.id)
+				queue = append(queue, up)
+			}
+		}
+		for _, down := range info.dependents {
+			if downNode, ok := down.(graphNode); ok {
+				b.addEdge(info.id, downNode.graphInfo().id)
+				queue = append(queue, down)
+			}
+		}
+		for _, watcherID := range info.watcherIDs {
+			b.addNode(DependencyNode{ID: watcherID, Kind: NodeWatcher})
+			b.addEdge(info.id, watcherID)
+		}
+	}
+}
+
+// DependencyGraph returns the reactive graph reachable from roots: the Refs
+// and Computeds they depend on or feed, transitively, plus their watchers
+// and effects.
+//
+// Computed values are wired to their dependencies when first evaluated, so
+// a Computed that has never been read has no edges yet. Values are not
+// evaluated by this call.
+//
+// Example:
+//
+//	graph := bubbly.DependencyGraph(count, doubled)
+//	os.WriteFile("reactive.dot", []byte(graph.DOT()), 0644)
+//	// dot -Tsvg reactive.dot > reactive.svg
+func DependencyGraph(roots ...Dependency) *ReactiveGraph {
+	b := newGraphBuilder()
+	b.walk(roots)
+	return &b.graph
+}
+
+// ComponentDependencyGraph returns the reactive graph reachable from the
+// values exposed by c and its descendants. Exposed values are labeled
+// "<Component>.<key>".
+//
+// Example:
+//
+//	data, _ := json.MarshalIndent(bubbly.ComponentDependencyGraph(app), "", "  ")
+func ComponentDependencyGraph(c Component) *ReactiveGraph {
+	b := newGraphBuilder()
+
+	var roots []Dependency
+	var collect func(c Component)
+	collect = func(c Component) {
+		impl, ok := c.(*componentImpl)
+		if !ok {
+			return
+		}
+
+		impl.stateMu.RLock()
+		keys := make([]string, 0, len(impl.state))
+		for key := range impl.state {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if node, ok := impl.state[key].(graphNode); ok {
+				id := node.graphInfo().id
+				if _, labeled := b.labels[id]; !labeled {
+					b.labels[id] = impl.name + "." + key
+				}
+				roots = append(roots, impl.state[key].(Dependency))
+			}
+		}
+		impl.stateMu.RUnlock()
+
+		for _, child := range impl.Children() {
+			collect(child)
+		}
+	}
+	collect(c)
+
+	b.walk(roots)
+	return &b.graph
+}
+
+// DOT renders the graph in Graphviz DOT format. Refs are ellipses,
+// Computeds boxes, watchers notes, and effects diamonds.
+//
+// Example:
+//
+//	fmt.Println(graph.DOT()) // Pipe into: dot -Tpng -o graph.png
+func (g *ReactiveGraph) DOT() string {
+	shapes := map[DependencyNodeKind]string{
+		NodeRef:      "ellipse",
+		NodeComputed: "box",
+		NodeWatcher:  "note",
+		NodeEffect:   "diamond",
+	}
+
+	var sb strings.Builder
+	sb.WriteString("digraph reactive {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range g.Nodes {
+		label := fmt.Sprintf("%s\n(%s)", node.Label, node.Kind)
+		if node.Value != "" {
+			label += "\n= " + node.Value
+		}
+		fmt.Fprintf(&sb, "  %s [label=%s, shape=%s];\n", dotQuote(node.ID), dotQuote(label), shapes[node.Kind])
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", dotQuote(edge.From), dotQuote(edge.To))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotQuote quotes s as a DOT string literal.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,113 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphKinds maps node labels to kinds for assertions.
+func graphKinds(g *ReactiveGraph) map[string]DependencyNodeKind {
+	kinds := make(map[string]DependencyNodeKind, len(g.Nodes))
+	for _, node := range g.Nodes {
+		kinds[node.Label] = node.Kind
+	}
+	return kinds
+}
+
+// graphHasEdge reports whether g has an edge between the labeled nodes.
+func graphHasEdge(g *ReactiveGraph, fromLabel, toLabel string) bool {
+	ids := make(map[string]string, len(g.Nodes))
+	for _, node := range g.Nodes {
+		ids[node.Label] = node.ID
+	}
+	for _, edge := range g.Edges {
+		if edge.From == ids[fromLabel] && edge.To == ids[toLabel] {
+			return true
+		}
+	}
+	return false
+}
+
+// TestDependencyGraph tests walking from roots in both directions
+func TestDependencyGraph(t *testing.T) {
+	count := NewRef(2)
+	doubled := NewComputed(func() int { return count.GetTyped() * 2 })
+	label := NewComputed(func() int { return doubled.GetTyped() + 1 })
+	label.GetTyped()
+	unrelated := NewRef("x")
+
+	cleanupWatch := Watch(count, func(_, _ int) {})
+	defer cleanupWatch()
+	cleanupEffect := WatchEffect(func() { doubled.GetTyped() })
+	defer cleanupEffect()
+
+	graph := DependencyGraph(doubled)
+
+	kinds := map[DependencyNodeKind]int{}
+	for _, node := range graph.Nodes {
+		kinds[node.Kind]++
+		assert.NotEqual(t, unrelated.graphInfo().id, node.ID)
+		if node.Kind == NodeRef {
+			assert.Equal(t, "2", node.Value)
+		}
+	}
+	assert.Equal(t, map[DependencyNodeKind]int{NodeRef: 1, NodeComputed: 2, NodeWatcher: 1, NodeEffect: 1}, kinds)
+	assert.Len(t, graph.Edges, 4, "count->doubled, count->watcher, doubled->label, doubled->effect")
+
+	empty := DependencyGraph()
+	assert.Empty(t, empty.Nodes)
+}
+
+// TestComponentDependencyGraph tests labels from exposed state and JSON/DOT export
+func TestComponentDependencyGraph(t *testing.T) {
+	child, err := NewComponent("Child").
+		Setup(func(ctx *Context) {
+			ctx.Expose("flag", NewRef(true))
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+
+	app, err := NewComponent("App").
+		Setup(func(ctx *Context) {
+			items := NewRef([]string{"a", "b"})
+			total := NewComputed(func() int { return len(items.GetTyped()) })
+			total.GetTyped()
+			ctx.Expose("items", items)
+			ctx.Expose("total", total)
+			ctx.Expose("title", "not reactive")
+			require.NoError(t, ctx.ExposeComponent("child", child))
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	app.Init()
+
+	graph := ComponentDependencyGraph(app)
+	assert.Equal(t, map[string]DependencyNodeKind{
+		"App.items":  NodeRef,
+		"App.total":  NodeComputed,
+		"Child.flag": NodeRef,
+	}, graphKinds(graph))
+	assert.True(t, graphHasEdge(graph, "App.items", "App.total"))
+
+	data, err := json.Marshal(graph)
+	require.NoError(t, err)
+	var decoded ReactiveGraph
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, *graph, decoded)
+
+	dot := graph.DOT()
+	assert.Contains(t, dot, "digraph reactive {")
+	assert.Contains(t, dot, `label="App.total\n(computed)", shape=box`)
+	assert.Contains(t, dot, `label="App.items\n(ref)\n= [a b]", shape=ellipse`)
+	assert.Contains(t, dot, " -> ")
+}
+
+// TestDotQuote tests DOT string escaping
+func TestDotQuote(t *testing.T) {
+	assert.Equal(t, `"say \"hi\"\n\\"`, dotQuote("say \"hi\"\n\\"))
+}
@@ -0,0 +1,304 @@
+package directives
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// Mask placeholders accepted by BindMasked. Any other mask character is a
+// literal; prefix a placeholder with a backslash to use it as a literal.
+const (
+	// MaskDigit accepts a digit.
+	MaskDigit = '#'
+	// MaskLetter accepts a letter.
+	MaskLetter = 'A'
+	// MaskAlphanumeric accepts a letter or a digit.
+	MaskAlphanumeric = '*'
+)
+
+// maskToken is a placeholder (slot) or a literal of a parsed mask.
+type maskToken struct {
+	char rune
+	slot bool
+}
+
+// accepts reports whether r may fill the placeholder.
+func (t maskToken) accepts(r rune) bool {
+	switch t.char {
+	case MaskDigit:
+		return unicode.IsDigit(r)
+	case MaskLetter:
+		return unicode.IsLetter(r)
+	default:
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+}
+
+// maskBindConfig holds the settings of a masked binding.
+type maskBindConfig struct {
+	raw bool
+}
+
+// MaskOption configures BindMasked.
+type MaskOption func(*maskBindConfig)
+
+// WithRawValue stores only the characters the user typed in the Ref, e.g.
+// "5551234567" instead of "(555) 123-4567". The input still shows the
+// formatted text.
+func WithRawValue() MaskOption {
+	return func(c *maskBindConfig) {
+		c.raw = true
+	}
+}
+
+// MaskedBindDirective binds a string Ref to an input formatted by a mask,
+// such as a phone number, date or card number.
+//
+// Like NumberBindDirective, it holds the text being edited, so create it
+// once in Setup and feed it key presses with HandleKey, or the whole field
+// text with Input:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    phone := bubbly.NewRef("")
+//	    phoneInput := directives.BindMasked(phone, "(###) ###-####")
+//	    ctx.Expose("phoneInput", phoneInput)
+//
+//	    ctx.On("key", func(data interface{}) {
+//	        phoneInput.HandleKey(data.(tea.KeyMsg))
+//	    })
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    phoneInput := ctx.Get("phoneInput").(*directives.MaskedBindDirective)
+//	    return phoneInput.Render()
+//	})
+//
+// # Masks
+//
+// MaskDigit (#), MaskLetter (A) and MaskAlphanumeric (*) are placeholders
+// for one typed character; everything else is a literal inserted for the
+// user: typing "5551" into "(###) ###-####" shows "(555) 1". Literals
+// right after a filled placeholder are shown as soon as it is filled, so
+// the user sees where the next character goes.
+//
+// # Input Handling
+//
+//   - Characters matching the next placeholder are added; typing the next
+//     literal yourself (such as "/" in a date) is allowed and ignored.
+//   - Other characters, and characters past the end of the mask, are
+//     rejected and Invalid reports true until the next accepted input.
+//   - Backspace removes the last typed character together with the
+//     literals after it, so it never gets stuck on a separator.
+//
+// If the Ref is changed elsewhere, the matching characters of the new value
+// are reloaded into the mask.
+type MaskedBindDirective struct {
+	ref     *bubbly.Ref[string]
+	config  maskBindConfig
+	tokens  []maskToken
+	slots   int
+	typed   []rune
+	value   string
+	invalid bool
+}
+
+// BindMasked creates a masked binding. The Ref stores the formatted text
+// unless WithRawValue is given.
+//
+// Parameters:
+//   - ref: The Ref to keep in sync with the input
+//   - mask: The format, e.g. "(###) ###-####", "##/##/####", "AA-****"
+//   - opts: Optional settings (WithRawValue)
+//
+// Returns:
+//   - *MaskedBindDirective: The binding, to keep and feed with HandleKey
+//     or Input
+//
+// Example:
+//
+//	expiry := directives.BindMasked(expiryRef, "##/##")
+//	expiry.Input("1227") // expiryRef is set to "12/27"
+func BindMasked(ref *bubbly.Ref[string], mask string, opts ...MaskOption) *MaskedBindDirective {
+	var config maskBindConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	d := &MaskedBindDirective{ref: ref, config: config}
+	escaped := false
+	for _, r := range mask {
+		switch {
+		case escaped:
+			d.tokens = append(d.tokens, maskToken{char: r})
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == MaskDigit || r == MaskLetter || r == MaskAlphanumeric:
+			d.tokens = append(d.tokens, maskToken{char: r, slot: true})
+			d.slots++
+		default:
+			d.tokens = append(d.tokens, maskToken{char: r})
+		}
+	}
+
+	d.load(ref.GetTyped())
+	return d
+}
+
+// HandleKey applies a key press as described in MaskedBindDirective and
+// reports whether the key was handled. Rejected characters count as
+// handled.
+func (d *MaskedBindDirective) HandleKey(msg tea.KeyMsg) bool {
+	d.sync()
+
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace:
+		d.invalid = false
+		for _, r := range msg.Runes {
+			if !d.typeRune(r) {
+				d.invalid = true
+			}
+		}
+		d.commit()
+	case tea.KeyBackspace:
+		if len(d.typed) > 0 {
+			d.typed = d.typed[:len(d.typed)-1]
+		}
+		d.invalid = false
+		d.commit()
+	default:
+		return false
+	}
+	return true
+}
+
+// Input replaces the text with the characters of text that fit the mask,
+// ignoring literals, and reports whether every other character fit. Use
+// it with inputs that hand over the whole field text.
+func (d *MaskedBindDirective) Input(text string) bool {
+	d.typed = d.typed[:0]
+	d.invalid = false
+	for _, r := range text {
+		if !d.typeRune(r) {
+			d.invalid = true
+		}
+	}
+	d.commit()
+	return !d.invalid
+}
+
+// Text returns the formatted text to show in the input field.
+func (d *MaskedBindDirective) Text() string {
+	d.sync()
+	return d.format()
+}
+
+// Raw returns only the typed characters, without literals.
+func (d *MaskedBindDirective) Raw() string {
+	d.sync()
+	return string(d.typed)
+}
+
+// Complete reports whether every placeholder of the mask is filled.
+func (d *MaskedBindDirective) Complete() bool {
+	d.sync()
+	return len(d.typed) == d.slots
+}
+
+// Invalid reports whether the last input contained rejected characters.
+func (d *MaskedBindDirective) Invalid() bool {
+	return d.invalid
+}
+
+// Render returns the input field in the same format as Bind.
+func (d *MaskedBindDirective) Render() string {
+	var builder strings.Builder
+	builder.Grow(10 + len(d.tokens))
+	builder.WriteString("[Input: ")
+	builder.WriteString(d.Text())
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// typeRune adds r to the typed characters if it fits the next placeholder.
+// Typing the literal the mask shows next is accepted without adding it.
+func (d *MaskedBindDirective) typeRune(r rune) bool {
+	if len(d.typed) == d.slots {
+		return false
+	}
+
+	filled := 0
+	for _, token := range d.tokens {
+		if !token.slot {
+			if filled == len(d.typed) && token.char == r {
+				return true
+			}
+			continue
+		}
+		if filled == len(d.typed) {
+			if token.accepts(r) {
+				d.typed = append(d.typed, r)
+				return true
+			}
+			return false
+		}
+		filled++
+	}
+	return false
+}
+
+// format lays the typed characters into the mask. Literals are shown up to
+// the first unfilled placeholder, and only once something is typed.
+func (d *MaskedBindDirective) format() string {
+	if len(d.typed) == 0 {
+		return ""
+	}
+
+	var builder strings.Builder
+	filled := 0
+	for _, token := range d.tokens {
+		if !token.slot {
+			builder.WriteRune(token.char)
+			continue
+		}
+		if filled == len(d.typed) {
+			break
+		}
+		builder.WriteRune(d.typed[filled])
+		filled++
+	}
+	return builder.String()
+}
+
+// stored returns the value to keep in the Ref.
+func (d *MaskedBindDirective) stored() string {
+	if d.config.raw {
+		return string(d.typed)
+	}
+	return d.format()
+}
+
+// commit writes the current value to the Ref.
+func (d *MaskedBindDirective) commit() {
+	d.value = d.stored()
+	d.ref.Set(d.value)
+}
+
+// sync reloads the text if the Ref was changed elsewhere.
+func (d *MaskedBindDirective) sync() {
+	if d.ref.GetTyped() != d.value {
+		d.load(d.ref.GetTyped())
+	}
+}
+
+// load fills the mask with the characters of value that fit it.
+func (d *MaskedBindDirective) load(value string) {
+	d.typed = d.typed[:0]
+	for _, r := range value {
+		d.typeRune(r)
+	}
+	d.value = value
+}
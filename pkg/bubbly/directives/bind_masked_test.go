@@ -0,0 +1,150 @@
+package directives
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestBindMasked_HandleKey tests literals are inserted and stepped over while typing
+func TestBindMasked_HandleKey(t *testing.T) {
+	backspace := tea.KeyMsg{Type: tea.KeyBackspace}
+
+	tests := []struct {
+		name            string
+		mask            string
+		opts            []MaskOption
+		keys            []tea.KeyMsg
+		expectedValue   string
+		expectedText    string
+		expectedInvalid bool
+		complete        bool
+	}{
+		{
+			name:          "inserts literals as digits are typed",
+			mask:          "(###) ###-####",
+			keys:          textAreaKeys("5", "5", "5", "1"),
+			expectedValue: "(555) 1",
+			expectedText:  "(555) 1",
+		},
+		{
+			name:          "shows the literal after a filled placeholder",
+			mask:          "##/##/####",
+			keys:          textAreaKeys("1", "2"),
+			expectedValue: "12/",
+			expectedText:  "12/",
+		},
+		{
+			name:          "typed literals are skipped",
+			mask:          "##/##/####",
+			keys:          textAreaKeys("1", "2", "/", "3", "1", "/", "2024"),
+			expectedValue: "12/31/2024",
+			expectedText:  "12/31/2024",
+			complete:      true,
+		},
+		{
+			name:            "rejects characters of the wrong class",
+			mask:            "(###) ###-####",
+			keys:            textAreaKeys("5", "x"),
+			expectedValue:   "(5",
+			expectedText:    "(5",
+			expectedInvalid: true,
+		},
+		{
+			name:            "rejects characters past the end",
+			mask:            "##",
+			keys:            textAreaKeys("123"),
+			expectedValue:   "12",
+			expectedText:    "12",
+			expectedInvalid: true,
+			complete:        true,
+		},
+		{
+			name:          "backspace steps over literals",
+			mask:          "(###) ###-####",
+			keys:          append(textAreaKeys("5554"), backspace, backspace),
+			expectedValue: "(55",
+			expectedText:  "(55",
+		},
+		{
+			name:          "backspace to empty clears literals",
+			mask:          "(###)",
+			keys:          append(textAreaKeys("5"), backspace, backspace),
+			expectedValue: "",
+			expectedText:  "",
+		},
+		{
+			name:          "letters and alphanumerics",
+			mask:          "AA-****",
+			keys:          textAreaKeys("ab", "1c2d"),
+			expectedValue: "ab-1c2d",
+			expectedText:  "ab-1c2d",
+			complete:      true,
+		},
+		{
+			name:          "escaped placeholder is a literal",
+			mask:          `\##`,
+			keys:          textAreaKeys("7"),
+			expectedValue: "#7",
+			expectedText:  "#7",
+			complete:      true,
+		},
+		{
+			name:          "raw value",
+			mask:          "(###) ###-####",
+			opts:          []MaskOption{WithRawValue()},
+			keys:          textAreaKeys("5551234567"),
+			expectedValue: "5551234567",
+			expectedText:  "(555) 123-4567",
+			complete:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := bubbly.NewRef("")
+			input := BindMasked(ref, tt.mask, tt.opts...)
+			for _, key := range tt.keys {
+				assert.True(t, input.HandleKey(key))
+			}
+
+			assert.Equal(t, tt.expectedValue, ref.GetTyped())
+			assert.Equal(t, tt.expectedText, input.Text())
+			assert.Equal(t, tt.expectedInvalid, input.Invalid())
+			assert.Equal(t, tt.complete, input.Complete())
+		})
+	}
+}
+
+// TestBindMasked_Input tests whole-text input is reformatted
+func TestBindMasked_Input(t *testing.T) {
+	ref := bubbly.NewRef("")
+	input := BindMasked(ref, "#### #### #### ####")
+
+	assert.True(t, input.Input("4111 1111 1111 1111"))
+	assert.Equal(t, "4111 1111 1111 1111", ref.GetTyped())
+	assert.Equal(t, "4111111111111111", input.Raw())
+	assert.Equal(t, "[Input: 4111 1111 1111 1111]", input.Render())
+
+	assert.False(t, input.Input("41a1"))
+	assert.Equal(t, "411", ref.GetTyped())
+	assert.True(t, input.Invalid())
+
+	assert.False(t, input.HandleKey(tea.KeyMsg{Type: tea.KeyTab}))
+}
+
+// TestBindMasked_ExternalChange tests a Ref set elsewhere is loaded into the mask
+func TestBindMasked_ExternalChange(t *testing.T) {
+	ref := bubbly.NewRef("5551234567")
+	input := BindMasked(ref, "(###) ###-####", WithRawValue())
+	assert.Equal(t, "(555) 123-4567", input.Text())
+
+	ref.Set("2125550000")
+	assert.Equal(t, "(212) 555-0000", input.Text())
+
+	input.HandleKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "212555000", ref.GetTyped())
+}
@@ -0,0 +1,269 @@
+package directives
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// numberBindConfig holds the constraints of a numeric binding.
+type numberBindConfig struct {
+	min, max       float64
+	hasMin, hasMax bool
+	step           float64
+	defaultValue   float64
+}
+
+// NumberBindOption configures BindNumber and BindFloat.
+type NumberBindOption func(*numberBindConfig)
+
+// WithMin sets the smallest value the binding accepts; smaller inputs are
+// clamped to it.
+func WithMin(min float64) NumberBindOption {
+	return func(c *numberBindConfig) {
+		c.min, c.hasMin = min, true
+	}
+}
+
+// WithMax sets the largest value the binding accepts; larger inputs are
+// clamped to it.
+func WithMax(max float64) NumberBindOption {
+	return func(c *numberBindConfig) {
+		c.max, c.hasMax = max, true
+	}
+}
+
+// WithStep snaps accepted values to the nearest multiple of step, counted
+// from the minimum (or from zero without WithMin). Non-positive steps are
+// ignored.
+func WithStep(step float64) NumberBindOption {
+	return func(c *numberBindConfig) {
+		if step > 0 {
+			c.step = step
+		}
+	}
+}
+
+// WithDefault sets the value an empty input maps to. Default: 0.
+func WithDefault(value float64) NumberBindOption {
+	return func(c *numberBindConfig) {
+		c.defaultValue = value
+	}
+}
+
+// NumberBindDirective binds a numeric Ref to a text input, parsing what the
+// user types and keeping the Ref within the configured constraints.
+//
+// Unlike Bind, a number binding holds the text being edited, so create it
+// once in Setup and feed it the input text with Input on every keystroke:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    age := bubbly.NewRef(0)
+//	    ageInput := directives.BindNumber(age,
+//	        directives.WithMin(0), directives.WithMax(150))
+//	    ctx.Expose("ageInput", ageInput)
+//
+//	    ctx.On("ageTyped", func(data interface{}) {
+//	        ageInput.Input(data.(string))
+//	    })
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    ageInput := ctx.Get("ageInput").(*directives.NumberBindDirective[int])
+//	    if ageInput.Invalid() {
+//	        return ageInput.Render() + " (numbers only)"
+//	    }
+//	    return ageInput.Render()
+//	})
+//
+// # Input Handling
+//
+//   - Numeric text is parsed, snapped to the step, clamped to min/max, and
+//     written to the Ref. If clamping or snapping changed the value, the
+//     text is replaced by the value actually stored.
+//   - Empty text sets the Ref to the default (WithDefault, clamped).
+//   - A lone sign ("-" or "+"), and for floats a lone or signed decimal
+//     point, is accepted as in-progress editing: the text is kept and the
+//     Ref is left unchanged until a digit follows.
+//   - Anything else is rejected: the text and the Ref keep their last valid
+//     value and Invalid reports true until the next accepted input.
+//
+// If the Ref is changed elsewhere, Text and Render show the new value.
+type NumberBindDirective[T int | float64] struct {
+	ref     *bubbly.Ref[T]
+	config  numberBindConfig
+	parse   func(text string) (T, error)
+	format  func(value T) string
+	text    string
+	value   T
+	invalid bool
+}
+
+// BindNumber creates a numeric binding for an integer Ref. Snapped values
+// are rounded to the nearest integer.
+//
+// Parameters:
+//   - ref: The Ref to keep in sync with the input
+//   - opts: Optional constraints (WithMin, WithMax, WithStep, WithDefault)
+//
+// Returns:
+//   - *NumberBindDirective[int]: The binding, to keep and feed with Input
+//
+// Example:
+//
+//	quantity := directives.BindNumber(qty, directives.WithMin(1), directives.WithMax(99))
+//	quantity.Input("250") // qty is set to 99
+func BindNumber(ref *bubbly.Ref[int], opts ...NumberBindOption) *NumberBindDirective[int] {
+	return newNumberBind(ref, strconv.Atoi, strconv.Itoa, opts)
+}
+
+// BindFloat creates a numeric binding for a float64 Ref.
+//
+// Example:
+//
+//	volume := directives.BindFloat(level,
+//	    directives.WithMin(0), directives.WithMax(1), directives.WithStep(0.05))
+//	volume.Input("0.42") // level is set to 0.4
+func BindFloat(ref *bubbly.Ref[float64], opts ...NumberBindOption) *NumberBindDirective[float64] {
+	parse := func(text string) (float64, error) {
+		return strconv.ParseFloat(text, 64)
+	}
+	format := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	return newNumberBind(ref, parse, format, opts)
+}
+
+// newNumberBind creates a numeric binding with the given conversions.
+func newNumberBind[T int | float64](
+	ref *bubbly.Ref[T],
+	parse func(string) (T, error),
+	format func(T) string,
+	opts []NumberBindOption,
+) *NumberBindDirective[T] {
+	var config numberBindConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	value := ref.GetTyped()
+	return &NumberBindDirective[T]{
+		ref:    ref,
+		config: config,
+		parse:  parse,
+		format: format,
+		text:   format(value),
+		value:  value,
+	}
+}
+
+// Input applies the current text of the input field, as described in
+// NumberBindDirective, and reports whether it was accepted.
+func (d *NumberBindDirective[T]) Input(text string) bool {
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		d.apply("", d.constrain(d.config.defaultValue))
+		return true
+	}
+
+	if d.isPartial(text) {
+		d.text = text
+		d.invalid = false
+		return true
+	}
+
+	parsed, err := d.parse(text)
+	if err != nil || math.IsNaN(float64(parsed)) || math.IsInf(float64(parsed), 0) {
+		d.invalid = true
+		return false
+	}
+
+	value := d.constrain(float64(parsed))
+	if value != parsed {
+		text = d.format(value)
+	}
+	d.apply(text, value)
+	return true
+}
+
+// Invalid reports whether the last input was rejected.
+func (d *NumberBindDirective[T]) Invalid() bool {
+	return d.invalid
+}
+
+// Text returns the text to show in the input field.
+func (d *NumberBindDirective[T]) Text() string {
+	if current := d.ref.GetTyped(); current != d.value {
+		d.text = d.format(current)
+		d.value = current
+	}
+	return d.text
+}
+
+// Render returns the input field in the same format as Bind.
+func (d *NumberBindDirective[T]) Render() string {
+	var builder strings.Builder
+	builder.Grow(10 + 20)
+	builder.WriteString("[Input: ")
+	builder.WriteString(d.Text())
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// apply stores an accepted input and writes its value to the Ref.
+func (d *NumberBindDirective[T]) apply(text string, value T) {
+	d.text = text
+	d.value = value
+	d.invalid = false
+	d.ref.Set(value)
+}
+
+// isPartial reports whether text is an incomplete number still being typed.
+func (d *NumberBindDirective[T]) isPartial(text string) bool {
+	switch text {
+	case "-", "+":
+		return true
+	case ".", "-.", "+.":
+		var zero T
+		_, isFloat := any(zero).(float64)
+		return isFloat
+	}
+	return false
+}
+
+// constrain snaps value to the step, clamps it to min/max, and converts it.
+func (d *NumberBindDirective[T]) constrain(value float64) T {
+	if d.config.step > 0 {
+		base := 0.0
+		if d.config.hasMin {
+			base = d.config.min
+		}
+		value = base + math.Round((value-base)/d.config.step)*d.config.step
+		// Drop floating-point noise such as 0.30000000000000004
+		scale := math.Pow(10, float64(max(decimals(d.config.step), decimals(base))))
+		value = math.Round(value*scale) / scale
+	}
+	if d.config.hasMax && value > d.config.max {
+		value = d.config.max
+	}
+	if d.config.hasMin && value < d.config.min {
+		value = d.config.min
+	}
+
+	var zero T
+	if _, isInt := any(zero).(int); isInt {
+		value = math.Round(value)
+	}
+	return T(value)
+}
+
+// decimals returns the number of decimal places of value.
+func decimals(value float64) int {
+	text := strconv.FormatFloat(value, 'f', -1, 64)
+	if i := strings.IndexByte(text, '.'); i >= 0 {
+		return len(text) - i - 1
+	}
+	return 0
+}
@@ -0,0 +1,103 @@
+package directives
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestBindNumber_Input tests parsing, clamping, and rejection for int refs
+func TestBindNumber_Input(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []NumberBindOption
+		inputs          []string
+		expectedValue   int
+		expectedText    string
+		expectedInvalid bool
+	}{
+		{name: "parses digits", inputs: []string{"4", "42"}, expectedValue: 42, expectedText: "42"},
+		{name: "negative number", inputs: []string{"-", "-7"}, expectedValue: -7, expectedText: "-7"},
+		{name: "sign alone keeps value", inputs: []string{"5", "-"}, expectedValue: 5, expectedText: "-"},
+		{name: "clamps to max", opts: []NumberBindOption{WithMax(150)}, inputs: []string{"200"}, expectedValue: 150, expectedText: "150"},
+		{name: "clamps to min", opts: []NumberBindOption{WithMin(0)}, inputs: []string{"-3"}, expectedValue: 0, expectedText: "0"},
+		{name: "snaps to step from min", opts: []NumberBindOption{WithMin(1), WithStep(5)}, inputs: []string{"9"}, expectedValue: 11, expectedText: "11"},
+		{name: "rejects non-numeric keystroke", inputs: []string{"12", "12a"}, expectedValue: 12, expectedText: "12", expectedInvalid: true},
+		{name: "rejects decimals", inputs: []string{"1", "1."}, expectedValue: 1, expectedText: "1", expectedInvalid: true},
+		{name: "valid input clears invalid", inputs: []string{"x", "3"}, expectedValue: 3, expectedText: "3"},
+		{name: "empty maps to zero", inputs: []string{"8", ""}, expectedValue: 0, expectedText: ""},
+		{name: "empty maps to default", opts: []NumberBindOption{WithDefault(18)}, inputs: []string{"8", ""}, expectedValue: 18, expectedText: ""},
+		{name: "default is clamped", opts: []NumberBindOption{WithMin(1)}, inputs: []string{""}, expectedValue: 1, expectedText: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := bubbly.NewRef(0)
+			d := BindNumber(ref, tt.opts...)
+
+			for _, input := range tt.inputs {
+				d.Input(input)
+			}
+
+			assert.Equal(t, tt.expectedValue, ref.GetTyped())
+			assert.Equal(t, tt.expectedText, d.Text())
+			assert.Equal(t, tt.expectedInvalid, d.Invalid())
+		})
+	}
+}
+
+// TestBindFloat_Input tests parsing and snapping for float refs
+func TestBindFloat_Input(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []NumberBindOption
+		inputs          []string
+		expectedValue   float64
+		expectedText    string
+		expectedInvalid bool
+	}{
+		{name: "parses decimals", inputs: []string{"0", "0.", "0.5"}, expectedValue: 0.5, expectedText: "0.5"},
+		{name: "leading decimal point", inputs: []string{".", ".2"}, expectedValue: 0.2, expectedText: ".2"},
+		{name: "negative decimal point", inputs: []string{"-", "-.", "-.5"}, expectedValue: -0.5, expectedText: "-.5"},
+		{name: "snaps without float noise", opts: []NumberBindOption{WithStep(0.1)}, inputs: []string{"0.29"}, expectedValue: 0.3, expectedText: "0.3"},
+		{name: "clamps range", opts: []NumberBindOption{WithMin(0), WithMax(1)}, inputs: []string{"1.5"}, expectedValue: 1, expectedText: "1"},
+		{name: "rejects NaN", inputs: []string{"2", "NaN"}, expectedValue: 2, expectedText: "2", expectedInvalid: true},
+		{name: "rejects letters", inputs: []string{"2", "2x"}, expectedValue: 2, expectedText: "2", expectedInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := bubbly.NewRef(0.0)
+			d := BindFloat(ref, tt.opts...)
+
+			for _, input := range tt.inputs {
+				d.Input(input)
+			}
+
+			assert.InDelta(t, tt.expectedValue, ref.GetTyped(), 1e-12)
+			assert.Equal(t, tt.expectedText, d.Text())
+			assert.Equal(t, tt.expectedInvalid, d.Invalid())
+		})
+	}
+}
+
+// TestBindNumber_ExternalChange tests the text follows changes to the ref
+func TestBindNumber_ExternalChange(t *testing.T) {
+	ref := bubbly.NewRef(5)
+	d := BindNumber(ref)
+	assert.Equal(t, "[Input: 5]", d.Render())
+
+	d.Input("-")
+	assert.Equal(t, "[Input: -]", d.Render(), "In-progress text should render")
+
+	ref.Set(9)
+	assert.Equal(t, "[Input: 9]", d.Render())
+}
+
+// TestBindNumber_InterfaceCompliance tests it is a Directive
+func TestBindNumber_InterfaceCompliance(t *testing.T) {
+	var _ Directive = BindNumber(bubbly.NewRef(0))
+	var _ Directive = BindFloat(bubbly.NewRef(0.0))
+}
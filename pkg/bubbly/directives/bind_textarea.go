@@ -0,0 +1,352 @@
+package directives
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// textAreaBindConfig holds the settings of a text area binding.
+type textAreaBindConfig struct {
+	maxLength    int
+	renderCursor func(char string) string
+}
+
+// TextAreaOption configures BindTextArea.
+type TextAreaOption func(*textAreaBindConfig)
+
+// WithMaxLength limits the text to n characters; typing or pasting past the
+// limit is cut off. Newlines count as characters. Non-positive values mean
+// no limit, the default.
+func WithMaxLength(n int) TextAreaOption {
+	return func(c *textAreaBindConfig) {
+		c.maxLength = max(n, 0)
+	}
+}
+
+// WithCursorRender sets how the cursor is drawn. render receives the
+// character under the cursor, or " " at the end of a line. Default: the
+// character in reverse video.
+//
+// Example:
+//
+//	directives.WithCursorRender(func(char string) string {
+//	    return lipgloss.NewStyle().Underline(true).Render(char)
+//	})
+func WithCursorRender(render func(char string) string) TextAreaOption {
+	return func(c *textAreaBindConfig) {
+		if render != nil {
+			c.renderCursor = render
+		}
+	}
+}
+
+// reverseCursor draws char in reverse video.
+func reverseCursor(char string) string {
+	return "\x1b[7m" + char + "\x1b[27m"
+}
+
+// TextAreaBindDirective binds a string Ref to a multi-line text input,
+// applying key presses at a cursor and writing the edited text to the Ref.
+//
+// Like NumberBindDirective, it holds editing state (the cursor), so create
+// it once in Setup and feed it key presses with HandleKey:
+//
+//	bubbly.NewComponent("BioEditor").
+//	    WithMessageHandler(func(comp bubbly.Component, msg tea.Msg) tea.Cmd {
+//	        if key, ok := msg.(tea.KeyMsg); ok {
+//	            comp.Emit("key", key)
+//	        }
+//	        return nil
+//	    }).
+//	    Setup(func(ctx *bubbly.Context) {
+//	        bio := directives.BindTextArea(bioRef, directives.WithMaxLength(500))
+//	        ctx.Expose("bio", bio)
+//	        ctx.On("key", func(data interface{}) {
+//	            bio.HandleKey(data.(tea.KeyMsg))
+//	        })
+//	    }).
+//	    Template(func(ctx bubbly.RenderContext) string {
+//	        return ctx.Get("bio").(*directives.TextAreaBindDirective).Render()
+//	    })
+//
+// # Key Handling
+//
+//   - Characters (and pasted text) are inserted at the cursor; Enter inserts
+//     a newline.
+//   - Backspace and Delete remove the character before or under the cursor,
+//     joining lines at a line boundary.
+//   - Left and Right move across line boundaries; Up and Down move between
+//     lines, remembering the column they started from.
+//   - Home/ctrl+a and End/ctrl+e move to the start and end of the line.
+//
+// Other keys, such as Tab and Esc, are left to the caller. Columns count
+// characters (runes), not bytes. If the Ref is changed elsewhere, the text
+// is reloaded and the cursor kept where possible.
+type TextAreaBindDirective struct {
+	ref    *bubbly.Ref[string]
+	config textAreaBindConfig
+	value  string
+	lines  [][]rune
+	row    int
+	col    int
+	// goalCol is the column Up and Down aim for, -1 if unset
+	goalCol int
+}
+
+// BindTextArea creates a multi-line binding for ref. The cursor starts at
+// the end of the text.
+//
+// Parameters:
+//   - ref: The Ref to keep in sync with the text area
+//   - opts: Optional settings (WithMaxLength, WithCursorRender)
+//
+// Returns:
+//   - *TextAreaBindDirective: The binding, to keep and feed with HandleKey
+//
+// Example:
+//
+//	notes := directives.BindTextArea(notesRef)
+//	notes.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+//	row, col := notes.Cursor() // Scroll the view to keep row visible
+func BindTextArea(ref *bubbly.Ref[string], opts ...TextAreaOption) *TextAreaBindDirective {
+	config := textAreaBindConfig{renderCursor: reverseCursor}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	d := &TextAreaBindDirective{ref: ref, config: config, goalCol: -1}
+	d.load(ref.GetTyped())
+	d.row = len(d.lines) - 1
+	d.col = len(d.lines[d.row])
+	return d
+}
+
+// HandleKey applies a key press as described in TextAreaBindDirective and
+// reports whether the key was handled.
+func (d *TextAreaBindDirective) HandleKey(msg tea.KeyMsg) bool {
+	d.sync()
+
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace:
+		d.insert(msg.Runes)
+	case tea.KeyEnter:
+		d.insert([]rune{'\n'})
+	case tea.KeyBackspace:
+		d.backspace()
+	case tea.KeyDelete:
+		d.delete()
+	case tea.KeyLeft:
+		d.moveLeft()
+	case tea.KeyRight:
+		d.moveRight()
+	case tea.KeyUp:
+		d.moveVertical(-1)
+	case tea.KeyDown:
+		d.moveVertical(1)
+	case tea.KeyHome, tea.KeyCtrlA:
+		d.col, d.goalCol = 0, -1
+	case tea.KeyEnd, tea.KeyCtrlE:
+		d.col, d.goalCol = len(d.lines[d.row]), -1
+	default:
+		return false
+	}
+	return true
+}
+
+// Cursor returns the cursor's line and column, both zero-based. Use the
+// row to scroll a view of Rows lines so the cursor stays visible.
+func (d *TextAreaBindDirective) Cursor() (row, col int) {
+	d.sync()
+	return d.row, d.col
+}
+
+// SetCursor moves the cursor, clamping it to the text.
+func (d *TextAreaBindDirective) SetCursor(row, col int) {
+	d.sync()
+	d.row = min(max(row, 0), len(d.lines)-1)
+	d.col = min(max(col, 0), len(d.lines[d.row]))
+	d.goalCol = -1
+}
+
+// LineCount returns the number of lines in the text, at least 1.
+func (d *TextAreaBindDirective) LineCount() int {
+	d.sync()
+	return len(d.lines)
+}
+
+// Text returns the bound text, without a cursor.
+func (d *TextAreaBindDirective) Text() string {
+	d.sync()
+	return d.value
+}
+
+// Render returns the text with the cursor drawn in it, one line per text
+// line.
+func (d *TextAreaBindDirective) Render() string {
+	d.sync()
+
+	var builder strings.Builder
+	builder.Grow(len(d.value) + 16)
+	for i, line := range d.lines {
+		if i > 0 {
+			builder.WriteByte('\n')
+		}
+		if i != d.row {
+			builder.WriteString(string(line))
+			continue
+		}
+
+		builder.WriteString(string(line[:d.col]))
+		if d.col < len(line) {
+			builder.WriteString(d.config.renderCursor(string(line[d.col])))
+			builder.WriteString(string(line[d.col+1:]))
+		} else {
+			builder.WriteString(d.config.renderCursor(" "))
+		}
+	}
+	return builder.String()
+}
+
+// sync reloads the text if the Ref was changed elsewhere.
+func (d *TextAreaBindDirective) sync() {
+	if current := d.ref.GetTyped(); current != d.value {
+		d.load(current)
+		d.row = min(d.row, len(d.lines)-1)
+		d.col = min(d.col, len(d.lines[d.row]))
+		d.goalCol = -1
+	}
+}
+
+// load splits value into lines.
+func (d *TextAreaBindDirective) load(value string) {
+	d.value = value
+	parts := strings.Split(value, "\n")
+	d.lines = make([][]rune, len(parts))
+	for i, part := range parts {
+		d.lines[i] = []rune(part)
+	}
+}
+
+// commit writes the edited lines to the Ref.
+func (d *TextAreaBindDirective) commit() {
+	parts := make([]string, len(d.lines))
+	for i, line := range d.lines {
+		parts[i] = string(line)
+	}
+	d.value = strings.Join(parts, "\n")
+	d.goalCol = -1
+	d.ref.Set(d.value)
+}
+
+// insert inserts text at the cursor, splitting lines at newlines.
+func (d *TextAreaBindDirective) insert(text []rune) {
+	text = []rune(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(string(text)))
+	if d.config.maxLength > 0 {
+		room := max(d.config.maxLength-len([]rune(d.value)), 0)
+		text = text[:min(len(text), room)]
+	}
+	if len(text) == 0 {
+		return
+	}
+
+	line := d.lines[d.row]
+	tail := append([]rune(nil), line[d.col:]...)
+	line = line[:d.col]
+	for _, r := range text {
+		if r != '\n' {
+			line = append(line, r)
+			continue
+		}
+		d.lines[d.row] = line
+		d.lines = append(d.lines[:d.row+1], append([][]rune{nil}, d.lines[d.row+1:]...)...)
+		d.row++
+		line = nil
+	}
+	d.col = len(line)
+	d.lines[d.row] = append(line, tail...)
+	d.commit()
+}
+
+// backspace removes the character before the cursor.
+func (d *TextAreaBindDirective) backspace() {
+	switch {
+	case d.col > 0:
+		line := d.lines[d.row]
+		d.lines[d.row] = append(line[:d.col-1], line[d.col:]...)
+		d.col--
+	case d.row > 0:
+		d.col = len(d.lines[d.row-1])
+		d.lines[d.row-1] = append(d.lines[d.row-1], d.lines[d.row]...)
+		d.lines = append(d.lines[:d.row], d.lines[d.row+1:]...)
+		d.row--
+	default:
+		return
+	}
+	d.commit()
+}
+
+// delete removes the character under the cursor.
+func (d *TextAreaBindDirective) delete() {
+	line := d.lines[d.row]
+	switch {
+	case d.col < len(line):
+		d.lines[d.row] = append(line[:d.col], line[d.col+1:]...)
+	case d.row < len(d.lines)-1:
+		d.lines[d.row] = append(line, d.lines[d.row+1]...)
+		d.lines = append(d.lines[:d.row+1], d.lines[d.row+2:]...)
+	default:
+		return
+	}
+	d.commit()
+}
+
+// moveLeft moves the cursor back one character, onto the previous line at
+// the start of a line.
+func (d *TextAreaBindDirective) moveLeft() {
+	d.goalCol = -1
+	switch {
+	case d.col > 0:
+		d.col--
+	case d.row > 0:
+		d.row--
+		d.col = len(d.lines[d.row])
+	}
+}
+
+// moveRight moves the cursor forward one character, onto the next line at
+// the end of a line.
+func (d *TextAreaBindDirective) moveRight() {
+	d.goalCol = -1
+	switch {
+	case d.col < len(d.lines[d.row]):
+		d.col++
+	case d.row < len(d.lines)-1:
+		d.row++
+		d.col = 0
+	}
+}
+
+// moveVertical moves the cursor delta lines, keeping the column it started
+// from when lines are shorter. It moves to the start or end of the text at
+// the first or last line.
+func (d *TextAreaBindDirective) moveVertical(delta int) {
+	row := d.row + delta
+	if row < 0 || row >= len(d.lines) {
+		if delta < 0 {
+			d.col = 0
+		} else {
+			d.col = len(d.lines[d.row])
+		}
+		d.goalCol = -1
+		return
+	}
+
+	if d.goalCol < 0 {
+		d.goalCol = d.col
+	}
+	d.row = row
+	d.col = min(d.goalCol, len(d.lines[row]))
+}
@@ -0,0 +1,205 @@
+package directives
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// textAreaKeys returns key messages: strings are typed, key types pressed.
+func textAreaKeys(keys ...interface{}) []tea.KeyMsg {
+	msgs := make([]tea.KeyMsg, len(keys))
+	for i, key := range keys {
+		switch k := key.(type) {
+		case string:
+			msgs[i] = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		case tea.KeyType:
+			msgs[i] = tea.KeyMsg{Type: k}
+		}
+	}
+	return msgs
+}
+
+// TestBindTextArea_HandleKey tests editing and cursor movement across lines
+func TestBindTextArea_HandleKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		initial  string
+		opts     []TextAreaOption
+		start    []int // Cursor row and column before the keys; nil is the end
+		keys     []tea.KeyMsg
+		expected string
+		row, col int
+	}{
+		{
+			name:     "types at the end",
+			initial:  "hi",
+			keys:     textAreaKeys(" ", "there"),
+			expected: "hi there",
+			row:      0,
+			col:      8,
+		},
+		{
+			name:     "enter splits the line",
+			initial:  "hello world",
+			start:    []int{0, 5},
+			keys:     textAreaKeys(tea.KeyEnter),
+			expected: "hello\n world",
+			row:      1,
+			col:      0,
+		},
+		{
+			name:     "backspace joins lines",
+			initial:  "ab\ncd",
+			start:    []int{1, 0},
+			keys:     textAreaKeys(tea.KeyBackspace),
+			expected: "abcd",
+			row:      0,
+			col:      2,
+		},
+		{
+			name:     "delete joins lines",
+			initial:  "ab\ncd",
+			start:    []int{0, 2},
+			keys:     textAreaKeys(tea.KeyDelete, tea.KeyDelete),
+			expected: "abd",
+			row:      0,
+			col:      2,
+		},
+		{
+			name:     "backspace at start does nothing",
+			initial:  "ab",
+			start:    []int{0, 0},
+			keys:     textAreaKeys(tea.KeyBackspace, tea.KeyDelete),
+			expected: "b",
+			row:      0,
+			col:      0,
+		},
+		{
+			name:     "left and right cross lines",
+			initial:  "ab\ncd",
+			start:    []int{1, 0},
+			keys:     textAreaKeys(tea.KeyLeft, "!", tea.KeyRight, tea.KeyRight, "?"),
+			expected: "ab!\nc?d",
+			row:      1,
+			col:      2,
+		},
+		{
+			name:     "up and down keep the goal column",
+			initial:  "long line\nab\nanother line",
+			start:    []int{0, 7},
+			keys:     textAreaKeys(tea.KeyDown, tea.KeyDown, "|"),
+			expected: "long line\nab\nanother| line",
+			row:      2,
+			col:      8,
+		},
+		{
+			name:     "up on first line moves to start",
+			initial:  "abc\ndef",
+			start:    []int{0, 2},
+			keys:     textAreaKeys(tea.KeyUp, ">"),
+			expected: ">abc\ndef",
+			row:      0,
+			col:      1,
+		},
+		{
+			name:     "home and end",
+			initial:  "abc",
+			start:    []int{0, 1},
+			keys:     textAreaKeys(tea.KeyHome, "<", tea.KeyCtrlE, ">"),
+			expected: "<abc>",
+			row:      0,
+			col:      5,
+		},
+		{
+			name:     "paste with newlines",
+			initial:  "",
+			keys:     textAreaKeys("one\r\ntwo\nthree"),
+			expected: "one\ntwo\nthree",
+			row:      2,
+			col:      5,
+		},
+		{
+			name:     "multi-byte characters count as one column",
+			initial:  "héllo",
+			start:    []int{0, 2},
+			keys:     textAreaKeys(tea.KeyBackspace, "e"),
+			expected: "hello",
+			row:      0,
+			col:      2,
+		},
+		{
+			name:     "max length cuts insertions",
+			initial:  "abc",
+			opts:     []TextAreaOption{WithMaxLength(5)},
+			keys:     textAreaKeys("defg", tea.KeyEnter),
+			expected: "abcde",
+			row:      0,
+			col:      5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref := bubbly.NewRef(tt.initial)
+			area := BindTextArea(ref, tt.opts...)
+			if tt.start != nil {
+				area.SetCursor(tt.start[0], tt.start[1])
+			}
+			for _, key := range tt.keys {
+				assert.True(t, area.HandleKey(key))
+			}
+
+			assert.Equal(t, tt.expected, ref.GetTyped())
+			row, col := area.Cursor()
+			assert.Equal(t, tt.row, row, "row")
+			assert.Equal(t, tt.col, col, "col")
+		})
+	}
+}
+
+// TestBindTextArea_UnhandledKeys tests keys left to the caller
+func TestBindTextArea_UnhandledKeys(t *testing.T) {
+	ref := bubbly.NewRef("text")
+	area := BindTextArea(ref)
+
+	assert.False(t, area.HandleKey(tea.KeyMsg{Type: tea.KeyTab}))
+	assert.False(t, area.HandleKey(tea.KeyMsg{Type: tea.KeyEsc}))
+	assert.Equal(t, "text", ref.GetTyped())
+}
+
+// TestBindTextArea_Render tests the cursor is drawn on its line
+func TestBindTextArea_Render(t *testing.T) {
+	bracket := WithCursorRender(func(char string) string { return "[" + char + "]" })
+
+	ref := bubbly.NewRef("ab\ncd")
+	area := BindTextArea(ref, bracket)
+	assert.Equal(t, "ab\ncd[ ]", area.Render())
+
+	area.SetCursor(0, 1)
+	assert.Equal(t, "a[b]\ncd", area.Render())
+	assert.Equal(t, "ab\ncd", area.Text())
+	assert.Equal(t, 2, area.LineCount())
+
+	defaultCursor := BindTextArea(bubbly.NewRef("x"))
+	defaultCursor.SetCursor(0, 0)
+	assert.Equal(t, "\x1b[7mx\x1b[27m", defaultCursor.Render())
+}
+
+// TestBindTextArea_ExternalChange tests the text reloads when the Ref changes elsewhere
+func TestBindTextArea_ExternalChange(t *testing.T) {
+	ref := bubbly.NewRef("first line\nsecond line")
+	area := BindTextArea(ref)
+
+	ref.Set("short")
+	row, col := area.Cursor()
+	assert.Equal(t, 0, row)
+	assert.Equal(t, 5, col)
+
+	area.HandleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("!")})
+	assert.Equal(t, "short!", ref.GetTyped())
+	assert.Equal(t, 1, area.LineCount())
+}
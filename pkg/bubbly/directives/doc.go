@@ -11,9 +11,17 @@
 // BubblyUI provides five core directive types:
 //
 //   - If/Show: Conditional rendering and visibility control
+//   - Switch: Multi-branch rendering on a value (Case/Default)
 //   - ForEach: Type-safe list iteration with generics
+//   - ForEachMap/ForEachN: Iteration over maps (in sorted key order) and counts
+//   - ForEachKeyed: Keyed list iteration that re-renders only changed items
+//   - ForEachWindow: Windowed list iteration that renders only visible items
 //   - Bind: Two-way data binding for inputs with type safety
-//   - On: Declarative event handling with modifiers
+//   - BindNumber/BindFloat: Numeric inputs with min/max/step clamping
+//   - BindTextArea: Multi-line text editing with a cursor
+//   - BindMasked: Inputs formatted by a mask, such as phone numbers and dates
+//   - On: Declarative event handling with modifiers (including Debounce and
+//     Throttle), and OnKey for key handlers
 //
 // # Type Safety
 //
@@ -53,6 +53,10 @@ import (
 //	ForEach([]string{}, renderFunc).Render() // Returns: ""
 //	ForEach(nil, renderFunc).Render()        // Returns: ""
 //
+// Use Empty to render a fallback instead:
+//
+//	ForEach(nil, renderFunc).Empty(func() string { return "No items" }).Render()
+//
 // # Type Safety
 //
 // The directive uses Go generics to ensure type safety at compile time. The item
@@ -90,6 +94,8 @@ import (
 type ForEachDirective[T any] struct {
 	items      []T
 	renderItem func(T, int) string
+	separator  string
+	empty      func() string
 }
 
 // ForEach creates a new iteration directive for the given slice.
@@ -136,17 +142,45 @@ func ForEach[T any](items []T, render func(T, int) string) *ForEachDirective[T]
 	}
 }
 
+// Separator sets a string placed between rendered items, such as "\n", so
+// render functions don't need to append one themselves. The separator only
+// appears between items, never before the first or after the last.
+//
+// Example:
+//
+//	ForEach(items, func(item string, index int) string {
+//	    return "• " + item
+//	}).Separator("\n").Render()
+//	// Output: "• Apple\n• Banana"
+func (d *ForEachDirective[T]) Separator(sep string) *ForEachDirective[T] {
+	d.separator = sep
+	return d
+}
+
+// Empty sets a fallback rendered instead of the items when the slice is nil
+// or empty, such as a "No results" message.
+//
+// Example:
+//
+//	ForEach(results, renderResult).
+//	    Empty(func() string { return "No matches" }).
+//	    Render()
+func (d *ForEachDirective[T]) Empty(fallback func() string) *ForEachDirective[T] {
+	d.empty = fallback
+	return d
+}
+
 // Render executes the directive logic and returns the resulting string output.
 //
 // This method iterates over the items slice and calls the render function for
 // each item, collecting the results and joining them into a single string.
 //
 // Behavior:
-//  1. If items is nil or empty, return empty string immediately
+//  1. If items is nil or empty, return the Empty fallback (or empty string)
 //  2. Pre-allocate output slice with capacity equal to number of items
 //  3. For each item, call render function with item and index
 //  4. Collect all rendered strings
-//  5. Join all strings and return the result
+//  5. Join all strings with the Separator (if any) and return the result
 //
 // Returns:
 //   - string: The concatenated output from all render function calls, or empty string
@@ -187,6 +221,9 @@ func ForEach[T any](items []T, render func(T, int) string) *ForEachDirective[T]
 func (d *ForEachDirective[T]) Render() string {
 	// Handle empty or nil slices
 	if len(d.items) == 0 {
+		if d.empty != nil {
+			return d.empty()
+		}
 		return ""
 	}
 
@@ -200,8 +237,8 @@ func (d *ForEachDirective[T]) Render() string {
 	}
 
 	// Join all rendered strings
-	// strings.Join is optimized in the standard library
-	return strings.Join(output, "")
+	// strings.Join sizes its strings.Builder up front, separators included
+	return strings.Join(output, d.separator)
 }
 
 // safeExecute wraps renderItem function execution with panic recovery.
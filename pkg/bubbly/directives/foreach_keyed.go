@@ -0,0 +1,200 @@
+package directives
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// ForEachKeyedDirective renders a list like ForEach, but caches each item's
+// output by key and only re-renders items that are new, changed, or moved.
+//
+// Unlike the other directives, a keyed directive is long-lived: create it
+// once in Setup and pass the current items to Update on every render, so
+// the cache survives between renders. When one row of a large list changes,
+// only that row's render function runs.
+//
+// # Usage
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    rows := directives.ForEachKeyed([]Todo(nil),
+//	        func(todo Todo) string { return todo.ID },
+//	        func(todo Todo, i int) string { return renderExpensiveRow(todo, i) },
+//	    )
+//	    ctx.Expose("rows", rows)
+//	}).
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    rows := ctx.Get("rows").(*directives.ForEachKeyedDirective[Todo])
+//	    return rows.Update(todos.GetTyped()).Render()
+//	})
+//
+// # Change Detection
+//
+// An item is re-rendered when its key was not rendered last time, when its
+// index changed (render receives the index), or when it differs from the
+// cached item. Items are compared with reflect.DeepEqual unless a
+// comparator is set with WithEqual.
+//
+// # Memory
+//
+// The cache holds exactly the keys rendered last time; keys no longer in the
+// slice are evicted on every Render, so memory does not grow as the list
+// churns.
+//
+// # Duplicate Keys
+//
+// Keys should be unique. Items whose key already appeared earlier in the
+// slice are rendered every time and not cached.
+//
+// # Thread Safety
+//
+// Update and Render are safe for concurrent use, but the pair is not atomic:
+// render from one goroutine (the template) as usual.
+type ForEachKeyedDirective[T any] struct {
+	mu         sync.Mutex
+	items      []T
+	keyFn      func(T) string
+	renderItem func(T, int) string
+	equal      func(a, b T) bool
+	cache      map[string]keyedRender[T]
+}
+
+// keyedRender is the cached output of one item.
+type keyedRender[T any] struct {
+	item   T
+	index  int
+	output string
+}
+
+// ForEachKeyed creates a keyed iteration directive with an empty cache.
+//
+// Parameters:
+//   - items: The initial items (may be nil; see Update)
+//   - keyFn: Returns a stable, unique key for an item (e.g., its ID)
+//   - render: Renders an item at an index, like ForEach
+//
+// Returns:
+//   - *ForEachKeyedDirective[T]: A directive to keep and re-render
+//
+// Example:
+//
+//	rows := ForEachKeyed(users,
+//	    func(u User) string { return u.ID },
+//	    func(u User, i int) string { return fmt.Sprintf("%d. %s\n", i+1, u.Name) },
+//	)
+//	output := rows.Render()
+func ForEachKeyed[T any](items []T, keyFn func(T) string, render func(T, int) string) *ForEachKeyedDirective[T] {
+	return &ForEachKeyedDirective[T]{
+		items:      items,
+		keyFn:      keyFn,
+		renderItem: render,
+		cache:      make(map[string]keyedRender[T]),
+	}
+}
+
+// WithEqual sets the comparator deciding whether an item changed since it
+// was last rendered, replacing reflect.DeepEqual. Use it for items holding
+// functions or for cheaper comparisons (e.g., by a version field).
+//
+// Example:
+//
+//	rows.WithEqual(func(a, b Todo) bool { return a.Version == b.Version })
+func (d *ForEachKeyedDirective[T]) WithEqual(equal func(a, b T) bool) *ForEachKeyedDirective[T] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.equal = equal
+	return d
+}
+
+// Update sets the items for the next Render, keeping the cache.
+func (d *ForEachKeyedDirective[T]) Update(items []T) *ForEachKeyedDirective[T] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.items = items
+	return d
+}
+
+// Render renders the items, reusing cached output for unchanged items, and
+// evicts cached keys no longer present.
+//
+// A render function that panics produces an empty string for that item
+// (reported via observability, like ForEach) and is retried next Render.
+func (d *ForEachKeyedDirective[T]) Render() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.items) == 0 {
+		clear(d.cache)
+		return ""
+	}
+
+	output := make([]string, len(d.items))
+	next := make(map[string]keyedRender[T], len(d.items))
+
+	for i, item := range d.items {
+		key := d.keyFn(item)
+		if _, duplicate := next[key]; duplicate {
+			output[i], _ = d.safeExecute(item, i)
+			continue
+		}
+
+		if cached, ok := d.cache[key]; ok && cached.index == i && d.unchanged(cached.item, item) {
+			output[i] = cached.output
+			next[key] = cached
+			continue
+		}
+
+		rendered, ok := d.safeExecute(item, i)
+		output[i] = rendered
+		if ok {
+			next[key] = keyedRender[T]{item: item, index: i, output: rendered}
+		}
+	}
+
+	d.cache = next
+	return strings.Join(output, "")
+}
+
+// unchanged reports whether item equals the cached item.
+func (d *ForEachKeyedDirective[T]) unchanged(cached, item T) bool {
+	if d.equal != nil {
+		return d.equal(cached, item)
+	}
+	return reflect.DeepEqual(cached, item)
+}
+
+// safeExecute wraps renderItem function execution with panic recovery.
+// It reports false if the render function panicked.
+func (d *ForEachKeyedDirective[T]) safeExecute(item T, index int) (output string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if reporter := observability.GetErrorReporter(); reporter != nil {
+				err := fmt.Errorf("%w: ForEachKeyed directive renderItem panicked at index %d: %v", ErrRenderPanic, index, r)
+				ctx := &observability.ErrorContext{
+					ComponentName: "ForEachKeyed",
+					Timestamp:     time.Now(),
+					StackTrace:    debug.Stack(),
+					Tags: map[string]string{
+						"directive_type": "ForEachKeyed",
+						"error_type":     "render_panic",
+						"item_index":     fmt.Sprintf("%d", index),
+					},
+					Extra: map[string]interface{}{
+						"panic_value": r,
+						"index":       index,
+						"total_items": len(d.items),
+					},
+				}
+				reporter.ReportError(err, ctx)
+			}
+		}
+	}()
+	return d.renderItem(item, index), true
+}
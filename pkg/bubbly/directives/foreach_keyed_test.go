@@ -0,0 +1,137 @@
+package directives
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+type keyedRow struct {
+	ID    string
+	Title string
+}
+
+// countingRender renders rows and records how often each ID was rendered.
+func countingRender(calls map[string]int) func(keyedRow, int) string {
+	return func(row keyedRow, index int) string {
+		calls[row.ID]++
+		return fmt.Sprintf("%d:%s;", index, row.Title)
+	}
+}
+
+func keyedRowID(row keyedRow) string { return row.ID }
+
+// TestForEachKeyedDirective_RerendersOnlyChanges tests which items re-render
+func TestForEachKeyedDirective_RerendersOnlyChanges(t *testing.T) {
+	initial := []keyedRow{{ID: "a", Title: "A"}, {ID: "b", Title: "B"}, {ID: "c", Title: "C"}}
+
+	tests := []struct {
+		name          string
+		next          []keyedRow
+		expected      string
+		expectedCalls map[string]int
+	}{
+		{
+			name:          "unchanged list renders nothing",
+			next:          initial,
+			expected:      "0:A;1:B;2:C;",
+			expectedCalls: map[string]int{},
+		},
+		{
+			name:          "changed item",
+			next:          []keyedRow{{ID: "a", Title: "A"}, {ID: "b", Title: "B2"}, {ID: "c", Title: "C"}},
+			expected:      "0:A;1:B2;2:C;",
+			expectedCalls: map[string]int{"b": 1},
+		},
+		{
+			name:          "appended item",
+			next:          append(append([]keyedRow(nil), initial...), keyedRow{ID: "d", Title: "D"}),
+			expected:      "0:A;1:B;2:C;3:D;",
+			expectedCalls: map[string]int{"d": 1},
+		},
+		{
+			name:          "moved items re-render with their new index",
+			next:          []keyedRow{{ID: "b", Title: "B"}, {ID: "a", Title: "A"}, {ID: "c", Title: "C"}},
+			expected:      "0:B;1:A;2:C;",
+			expectedCalls: map[string]int{"a": 1, "b": 1},
+		},
+		{
+			name:          "duplicate keys always render",
+			next:          []keyedRow{{ID: "a", Title: "A"}, {ID: "a", Title: "A again"}, {ID: "c", Title: "C"}},
+			expected:      "0:A;1:A again;2:C;",
+			expectedCalls: map[string]int{"a": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := map[string]int{}
+			rows := ForEachKeyed(initial, keyedRowID, countingRender(calls))
+			rows.Render()
+			clear(calls)
+
+			result := rows.Update(tt.next).Render()
+
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+// TestForEachKeyedDirective_EvictsRemovedKeys tests the cache only holds present keys
+func TestForEachKeyedDirective_EvictsRemovedKeys(t *testing.T) {
+	calls := map[string]int{}
+	rows := ForEachKeyed([]keyedRow{{ID: "a"}, {ID: "b"}, {ID: "c"}}, keyedRowID, countingRender(calls))
+	rows.Render()
+
+	rows.Update([]keyedRow{{ID: "a"}}).Render()
+	assert.Len(t, rows.cache, 1)
+
+	rows.Update(nil).Render()
+	assert.Empty(t, rows.cache)
+
+	rows.Update([]keyedRow{{ID: "a"}}).Render()
+	assert.Equal(t, 2, calls["a"], "Evicted keys should render again")
+}
+
+// TestForEachKeyedDirective_WithEqual tests a custom comparator decides changes
+func TestForEachKeyedDirective_WithEqual(t *testing.T) {
+	calls := map[string]int{}
+	rows := ForEachKeyed([]keyedRow{{ID: "a", Title: "A"}}, keyedRowID, countingRender(calls)).
+		WithEqual(func(a, b keyedRow) bool { return a.ID == b.ID })
+	rows.Render()
+
+	result := rows.Update([]keyedRow{{ID: "a", Title: "ignored"}}).Render()
+
+	assert.Equal(t, "0:A;", result, "Comparator said unchanged, so cached output is reused")
+	assert.Equal(t, 1, calls["a"])
+}
+
+// TestForEachKeyedDirective_PanicRecovery tests panicking items are not cached
+func TestForEachKeyedDirective_PanicRecovery(t *testing.T) {
+	reporter := &mockReporter{}
+	observability.SetErrorReporter(reporter)
+	defer observability.SetErrorReporter(nil)
+
+	fail := true
+	rows := ForEachKeyed([]string{"A", "B"}, func(s string) string { return s }, func(item string, _ int) string {
+		if item == "B" && fail {
+			panic("render failed")
+		}
+		return item
+	})
+
+	assert.Equal(t, "A", rows.Render())
+	assert.Equal(t, 1, reporter.getErrorCallCount())
+
+	fail = false
+	assert.Equal(t, "AB", rows.Render(), "Failed items should be retried")
+}
+
+// TestForEachKeyedDirective_InterfaceCompliance tests it is a Directive
+func TestForEachKeyedDirective_InterfaceCompliance(t *testing.T) {
+	var _ Directive = ForEachKeyed([]string{}, func(s string) string { return s }, func(s string, _ int) string { return s })
+}
@@ -0,0 +1,109 @@
+package directives
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// ForEachMap creates an iteration directive over the entries of a map.
+//
+// It returns the same *ForEachDirective as ForEach, iterating over the map's
+// keys, so Render and panic recovery behave identically. The index passed
+// to the directive is the key's position in the iteration order.
+//
+// # Ordering Guarantee
+//
+// Go randomizes map iteration order, which would make the output change
+// between renders. ForEachMap instead iterates in a deterministic order:
+//
+//   - Keys of an ordered kind (integers, floats, and strings, including named
+//     types such as `type Status string`) are sorted ascending.
+//   - Other keys (structs, pointers, bools, ...) are sorted by their
+//     fmt "%v" representation, so a given set of keys always renders in the
+//     same order. Go maps do not record insertion order, so it cannot be used.
+//
+// The entries are captured when ForEachMap is called; later changes to the
+// map are not seen by the directive.
+//
+// Parameters:
+//   - m: The map to iterate over (can be nil or empty)
+//   - render: Function to call for each entry, receives (key K, value V)
+//
+// Returns:
+//   - *ForEachDirective[K]: A ForEach directive over the sorted keys
+//
+// Example:
+//
+//	scores := map[string]int{"bob": 7, "alice": 9}
+//	ForEachMap(scores, func(name string, score int) string {
+//	    return fmt.Sprintf("%s: %d\n", name, score)
+//	}).Render()
+//	// Output:
+//	// alice: 9
+//	// bob: 7
+func ForEachMap[K comparable, V any](m map[K]V, render func(K, V) string) *ForEachDirective[K] {
+	keys := make([]K, 0, len(m))
+	values := make(map[K]V, len(m))
+	for key, value := range m {
+		keys = append(keys, key)
+		values[key] = value
+	}
+	sortMapKeys(keys)
+
+	return ForEach(keys, func(key K, _ int) string {
+		return render(key, values[key])
+	})
+}
+
+// ForEachN creates an iteration directive that renders the indices 0 to n-1,
+// for simple counts such as rating stars or placeholder rows. It returns the
+// same *ForEachDirective as ForEach; n <= 0 renders nothing.
+//
+// Example:
+//
+//	ForEachN(3, func(i int) string {
+//	    return "★"
+//	}).Render()
+//	// Output: ★★★
+func ForEachN(n int, render func(i int) string) *ForEachDirective[int] {
+	indices := make([]int, max(n, 0))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	return ForEach(indices, func(i int, _ int) string {
+		return render(i)
+	})
+}
+
+// sortMapKeys sorts keys in the order documented by ForEachMap.
+func sortMapKeys[K comparable](keys []K) {
+	if len(keys) < 2 {
+		return
+	}
+
+	switch reflect.TypeFor[K]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		slices.SortFunc(keys, func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int())
+		})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		slices.SortFunc(keys, func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint())
+		})
+	case reflect.Float32, reflect.Float64:
+		slices.SortFunc(keys, func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float())
+		})
+	case reflect.String:
+		slices.SortFunc(keys, func(a, b K) int {
+			return cmp.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		})
+	default:
+		slices.SortStableFunc(keys, func(a, b K) int {
+			return cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+		})
+	}
+}
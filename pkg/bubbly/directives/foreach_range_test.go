@@ -0,0 +1,140 @@
+package directives
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+type mapStatus string
+
+type mapPoint struct {
+	X, Y int
+}
+
+// TestForEachMap_Ordering tests map entries render in a deterministic order
+func TestForEachMap_Ordering(t *testing.T) {
+	tests := []struct {
+		name     string
+		render   func() string
+		expected string
+	}{
+		{
+			name: "string keys sorted",
+			render: func() string {
+				m := map[string]int{"cherry": 3, "apple": 1, "banana": 2}
+				return ForEachMap(m, func(k string, v int) string { return fmt.Sprintf("%s=%d;", k, v) }).Render()
+			},
+			expected: "apple=1;banana=2;cherry=3;",
+		},
+		{
+			name: "int keys sorted numerically",
+			render: func() string {
+				m := map[int]string{10: "ten", -1: "minus one", 2: "two"}
+				return ForEachMap(m, func(k int, v string) string { return fmt.Sprintf("%d=%s;", k, v) }).Render()
+			},
+			expected: "-1=minus one;2=two;10=ten;",
+		},
+		{
+			name: "uint and float keys sorted",
+			render: func() string {
+				m := map[float64]uint{2.5: 2, 0.5: 0, 1.5: 1}
+				return ForEachMap(m, func(k float64, v uint) string { return fmt.Sprintf("%d", v) }).Render()
+			},
+			expected: "012",
+		},
+		{
+			name: "named string keys sorted",
+			render: func() string {
+				m := map[mapStatus]bool{"open": true, "closed": false}
+				return ForEachMap(m, func(k mapStatus, _ bool) string { return string(k) + ";" }).Render()
+			},
+			expected: "closed;open;",
+		},
+		{
+			name: "struct keys sorted by representation",
+			render: func() string {
+				m := map[mapPoint]string{{2, 0}: "c", {1, 5}: "b", {1, 0}: "a"}
+				return ForEachMap(m, func(_ mapPoint, v string) string { return v }).Render()
+			},
+			expected: "abc",
+		},
+		{
+			name: "interface keys of mixed kinds",
+			render: func() string {
+				m := map[any]string{"b": "2", 1: "1", "a": "3"}
+				return ForEachMap(m, func(_ any, v string) string { return v }).Render()
+			},
+			expected: "132",
+		},
+		{
+			name: "nil map",
+			render: func() string {
+				var m map[string]int
+				return ForEachMap(m, func(k string, v int) string { return k }).Render()
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Render repeatedly to catch map iteration order leaking through
+			for range 10 {
+				assert.Equal(t, tt.expected, tt.render())
+			}
+		})
+	}
+}
+
+// TestForEachMap_SnapshotsEntries tests later map changes are not seen
+func TestForEachMap_SnapshotsEntries(t *testing.T) {
+	m := map[string]int{"a": 1}
+	d := ForEachMap(m, func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) })
+
+	m["a"] = 2
+	delete(m, "a")
+
+	assert.Equal(t, "a=1", d.Render())
+}
+
+// TestForEachN tests rendering of integer ranges
+func TestForEachN(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{name: "three", n: 3, expected: "012"},
+		{name: "one", n: 1, expected: "0"},
+		{name: "zero", n: 0, expected: ""},
+		{name: "negative", n: -2, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ForEachN(tt.n, func(i int) string { return fmt.Sprintf("%d", i) }).Render()
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// TestForEachN_PanicRecovery tests ForEachN shares ForEach's panic recovery
+func TestForEachN_PanicRecovery(t *testing.T) {
+	reporter := &mockReporter{}
+	observability.SetErrorReporter(reporter)
+	defer observability.SetErrorReporter(nil)
+
+	result := ForEachN(3, func(i int) string {
+		if i == 1 {
+			panic("boom")
+		}
+		return fmt.Sprintf("%d", i)
+	}).Render()
+
+	assert.Equal(t, "02", result)
+	assert.Equal(t, 1, reporter.getErrorCallCount())
+}
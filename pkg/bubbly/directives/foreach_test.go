@@ -352,6 +352,54 @@ func TestForEachDirective_IndexUsage(t *testing.T) {
 	assert.Equal(t, "[0][1][2][3][4]", result)
 }
 
+// TestForEachDirective_Separator tests separators appear only between items
+func TestForEachDirective_Separator(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     []string
+		separator string
+		expected  string
+	}{
+		{name: "newline", items: []string{"a", "b", "c"}, separator: "\n", expected: "a\nb\nc"},
+		{name: "multi-char", items: []string{"a", "b"}, separator: ", ", expected: "a, b"},
+		{name: "single item has no separator", items: []string{"a"}, separator: "\n", expected: "a"},
+		{name: "empty separator concatenates", items: []string{"a", "b"}, separator: "", expected: "ab"},
+		{name: "empty slice", items: []string{}, separator: "\n", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ForEach(tt.items, func(item string, index int) string {
+				return item
+			}).Separator(tt.separator).Render()
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// TestForEachDirective_Empty tests the fallback for empty slices
+func TestForEachDirective_Empty(t *testing.T) {
+	fallback := func() string { return "No items" }
+	render := func(item string, index int) string { return item }
+
+	tests := []struct {
+		name     string
+		items    []string
+		expected string
+	}{
+		{name: "nil slice", items: nil, expected: "No items"},
+		{name: "empty slice", items: []string{}, expected: "No items"},
+		{name: "non-empty slice ignores fallback", items: []string{"a", "b"}, expected: "a|b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ForEach(tt.items, render).Separator("|").Empty(fallback).Render()
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 // ============================================================================
 // Benchmark Tests
 // ============================================================================
@@ -416,6 +464,26 @@ func BenchmarkForEach1000Items(b *testing.B) {
 	}
 }
 
+// BenchmarkForEach1000ItemsSeparator benchmarks ForEach with a separator
+// Target: < 10ms
+func BenchmarkForEach1000ItemsSeparator(b *testing.B) {
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i
+	}
+
+	render := func(item int, index int) string {
+		return fmt.Sprintf("%d:%d", index, item)
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = ForEach(items, render).Separator("\n").Render()
+	}
+}
+
 // BenchmarkForEachString benchmarks ForEach with string concatenation
 func BenchmarkForEachString(b *testing.B) {
 	items := make([]string, 100)
@@ -0,0 +1,235 @@
+package directives
+
+import (
+	"fmt"
+	"math"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// DefaultOverscan is the Window.Overscan used when it is left at zero.
+const DefaultOverscan = 3
+
+// Window is the visible part of a list rendered with ForEachWindow.
+type Window struct {
+	// Offset is the index of the first visible item (the scroll position).
+	Offset int
+	// Height is the number of visible lines.
+	Height int
+	// Overscan is how many items beyond each edge of the window are rendered
+	// to measure their height, so estimated totals stay steady while
+	// scrolling. Zero means DefaultOverscan; negative disables it. Overscan
+	// items are never part of the output, and are skipped when ItemHeight
+	// is set.
+	Overscan int
+	// ItemHeight is the number of lines every item renders. Setting it
+	// enables the fast path: nothing is measured and WindowInfo is exact.
+	// Zero means items vary in height and are measured.
+	ItemHeight int
+}
+
+// WindowInfo describes what ForEachWindow rendered, for drawing a scrollbar.
+type WindowInfo struct {
+	// Start and End are the rendered item indexes, End exclusive.
+	Start, End int
+	// Total is the number of items in the list.
+	Total int
+	// OffsetLines is the height of the items above the window.
+	OffsetLines int
+	// TotalLines is the height of the whole list.
+	TotalLines int
+	// Exact reports whether OffsetLines and TotalLines are exact (fixed
+	// ItemHeight) or estimated from the average height of measured items.
+	Exact bool
+}
+
+// Thumb returns the position and size of a scrollbar thumb on a track of
+// trackHeight cells. The thumb is at least one cell.
+//
+// Example:
+//
+//	pos, size := info.Thumb(viewport.Height)
+//	for i := 0; i < viewport.Height; i++ {
+//	    if i >= pos && i < pos+size { bar[i] = "┃" } else { bar[i] = "│" }
+//	}
+func (i WindowInfo) Thumb(trackHeight int) (pos, size int) {
+	if trackHeight <= 0 {
+		return 0, 0
+	}
+	if i.TotalLines <= trackHeight {
+		return 0, trackHeight
+	}
+
+	size = max(trackHeight*trackHeight/i.TotalLines, 1)
+	pos = i.OffsetLines * (trackHeight - size) / (i.TotalLines - trackHeight)
+	return min(pos, trackHeight-size), size
+}
+
+// ForEachWindowDirective renders only the visible window of a list.
+// See ForEachWindow.
+type ForEachWindowDirective[T any] struct {
+	items      []T
+	viewport   Window
+	renderItem func(T, int) string
+
+	rendered bool
+	output   string
+	info     WindowInfo
+}
+
+// ForEachWindow creates an iteration directive that renders only the items
+// visible in viewport, so a list of 10,000 items costs no more to render
+// than the handful on screen.
+//
+// Rendering starts at item viewport.Offset and stops once viewport.Height
+// lines are filled; an item that does not fit completely is cut at the
+// bottom. An item may render several lines, and a trailing newline is
+// ignored. Info reports the rendered range and the list's total height for
+// a scrollbar.
+//
+// Set viewport.ItemHeight when every item renders the same number of
+// lines: items are then not measured and the heights in Info are exact.
+// Otherwise the heights are estimated from the items measured in this
+// render (visible ones plus viewport.Overscan on each side).
+//
+// Like ForEach, panics in render are recovered and reported, and the item
+// renders empty.
+//
+// Example:
+//
+//	rows := directives.ForEachWindow(logs, directives.Window{
+//	    Offset: scroll.GetTyped(),
+//	    Height: 20,
+//	    ItemHeight: 1,
+//	}, func(entry LogEntry, i int) string {
+//	    return entry.String()
+//	})
+//	body := rows.Render()
+//	pos, size := rows.Info().Thumb(20)
+func ForEachWindow[T any](items []T, viewport Window, render func(T, int) string) *ForEachWindowDirective[T] {
+	return &ForEachWindowDirective[T]{
+		items:      items,
+		viewport:   viewport,
+		renderItem: render,
+	}
+}
+
+// Render returns the visible lines, at most viewport.Height of them.
+func (d *ForEachWindowDirective[T]) Render() string {
+	if !d.rendered {
+		d.output, d.info = d.renderWindow()
+		d.rendered = true
+	}
+	return d.output
+}
+
+// Info describes the rendered window, rendering it first if needed.
+func (d *ForEachWindowDirective[T]) Info() WindowInfo {
+	d.Render()
+	return d.info
+}
+
+// renderWindow renders the visible items and measures the list.
+func (d *ForEachWindowDirective[T]) renderWindow() (string, WindowInfo) {
+	total := len(d.items)
+	start := min(max(d.viewport.Offset, 0), max(total-1, 0))
+	info := WindowInfo{Start: start, End: start, Total: total}
+
+	if d.viewport.ItemHeight > 0 {
+		return d.renderFixed(info)
+	}
+
+	var lines []string
+	measuredLines, measuredItems := 0, 0
+	for i := start; i < total && len(lines) < d.viewport.Height; i++ {
+		itemLines := d.itemLines(i)
+		lines = append(lines, itemLines...)
+		measuredLines += len(itemLines)
+		measuredItems++
+		info.End = i + 1
+	}
+
+	overscan := d.viewport.Overscan
+	if overscan == 0 {
+		overscan = DefaultOverscan
+	}
+	for i := start - 1; i >= max(start-overscan, 0); i-- {
+		measuredLines += len(d.itemLines(i))
+		measuredItems++
+	}
+	for i := info.End; i < min(info.End+overscan, total); i++ {
+		measuredLines += len(d.itemLines(i))
+		measuredItems++
+	}
+
+	if measuredItems > 0 {
+		average := float64(measuredLines) / float64(measuredItems)
+		info.OffsetLines = int(math.Round(average * float64(start)))
+		info.TotalLines = int(math.Round(average * float64(total)))
+	}
+	info.Exact = measuredItems == total
+
+	if len(lines) > d.viewport.Height {
+		lines = lines[:max(d.viewport.Height, 0)]
+	}
+	return strings.Join(lines, "\n"), info
+}
+
+// renderFixed renders the visible items without measuring them.
+func (d *ForEachWindowDirective[T]) renderFixed(info WindowInfo) (string, WindowInfo) {
+	height := d.viewport.ItemHeight
+	visible := (max(d.viewport.Height, 0) + height - 1) / height
+	info.End = min(info.Start+visible, info.Total)
+	info.OffsetLines = info.Start * height
+	info.TotalLines = info.Total * height
+	info.Exact = true
+
+	outputs := make([]string, 0, info.End-info.Start)
+	for i := info.Start; i < info.End; i++ {
+		outputs = append(outputs, strings.TrimSuffix(d.safeExecute(d.items[i], i), "\n"))
+	}
+	output := strings.Join(outputs, "\n")
+
+	// Only the last item can overflow the window
+	if info.End-info.Start > 0 && (info.End-info.Start)*height > d.viewport.Height {
+		lines := strings.Split(output, "\n")
+		output = strings.Join(lines[:min(d.viewport.Height, len(lines))], "\n")
+	}
+	return output, info
+}
+
+// itemLines renders item i and splits it into lines.
+func (d *ForEachWindowDirective[T]) itemLines(i int) []string {
+	return strings.Split(strings.TrimSuffix(d.safeExecute(d.items[i], i), "\n"), "\n")
+}
+
+// safeExecute wraps renderItem function execution with panic recovery.
+func (d *ForEachWindowDirective[T]) safeExecute(item T, index int) string {
+	defer func() {
+		if r := recover(); r != nil {
+			if reporter := observability.GetErrorReporter(); reporter != nil {
+				err := fmt.Errorf("%w: ForEachWindow directive renderItem panicked at index %d: %v", ErrRenderPanic, index, r)
+				ctx := &observability.ErrorContext{
+					ComponentName: "ForEachWindow",
+					Timestamp:     time.Now(),
+					StackTrace:    debug.Stack(),
+					Tags: map[string]string{
+						"directive_type": "ForEachWindow",
+						"error_type":     "render_panic",
+						"item_index":     fmt.Sprintf("%d", index),
+					},
+					Extra: map[string]interface{}{
+						"panic_value": r,
+						"index":       index,
+						"total_items": len(d.items),
+					},
+				}
+				reporter.ReportError(err, ctx)
+			}
+		}
+	}()
+	return d.renderItem(item, index)
+}
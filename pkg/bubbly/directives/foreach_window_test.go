@@ -0,0 +1,156 @@
+package directives
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// windowTestItems returns n items, each rendering lines lines.
+func windowTestItems(n, lines int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = lines
+	}
+	return items
+}
+
+// windowTestRender renders item i as lines "i.0", "i.1", ...
+func windowTestRender(lines int, i int) string {
+	out := make([]string, lines)
+	for l := range out {
+		out[l] = fmt.Sprintf("%d.%d", i, l)
+	}
+	return strings.Join(out, "\n") + "\n"
+}
+
+// TestForEachWindow_Render tests the visible window and its metadata
+func TestForEachWindow_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		viewport Window
+		expected string
+		info     WindowInfo
+		calls    int
+	}{
+		{
+			name:     "fixed height renders only visible items",
+			items:    windowTestItems(1000, 1),
+			viewport: Window{Offset: 10, Height: 3, ItemHeight: 1},
+			expected: "10.0\n11.0\n12.0",
+			info:     WindowInfo{Start: 10, End: 13, Total: 1000, OffsetLines: 10, TotalLines: 1000, Exact: true},
+			calls:    3,
+		},
+		{
+			name:     "fixed height cuts the last item",
+			items:    windowTestItems(10, 2),
+			viewport: Window{Offset: 1, Height: 3, ItemHeight: 2},
+			expected: "1.0\n1.1\n2.0",
+			info:     WindowInfo{Start: 1, End: 3, Total: 10, OffsetLines: 2, TotalLines: 20, Exact: true},
+			calls:    2,
+		},
+		{
+			name:     "offset clamped to the last item",
+			items:    windowTestItems(5, 1),
+			viewport: Window{Offset: 50, Height: 3, ItemHeight: 1},
+			expected: "4.0",
+			info:     WindowInfo{Start: 4, End: 5, Total: 5, OffsetLines: 4, TotalLines: 5, Exact: true},
+			calls:    1,
+		},
+		{
+			name:     "empty list",
+			items:    nil,
+			viewport: Window{Height: 3},
+			expected: "",
+			info:     WindowInfo{Exact: true},
+		},
+		{
+			name:     "variable height estimated from measured items",
+			items:    windowTestItems(20, 2),
+			viewport: Window{Offset: 4, Height: 5, Overscan: 1},
+			expected: "4.0\n4.1\n5.0\n5.1\n6.0",
+			info:     WindowInfo{Start: 4, End: 7, Total: 20, OffsetLines: 8, TotalLines: 40},
+			calls:    5, // 3 visible, 1 overscan on each side
+		},
+		{
+			name:     "variable height mixed sizes",
+			items:    []int{1, 3, 1, 2, 1, 1, 1, 1, 1, 1},
+			viewport: Window{Offset: 1, Height: 4, Overscan: -1},
+			expected: "1.0\n1.1\n1.2\n2.0",
+			info:     WindowInfo{Start: 1, End: 3, Total: 10, OffsetLines: 2, TotalLines: 20},
+			calls:    2,
+		},
+		{
+			name:     "variable height exact when all items measured",
+			items:    []int{1, 2, 3},
+			viewport: Window{Offset: 1, Height: 10},
+			expected: "1.0\n1.1\n2.0\n2.1\n2.2",
+			info:     WindowInfo{Start: 1, End: 3, Total: 3, OffsetLines: 2, TotalLines: 6, Exact: true},
+			calls:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			window := ForEachWindow(tt.items, tt.viewport, func(lines int, i int) string {
+				calls++
+				return windowTestRender(lines, i)
+			})
+
+			assert.Equal(t, tt.expected, window.Render())
+			assert.Equal(t, tt.info, window.Info())
+			assert.Equal(t, tt.expected, window.Render(), "rendered once")
+			assert.Equal(t, tt.calls, calls)
+		})
+	}
+}
+
+// TestWindowInfo_Thumb tests scrollbar thumb position and size
+func TestWindowInfo_Thumb(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     WindowInfo
+		track    int
+		wantPos  int
+		wantSize int
+	}{
+		{name: "content fits", info: WindowInfo{TotalLines: 5}, track: 10, wantPos: 0, wantSize: 10},
+		{name: "top", info: WindowInfo{OffsetLines: 0, TotalLines: 100}, track: 10, wantPos: 0, wantSize: 1},
+		{name: "middle", info: WindowInfo{OffsetLines: 45, TotalLines: 100}, track: 10, wantPos: 4, wantSize: 1},
+		{name: "bottom", info: WindowInfo{OffsetLines: 90, TotalLines: 100}, track: 10, wantPos: 9, wantSize: 1},
+		{name: "past the end stays on track", info: WindowInfo{OffsetLines: 99, TotalLines: 100}, track: 10, wantPos: 9, wantSize: 1},
+		{name: "large thumb", info: WindowInfo{OffsetLines: 10, TotalLines: 20}, track: 10, wantPos: 5, wantSize: 5},
+		{name: "no track", info: WindowInfo{TotalLines: 100}, track: 0, wantPos: 0, wantSize: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pos, size := tt.info.Thumb(tt.track)
+			assert.Equal(t, tt.wantPos, pos)
+			assert.Equal(t, tt.wantSize, size)
+		})
+	}
+}
+
+// TestForEachWindow_PanicRecovery tests a panicking item renders empty and is reported
+func TestForEachWindow_PanicRecovery(t *testing.T) {
+	reporter := &mockReporter{}
+	observability.SetErrorReporter(reporter)
+	defer observability.SetErrorReporter(nil)
+
+	result := ForEachWindow([]string{"a", "b", "c"}, Window{Height: 3, ItemHeight: 1}, func(item string, i int) string {
+		if i == 1 {
+			panic("boom")
+		}
+		return item
+	}).Render()
+
+	assert.Equal(t, "a\n\nc", result)
+	assert.Equal(t, 1, reporter.getErrorCallCount())
+}
@@ -1,6 +1,12 @@
 package directives
 
-import "strings"
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
 
 // OnDirective implements declarative event handling for template elements.
 //
@@ -87,6 +93,33 @@ import "strings"
 //	    return On("click", handler).Render(item)
 //	}).Render()
 //
+// # Mouse Events
+//
+// For mouse events ("click", "mousedown", "mouseup", "mousemove",
+// "mouseover", "mouseout", "wheel"), RenderZone registers the content as a
+// hit region instead of emitting a text marker. When mouse support is enabled
+// (bubbly.Run with WithMouseCellMotion, or bubbly.EnableMouseZones), the
+// handler receives a bubbly.MouseEvent whenever the event occurs within the
+// content's rendered bounds:
+//
+//	On(bubbly.MouseEventClick, func(data interface{}) {
+//	    ev := data.(bubbly.MouseEvent)
+//	    selectRow(ev.LocalY)
+//	}).RenderZone(rows)
+//
+// # Key Events
+//
+// OnKey binds handlers to specific keys, so key handling can live next to
+// the content it belongs to instead of in a model's Update switch. RenderKeys
+// registers them with the component, whose key events then run them:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    return OnKey("enter", submit).
+//	        OnKey("esc", cancel).
+//	        OnKey("ctrl+s", save).
+//	        RenderKeys(ctx, form)
+//	})
+//
 // # Purity
 //
 // The directive is pure - it has no side effects and only wraps content with
@@ -98,6 +131,18 @@ type OnDirective struct {
 	preventDefault  bool
 	stopPropagation bool
 	once            bool
+	keys            []keyHandler
+	limiters        []*eventLimiter
+}
+
+// KeyAnyRune is the OnKey pattern matching any printable character typed
+// without Alt, when no exact pattern for the character is registered.
+const KeyAnyRune = bubbly.KeyAnyRune
+
+// keyHandler is a key pattern registered with OnKey.
+type keyHandler struct {
+	key     string
+	handler func()
 }
 
 // On creates a new event handling directive for the given event name and handler.
@@ -356,3 +401,81 @@ func (d *OnDirective) Render(content string) string {
 
 	return builder.String()
 }
+
+// RenderZone renders content as a mouse hit region for the directive's event.
+//
+// Unlike Render, no text marker is added. Instead, the content is registered
+// with the framework's mouse hit-testing so the handler is called with a
+// bubbly.MouseEvent when the event occurs inside the content's screen bounds.
+// Layout is unaffected: the zone marker is zero-width and removed before the
+// frame is drawn.
+//
+// When mouse zones are disabled, content is returned unchanged and the
+// handler never fires. Modifiers have no effect on zones, because zones are
+// re-registered on every render.
+//
+// Example:
+//
+//	On("click", func(data interface{}) {
+//	    submit()
+//	}).RenderZone(buttonStyle.Render("Submit"))
+func (d *OnDirective) RenderZone(content string) string {
+	if d.handler == nil {
+		return content
+	}
+	handler := d.handler
+	return bubbly.MouseZone(content, d.event, func(ev bubbly.MouseEvent) {
+		handler(ev)
+	})
+}
+
+// OnKey creates an event directive that runs handler when key is pressed.
+// Chain more OnKey calls for other keys, then call RenderKeys from the
+// template.
+//
+// Keys use Bubbletea's tea.KeyMsg.String() format: "enter", "esc", "up",
+// "ctrl+s", "alt+a", or a single character. KeyAnyRune matches any
+// printable character.
+//
+// Example:
+//
+//	OnKey("enter", submit).OnKey("esc", cancel).RenderKeys(ctx, form)
+func OnKey(key string, handler func()) *OnDirective {
+	return (&OnDirective{event: "keypress"}).OnKey(key, handler)
+}
+
+// OnKey adds a handler run when key is pressed. See the OnKey function.
+func (d *OnDirective) OnKey(key string, handler func()) *OnDirective {
+	if handler != nil {
+		d.keys = append(d.keys, keyHandler{key: key, handler: handler})
+	}
+	return d
+}
+
+// RenderKeys registers the directive's key handlers with the component being
+// rendered and returns content unchanged.
+//
+// When the component receives a matching tea.KeyMsg, it emits a
+// bubbly.TemplateKeyEventPrefix+key event that runs the handler, so key
+// handlers go through the component event system like other events. Like
+// zones, keys are re-registered on every render: keys registered inside a
+// conditional branch are only active while the branch is rendered.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    return If(editing.GetTyped(), func() string {
+//	        return OnKey("esc", stopEditing).RenderKeys(ctx, editor)
+//	    }).Else(func() string {
+//	        return OnKey("e", startEditing).RenderKeys(ctx, preview)
+//	    }).Render()
+//	})
+func (d *OnDirective) RenderKeys(ctx bubbly.RenderContext, content string) string {
+	for _, k := range d.keys {
+		handler := k.handler
+		ctx.OnKey(k.key, func(tea.KeyMsg) {
+			handler()
+		})
+	}
+	return content
+}
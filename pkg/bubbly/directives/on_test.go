@@ -3,7 +3,11 @@ package directives
 import (
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
 )
 
 // TestOn_CreatesDirective tests that On() creates a valid OnDirective.
@@ -597,3 +601,57 @@ func BenchmarkOnDirective_Multiple(b *testing.B) {
 		_ = content
 	}
 }
+
+// TestOnDirective_RenderZone tests mouse hit zones rendered by the On directive
+func TestOnDirective_RenderZone(t *testing.T) {
+	t.Run("disabled returns content unchanged", func(t *testing.T) {
+		bubbly.EnableMouseZones(false)
+		assert.Equal(t, "Click", On("click", func(interface{}) {}).RenderZone("Click"))
+	})
+
+	t.Run("click inside zone invokes handler", func(t *testing.T) {
+		bubbly.EnableMouseZones(true)
+		defer bubbly.EnableMouseZones(false)
+
+		var got bubbly.MouseEvent
+		view := On("click", func(data interface{}) {
+			got = data.(bubbly.MouseEvent)
+		}).RenderZone("Click")
+		assert.Equal(t, "Click", bubbly.ScanMouseZones(view))
+
+		bubbly.DispatchMouse(tea.MouseMsg{X: 1, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+		bubbly.DispatchMouse(tea.MouseMsg{X: 1, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+		assert.Equal(t, bubbly.MouseEventClick, got.Name)
+		assert.Equal(t, 1, got.LocalX)
+	})
+
+	t.Run("nil handler returns content", func(t *testing.T) {
+		bubbly.EnableMouseZones(true)
+		defer bubbly.EnableMouseZones(false)
+		assert.Equal(t, "x", On("click", nil).RenderZone("x"))
+	})
+}
+
+// TestOnDirective_OnKey tests key handlers registered by RenderKeys
+func TestOnDirective_OnKey(t *testing.T) {
+	var pressed []string
+	comp, err := bubbly.NewComponent("Form").
+		Template(func(ctx bubbly.RenderContext) string {
+			return OnKey("enter", func() { pressed = append(pressed, "submit") }).
+				OnKey("ctrl+s", func() { pressed = append(pressed, "save") }).
+				OnKey(KeyAnyRune, func() { pressed = append(pressed, "typed") }).
+				OnKey("esc", nil).
+				RenderKeys(ctx, "form")
+		}).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	assert.Equal(t, "form", comp.View(), "RenderKeys should return content unchanged")
+
+	comp.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	comp.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	comp.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	comp.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	assert.Equal(t, []string{"submit", "save", "typed"}, pressed)
+}
@@ -0,0 +1,162 @@
+package directives
+
+import (
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// eventLimiter holds the timer state of a Debounce or Throttle modifier.
+type eventLimiter struct {
+	mu        sync.Mutex
+	timer     *time.Timer
+	throttled bool
+	stopped   bool
+}
+
+// debounce runs fn after delay, cancelling the previously scheduled call.
+func (l *eventLimiter) debounce(delay time.Duration, fn func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.stopped {
+		return
+	}
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	l.timer = time.AfterFunc(delay, func() {
+		l.mu.Lock()
+		stopped := l.stopped
+		l.timer = nil
+		l.mu.Unlock()
+
+		if !stopped {
+			fn()
+		}
+	})
+}
+
+// throttle runs fn unless it already ran within the last delay.
+func (l *eventLimiter) throttle(delay time.Duration, fn func()) {
+	l.mu.Lock()
+	if l.stopped || l.throttled {
+		l.mu.Unlock()
+		return
+	}
+	l.throttled = true
+	l.timer = time.AfterFunc(delay, func() {
+		l.mu.Lock()
+		l.throttled = false
+		l.timer = nil
+		l.mu.Unlock()
+	})
+	l.mu.Unlock()
+
+	fn()
+}
+
+// stop cancels any pending call and disables the limiter.
+func (l *eventLimiter) stop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopped = true
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
+
+// Debounce delays the handler until events stop arriving for delay, then
+// calls it once with the data of the last event, like Vue's .debounce. This
+// suits search-as-you-type, where only the final query matters. It uses the
+// same timing as composables.UseDebounce.
+//
+// The timer state lives in the directive, so a debounced directive must be
+// created once (in Setup) rather than on every render, and tied to the
+// component with WithContext so pending calls are dropped on unmount.
+//
+// PreventDefault and StopPropagation are not delayed: they describe how each
+// event is dispatched, so they apply to every event as it occurs, including
+// events whose handler call is later superseded.
+//
+// Non-positive delays leave the handler unchanged. Debounce applies to the
+// On handler, not to keys added with OnKey.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    search := directives.On("input", func(data interface{}) {
+//	        runSearch(data.(string))
+//	    }).Debounce(300 * time.Millisecond).WithContext(ctx)
+//	    ctx.Expose("search", search)
+//	})
+func (d *OnDirective) Debounce(delay time.Duration) *OnDirective {
+	if d.handler == nil || delay <= 0 {
+		return d
+	}
+	limiter := &eventLimiter{}
+	d.limiters = append(d.limiters, limiter)
+
+	handler := d.handler
+	d.handler = func(data interface{}) {
+		limiter.debounce(delay, func() {
+			handler(data)
+		})
+	}
+	return d
+}
+
+// Throttle calls the handler immediately for the first event, then ignores
+// further events until delay has passed, so it fires at most once per
+// window. This suits rapid clicks or key repeats. It uses the same timing as
+// composables.UseThrottle.
+//
+// Like Debounce, a throttled directive must be created once (in Setup) and
+// tied to the component with WithContext; PreventDefault and
+// StopPropagation still apply to ignored events.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    refresh := directives.On("click", func(interface{}) {
+//	        reload()
+//	    }).Throttle(time.Second).WithContext(ctx)
+//	    ctx.Expose("refresh", refresh)
+//	})
+func (d *OnDirective) Throttle(delay time.Duration) *OnDirective {
+	if d.handler == nil || delay <= 0 {
+		return d
+	}
+	limiter := &eventLimiter{}
+	d.limiters = append(d.limiters, limiter)
+
+	handler := d.handler
+	d.handler = func(data interface{}) {
+		limiter.throttle(delay, func() {
+			handler(data)
+		})
+	}
+	return d
+}
+
+// Stop cancels pending debounced calls and turns off the Debounce and
+// Throttle modifiers: afterwards the handler is no longer called by them.
+// It is safe to call more than once.
+func (d *OnDirective) Stop() {
+	for _, limiter := range d.limiters {
+		limiter.stop()
+	}
+}
+
+// WithContext ties the directive to a component's lifecycle: Stop is called
+// when the component unmounts, so a pending debounced call never runs on a
+// disposed component. A nil ctx is ignored.
+func (d *OnDirective) WithContext(ctx *bubbly.Context) *OnDirective {
+	if ctx != nil {
+		ctx.OnUnmounted(d.Stop)
+	}
+	return d
+}
@@ -0,0 +1,113 @@
+package directives
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// recorder collects handler calls from timer goroutines.
+type recorder struct {
+	mu    sync.Mutex
+	calls []interface{}
+}
+
+func (r *recorder) handle(data interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, data)
+}
+
+func (r *recorder) get() []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]interface{}(nil), r.calls...)
+}
+
+// TestOnDirective_Debounce tests only the last event of a burst is handled
+func TestOnDirective_Debounce(t *testing.T) {
+	rec := &recorder{}
+	d := On("input", rec.handle).Debounce(30 * time.Millisecond)
+
+	d.handler("g")
+	d.handler("go")
+	d.handler("gol")
+
+	assert.Empty(t, rec.get(), "Handler should wait for quiet time")
+	assert.Eventually(t, func() bool { return len(rec.get()) == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []interface{}{"gol"}, rec.get())
+}
+
+// TestOnDirective_Throttle tests at most one event per window is handled
+func TestOnDirective_Throttle(t *testing.T) {
+	rec := &recorder{}
+	d := On("click", rec.handle).Throttle(50 * time.Millisecond)
+
+	d.handler(1)
+	d.handler(2)
+	d.handler(3)
+	assert.Equal(t, []interface{}{1}, rec.get(), "First event fires immediately, the rest are dropped")
+
+	assert.Eventually(t, func() bool {
+		d.handler(4)
+		return len(rec.get()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []interface{}{1, 4}, rec.get())
+}
+
+// TestOnDirective_TimingIgnoredValues tests modifiers without effect
+func TestOnDirective_TimingIgnoredValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		directive func(rec *recorder) *OnDirective
+	}{
+		{name: "zero debounce", directive: func(rec *recorder) *OnDirective { return On("x", rec.handle).Debounce(0) }},
+		{name: "negative throttle", directive: func(rec *recorder) *OnDirective { return On("x", rec.handle).Throttle(-time.Second) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{}
+			d := tt.directive(rec)
+
+			d.handler("a")
+			d.handler("b")
+
+			assert.Equal(t, []interface{}{"a", "b"}, rec.get())
+			assert.Empty(t, d.limiters)
+		})
+	}
+
+	assert.NotPanics(t, func() { On("x", nil).Debounce(time.Second).Throttle(time.Second).Stop() })
+}
+
+// TestOnDirective_WithContext tests pending calls are dropped on unmount
+func TestOnDirective_WithContext(t *testing.T) {
+	ctx := bubbly.NewTestContext()
+	rec := &recorder{}
+	d := On("input", rec.handle).Debounce(20 * time.Millisecond).WithContext(ctx)
+
+	d.handler("pending")
+	bubbly.TriggerUnmount(ctx)
+	d.handler("after unmount")
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Empty(t, rec.get())
+}
+
+// TestOnDirective_Stop tests Stop disables throttled handlers
+func TestOnDirective_Stop(t *testing.T) {
+	rec := &recorder{}
+	d := On("click", rec.handle).Throttle(time.Hour)
+
+	d.Stop()
+	d.Stop()
+	d.handler("ignored")
+
+	assert.Empty(t, rec.get())
+}
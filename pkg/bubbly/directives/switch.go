@@ -0,0 +1,129 @@
+package directives
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// SwitchDirective implements multi-branch conditional rendering on a value.
+//
+// Switch is the readable alternative to long If/ElseIf chains comparing the
+// same value, like Vue's v-if/v-else-if/v-else on one expression. The first
+// Case whose value equals the switch value is rendered; if none matches,
+// Default is rendered (or an empty string without a Default).
+//
+// # Basic Usage
+//
+//	Switch(viewMode).
+//	    Case("list", func() string { return renderList() }).
+//	    Case("details", func() string { return renderDetails() }).
+//	    Default(func() string { return "Unknown view" }).
+//	    Render()
+//
+// # Performance
+//
+// Branches are selected as Case is called, so only the matched branch's
+// closure runs and no case list is allocated; like If, rendering costs a
+// few nanoseconds beyond the branch itself.
+//
+// # Purity
+//
+// The directive is pure - it has no side effects and always produces the
+// same output for the same input.
+type SwitchDirective[T comparable] struct {
+	value       T
+	matched     func() string
+	matchedCase int
+	cases       int
+	defaultFn   func() string
+}
+
+// Switch creates a new multi-branch directive on value.
+//
+// Parameters:
+//   - value: The value compared against each Case
+//
+// Returns:
+//   - *SwitchDirective[T]: A new Switch directive to chain Case/Default on
+//
+// Example:
+//
+//	Switch(activeTab).
+//	    Case(0, func() string { return "Home" }).
+//	    Case(1, func() string { return "Settings" }).
+//	    Render()
+func Switch[T comparable](value T) *SwitchDirective[T] {
+	return &SwitchDirective[T]{
+		value:       value,
+		matchedCase: -1,
+	}
+}
+
+// Case adds a branch rendered when the switch value equals val. Cases are
+// checked in order and the first match wins; later matching cases are
+// ignored.
+func (d *SwitchDirective[T]) Case(val T, then func() string) *SwitchDirective[T] {
+	if d.matched == nil && then != nil && d.value == val {
+		d.matched = then
+		d.matchedCase = d.cases
+	}
+	d.cases++
+	return d
+}
+
+// Default sets the branch rendered when no Case matches.
+func (d *SwitchDirective[T]) Default(then func() string) *SwitchDirective[T] {
+	d.defaultFn = then
+	return d
+}
+
+// Render executes the matched Case branch, or the Default branch if no case
+// matched. It returns an empty string when nothing matched and there is no
+// Default.
+//
+// A branch that panics renders an empty string; the panic is reported via
+// observability like other directives.
+func (d *SwitchDirective[T]) Render() string {
+	if d.matched != nil {
+		return d.safeExecute(d.matched, "case", d.matchedCase)
+	}
+	if d.defaultFn != nil {
+		return d.safeExecute(d.defaultFn, "default", -1)
+	}
+	return ""
+}
+
+// safeExecute wraps branch execution with panic recovery.
+func (d *SwitchDirective[T]) safeExecute(fn func() string, branchName string, caseIndex int) string {
+	defer func() {
+		if r := recover(); r != nil {
+			if reporter := observability.GetErrorReporter(); reporter != nil {
+				if caseIndex >= 0 {
+					branchName = fmt.Sprintf("case[%d]", caseIndex)
+				}
+				err := fmt.Errorf("%w: Switch directive %s branch panicked: %v", ErrRenderPanic, branchName, r)
+				ctx := &observability.ErrorContext{
+					ComponentName: "Switch",
+					Timestamp:     time.Now(),
+					StackTrace:    debug.Stack(),
+					Tags: map[string]string{
+						"directive_type": "Switch",
+						"branch_name":    branchName,
+						"error_type":     "render_panic",
+					},
+					Extra: map[string]interface{}{
+						"panic_value":  r,
+						"branch":       branchName,
+						"switch_value": fmt.Sprintf("%v", d.value),
+					},
+				}
+				reporter.ReportError(err, ctx)
+			}
+		}
+	}()
+
+	return fn()
+}
@@ -0,0 +1,109 @@
+package directives
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// TestSwitchDirective_Render tests case selection
+func TestSwitchDirective_Render(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		withDefault bool
+		expected    string
+	}{
+		{name: "first case", value: "list", withDefault: true, expected: "List view"},
+		{name: "second case", value: "details", withDefault: true, expected: "Details view"},
+		{name: "default", value: "grid", withDefault: true, expected: "Unknown view"},
+		{name: "no match without default", value: "grid", withDefault: false, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Switch(tt.value).
+				Case("list", func() string { return "List view" }).
+				Case("details", func() string { return "Details view" })
+			if tt.withDefault {
+				d.Default(func() string { return "Unknown view" })
+			}
+
+			assert.Equal(t, tt.expected, d.Render())
+		})
+	}
+}
+
+// TestSwitchDirective_ShortCircuit tests only the matched branch runs
+func TestSwitchDirective_ShortCircuit(t *testing.T) {
+	var calls []string
+	branch := func(name string) func() string {
+		return func() string {
+			calls = append(calls, name)
+			return name
+		}
+	}
+
+	result := Switch(2).
+		Case(1, branch("one")).
+		Case(2, branch("two")).
+		Case(2, branch("two again")).
+		Default(branch("default")).
+		Render()
+
+	assert.Equal(t, "two", result, "The first matching case should win")
+	assert.Equal(t, []string{"two"}, calls)
+}
+
+// TestSwitchDirective_NilBranch tests a nil case branch is skipped
+func TestSwitchDirective_NilBranch(t *testing.T) {
+	result := Switch(1).
+		Case(1, nil).
+		Default(func() string { return "default" }).
+		Render()
+
+	assert.Equal(t, "default", result)
+}
+
+// TestSwitchDirective_PanicRecovery tests panicking branches are reported
+func TestSwitchDirective_PanicRecovery(t *testing.T) {
+	reporter := &mockReporter{}
+	observability.SetErrorReporter(reporter)
+	defer observability.SetErrorReporter(nil)
+
+	result := Switch("b").
+		Case("a", func() string { return "a" }).
+		Case("b", func() string { panic("boom") }).
+		Render()
+
+	assert.Equal(t, "", result)
+	require.Equal(t, 1, reporter.getErrorCallCount())
+	call := reporter.errorCalls[0]
+	assert.True(t, errors.Is(call.err, ErrRenderPanic))
+	assert.Equal(t, "Switch", call.ctx.Tags["directive_type"])
+	assert.Equal(t, "case[1]", call.ctx.Tags["branch_name"])
+}
+
+// TestSwitchDirective_InterfaceCompliance tests it is a Directive
+func TestSwitchDirective_InterfaceCompliance(t *testing.T) {
+	var _ Directive = Switch(0)
+}
+
+// BenchmarkSwitchDirective benchmarks a Switch matching its second case
+// Target: < 50ns
+func BenchmarkSwitchDirective(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = Switch("details").
+			Case("list", func() string { return "list" }).
+			Case("details", func() string { return "details" }).
+			Default(func() string { return "default" }).
+			Render()
+	}
+}
@@ -32,6 +32,18 @@ Create a computed value:
 	})
 	result := doubled.GetTyped()  // Automatically recomputes when count changes
 
+Create a writable computed value, whose Set writes the underlying refs:
+
+	fullName := bubbly.NewWritableComputed(
+	    func() string { return first.GetTyped() + " " + last.GetTyped() },
+	    func(name string) {
+	        f, l, _ := strings.Cut(name, " ")
+	        first.Set(f)
+	        last.Set(l)
+	    },
+	)
+	fullName.Set("Grace Hopper")
+
 Watch for changes:
 
 	cleanup := bubbly.Watch(count, func(newVal, oldVal int) {
@@ -142,6 +154,19 @@ The Template function defines how the component renders:
 
 Templates are called on every View() invocation and should be pure functions.
 
+GetRef and GetComputed fetch exposed reactive values without type
+assertions; on a mismatch they panic with the key and the stored type:
+
+	count := bubbly.GetRef[int](ctx, "count")
+	total := bubbly.GetComputed[float64](ctx, "total")
+
+Get returns nil for keys that were not exposed, so the assertion after it
+panics. Shared templates with optional values use GetOr or GetRefOr, which
+return a fallback instead:
+
+	title := ctx.GetOr("title", "Untitled").(string)
+	selected := bubbly.GetRefOr(ctx, "selected", bubbly.NewRef(-1))
+
 # Event System
 
 Components communicate through events:
@@ -183,6 +208,75 @@ Nest components to build complex UIs:
 
 Children are initialized and updated automatically by the parent component.
 
+Dynamic lists of child components (tabs, draggable panels) use
+ctx.ReconcileChildren, which matches children to stable keys so reordering
+keeps each child's state and lifecycle; only new keys create children:
+
+	tabs, err := ctx.ReconcileChildren(ids, func(id string) bubbly.Component {
+	    return NewTab(id)
+	})
+
+Slots pass render fragments instead of components. A layout places them
+with ctx.Slot (empty when unfilled) or ctx.SlotOr (with a default), and
+consumers fill them with WithSlot:
+
+	card, _ := bubbly.NewComponent("Card").
+	    Template(func(ctx bubbly.RenderContext) string {
+	        return ctx.SlotOr("header", "Untitled") + "\n" + ctx.Slot("body")
+	    }).
+	    WithSlot("body", func(bubbly.RenderContext) string { return "Hello" }).
+	    Build()
+
+Scoped slots receive data from the component, such as each row of a list.
+Components are not generic, so the data crosses as interface{}: the
+template passes it to ctx.ScopedSlot, and Scoped states its type once for
+the consumer's render function (a mismatch panics, naming both types):
+
+	    WithScopedSlot("item", bubbly.Scoped(func(_ bubbly.RenderContext, todo Todo) string {
+	        return "• " + todo.Title
+	    }))
+
+	// In the component's template
+	rows = append(rows, ctx.ScopedSlot("item", todo))
+
+Memo makes View return the previous output instead of calling Template when
+the props are unchanged (reflect.DeepEqual, or the WithShouldUpdate
+function) and no Ref or Computed the template read has changed since.
+Adding or removing children, and hot-reloading the template, also clear
+the cache:
+
+	row, _ := bubbly.NewComponent("Row").
+	    Props(RowProps{Title: "Inbox"}).
+	    Memo().
+	    Template(renderRow).
+	    Build()
+
+NewKeepAlive switches between keyed components without tearing down the
+hidden ones, bounded by WithMaxAlive. Hidden components keep their state
+and run OnDeactivated; OnActivated runs each time one is shown:
+
+	views := bubbly.NewKeepAlive(bubbly.WithMaxAlive(5))
+	views.Show("inbox", CreateInbox) // In the template; builds once per key
+	return views.View()
+
+A component becomes an error boundary with OnErrorCaptured. Panics in its
+descendants' templates, event handlers and lifecycle hooks reach its hook
+as a *ComponentPanicError; returning true keeps a failing template from
+crashing the program, and ctx.CapturedError lets the boundary's template
+render a fallback:
+
+	ctx.OnErrorCaptured(func(err error, source bubbly.Component) bool {
+	    return true
+	})
+
+Teleport draws content in a named overlay layer above the whole view,
+wherever it is declared. Wrap and Run composite the layers each frame
+(LayerPopover, then LayerModal, then LayerToast on top):
+
+	if confirming.GetTyped() {
+	    bubbly.Teleport(bubbly.LayerModal, func() string { return dialog.View() })
+	}
+
 # Complete Component Example
 
 A stateful counter component:
@@ -248,6 +342,23 @@ Execute callback immediately on watcher creation:
 
 	bubbly.Watch(count, callback, bubbly.WithImmediate())
 
+Multiple Sources:
+
+Run one callback when any of several sources changes, with one cleanup:
+
+	cleanup := bubbly.WatchMany([]bubbly.WatchSource{query, page}, reload,
+	    bubbly.WithFlush("post"))
+
+Batched Updates:
+
+Set several refs and notify once, without FlushWatchers. Nested batches
+deliver notifications when the outermost one returns:
+
+	bubbly.Batch(func() {
+	    query.Set("")
+	    page.Set(1)
+	}) // Watchers of query and page run once here
+
 # Performance
 
 Bubbly is designed for high performance:
@@ -265,8 +376,17 @@ Bubbly panics on programming errors to catch bugs early:
   - ErrNilCallback: Watch() called with nil callback
   - ErrNilComputeFn: NewComputed() called with nil function
   - ErrCircularDependency: Circular dependency detected in computed values
+    (raised as a *CycleError carrying the full path, e.g. "a → b → a";
+    use DetectCycles for a non-panicking check)
   - ErrMaxDepthExceeded: Dependency chain exceeds 100 levels
 
+A panic in a computed function propagates to the caller (usually the
+render). WithComputedFallback recovers it instead, reporting it through
+observability and serving a fallback value, with the error available from
+Computed.Err:
+
+	label := bubbly.NewComputed(deriveLabel, bubbly.WithComputedFallback("—"))
+
 # Thread Safety
 
 All operations are thread-safe:
@@ -323,6 +443,7 @@ The package is organized into focused files:
 
   - ref.go: Reactive references (Ref[T])
   - computed.go: Computed values (Computed[T])
+  - writable_computed.go: Writable computed values (WritableComputed[T])
   - watch.go: Watchers and options
   - tracker.go: Dependency tracking system
   - scheduler.go: Async flush scheduler
@@ -0,0 +1,213 @@
+package bubbly
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// ErrorCapturedHook is called with an error raised by a descendant
+// component and the component it came from. Returning true marks the error
+// as handled, which stops it from reaching boundaries further up the tree.
+type ErrorCapturedHook func(err error, source Component) (handled bool)
+
+// ComponentPanicError is the error passed to OnErrorCaptured hooks when a
+// component's template, event handler or lifecycle hook panics.
+type ComponentPanicError struct {
+	// ComponentName is the name of the component that panicked
+	ComponentName string
+	// Phase is where the panic happened: "template", "event:<name>" or
+	// "lifecycle:<hook>"
+	Phase string
+	// PanicValue is the value passed to panic()
+	PanicValue interface{}
+}
+
+// Error implements the error interface for ComponentPanicError.
+func (e *ComponentPanicError) Error() string {
+	return fmt.Sprintf("panic in component '%s' (%s): %v", e.ComponentName, e.Phase, e.PanicValue)
+}
+
+// OnErrorCaptured makes the component an error boundary for its
+// descendants. The hook is called, nearest boundary first, when a
+// descendant's template, event handler or lifecycle hook panics.
+//
+// A handled template panic makes the failing component render nothing
+// instead of crashing the program, and the boundary keeps the error until
+// ClearCapturedError so its template can show a fallback (see
+// RenderContext.CapturedError). An unhandled template panic propagates as
+// it would without a boundary. Panics in event handlers and lifecycle hooks
+// are always recovered; for them, handled only stops propagation.
+//
+// Captured errors are still reported to the observability system.
+//
+// Hooks run while the failing component renders, so they must not set
+// refs; read CapturedError in the template instead.
+//
+// Example:
+//
+//	ctx.OnErrorCaptured(func(err error, source bubbly.Component) bool {
+//	    return source.Name() == "StatsPanel" // Keep the dashboard running
+//	})
+func (ctx *Context) OnErrorCaptured(hook ErrorCapturedHook) {
+	if ctx.component.lifecycle == nil {
+		ctx.component.lifecycle = newLifecycleManager(ctx.component)
+	}
+	ctx.component.lifecycle.errorHooks = append(ctx.component.lifecycle.errorHooks, hook)
+}
+
+// CapturedError returns the last error handled by this component's
+// OnErrorCaptured hooks, or nil.
+func (ctx *Context) CapturedError() error {
+	return ctx.component.getCapturedError()
+}
+
+// ClearCapturedError forgets the error returned by CapturedError, e.g.
+// when the user asks to retry.
+func (ctx *Context) ClearCapturedError() {
+	ctx.component.setCapturedError(nil)
+}
+
+// CapturedError returns the last error handled by this component's
+// OnErrorCaptured hooks, or nil. Check it after rendering children to
+// show a fallback in their place.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    stats := ctx.Children()[0].View()
+//	    if err := ctx.CapturedError(); err != nil {
+//	        return "Stats unavailable: " + err.Error()
+//	    }
+//	    return stats
+//	})
+func (ctx RenderContext) CapturedError() error {
+	return ctx.component.getCapturedError()
+}
+
+// getCapturedError returns the error kept by this boundary.
+func (c *componentImpl) getCapturedError() error {
+	c.capturedErrMu.RLock()
+	defer c.capturedErrMu.RUnlock()
+	return c.capturedErr
+}
+
+// setCapturedError keeps err for CapturedError. The boundary's output
+// changes with it, so a memoized boundary renders again.
+func (c *componentImpl) setCapturedError(err error) {
+	c.capturedErrMu.Lock()
+	c.capturedErr = err
+	c.capturedErrMu.Unlock()
+
+	c.invalidateMemo()
+}
+
+// hasErrorBoundary reports whether an ancestor of c has OnErrorCaptured hooks.
+func (c *componentImpl) hasErrorBoundary() bool {
+	for p := c.parent; p != nil; p = p.parent {
+		if p.lifecycle != nil && len(p.lifecycle.errorHooks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// captureError passes err, raised by c, to the OnErrorCaptured hooks of
+// c's ancestors, nearest first, until one handles it. It returns the
+// boundary that handled it, or nil.
+func (c *componentImpl) captureError(err error) *componentImpl {
+	for p := c.parent; p != nil; p = p.parent {
+		if p.lifecycle == nil {
+			continue
+		}
+		for _, hook := range p.lifecycle.errorHooks {
+			if p.lifecycle.safeExecuteErrorHook(hook, err, c) {
+				p.setCapturedError(err)
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// safeRender runs render and routes a panic to the error boundaries above
+// c. It returns false if a boundary handled the panic. Without a boundary,
+// or if none handles it, the panic propagates unchanged.
+func (c *componentImpl) safeRender(render func() string) (output string, ok bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		// A panic no boundary handled passes through the ancestors' renders
+		// untouched, and leaves the tree with its original value
+		if escaped, ok := r.(uncapturedPanic); ok {
+			if c.hasErrorBoundary() {
+				panic(escaped)
+			}
+			panic(escaped.value)
+		}
+		if !c.hasErrorBoundary() {
+			panic(r)
+		}
+
+		err := &ComponentPanicError{ComponentName: c.name, Phase: "template", PanicValue: r}
+		boundary := c.captureError(err)
+		c.reportTemplatePanic(err, boundary)
+		if boundary == nil {
+			panic(uncapturedPanic{value: r})
+		}
+		output, ok = "", false
+	}()
+
+	return render(), true
+}
+
+// uncapturedPanic wraps a template panic that the error boundaries have
+// already seen and not handled, on its way up through ancestor renders.
+type uncapturedPanic struct {
+	value interface{}
+}
+
+// reportTemplatePanic reports a template panic seen by an error boundary.
+// boundary is the component that handled it, or nil.
+func (c *componentImpl) reportTemplatePanic(err *ComponentPanicError, boundary *componentImpl) {
+	reporter := observability.GetErrorReporter()
+	if reporter == nil {
+		return
+	}
+
+	tags := map[string]string{
+		"error_type": "template_panic",
+		"handled":    fmt.Sprintf("%t", boundary != nil),
+	}
+	if boundary != nil {
+		tags["boundary"] = boundary.name
+	}
+
+	reporter.ReportPanic(&observability.HandlerPanicError{
+		ComponentName: c.name,
+		EventName:     err.Phase,
+		PanicValue:    err.PanicValue,
+	}, &observability.ErrorContext{
+		ComponentName: c.name,
+		ComponentID:   c.id,
+		EventName:     err.Phase,
+		Timestamp:     time.Now(),
+		StackTrace:    debug.Stack(),
+		Tags:          tags,
+		Breadcrumbs:   observability.GetBreadcrumbs(),
+	})
+}
+
+// safeExecuteErrorHook runs an OnErrorCaptured hook of lm's component. A
+// panicking hook is reported and counts as not handling the error.
+func (lm *LifecycleManager) safeExecuteErrorHook(hook ErrorCapturedHook, err error, source Component) (handled bool) {
+	lm.safeExecuteWithRecovery("lifecycle:errorCaptured", "errorCaptured", func() {
+		handled = hook(err, source)
+	}, map[string]interface{}{"captured_error": err.Error()})
+	return handled
+}
@@ -0,0 +1,169 @@
+package bubbly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// capturedCall records one OnErrorCaptured call.
+type capturedCall struct {
+	boundary string
+	phase    string
+	source   string
+}
+
+// newBoundaryTestTree builds Outer > Inner > Panel, where Panel's template
+// panics while *broken is true and both ancestors are boundaries that
+// record calls and return their handles value.
+func newBoundaryTestTree(t *testing.T, broken *bool, outerHandles, innerHandles bool, calls *[]capturedCall) (outer Component, outerCtx *Context) {
+	t.Helper()
+
+	boundary := func(name string, handles bool) func(*Context) {
+		return func(ctx *Context) {
+			if name == "Outer" {
+				outerCtx = ctx
+			}
+			ctx.OnErrorCaptured(func(err error, source Component) bool {
+				var panicErr *ComponentPanicError
+				require.ErrorAs(t, err, &panicErr)
+				*calls = append(*calls, capturedCall{boundary: name, phase: panicErr.Phase, source: source.Name()})
+				return handles
+			})
+		}
+	}
+	renderChild := func(ctx RenderContext) string {
+		out := ctx.Children()[0].View()
+		if err := ctx.CapturedError(); err != nil {
+			return "fallback: " + err.Error()
+		}
+		return out
+	}
+
+	panel, err := NewComponent("Panel").
+		Setup(func(ctx *Context) {
+			ctx.On("save", func(interface{}) { panic("save failed") })
+		}).
+		Template(func(RenderContext) string {
+			if *broken {
+				panic("bad data")
+			}
+			return "panel"
+		}).
+		Build()
+	require.NoError(t, err)
+
+	inner, err := NewComponent("Inner").
+		Setup(boundary("Inner", innerHandles)).
+		Children(panel).
+		Template(renderChild).
+		Build()
+	require.NoError(t, err)
+
+	outer, err = NewComponent("Outer").
+		Setup(boundary("Outer", outerHandles)).
+		Children(inner).
+		Template(renderChild).
+		Build()
+	require.NoError(t, err)
+	outer.Init()
+	return outer, outerCtx
+}
+
+// TestOnErrorCaptured_TemplatePanic tests that a handled template panic renders a fallback
+func TestOnErrorCaptured_TemplatePanic(t *testing.T) {
+	var reports []map[string]string
+	observability.SetErrorReporter(&mockErrorReporter{
+		reportPanicFn: func(_ *observability.HandlerPanicError, ctx *observability.ErrorContext) {
+			reports = append(reports, ctx.Tags)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	broken := true
+	var calls []capturedCall
+	outer, outerCtx := newBoundaryTestTree(t, &broken, true, false, &calls)
+
+	assert.Equal(t, "fallback: panic in component 'Panel' (template): bad data", outer.View())
+	assert.Equal(t, []capturedCall{
+		{boundary: "Inner", phase: "template", source: "Panel"},
+		{boundary: "Outer", phase: "template", source: "Panel"},
+	}, calls, "nearest boundary first")
+	assert.Equal(t, []map[string]string{
+		{"error_type": "template_panic", "handled": "true", "boundary": "Outer"},
+	}, reports)
+
+	broken = false
+	assert.Contains(t, outer.View(), "fallback", "the boundary keeps the error until cleared")
+	outerCtx.ClearCapturedError()
+	assert.Nil(t, outerCtx.CapturedError())
+	assert.Equal(t, "panel", outer.View())
+}
+
+// TestOnErrorCaptured_Unhandled tests that unhandled template panics propagate
+func TestOnErrorCaptured_Unhandled(t *testing.T) {
+	broken := true
+	var calls []capturedCall
+	outer, _ := newBoundaryTestTree(t, &broken, false, false, &calls)
+
+	assert.PanicsWithValue(t, "bad data", func() { outer.View() })
+	assert.Len(t, calls, 2)
+
+	standalone, err := NewComponent("Standalone").
+		Template(func(RenderContext) string { panic("no boundary") }).
+		Build()
+	require.NoError(t, err)
+	assert.PanicsWithValue(t, "no boundary", func() { standalone.View() })
+}
+
+// TestOnErrorCaptured_HandlerAndHookPanics tests capture of recovered handler and hook panics
+func TestOnErrorCaptured_HandlerAndHookPanics(t *testing.T) {
+	var calls []capturedCall
+	var handled error
+
+	child, err := NewComponent("Child").
+		Setup(func(ctx *Context) {
+			ctx.OnMounted(func() { panic("mount failed") })
+			ctx.On("save", func(interface{}) { panic("save failed") })
+		}).
+		Template(func(RenderContext) string { return "child" }).
+		Build()
+	require.NoError(t, err)
+
+	parent, err := NewComponent("Parent").
+		Setup(func(ctx *Context) {
+			ctx.OnErrorCaptured(func(err error, source Component) bool {
+				panic("boundary bug") // Reported, counts as unhandled
+			})
+			ctx.OnErrorCaptured(func(err error, source Component) bool {
+				calls = append(calls, capturedCall{boundary: "Parent", phase: err.(*ComponentPanicError).Phase, source: source.Name()})
+				handled = ctx.CapturedError()
+				return true
+			})
+		}).
+		Children(child).
+		Template(func(ctx RenderContext) string { return ctx.Children()[0].View() }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	assert.Equal(t, "child", parent.View())
+	child.Emit("save", nil)
+
+	assert.Equal(t, []capturedCall{
+		{boundary: "Parent", phase: "lifecycle:mounted", source: "Child"},
+		{boundary: "Parent", phase: "event:save", source: "Child"},
+	}, calls)
+	assert.EqualError(t, handled, "panic in component 'Child' (lifecycle:mounted): mount failed",
+		"CapturedError is set once a hook handles the error")
+}
+
+// TestComponentPanicError_Error tests the error message format
+func TestComponentPanicError_Error(t *testing.T) {
+	err := &ComponentPanicError{ComponentName: "Chart", Phase: "event:refresh", PanicValue: time.Second}
+	assert.Equal(t, "panic in component 'Chart' (event:refresh): 1s", err.Error())
+}
@@ -0,0 +1,213 @@
+package bubbly
+
+import (
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// eventBusProvideKey is the provide/inject key used by ProvideEventBus.
+const eventBusProvideKey = "bubbly.eventBus"
+
+// defaultEventBus is the application-wide bus used when no component
+// provides a scoped one.
+var defaultEventBus = NewEventBus()
+
+// EventBus is a publish/subscribe channel for decoupled, cross-component
+// communication.
+//
+// Component events (Emit/On) bubble from child to parent. The EventBus
+// complements this for app-wide events such as notifications or navigation
+// requests, where publisher and subscriber have no parent/child relationship
+// and prop drilling would otherwise be needed.
+//
+// Handlers run synchronously on the publishing goroutine, in subscription
+// order. A panicking handler is recovered and reported to the observability
+// system; the remaining handlers still run.
+//
+// EventBus is thread-safe and can be used concurrently.
+type EventBus struct {
+	mu     sync.RWMutex
+	topics map[string][]*busSubscription
+	nextID uint64
+}
+
+// busSubscription is a single registered handler.
+type busSubscription struct {
+	id      uint64
+	handler EventHandler
+}
+
+// NewEventBus creates an empty EventBus.
+//
+// Most applications use the default bus (DefaultEventBus or ctx.Subscribe);
+// create a separate bus to isolate a subtree or in tests, and make it
+// available to descendants with ctx.ProvideEventBus.
+//
+// Example:
+//
+//	bus := bubbly.NewEventBus()
+//	cleanup := bus.Subscribe("saved", func(data interface{}) { ... })
+//	defer cleanup()
+//	bus.Publish("saved", "settings.json")
+func NewEventBus() *EventBus {
+	return &EventBus{
+		topics: make(map[string][]*busSubscription),
+	}
+}
+
+// DefaultEventBus returns the application-wide EventBus.
+func DefaultEventBus() *EventBus {
+	return defaultEventBus
+}
+
+// Subscribe registers handler for topic and returns a function that removes
+// the subscription. The returned cleanup is idempotent.
+//
+// Example:
+//
+//	cleanup := bus.Subscribe("notify", func(data interface{}) {
+//	    message := data.(string)
+//	    ...
+//	})
+func (b *EventBus) Subscribe(topic string, handler EventHandler) func() {
+	if handler == nil {
+		return func() {}
+	}
+
+	b.mu.Lock()
+	b.nextID++
+	sub := &busSubscription{id: b.nextID, handler: handler}
+	b.topics[topic] = append(b.topics[topic], sub)
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.unsubscribe(topic, sub.id)
+		})
+	}
+}
+
+// unsubscribe removes the subscription with id from topic.
+func (b *EventBus) unsubscribe(topic string, id uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subs := b.topics[topic]
+	for i, sub := range subs {
+		if sub.id == id {
+			// Copy so in-flight Publish snapshots are not affected
+			remaining := make([]*busSubscription, 0, len(subs)-1)
+			remaining = append(remaining, subs[:i]...)
+			remaining = append(remaining, subs[i+1:]...)
+			if len(remaining) == 0 {
+				delete(b.topics, topic)
+			} else {
+				b.topics[topic] = remaining
+			}
+			return
+		}
+	}
+}
+
+// Publish delivers data to every handler subscribed to topic.
+// Publishing to a topic with no subscribers is a no-op.
+//
+// Example:
+//
+//	bus.Publish("navigate", "/settings")
+func (b *EventBus) Publish(topic string, data interface{}) {
+	b.mu.RLock()
+	subs := b.topics[topic]
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		b.deliver(topic, sub.handler, data)
+	}
+}
+
+// deliver invokes handler, recovering and reporting panics.
+func (b *EventBus) deliver(topic string, handler EventHandler, data interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicErr := &observability.HandlerPanicError{
+				ComponentName: "EventBus",
+				EventName:     topic,
+				PanicValue:    r,
+			}
+
+			if reporter := observability.GetErrorReporter(); reporter != nil {
+				reporter.ReportPanic(panicErr, &observability.ErrorContext{
+					ComponentName: "EventBus",
+					EventName:     topic,
+					Timestamp:     time.Now(),
+					StackTrace:    debug.Stack(),
+					Breadcrumbs:   observability.GetBreadcrumbs(),
+				})
+			}
+		}
+	}()
+
+	handler(data)
+}
+
+// SubscriberCount returns the number of handlers subscribed to topic.
+func (b *EventBus) SubscriberCount(topic string) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.topics[topic])
+}
+
+// ProvideEventBus makes bus the EventBus for this component and all of its
+// descendants. Descendants calling ctx.Subscribe, ctx.Publish, or
+// ctx.EventBus use the nearest provided bus instead of the default.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    ctx.ProvideEventBus(bubbly.NewEventBus()) // Isolate this subtree
+//	})
+func (ctx *Context) ProvideEventBus(bus *EventBus) {
+	ctx.Provide(eventBusProvideKey, bus)
+}
+
+// EventBus returns the nearest EventBus provided by this component or an
+// ancestor (see ProvideEventBus), or DefaultEventBus if none was provided.
+func (ctx *Context) EventBus() *EventBus {
+	if bus, ok := ctx.Inject(eventBusProvideKey, nil).(*EventBus); ok && bus != nil {
+		return bus
+	}
+	return defaultEventBus
+}
+
+// Subscribe subscribes handler to topic on the component's EventBus.
+// The subscription is removed automatically when the component unmounts;
+// the returned function removes it early.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    toasts := ctx.Ref([]string{})
+//	    ctx.Subscribe("notify", func(data interface{}) {
+//	        toasts.Set(append(toasts.Get().([]string), data.(string)))
+//	    })
+//	})
+func (ctx *Context) Subscribe(topic string, handler EventHandler) func() {
+	cleanup := ctx.EventBus().Subscribe(topic, handler)
+	ctx.OnCleanup(cleanup)
+	return cleanup
+}
+
+// Publish publishes data to topic on the component's EventBus.
+//
+// Example:
+//
+//	ctx.On("save", func(_ interface{}) {
+//	    ctx.Publish("notify", "Settings saved")
+//	})
+func (ctx *Context) Publish(topic string, data interface{}) {
+	ctx.EventBus().Publish(topic, data)
+}
@@ -0,0 +1,139 @@
+package bubbly
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventBus_PublishSubscribe tests delivery, ordering, and topic isolation
+func TestEventBus_PublishSubscribe(t *testing.T) {
+	bus := NewEventBus()
+
+	var got []string
+	bus.Subscribe("notify", func(data interface{}) { got = append(got, "a:"+data.(string)) })
+	cleanup := bus.Subscribe("notify", func(data interface{}) { got = append(got, "b:"+data.(string)) })
+	bus.Subscribe("other", func(data interface{}) { got = append(got, "other") })
+
+	bus.Publish("notify", "saved")
+	assert.Equal(t, []string{"a:saved", "b:saved"}, got)
+	assert.Equal(t, 2, bus.SubscriberCount("notify"))
+
+	cleanup()
+	cleanup() // idempotent
+	got = nil
+	bus.Publish("notify", "again")
+	assert.Equal(t, []string{"a:again"}, got)
+	assert.Equal(t, 1, bus.SubscriberCount("notify"))
+
+	assert.NotPanics(t, func() { bus.Publish("nobody", nil) })
+	assert.NotPanics(t, func() { bus.Subscribe("x", nil)() })
+}
+
+// TestEventBus_HandlerPanic tests that a panicking handler doesn't stop delivery
+func TestEventBus_HandlerPanic(t *testing.T) {
+	bus := NewEventBus()
+
+	called := false
+	bus.Subscribe("topic", func(_ interface{}) { panic("boom") })
+	bus.Subscribe("topic", func(_ interface{}) { called = true })
+
+	assert.NotPanics(t, func() { bus.Publish("topic", nil) })
+	assert.True(t, called)
+}
+
+// TestEventBus_UnsubscribeDuringPublish tests removing a subscription from a handler
+func TestEventBus_UnsubscribeDuringPublish(t *testing.T) {
+	bus := NewEventBus()
+
+	calls := 0
+	var cleanup func()
+	cleanup = bus.Subscribe("once", func(_ interface{}) {
+		calls++
+		cleanup()
+	})
+	bus.Subscribe("once", func(_ interface{}) { calls++ })
+
+	bus.Publish("once", nil)
+	bus.Publish("once", nil)
+	assert.Equal(t, 3, calls)
+}
+
+// TestEventBus_Concurrent tests concurrent subscribe/publish/unsubscribe
+func TestEventBus_Concurrent(t *testing.T) {
+	bus := NewEventBus()
+	var count atomic.Int64
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cleanup := bus.Subscribe("tick", func(_ interface{}) { count.Add(1) })
+			bus.Publish("tick", nil)
+			cleanup()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, bus.SubscriberCount("tick"))
+	assert.Positive(t, count.Load())
+}
+
+// TestContext_EventBus tests provided buses and unmount cleanup
+func TestContext_EventBus(t *testing.T) {
+	parent := newComponentImpl("Parent")
+	child := newComponentImpl("Child")
+	child.parent = parent
+	orphan := newComponentImpl("Orphan")
+
+	parentCtx := &Context{component: parent}
+	childCtx := &Context{component: child}
+	orphanCtx := &Context{component: orphan}
+
+	bus := NewEventBus()
+	parentCtx.ProvideEventBus(bus)
+
+	assert.Same(t, bus, childCtx.EventBus(), "descendants use the provided bus")
+	assert.Same(t, DefaultEventBus(), orphanCtx.EventBus(), "falls back to the default bus")
+
+	var received []interface{}
+	childCtx.Subscribe("navigate", func(data interface{}) { received = append(received, data) })
+	parentCtx.Publish("navigate", "/settings")
+	assert.Equal(t, []interface{}{"/settings"}, received)
+
+	// Unmount removes the subscription
+	child.mounted = true
+	child.Unmount()
+	parentCtx.Publish("navigate", "/home")
+	assert.Equal(t, []interface{}{"/settings"}, received)
+	assert.Equal(t, 0, bus.SubscriberCount("navigate"))
+}
+
+// TestContext_Subscribe_BuiltComponent tests auto-cleanup through the public component API
+func TestContext_Subscribe_BuiltComponent(t *testing.T) {
+	bus := DefaultEventBus()
+	before := bus.SubscriberCount("test.toast")
+
+	toasts := NewRef([]string{})
+	comp, err := NewComponent("Toasts").
+		Setup(func(ctx *Context) {
+			ctx.Subscribe("test.toast", func(data interface{}) {
+				toasts.Set(append(toasts.GetTyped(), data.(string)))
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+
+	bus.Publish("test.toast", "hello")
+	assert.Equal(t, []string{"hello"}, toasts.GetTyped())
+	assert.Equal(t, before+1, bus.SubscriberCount("test.toast"))
+
+	comp.(interface{ Unmount() }).Unmount()
+	assert.Equal(t, before, bus.SubscriberCount("test.toast"))
+}
@@ -0,0 +1,125 @@
+package bubbly
+
+// EventDispatchFunc dispatches an event. It is the unit that event
+// middleware wraps.
+type EventDispatchFunc func(event *Event)
+
+// EventMiddleware wraps event dispatch to add cross-cutting behavior such as
+// logging, breadcrumbs, rate limiting, or payload transformation.
+//
+// A middleware receives the next dispatch step and returns a new one. It may:
+//   - Run code before and after calling next(event)
+//   - Modify event.Data before calling next to transform the payload
+//   - Call event.StopPropagation() to keep the event from reaching parents
+//   - Return without calling next to short-circuit dispatch entirely
+//
+// Example:
+//
+//	ctx.UseEventMiddleware(func(next bubbly.EventDispatchFunc) bubbly.EventDispatchFunc {
+//	    return func(event *bubbly.Event) {
+//	        if transitioning.Get() {
+//	            return // Block events during a transition
+//	        }
+//	        next(event)
+//	    }
+//	})
+type EventMiddleware func(next EventDispatchFunc) EventDispatchFunc
+
+// UseEventMiddleware adds middleware around this component's own event
+// handling.
+//
+// It runs every time an event reaches the component, whether emitted by the
+// component itself or bubbled up from a child, and wraps the component's
+// registered handlers only. Returning without calling next skips those
+// handlers; the event still bubbles to the parent unless the middleware
+// calls event.StopPropagation().
+//
+// Middleware runs in registration order: the first registered is the
+// outermost and sees the event first.
+func (c *componentImpl) UseEventMiddleware(mw EventMiddleware) {
+	c.eventMiddlewareMu.Lock()
+	defer c.eventMiddlewareMu.Unlock()
+	c.eventMiddleware = append(c.eventMiddleware, mw)
+}
+
+// UseSubtreeEventMiddleware adds middleware around the dispatch of every
+// event emitted by this component or any of its descendants.
+//
+// It runs once per Emit and wraps the whole dispatch, including bubbling
+// through every ancestor. Returning without calling next drops the event
+// before any handler sees it.
+//
+// Middleware registered by outer ancestors wraps that of inner ones, so a
+// root component's subtree middleware always sees events first. Within a
+// component, middleware runs in registration order.
+func (c *componentImpl) UseSubtreeEventMiddleware(mw EventMiddleware) {
+	c.eventMiddlewareMu.Lock()
+	defer c.eventMiddlewareMu.Unlock()
+	c.subtreeEventMiddleware = append(c.subtreeEventMiddleware, mw)
+}
+
+// UseEventMiddleware adds middleware around the component's own event
+// handling. See componentImpl.UseEventMiddleware for execution order and
+// short-circuiting.
+//
+// Example:
+//
+//	ctx.UseEventMiddleware(func(next bubbly.EventDispatchFunc) bubbly.EventDispatchFunc {
+//	    return func(event *bubbly.Event) {
+//	        log.Printf("received %s: %v", event.Name, event.Data)
+//	        next(event)
+//	    }
+//	})
+func (ctx *Context) UseEventMiddleware(mw EventMiddleware) {
+	ctx.component.UseEventMiddleware(mw)
+}
+
+// UseSubtreeEventMiddleware adds middleware around every event emitted by
+// the component or its descendants. See
+// componentImpl.UseSubtreeEventMiddleware for execution order and
+// short-circuiting.
+//
+// Example:
+//
+//	ctx.UseSubtreeEventMiddleware(func(next bubbly.EventDispatchFunc) bubbly.EventDispatchFunc {
+//	    return func(event *bubbly.Event) {
+//	        observability.RecordBreadcrumb("event", event.Name, nil)
+//	        next(event)
+//	    }
+//	})
+func (ctx *Context) UseSubtreeEventMiddleware(mw EventMiddleware) {
+	ctx.component.UseSubtreeEventMiddleware(mw)
+}
+
+// localEventMiddleware returns a snapshot of the component's own middleware.
+func (c *componentImpl) localEventMiddleware() []EventMiddleware {
+	c.eventMiddlewareMu.RLock()
+	defer c.eventMiddlewareMu.RUnlock()
+	if len(c.eventMiddleware) == 0 {
+		return nil
+	}
+	return append([]EventMiddleware(nil), c.eventMiddleware...)
+}
+
+// subtreeEventMiddlewareChain collects subtree middleware from c and its
+// ancestors, outermost ancestor first.
+func (c *componentImpl) subtreeEventMiddlewareChain() []EventMiddleware {
+	var chain []EventMiddleware
+	for comp := c; comp != nil; comp = comp.parent {
+		comp.eventMiddlewareMu.RLock()
+		if len(comp.subtreeEventMiddleware) > 0 {
+			// Prepend so ancestors end up outermost
+			chain = append(append([]EventMiddleware(nil), comp.subtreeEventMiddleware...), chain...)
+		}
+		comp.eventMiddlewareMu.RUnlock()
+	}
+	return chain
+}
+
+// applyEventMiddleware wraps dispatch so that middleware[0] is outermost.
+func applyEventMiddleware(middleware []EventMiddleware, dispatch EventDispatchFunc) EventDispatchFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		dispatch = middleware[i](dispatch)
+	}
+	return dispatch
+}
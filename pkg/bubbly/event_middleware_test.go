@@ -0,0 +1,155 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMiddleware appends label to log before calling next.
+func recordingMiddleware(log *[]string, label string) EventMiddleware {
+	return func(next EventDispatchFunc) EventDispatchFunc {
+		return func(event *Event) {
+			*log = append(*log, label+":"+event.Name)
+			next(event)
+		}
+	}
+}
+
+func buildMiddlewareTree(t *testing.T, parentSetup, childSetup SetupFunc) (parent, child Component) {
+	t.Helper()
+	child, err := NewComponent("Child").
+		Setup(childSetup).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+
+	parent, err = NewComponent("Parent").
+		Children(child).
+		Setup(parentSetup).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+	return parent, child
+}
+
+func TestUseEventMiddleware_Order(t *testing.T) {
+	var log []string
+	_, child := buildMiddlewareTree(t,
+		func(ctx *Context) {
+			ctx.UseSubtreeEventMiddleware(recordingMiddleware(&log, "parent-subtree"))
+			ctx.UseEventMiddleware(recordingMiddleware(&log, "parent-local"))
+			ctx.On("save", func(interface{}) { log = append(log, "parent-handler") })
+		},
+		func(ctx *Context) {
+			ctx.UseSubtreeEventMiddleware(recordingMiddleware(&log, "child-subtree"))
+			ctx.UseEventMiddleware(recordingMiddleware(&log, "child-local-1"))
+			ctx.UseEventMiddleware(recordingMiddleware(&log, "child-local-2"))
+			ctx.On("save", func(interface{}) { log = append(log, "child-handler") })
+		},
+	)
+
+	child.Emit("save", nil)
+
+	assert.Equal(t, []string{
+		"parent-subtree:save",
+		"child-subtree:save",
+		"child-local-1:save",
+		"child-local-2:save",
+		"child-handler",
+		"parent-local:save",
+		"parent-handler",
+	}, log)
+}
+
+func TestUseEventMiddleware_ShortCircuit(t *testing.T) {
+	tests := []struct {
+		name     string
+		local    EventMiddleware
+		expected []string
+	}{
+		{
+			name: "skip next skips local handlers only",
+			local: func(next EventDispatchFunc) EventDispatchFunc {
+				return func(event *Event) {}
+			},
+			expected: []string{"parent"},
+		},
+		{
+			name: "stop propagation keeps event local",
+			local: func(next EventDispatchFunc) EventDispatchFunc {
+				return func(event *Event) {
+					next(event)
+					event.StopPropagation()
+				}
+			},
+			expected: []string{"child"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			_, child := buildMiddlewareTree(t,
+				func(ctx *Context) {
+					ctx.On("save", func(interface{}) { got = append(got, "parent") })
+				},
+				func(ctx *Context) {
+					ctx.UseEventMiddleware(tt.local)
+					ctx.On("save", func(interface{}) { got = append(got, "child") })
+				},
+			)
+
+			child.Emit("save", nil)
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestUseSubtreeEventMiddleware_BlocksAndTransforms(t *testing.T) {
+	blocked := true
+	var got []interface{}
+	_, child := buildMiddlewareTree(t,
+		func(ctx *Context) {
+			ctx.UseSubtreeEventMiddleware(func(next EventDispatchFunc) EventDispatchFunc {
+				return func(event *Event) {
+					if blocked {
+						return
+					}
+					if n, ok := event.Data.(int); ok {
+						event.Data = n * 10
+					}
+					next(event)
+				}
+			})
+			ctx.On("value", func(data interface{}) { got = append(got, data) })
+		},
+		func(ctx *Context) {
+			ctx.On("value", func(data interface{}) { got = append(got, data) })
+		},
+	)
+
+	child.Emit("value", 1)
+	assert.Empty(t, got)
+
+	blocked = false
+	child.Emit("value", 2)
+	assert.Equal(t, []interface{}{20, 20}, got)
+}
+
+func TestUseSubtreeEventMiddleware_IgnoresEventsFromAncestors(t *testing.T) {
+	var log []string
+	parent, _ := buildMiddlewareTree(t,
+		func(ctx *Context) {},
+		func(ctx *Context) {
+			ctx.UseSubtreeEventMiddleware(recordingMiddleware(&log, "child-subtree"))
+		},
+	)
+
+	parent.Emit("save", nil)
+
+	assert.Empty(t, log)
+}
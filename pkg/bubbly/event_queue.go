@@ -0,0 +1,98 @@
+package bubbly
+
+import (
+	"fmt"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// queuedEvent is an event emitted before setup completed, waiting to be
+// replayed (see WithQueuedEvents).
+type queuedEvent struct {
+	name string
+	data interface{}
+}
+
+// WithQueuedEvents enables or disables buffering of events emitted before
+// the component is initialized.
+//
+// Handlers are usually registered in Setup, which runs in Init(). Without
+// queueing, an event emitted before Init() reaches no handler and is lost.
+// When enabled, such events are buffered and replayed, in order, right
+// after Setup completes.
+//
+// When disabled (default), early events are delivered immediately; if no
+// handler receives one, an "event" breadcrumb is recorded via observability
+// to make the dropped event visible.
+//
+// Example:
+//
+//	form, _ := NewComponent("Form").
+//	    WithQueuedEvents(true).
+//	    Setup(func(ctx *Context) {
+//	        ctx.On("reset", func(_ interface{}) { ... })
+//	    }).
+//	    Template(...).
+//	    Build()
+//
+//	form.Emit("reset", nil) // Buffered
+//	form.Init()             // Setup runs, then "reset" is delivered
+func (b *ComponentBuilder) WithQueuedEvents(enabled bool) *ComponentBuilder {
+	b.component.queueEvents = enabled
+	return b
+}
+
+// queueEventBeforeSetup buffers an event if queueing is enabled and setup
+// has not completed yet. It returns true if the event was queued.
+func (c *componentImpl) queueEventBeforeSetup(name string, data interface{}) bool {
+	c.eventQueueMu.Lock()
+	defer c.eventQueueMu.Unlock()
+
+	if c.setupDone.Load() || !c.queueEvents {
+		return false
+	}
+	c.queuedEvents = append(c.queuedEvents, queuedEvent{name: name, data: data})
+	return true
+}
+
+// finishSetup marks setup as complete and replays queued events in order.
+func (c *componentImpl) finishSetup() {
+	c.eventQueueMu.Lock()
+	c.setupDone.Store(true)
+	queued := c.queuedEvents
+	c.queuedEvents = nil
+	c.eventQueueMu.Unlock()
+
+	for _, e := range queued {
+		c.Emit(e.name, e.data)
+	}
+}
+
+// hasHandlerInChain reports whether c or one of its ancestors has a handler
+// registered for the event.
+func (c *componentImpl) hasHandlerInChain(name string) bool {
+	for comp := c; comp != nil; comp = comp.parent {
+		comp.handlersMu.RLock()
+		n := len(comp.handlers[name])
+		comp.handlersMu.RUnlock()
+		if n > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// warnUnhandledEarlyEvent records a breadcrumb for an event emitted before
+// setup that no handler will receive.
+func (c *componentImpl) warnUnhandledEarlyEvent(name string) {
+	if c.hasHandlerInChain(name) {
+		return
+	}
+	observability.RecordBreadcrumb("event",
+		fmt.Sprintf("event %q emitted by %s before Init has no handler and was dropped (see WithQueuedEvents)", name, c.name),
+		map[string]interface{}{
+			"component":    c.name,
+			"component_id": c.id,
+			"event":        name,
+		})
+}
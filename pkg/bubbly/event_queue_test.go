@@ -0,0 +1,110 @@
+package bubbly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
+)
+
+// buildEventQueueComponent builds a component whose Setup records events.
+func buildEventQueueComponent(t *testing.T, queued bool, received *[]string) Component {
+	t.Helper()
+	c, err := NewComponent("Form").
+		WithQueuedEvents(queued).
+		Setup(func(ctx *Context) {
+			ctx.On("reset", func(data interface{}) {
+				*received = append(*received, "reset:"+data.(string))
+			})
+			ctx.On("submit", func(data interface{}) {
+				*received = append(*received, "submit:"+data.(string))
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "form" }).
+		Build()
+	require.NoError(t, err)
+	return c
+}
+
+func TestWithQueuedEvents_ReplaysAfterSetup(t *testing.T) {
+	var received []string
+	c := buildEventQueueComponent(t, true, &received)
+
+	c.Emit("reset", "1")
+	c.Emit("submit", "2")
+	c.Emit("reset", "3")
+	assert.Empty(t, received, "events should be buffered before Init")
+
+	c.Init()
+	assert.Equal(t, []string{"reset:1", "submit:2", "reset:3"}, received)
+
+	// After Init, events are delivered immediately
+	c.Emit("submit", "4")
+	assert.Equal(t, []string{"reset:1", "submit:2", "reset:3", "submit:4"}, received)
+
+	// Init is idempotent and does not replay again
+	c.Init()
+	assert.Len(t, received, 4)
+}
+
+func TestWithQueuedEvents_DisabledDropsEarlyEvents(t *testing.T) {
+	var received []string
+	c := buildEventQueueComponent(t, false, &received)
+
+	c.Emit("reset", "1")
+	c.Init()
+
+	assert.Empty(t, received)
+}
+
+func TestEmit_BeforeInitWarnsWhenUnhandled(t *testing.T) {
+	observability.ClearBreadcrumbs()
+	defer observability.ClearBreadcrumbs()
+
+	var received []string
+	c := buildEventQueueComponent(t, false, &received)
+	c.On("direct", func(interface{}) {})
+
+	// Handlers registered outside Setup still receive early events: no warning
+	c.Emit("direct", nil)
+	assert.Empty(t, observability.GetBreadcrumbs())
+
+	c.Emit("reset", "1")
+	crumbs := observability.GetBreadcrumbs()
+	require.Len(t, crumbs, 1)
+	assert.Equal(t, "event", crumbs[0].Category)
+	assert.True(t, strings.Contains(crumbs[0].Message, `"reset"`))
+	assert.Equal(t, "reset", crumbs[0].Data["event"])
+	assert.Equal(t, c.ID(), crumbs[0].Data["component_id"])
+
+	// After Init, unhandled events are normal and not reported
+	c.Init()
+	c.Emit("unknown", nil)
+	assert.Len(t, observability.GetBreadcrumbs(), 1)
+}
+
+func TestEmit_BeforeInitHandledByParentDoesNotWarn(t *testing.T) {
+	observability.ClearBreadcrumbs()
+	defer observability.ClearBreadcrumbs()
+
+	child, err := NewComponent("Child").
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+
+	var got interface{}
+	parent, err := NewComponent("Parent").
+		Children(child).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	parent.On("ping", func(data interface{}) { got = data })
+
+	child.Emit("ping", 42)
+
+	assert.Equal(t, 42, got)
+	assert.Empty(t, observability.GetBreadcrumbs())
+}
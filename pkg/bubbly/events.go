@@ -89,6 +89,9 @@ func (e *Event) StopPropagation() {
 //  3. Check if event.Stopped is true after handlers execute
 //  4. If not stopped and parent exists, recursively call parent.bubbleEvent
 //
+// Local handlers are wrapped by the component's event middleware, if any
+// (see UseEventMiddleware).
+//
 // Thread-safe: Uses existing handlersMu RWMutex for concurrent access.
 func (c *componentImpl) bubbleEvent(event *Event) {
 	// Skip if event propagation was already stopped
@@ -96,6 +99,21 @@ func (c *componentImpl) bubbleEvent(event *Event) {
 		return
 	}
 
+	if middleware := c.localEventMiddleware(); len(middleware) > 0 {
+		applyEventMiddleware(middleware, c.runHandlers)(event)
+	} else {
+		c.runHandlers(event)
+	}
+
+	// Bubble to parent if not stopped and parent exists
+	if !event.Stopped && c.parent != nil {
+		c.parent.bubbleEvent(event)
+	}
+}
+
+// runHandlers executes the component's handlers for the event, in
+// registration order, until one stops propagation.
+func (c *componentImpl) runHandlers(event *Event) {
 	// Get handlers with read lock
 	c.handlersMu.RLock()
 	handlers, ok := c.handlers[event.Name]
@@ -127,6 +145,13 @@ func (c *componentImpl) bubbleEvent(event *Event) {
 							})
 						}
 
+						// Let error boundaries above the component know
+						c.captureError(&ComponentPanicError{
+							ComponentName: c.name,
+							Phase:         "event:" + event.Name,
+							PanicValue:    r,
+						})
+
 						// Note: We don't stop propagation on panic - other handlers should still run
 					}
 				}()
@@ -142,11 +167,6 @@ func (c *componentImpl) bubbleEvent(event *Event) {
 			}
 		}
 	}
-
-	// Bubble to parent if not stopped and parent exists
-	if !event.Stopped && c.parent != nil {
-		c.parent.bubbleEvent(event)
-	}
 }
 
 // registerHandler is an internal method that registers an event handler.
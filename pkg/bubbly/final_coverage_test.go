@@ -67,11 +67,14 @@ func TestUpdate_BeforeUpdateHook(t *testing.T) {
 
 	component.Init()
 
-	// Update should execute beforeUpdate hooks
+	// Before the first render the component isn't mounted, so Update
+	// skips beforeUpdate hooks
 	component.Update(nil)
+	assert.False(t, *component.(*componentImpl).state["executed"].(*bool))
 
-	// Note: beforeUpdate hooks exist but may not be called automatically by Update
-	// This test ensures Update doesn't panic with beforeUpdate hooks present
+	component.View()
+	component.Update(nil)
+	assert.True(t, *component.(*componentImpl).state["executed"].(*bool))
 }
 
 // TestChildren_DepthCalculation tests calculateDepthToRoot edge cases.
@@ -0,0 +1,20 @@
+package bubbly
+
+// IsFocusable reports whether the component was marked as a focus target
+// via ComponentBuilder.Focusable().
+//
+// Components not created by the builder (custom Component implementations)
+// are never considered focusable.
+//
+// Example:
+//
+//	if bubbly.IsFocusable(child) {
+//	    manager.Register(child)
+//	}
+func IsFocusable(c Component) bool {
+	impl, ok := c.(*componentImpl)
+	if !ok || impl == nil {
+		return false
+	}
+	return impl.focusable
+}
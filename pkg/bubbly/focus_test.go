@@ -0,0 +1,38 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIsFocusable tests that the Focusable builder option marks components.
+func TestIsFocusable(t *testing.T) {
+	tests := []struct {
+		name      string
+		focusable bool
+	}{
+		{"marked focusable", true},
+		{"not marked", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewComponent("Field").
+				Template(func(ctx RenderContext) string { return "" })
+			if tt.focusable {
+				builder = builder.Focusable()
+			}
+			comp, err := builder.Build()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.focusable, IsFocusable(comp))
+		})
+	}
+}
+
+// TestIsFocusable_Nil tests that nil components are never focusable.
+func TestIsFocusable_Nil(t *testing.T) {
+	assert.False(t, IsFocusable(nil))
+}
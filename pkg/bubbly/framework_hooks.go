@@ -155,6 +155,19 @@ type FrameworkHook interface {
 	OnRefExposed(componentID, refID, refName string)
 }
 
+// RenderSkipHook is an optional interface for framework hooks that want to
+// know when a memoized component skips its template (see Memo). Hooks
+// registered with RegisterHook that implement it are notified.
+type RenderSkipHook interface {
+	// OnRenderSkipped is called when a component's View returns its cached
+	// output instead of running its template. OnRenderComplete is not
+	// called for that View.
+	//
+	// Parameters:
+	//   - componentID: The component whose render was skipped
+	OnRenderSkipped(componentID string)
+}
+
 // hookRegistry manages the registered framework hook.
 type hookRegistry struct {
 	mu   sync.RWMutex
@@ -320,6 +333,18 @@ func notifyHookRenderComplete(componentID string, duration time.Duration) {
 	}
 }
 
+// notifyHookRenderSkipped calls the registered hook's OnRenderSkipped
+// method if it implements RenderSkipHook.
+func notifyHookRenderSkipped(componentID string) {
+	globalHookRegistry.mu.RLock()
+	hook := globalHookRegistry.hook
+	globalHookRegistry.mu.RUnlock()
+
+	if skipHook, ok := hook.(RenderSkipHook); ok {
+		skipHook.OnRenderSkipped(componentID)
+	}
+}
+
 // notifyHookComputedChange calls the registered hook's OnComputedChange method.
 // This is an internal helper used by framework integration points.
 func notifyHookComputedChange(id string, oldValue, newValue interface{}) {
@@ -0,0 +1,69 @@
+package bubbly
+
+import "errors"
+
+// ErrHotReloadUnsupported is returned when a template is reloaded on a
+// Component implementation that does not own its template (e.g., wrappers).
+var ErrHotReloadUnsupported = errors.New("component does not support hot reload")
+
+// TemplateReloadMsg replaces the template of every component named Name in
+// the tree it is sent to, keeping their state. It is meant for development:
+// a file watcher in the host rebuilds a template and sends it to the running
+// program, and the UI re-renders with it without losing Refs, children, or
+// the cursor position.
+//
+// Sending the message (rather than calling ReloadTemplate from the watcher's
+// goroutine) swaps the template on the program's goroutine, so it never
+// races with View.
+//
+// Example:
+//
+//	go func() {
+//	    for range templateChanged {
+//	        program.Send(bubbly.TemplateReloadMsg{
+//	            Name:     "TodoList",
+//	            Template: todoListTemplate,
+//	        })
+//	    }
+//	}()
+type TemplateReloadMsg struct {
+	// Name is the name of the components to reload (as in NewComponent).
+	Name string
+
+	// Template is the new template. Messages with a nil Template are ignored.
+	Template RenderFunc
+}
+
+// ReloadTemplate replaces c's template without touching its state: Setup is
+// not re-run, so Refs, event handlers, lifecycle hooks, and children are
+// kept, and the next View renders with template.
+//
+// ReloadTemplate must not be called concurrently with View; from other
+// goroutines, send a TemplateReloadMsg instead.
+//
+// Example:
+//
+//	// In a test or a debug key handler
+//	err := bubbly.ReloadTemplate(counter, func(ctx bubbly.RenderContext) string {
+//	    return fmt.Sprintf("Count: %d", ctx.Get("count").(*bubbly.Ref[int]).GetTyped())
+//	})
+func ReloadTemplate(c Component, template RenderFunc) error {
+	if template == nil {
+		return ErrMissingTemplate
+	}
+	impl, ok := c.(*componentImpl)
+	if !ok {
+		return ErrHotReloadUnsupported
+	}
+	impl.template = template
+	impl.invalidateMemo()
+	return nil
+}
+
+// handleTemplateReloadMsg applies msg if it targets this component.
+func (c *componentImpl) handleTemplateReloadMsg(msg TemplateReloadMsg) {
+	if msg.Template != nil && msg.Name == c.name {
+		c.template = msg.Template
+		c.invalidateMemo()
+	}
+}
@@ -0,0 +1,100 @@
+package bubbly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHotReloadCounter builds an initialized counter whose "increment" event
+// bumps its exposed count.
+func newHotReloadCounter(t *testing.T, name string) Component {
+	t.Helper()
+	comp, err := NewComponent(name).
+		Setup(func(ctx *Context) {
+			count := NewRef(0)
+			ctx.Expose("count", count)
+			ctx.On("increment", func(_ interface{}) { count.Set(count.GetTyped() + 1) })
+		}).
+		Template(func(ctx RenderContext) string {
+			return fmt.Sprintf("count=%d", ctx.Get("count").(*Ref[int]).GetTyped())
+		}).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	return comp
+}
+
+func hotReloadTemplate(ctx RenderContext) string {
+	return fmt.Sprintf("Count: %d", ctx.Get("count").(*Ref[int]).GetTyped())
+}
+
+// TestReloadTemplate tests the template is replaced and state is kept
+func TestReloadTemplate(t *testing.T) {
+	comp := newHotReloadCounter(t, "Counter")
+	comp.Emit("increment", nil)
+	require.Equal(t, "count=1", comp.View())
+
+	err := ReloadTemplate(comp, hotReloadTemplate)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Count: 1", comp.View(), "State should survive the reload")
+	comp.Emit("increment", nil)
+	assert.Equal(t, "Count: 2", comp.View(), "Handlers should survive the reload")
+}
+
+// TestReloadTemplate_Errors tests invalid reloads leave the template alone
+func TestReloadTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		comp     func(t *testing.T) Component
+		template RenderFunc
+		expected error
+	}{
+		{
+			name:     "nil template",
+			comp:     func(t *testing.T) Component { return newHotReloadCounter(t, "Counter") },
+			template: nil,
+			expected: ErrMissingTemplate,
+		},
+		{
+			name:     "unsupported component",
+			comp:     func(t *testing.T) Component { return LazyComponent(nil) },
+			template: hotReloadTemplate,
+			expected: ErrHotReloadUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := tt.comp(t)
+			assert.ErrorIs(t, ReloadTemplate(comp, tt.template), tt.expected)
+		})
+	}
+}
+
+// TestTemplateReloadMsg tests the message reloads matching components in the tree
+func TestTemplateReloadMsg(t *testing.T) {
+	first := newHotReloadCounter(t, "Counter")
+	second := newHotReloadCounter(t, "Counter")
+	other := newHotReloadCounter(t, "Other")
+
+	root, err := NewComponent("App").
+		Children(first, second, other).
+		Template(func(ctx RenderContext) string {
+			return first.View() + " " + second.View() + " " + other.View()
+		}).
+		Build()
+	require.NoError(t, err)
+	root.Init()
+	second.Emit("increment", nil)
+
+	root.Update(TemplateReloadMsg{Name: "Counter", Template: hotReloadTemplate})
+
+	assert.Equal(t, "Count: 0 Count: 1 count=0", root.View())
+
+	root.Update(TemplateReloadMsg{Name: "Counter"})
+	assert.Equal(t, "Count: 0 Count: 1 count=0", root.View(), "Nil templates should be ignored")
+}
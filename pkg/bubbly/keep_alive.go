@@ -0,0 +1,428 @@
+package bubbly
+
+import (
+	"fmt"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultMaxAlive is the number of components a KeepAlive retains when
+// WithMaxAlive is not given.
+const DefaultMaxAlive = 10
+
+// KeepAliveOption configures a KeepAlive.
+type KeepAliveOption func(*KeepAlive)
+
+// WithMaxAlive bounds how many components a KeepAlive retains, including
+// the one being shown. When a new key would exceed the bound, the
+// component shown least recently is unmounted and discarded. Values below
+// 1 are ignored.
+//
+// Example:
+//
+//	views := bubbly.NewKeepAlive(bubbly.WithMaxAlive(3))
+func WithMaxAlive(n int) KeepAliveOption {
+	return func(k *KeepAlive) {
+		if n >= 1 {
+			k.max = n
+		}
+	}
+}
+
+// KeepAlive is a Component that shows one of several keyed components and
+// keeps the hidden ones alive, so switching back resumes them with their
+// state intact instead of rebuilding them. See NewKeepAlive.
+//
+// KeepAlive is thread-safe and can be used concurrently.
+type KeepAlive struct {
+	max int
+	id  string
+
+	mu         sync.Mutex
+	entries    map[string]Component
+	order      []string // Cached keys, least recently shown first
+	active     string
+	parent     *componentImpl
+	handlers   map[string][]EventHandler
+	pendingCmd tea.Cmd
+}
+
+// NewKeepAlive creates an empty KeepAlive. Call Show to pick the component
+// it renders.
+//
+// Components are built on first Show of their key and Init'd immediately;
+// any command returned by Init is delivered on the next Update. A hidden
+// component stays mounted: its refs, watchers and children are kept, but
+// it receives no messages and is not rendered. OnDeactivated hooks run
+// when it is hidden and OnActivated hooks run each time it is shown.
+//
+// At most WithMaxAlive components (DefaultMaxAlive by default) are kept;
+// beyond that the least recently shown one is unmounted.
+//
+// Like LazyComponent, the shown components join the surrounding tree when
+// the KeepAlive is added as a child.
+//
+// Example:
+//
+//	views := bubbly.NewKeepAlive(bubbly.WithMaxAlive(5))
+//	screens := map[string]func() bubbly.Component{
+//	    "inbox":    CreateInbox,
+//	    "settings": CreateSettings,
+//	}
+//
+//	// In the parent's template
+//	views.Show(tab.GetTyped(), screens[tab.GetTyped()])
+//	return views.View()
+func NewKeepAlive(opts ...KeepAliveOption) *KeepAlive {
+	k := &KeepAlive{
+		max:      DefaultMaxAlive,
+		id:       fmt.Sprintf("keep-alive-%d", componentIDCounter.Add(1)),
+		entries:  make(map[string]Component),
+		handlers: make(map[string][]EventHandler),
+	}
+	for _, opt := range opts {
+		opt(k)
+	}
+	return k
+}
+
+// Show makes the component under key the one rendered, building it with
+// factory if it is not cached. Showing the key that is already shown does
+// nothing, so Show can be called on every render.
+//
+// If key is not cached and factory is nil or returns nil, the KeepAlive
+// renders nothing until another key is shown.
+func (k *KeepAlive) Show(key string, factory func() Component) {
+	k.mu.Lock()
+	if key == k.active && k.entries[key] != nil {
+		k.mu.Unlock()
+		return
+	}
+
+	prev := k.entries[k.active]
+	next, cached := k.entries[key]
+	if !cached {
+		next = k.build(factory)
+		if next != nil {
+			k.entries[key] = next
+		}
+	}
+	k.active = key
+	if next != nil {
+		k.touch(key)
+	}
+	evicted := k.evict()
+	parent := k.parent
+	k.mu.Unlock()
+
+	if prev != nil {
+		runKeepAliveHooks(prev, "deactivated")
+	}
+	if next != nil {
+		runKeepAliveHooks(next, "activated")
+	}
+	unmountAll(evicted)
+
+	if parent != nil {
+		parent.invalidateMemo()
+	}
+}
+
+// Hide stops rendering the shown component, keeping it alive so a later
+// Show of its key resumes it.
+func (k *KeepAlive) Hide() {
+	k.mu.Lock()
+	prev := k.entries[k.active]
+	k.active = ""
+	parent := k.parent
+	k.mu.Unlock()
+
+	if prev == nil {
+		return
+	}
+	runKeepAliveHooks(prev, "deactivated")
+	if parent != nil {
+		parent.invalidateMemo()
+	}
+}
+
+// Remove unmounts and discards the component under key. If it is being
+// shown, the KeepAlive renders nothing until another key is shown.
+func (k *KeepAlive) Remove(key string) {
+	k.mu.Lock()
+	comp, ok := k.entries[key]
+	if !ok {
+		k.mu.Unlock()
+		return
+	}
+	delete(k.entries, key)
+	k.order = removeKey(k.order, key)
+	if k.active == key {
+		k.active = ""
+	}
+	k.mu.Unlock()
+
+	unmountAll([]Component{comp})
+}
+
+// Active returns the key last passed to Show, or "" if nothing is shown.
+func (k *KeepAlive) Active() string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.active
+}
+
+// Keys returns the cached keys, least recently shown first.
+func (k *KeepAlive) Keys() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	keys := make([]string, len(k.order))
+	copy(keys, k.order)
+	return keys
+}
+
+// build creates and initializes a component for Show.
+// Must be called with k.mu held.
+func (k *KeepAlive) build(factory func() Component) Component {
+	if factory == nil {
+		return nil
+	}
+	comp := factory()
+	if comp == nil {
+		return nil
+	}
+
+	// Join the surrounding tree before Init so Setup can Inject
+	if impl, ok := comp.(*componentImpl); ok && k.parent != nil {
+		impl.parent = k.parent
+	}
+	for event, handlers := range k.handlers {
+		for _, handler := range handlers {
+			comp.On(event, handler)
+		}
+	}
+
+	if !comp.IsInitialized() {
+		k.pendingCmd = tea.Batch(k.pendingCmd, comp.Init())
+	}
+	return comp
+}
+
+// touch marks key as the most recently shown.
+// Must be called with k.mu held.
+func (k *KeepAlive) touch(key string) {
+	k.order = append(removeKey(k.order, key), key)
+}
+
+// evict drops the least recently shown components beyond the bound and
+// returns them for unmounting. The shown component is never evicted.
+// Must be called with k.mu held.
+func (k *KeepAlive) evict() []Component {
+	var evicted []Component
+	for len(k.order) > k.max {
+		key := k.order[0]
+		if key == k.active {
+			break
+		}
+		evicted = append(evicted, k.entries[key])
+		delete(k.entries, key)
+		k.order = k.order[1:]
+	}
+	return evicted
+}
+
+// current returns the shown component, or nil.
+func (k *KeepAlive) current() Component {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.entries[k.active]
+}
+
+// setParent implements parentAware.
+func (k *KeepAlive) setParent(parent *componentImpl) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.parent = parent
+	for _, comp := range k.entries {
+		if impl, ok := comp.(*componentImpl); ok {
+			impl.parent = parent
+		}
+	}
+}
+
+// Unmount unmounts every cached component.
+// Called automatically when the KeepAlive's parent unmounts.
+func (k *KeepAlive) Unmount() {
+	k.mu.Lock()
+	comps := make([]Component, 0, len(k.order))
+	for _, key := range k.order {
+		comps = append(comps, k.entries[key])
+	}
+	k.entries = make(map[string]Component)
+	k.order = nil
+	k.active = ""
+	k.pendingCmd = nil
+	k.mu.Unlock()
+
+	unmountAll(comps)
+}
+
+// Init implements tea.Model. Components are built by Show, so it does nothing.
+func (k *KeepAlive) Init() tea.Cmd {
+	return nil
+}
+
+// Update forwards msg to the shown component only; hidden components keep
+// their state untouched until shown again.
+func (k *KeepAlive) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	k.mu.Lock()
+	comp := k.entries[k.active]
+	pending := k.pendingCmd
+	k.pendingCmd = nil
+	k.mu.Unlock()
+
+	var cmd tea.Cmd
+	if comp != nil {
+		_, cmd = comp.Update(msg)
+	}
+	if pending != nil {
+		cmd = tea.Batch(pending, cmd)
+	}
+	return k, cmd
+}
+
+// View renders the shown component, or "" if nothing is shown.
+func (k *KeepAlive) View() string {
+	if comp := k.current(); comp != nil {
+		return comp.View()
+	}
+	return ""
+}
+
+// Name returns the shown component's name, or "KeepAlive" if nothing is shown.
+func (k *KeepAlive) Name() string {
+	if comp := k.current(); comp != nil {
+		return comp.Name()
+	}
+	return "KeepAlive"
+}
+
+// ID returns the KeepAlive's own stable identifier.
+func (k *KeepAlive) ID() string {
+	return k.id
+}
+
+// Props returns the shown component's props, or nil if nothing is shown.
+func (k *KeepAlive) Props() interface{} {
+	if comp := k.current(); comp != nil {
+		return comp.Props()
+	}
+	return nil
+}
+
+// Emit forwards the event to the shown component.
+func (k *KeepAlive) Emit(event string, data interface{}) {
+	if comp := k.current(); comp != nil {
+		comp.Emit(event, data)
+	}
+}
+
+// On registers a handler on every cached component and on each component
+// built later.
+func (k *KeepAlive) On(event string, handler EventHandler) {
+	k.mu.Lock()
+	k.handlers[event] = append(k.handlers[event], handler)
+	comps := make([]Component, 0, len(k.entries))
+	for _, comp := range k.entries {
+		comps = append(comps, comp)
+	}
+	k.mu.Unlock()
+
+	for _, comp := range comps {
+		comp.On(event, handler)
+	}
+}
+
+// KeyBindings returns the shown component's key bindings, or nil.
+func (k *KeepAlive) KeyBindings() map[string][]KeyBinding {
+	if comp := k.current(); comp != nil {
+		return comp.KeyBindings()
+	}
+	return nil
+}
+
+// HelpText returns the shown component's help text, or "".
+func (k *KeepAlive) HelpText() string {
+	if comp := k.current(); comp != nil {
+		return comp.HelpText()
+	}
+	return ""
+}
+
+// IsInitialized reports whether a component is shown and initialized.
+func (k *KeepAlive) IsInitialized() bool {
+	if comp := k.current(); comp != nil {
+		return comp.IsInitialized()
+	}
+	return false
+}
+
+// Measure returns the shown component's last rendered size, or (0, 0).
+func (k *KeepAlive) Measure() (width, height int) {
+	if comp := k.current(); comp != nil {
+		return Measure(comp)
+	}
+	return 0, 0
+}
+
+// Bounds returns the shown component's screen rectangle, or zeros.
+func (k *KeepAlive) Bounds() (x, y, w, h int) {
+	if comp := k.current(); comp != nil {
+		return Bounds(comp)
+	}
+	return 0, 0, 0, 0
+}
+
+// runKeepAliveHooks runs the "activated" or "deactivated" hooks of comp and
+// its descendants, parents first. Wrappers pass the hooks on to the
+// component they currently hold.
+func runKeepAliveHooks(comp Component, hookType string) {
+	switch c := comp.(type) {
+	case *componentImpl:
+		if c.lifecycle != nil && !c.lifecycle.IsUnmounting() {
+			c.lifecycle.executeHooks(hookType)
+		}
+		for _, child := range c.Children() {
+			runKeepAliveHooks(child, hookType)
+		}
+	case *Lazy:
+		if inner := c.current(); inner != nil {
+			runKeepAliveHooks(inner, hookType)
+		}
+	case *KeepAlive:
+		if inner := c.current(); inner != nil {
+			runKeepAliveHooks(inner, hookType)
+		}
+	}
+}
+
+// unmountAll unmounts each component that supports it.
+func unmountAll(comps []Component) {
+	for _, comp := range comps {
+		if unmounter, ok := comp.(interface{ Unmount() }); ok {
+			unmounter.Unmount()
+		}
+	}
+}
+
+// removeKey returns keys without key, reusing its backing array.
+func removeKey(keys []string, key string) []string {
+	for i, k := range keys {
+		if k == key {
+			return append(keys[:i], keys[i+1:]...)
+		}
+	}
+	return keys
+}
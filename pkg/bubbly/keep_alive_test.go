@@ -0,0 +1,175 @@
+package bubbly
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keepAliveTestLog records builds and lifecycle events of keep-alive test components.
+type keepAliveTestLog struct {
+	events []string
+}
+
+// factory returns a factory for a counter named name that increments on "+".
+func (l *keepAliveTestLog) factory(t *testing.T, name string) func() Component {
+	t.Helper()
+	return func() Component {
+		l.events = append(l.events, name+":build")
+		comp, err := NewComponent(name).
+			Setup(func(ctx *Context) {
+				count := ctx.Ref(0)
+				ctx.Expose("count", count)
+				ctx.On("increment", func(interface{}) { count.Set(count.Get().(int) + 1) })
+				ctx.OnActivated(func() { l.events = append(l.events, name+":activated") })
+				ctx.OnDeactivated(func() { l.events = append(l.events, name+":deactivated") })
+				ctx.OnUnmounted(func() { l.events = append(l.events, name+":unmounted") })
+			}).
+			Template(func(ctx RenderContext) string {
+				return fmt.Sprintf("%s=%d", name, ctx.Get("count").(*Ref[interface{}]).Get())
+			}).
+			WithKeyBinding("+", "increment", "Increment").
+			Build()
+		require.NoError(t, err)
+		return comp
+	}
+}
+
+// take returns the recorded events and clears the log.
+func (l *keepAliveTestLog) take() []string {
+	events := l.events
+	l.events = nil
+	return events
+}
+
+var keepAlivePlus = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")}
+
+// TestKeepAlive_PreservesState tests that switching back resumes the same instance
+func TestKeepAlive_PreservesState(t *testing.T) {
+	log := &keepAliveTestLog{}
+	views := NewKeepAlive()
+
+	var _ Component = views
+	assert.Equal(t, "", views.View())
+	assert.Equal(t, "KeepAlive", views.Name())
+
+	views.Show("a", log.factory(t, "a"))
+	views.Update(keepAlivePlus)
+	views.Update(keepAlivePlus)
+	assert.Equal(t, "a=2", views.View())
+
+	views.Show("b", log.factory(t, "b"))
+	views.Update(keepAlivePlus)
+	assert.Equal(t, "b=1", views.View())
+	assert.Equal(t, "b", views.Name())
+
+	views.Show("a", log.factory(t, "a"))
+	assert.Equal(t, "a=2", views.View(), "state survives while hidden")
+	assert.Equal(t, "a", views.Active())
+	assert.Equal(t, []string{"b", "a"}, views.Keys())
+
+	views.Show("a", log.factory(t, "a"))
+	assert.Equal(t, []string{
+		"a:build", "a:activated",
+		"b:build", "a:deactivated", "b:activated",
+		"b:deactivated", "a:activated",
+	}, log.take(), "built once per key, showing the shown key is a no-op")
+}
+
+// TestKeepAlive_MaxAlive tests that the least recently shown component is evicted
+func TestKeepAlive_MaxAlive(t *testing.T) {
+	log := &keepAliveTestLog{}
+	views := NewKeepAlive(WithMaxAlive(2))
+
+	views.Show("a", log.factory(t, "a"))
+	views.Show("b", log.factory(t, "b"))
+	views.Show("a", log.factory(t, "a"))
+	log.take()
+
+	views.Show("c", log.factory(t, "c"))
+	assert.Equal(t, []string{"a", "c"}, views.Keys())
+	assert.Equal(t, []string{"c:build", "a:deactivated", "c:activated", "b:unmounted"}, log.take())
+
+	views.Show("b", log.factory(t, "b"))
+	assert.Equal(t, "b=0", views.View(), "evicted component is rebuilt")
+	assert.Equal(t, []string{"c", "b"}, views.Keys())
+
+	assert.Equal(t, DefaultMaxAlive, NewKeepAlive(WithMaxAlive(0)).max)
+}
+
+// TestKeepAlive_HideRemoveUnmount tests hiding, discarding and unmounting
+func TestKeepAlive_HideRemoveUnmount(t *testing.T) {
+	log := &keepAliveTestLog{}
+	views := NewKeepAlive()
+
+	views.Show("a", log.factory(t, "a"))
+	views.Show("b", log.factory(t, "b"))
+	log.take()
+
+	views.Hide()
+	assert.Equal(t, "", views.View())
+	assert.Equal(t, "", views.Active())
+	_, cmd := views.Update(keepAlivePlus)
+	assert.Nil(t, cmd)
+	assert.Equal(t, []string{"b:deactivated"}, log.take())
+
+	views.Remove("a")
+	views.Remove("missing")
+	assert.Equal(t, []string{"b"}, views.Keys())
+	assert.Equal(t, []string{"a:unmounted"}, log.take())
+
+	views.Show("b", nil)
+	assert.Equal(t, "b=0", views.View(), "cached key needs no factory")
+
+	views.Show("none", nil)
+	assert.Equal(t, "", views.View(), "unknown key without factory renders nothing")
+
+	views.Unmount()
+	assert.Empty(t, views.Keys())
+	assert.Equal(t, []string{"b:activated", "b:deactivated", "b:unmounted"}, log.take())
+}
+
+// TestKeepAlive_JoinsTree tests injection, event bubbling and hooks of descendants
+func TestKeepAlive_JoinsTree(t *testing.T) {
+	var events []string
+	views := NewKeepAlive()
+
+	parent, err := NewComponent("Parent").
+		Setup(func(ctx *Context) {
+			ctx.Provide("theme", "dark")
+			ctx.On("saved", func(interface{}) { events = append(events, "parent:saved") })
+		}).
+		Children(views).
+		Template(func(ctx RenderContext) string { return views.View() }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	views.Show("settings", func() Component {
+		child, err := NewComponent("Field").
+			Setup(func(ctx *Context) {
+				ctx.OnActivated(func() { events = append(events, "field:activated") })
+			}).
+			Template(func(RenderContext) string { return "" }).
+			Build()
+		require.NoError(t, err)
+
+		comp, err := NewComponent("Settings").
+			Setup(func(ctx *Context) {
+				ctx.Expose("theme", ctx.Inject("theme", "none"))
+				ctx.OnActivated(func() { events = append(events, "settings:activated") })
+			}).
+			Children(child).
+			Template(func(ctx RenderContext) string { return "theme:" + ctx.Get("theme").(string) }).
+			Build()
+		require.NoError(t, err)
+		return comp
+	})
+
+	assert.Equal(t, "theme:dark", parent.View())
+	views.Emit("saved", nil)
+	assert.Equal(t, []string{"settings:activated", "field:activated", "parent:saved"}, events)
+}
@@ -0,0 +1,136 @@
+package bubbly
+
+import "fmt"
+
+// ReconcileChildren brings this component's keyed children in line with
+// keys, matching children to keys across calls so that each child keeps its
+// state and lifecycle when the list is reordered. It is the component-tree
+// analog of keyed ForEach, for dynamic lists of components such as tabs or
+// draggable panels.
+//
+// For each key, in order:
+//   - A child already reconciled under that key is reused as is (not
+//     re-created or re-initialized)
+//   - Otherwise create is called; the new child is added (as with
+//     AddChild) and initialized, queueing its Init command like
+//     ExposeComponent
+//
+// Keyed children whose key is no longer in keys are removed and unmounted.
+// The keyed children are then ordered as keys, after any children not
+// managed by ReconcileChildren, which keep their positions.
+//
+// It returns the keyed children in the order of keys, for the template to
+// render. If keys contains a duplicate, create returns nil, or a child
+// cannot be added, it returns an error and leaves the children unchanged
+// (children created by the failed call are discarded).
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    panels := bubbly.NewRef[[]bubbly.Component](nil)
+//	    sync := func(ids []string) {
+//	        children, err := ctx.ReconcileChildren(ids, func(id string) bubbly.Component {
+//	            return NewPanel(id) // Runs only for new ids
+//	        })
+//	        if err == nil {
+//	            panels.Set(children)
+//	        }
+//	    }
+//	    sync(order.GetTyped())
+//	    bubbly.Watch(order, func(ids, _ []string) { sync(ids) }) // e.g. after a drag
+//	    ctx.Expose("panels", panels)
+//	})
+func (ctx *Context) ReconcileChildren(keys []string, create func(key string) Component) ([]Component, error) {
+	c := ctx.component
+
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateChildKey, key)
+		}
+		seen[key] = true
+	}
+
+	c.childrenMu.RLock()
+	previous := make(map[string]Component, len(c.childKeys))
+	for key, child := range c.childKeys {
+		previous[key] = child
+	}
+	c.childrenMu.RUnlock()
+
+	// Match keys to children, creating the missing ones
+	ordered := make([]Component, len(keys))
+	next := make(map[string]Component, len(keys))
+	var created []Component
+	for i, key := range keys {
+		if child, ok := previous[key]; ok {
+			ordered[i], next[key] = child, child
+			continue
+		}
+
+		child := create(key)
+		if child == nil {
+			ctx.discardChildren(created)
+			return nil, fmt.Errorf("%w: key %q", ErrNilChild, key)
+		}
+		if err := c.AddChild(child); err != nil {
+			ctx.discardChildren(created)
+			return nil, fmt.Errorf("failed to add child %q: %w", key, err)
+		}
+		created = append(created, child)
+		ordered[i], next[key] = child, child
+	}
+
+	for _, child := range created {
+		if child.IsInitialized() {
+			continue
+		}
+		if cmd := child.Init(); cmd != nil && c.commandQueue != nil {
+			c.commandQueue.Enqueue(cmd)
+		}
+	}
+
+	// Remove children whose key is gone
+	var removed []Component
+	for key, child := range previous {
+		if _, ok := next[key]; !ok {
+			removed = append(removed, child)
+		}
+	}
+	for _, child := range removed {
+		_ = c.RemoveChild(child)
+	}
+
+	// Order keyed children as keys, after the other children
+	keyedIDs := make(map[string]bool, len(ordered))
+	for _, child := range ordered {
+		keyedIDs[child.ID()] = true
+	}
+	c.childrenMu.Lock()
+	children := make([]Component, 0, len(c.children))
+	for _, child := range c.children {
+		if !keyedIDs[child.ID()] {
+			children = append(children, child)
+		}
+	}
+	c.children = append(children, ordered...)
+	c.childKeys = next
+	c.childrenMu.Unlock()
+
+	// Unmount outside the lock; unmount hooks may touch the tree
+	for _, child := range removed {
+		if unmounter, ok := child.(interface{ Unmount() }); ok {
+			unmounter.Unmount()
+		}
+	}
+
+	return append([]Component(nil), ordered...), nil
+}
+
+// discardChildren removes children added by a failed ReconcileChildren
+// call. They were never initialized, so they are not unmounted.
+func (ctx *Context) discardChildren(children []Component) {
+	for _, child := range children {
+		_ = ctx.component.RemoveChild(child)
+	}
+}
@@ -0,0 +1,134 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newKeyedParent returns an initialized parent, its context, and a create
+// function for counter children that records how often it ran and which
+// children were unmounted.
+func newKeyedParent(t *testing.T) (*componentImpl, *Context, func(string) Component, map[string]int, *[]string) {
+	t.Helper()
+
+	parent, err := NewComponent("Tabs").
+		Template(func(RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+	impl := parent.(*componentImpl)
+
+	creates := make(map[string]int)
+	var unmounted []string
+	create := func(key string) Component {
+		creates[key]++
+		child, err := NewComponent("Tab").
+			Setup(func(ctx *Context) {
+				ctx.Expose("count", NewRef(0))
+				ctx.OnUnmounted(func() { unmounted = append(unmounted, key) })
+			}).
+			Template(func(ctx RenderContext) string {
+				return key
+			}).
+			Build()
+		require.NoError(t, err)
+		return child
+	}
+
+	return impl, &Context{component: impl}, create, creates, &unmounted
+}
+
+// keyedNames renders each child, i.e. returns their keys.
+func keyedNames(children []Component) []string {
+	names := make([]string, len(children))
+	for i, child := range children {
+		names[i] = child.View()
+	}
+	return names
+}
+
+func TestReconcileChildren_PreservesStateAcrossReorder(t *testing.T) {
+	parent, ctx, create, creates, unmounted := newKeyedParent(t)
+
+	children, err := ctx.ReconcileChildren([]string{"a", "b", "c"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, keyedNames(children))
+	for _, child := range children {
+		assert.True(t, child.IsInitialized())
+	}
+
+	// Give "b" some state
+	b := children[1].(*componentImpl)
+	b.state["count"].(*Ref[int]).Set(7)
+
+	children, err = ctx.ReconcileChildren([]string{"c", "b", "a"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "b", "a"}, keyedNames(children))
+	assert.Same(t, b, children[1], "reordered child should be reused")
+	assert.Equal(t, 7, b.state["count"].(*Ref[int]).GetTyped())
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, creates)
+	assert.Empty(t, *unmounted)
+	assert.Equal(t, []string{"c", "b", "a"}, keyedNames(parent.Children()))
+}
+
+func TestReconcileChildren_AddsAndRemoves(t *testing.T) {
+	parent, ctx, create, creates, unmounted := newKeyedParent(t)
+
+	// A child not managed by ReconcileChildren keeps its place
+	other, err := NewComponent("Other").Template(func(RenderContext) string { return "other" }).Build()
+	require.NoError(t, err)
+	require.NoError(t, parent.AddChild(other))
+
+	_, err = ctx.ReconcileChildren([]string{"a", "b"}, create)
+	require.NoError(t, err)
+
+	children, err := ctx.ReconcileChildren([]string{"b", "d"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "d"}, keyedNames(children))
+	assert.Equal(t, []string{"a"}, *unmounted)
+	assert.Equal(t, 1, creates["d"])
+	assert.Equal(t, []string{"other", "b", "d"}, keyedNames(parent.Children()))
+
+	// A key that comes back is a new child
+	_, err = ctx.ReconcileChildren([]string{"a"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, 2, creates["a"])
+	assert.ElementsMatch(t, []string{"a", "b", "d"}, *unmounted)
+}
+
+func TestReconcileChildren_Errors(t *testing.T) {
+	parent, ctx, create, _, _ := newKeyedParent(t)
+
+	_, err := ctx.ReconcileChildren([]string{"a"}, create)
+	require.NoError(t, err)
+
+	_, err = ctx.ReconcileChildren([]string{"b", "b"}, create)
+	assert.ErrorIs(t, err, ErrDuplicateChildKey)
+
+	_, err = ctx.ReconcileChildren([]string{"b", "c"}, func(key string) Component {
+		if key == "c" {
+			return nil
+		}
+		return create(key)
+	})
+	assert.ErrorIs(t, err, ErrNilChild)
+
+	assert.Equal(t, []string{"a"}, keyedNames(parent.Children()),
+		"a failed call should leave the children unchanged")
+}
+
+func TestReconcileChildren_RemovedExternally(t *testing.T) {
+	parent, ctx, create, creates, _ := newKeyedParent(t)
+
+	children, err := ctx.ReconcileChildren([]string{"a"}, create)
+	require.NoError(t, err)
+	require.NoError(t, parent.RemoveChild(children[0]))
+
+	children, err = ctx.ReconcileChildren([]string{"a"}, create)
+	require.NoError(t, err)
+	assert.Equal(t, 2, creates["a"], "a child removed with RemoveChild is re-created")
+	assert.Len(t, parent.Children(), 1)
+	assert.Equal(t, []string{"a"}, keyedNames(children))
+}
@@ -0,0 +1,243 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownKeyMapEvent is returned when a KeyMap binds a key to an event
+// the component does not handle.
+var ErrUnknownKeyMapEvent = errors.New("keymap references unknown event")
+
+// ErrKeyMapUnsupported is returned when a KeyMap is applied to a Component
+// implementation that does not own its key bindings (e.g., wrappers).
+var ErrKeyMapUnsupported = errors.New("component does not support keymap overrides")
+
+// KeyMap is a data-driven set of key-to-event bindings.
+//
+// It is the serializable form of a component's key bindings: each key
+// (in tea.KeyMsg.String() format, like WithKeyBinding) maps to the event it
+// emits. An empty event name unbinds the key. The JSON form is a plain
+// object, which makes it suitable for user configuration files:
+//
+//	{
+//	    "ctrl+s": "save",
+//	    "w":      "moveUp",
+//	    "up":     ""
+//	}
+type KeyMap map[string]string
+
+// KeyMapConflict describes a key whose existing binding was replaced by an
+// override.
+type KeyMapConflict struct {
+	// Key is the contested key.
+	Key string
+
+	// Existing is the event the key was bound to before the override.
+	Existing string
+
+	// Override is the event the key is bound to after the override
+	// ("" when the override unbinds the key).
+	Override string
+}
+
+// String formats the conflict for logs and user-facing warnings.
+func (c KeyMapConflict) String() string {
+	if c.Override == "" {
+		return fmt.Sprintf("%s: unbound (was %s)", c.Key, c.Existing)
+	}
+	return fmt.Sprintf("%s: %s replaces %s", c.Key, c.Override, c.Existing)
+}
+
+// ExportKeyMap returns the key bindings of c as a KeyMap.
+// When a key has several (conditional) bindings, the first one is exported.
+//
+// Example:
+//
+//	data, _ := json.MarshalIndent(bubbly.ExportKeyMap(app), "", "  ")
+//	os.WriteFile("keymap.json", data, 0644)
+func ExportKeyMap(c Component) KeyMap {
+	keyMap := make(KeyMap)
+	for key, bindings := range c.KeyBindings() {
+		if len(bindings) > 0 {
+			keyMap[key] = bindings[0].Event
+		}
+	}
+	return keyMap
+}
+
+// ParseKeyMap decodes a KeyMap from JSON.
+//
+// Example:
+//
+//	data, err := os.ReadFile(filepath.Join(configDir, "keymap.json"))
+//	if err == nil {
+//	    overrides, err := bubbly.ParseKeyMap(data)
+//	    ...
+//	}
+func ParseKeyMap(data []byte) (KeyMap, error) {
+	var keyMap KeyMap
+	if err := json.Unmarshal(data, &keyMap); err != nil {
+		return nil, fmt.Errorf("parse keymap: %w", err)
+	}
+	if keyMap == nil {
+		keyMap = make(KeyMap)
+	}
+	return keyMap, nil
+}
+
+// Merge returns a new KeyMap with overrides applied on top of m, along with
+// the keys whose existing binding changed. Neither input is modified.
+// Overrides with an empty event remove the key from the result.
+//
+// Conflicts are sorted by key.
+//
+// Example:
+//
+//	merged, conflicts := defaults.Merge(userOverrides)
+//	for _, c := range conflicts {
+//	    log.Printf("keymap: %s", c)
+//	}
+func (m KeyMap) Merge(overrides KeyMap) (KeyMap, []KeyMapConflict) {
+	merged := make(KeyMap, len(m)+len(overrides))
+	for key, event := range m {
+		merged[key] = event
+	}
+
+	var conflicts []KeyMapConflict
+	for key, event := range overrides {
+		if existing, ok := merged[key]; ok && existing != event {
+			conflicts = append(conflicts, KeyMapConflict{Key: key, Existing: existing, Override: event})
+		}
+		if event == "" {
+			delete(merged, key)
+		} else {
+			merged[key] = event
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+	return merged, conflicts
+}
+
+// Validate checks that every event in m is one c can handle: an event of
+// one of its key bindings, an event with a handler registered via On, or
+// the built-in "quit". Unbinding entries (empty event) are always valid.
+//
+// The returned error wraps ErrUnknownKeyMapEvent and lists every offending
+// key, so it can be shown to the user as-is.
+func (m KeyMap) Validate(c Component) error {
+	known := knownKeyMapEvents(c)
+
+	var unknown []string
+	for key, event := range m {
+		if event != "" && !known[event] {
+			unknown = append(unknown, fmt.Sprintf("%s -> %s", key, event))
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	sort.Strings(unknown)
+	return fmt.Errorf("%w: %s", ErrUnknownKeyMapEvent, strings.Join(unknown, ", "))
+}
+
+// knownKeyMapEvents returns the set of events c can handle.
+func knownKeyMapEvents(c Component) map[string]bool {
+	known := map[string]bool{"quit": true}
+	for _, bindings := range c.KeyBindings() {
+		for _, binding := range bindings {
+			known[binding.Event] = true
+		}
+	}
+	if impl, ok := c.(*componentImpl); ok {
+		impl.handlersMu.RLock()
+		for event := range impl.handlers {
+			known[event] = true
+		}
+		impl.handlersMu.RUnlock()
+	}
+	return known
+}
+
+// ApplyKeyMap remaps c's key bindings with overrides (typically loaded from
+// a user's configuration with ParseKeyMap).
+//
+// Each override binds its key to an existing event; the new binding reuses
+// the description and data of the event's original binding, so help text
+// stays meaningful. The key's previous bindings are replaced, and each
+// replaced event is reported as a KeyMapConflict. An empty event unbinds
+// the key.
+//
+// Overrides are validated first (see KeyMap.Validate), so call ApplyKeyMap
+// after the component is initialized and its handlers are registered. If
+// validation fails, no bindings are changed.
+//
+// Example:
+//
+//	app.Init()
+//	conflicts, err := bubbly.ApplyKeyMap(app, overrides)
+//	if err != nil {
+//	    log.Printf("ignoring keymap: %v", err)
+//	}
+func ApplyKeyMap(c Component, overrides KeyMap) ([]KeyMapConflict, error) {
+	impl, ok := c.(*componentImpl)
+	if !ok {
+		return nil, ErrKeyMapUnsupported
+	}
+	if err := overrides.Validate(c); err != nil {
+		return nil, err
+	}
+
+	impl.keyBindingsMu.Lock()
+	defer impl.keyBindingsMu.Unlock()
+
+	if impl.keyBindings == nil {
+		impl.keyBindings = make(map[string][]KeyBinding)
+	}
+
+	// Template bindings by event, captured before any key is replaced
+	templates := make(map[string]KeyBinding)
+	for _, bindings := range impl.keyBindings {
+		for _, binding := range bindings {
+			if _, seen := templates[binding.Event]; !seen {
+				templates[binding.Event] = binding
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var conflicts []KeyMapConflict
+	for _, key := range keys {
+		event := overrides[key]
+
+		for _, existing := range impl.keyBindings[key] {
+			if existing.Event != event {
+				conflicts = append(conflicts, KeyMapConflict{Key: key, Existing: existing.Event, Override: event})
+			}
+		}
+
+		if event == "" {
+			delete(impl.keyBindings, key)
+			continue
+		}
+
+		binding := templates[event]
+		binding.Key = key
+		binding.Event = event
+		impl.keyBindings[key] = []KeyBinding{binding}
+	}
+
+	return conflicts, nil
+}
@@ -0,0 +1,144 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newKeyMapTestComponent builds an initialized component with a few bindings
+// and an On-only "refresh" handler, recording emitted events.
+func newKeyMapTestComponent(t *testing.T, events *[]string) Component {
+	t.Helper()
+	comp, err := NewComponent("Editor").
+		WithKeyBinding("ctrl+s", "save", "Save file").
+		WithKeyBinding("up", "moveUp", "Move up").
+		WithKeyBinding("ctrl+c", "quit", "Quit").
+		Setup(func(ctx *Context) {
+			for _, name := range []string{"save", "moveUp", "refresh"} {
+				name := name
+				ctx.On(name, func(_ interface{}) { *events = append(*events, name) })
+			}
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	return comp
+}
+
+// TestKeyMap_ExportAndJSON tests exporting bindings and the JSON round trip
+func TestKeyMap_ExportAndJSON(t *testing.T) {
+	var events []string
+	comp := newKeyMapTestComponent(t, &events)
+
+	exported := ExportKeyMap(comp)
+	assert.Equal(t, KeyMap{"ctrl+s": "save", "up": "moveUp", "ctrl+c": "quit"}, exported)
+
+	data, err := json.Marshal(exported)
+	require.NoError(t, err)
+	parsed, err := ParseKeyMap(data)
+	require.NoError(t, err)
+	assert.Equal(t, exported, parsed)
+
+	empty, err := ParseKeyMap([]byte("null"))
+	require.NoError(t, err)
+	assert.NotNil(t, empty)
+
+	_, err = ParseKeyMap([]byte(`{"a": 1}`))
+	assert.Error(t, err)
+}
+
+// TestKeyMap_Merge tests merging overrides and conflict reporting
+func TestKeyMap_Merge(t *testing.T) {
+	base := KeyMap{"up": "moveUp", "k": "moveUp", "ctrl+s": "save"}
+
+	merged, conflicts := base.Merge(KeyMap{
+		"ctrl+s": "saveAll", // conflict
+		"w":      "moveUp",  // new key
+		"k":      "",        // unbind
+		"up":     "moveUp",  // unchanged, no conflict
+	})
+
+	assert.Equal(t, KeyMap{"up": "moveUp", "w": "moveUp", "ctrl+s": "saveAll"}, merged)
+	assert.Equal(t, []KeyMapConflict{
+		{Key: "ctrl+s", Existing: "save", Override: "saveAll"},
+		{Key: "k", Existing: "moveUp", Override: ""},
+	}, conflicts)
+	assert.Equal(t, "ctrl+s: saveAll replaces save", conflicts[0].String())
+	assert.Equal(t, "k: unbound (was moveUp)", conflicts[1].String())
+	assert.Len(t, base, 3, "base is not modified")
+}
+
+// TestKeyMap_Validate tests validation against registered events
+func TestKeyMap_Validate(t *testing.T) {
+	var events []string
+	comp := newKeyMapTestComponent(t, &events)
+
+	tests := []struct {
+		name    string
+		keyMap  KeyMap
+		wantErr string
+	}{
+		{"binding event", KeyMap{"s": "save"}, ""},
+		{"handler-only event", KeyMap{"r": "refresh"}, ""},
+		{"quit", KeyMap{"q": "quit"}, ""},
+		{"unbind", KeyMap{"up": ""}, ""},
+		{"unknown events listed", KeyMap{"x": "explode", "a": "nope"}, "a -> nope, x -> explode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.keyMap.Validate(comp)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ErrUnknownKeyMapEvent))
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+// TestApplyKeyMap tests remapping a component's bindings
+func TestApplyKeyMap(t *testing.T) {
+	var events []string
+	comp := newKeyMapTestComponent(t, &events)
+
+	conflicts, err := ApplyKeyMap(comp, KeyMap{
+		"w":      "moveUp",
+		"up":     "",
+		"ctrl+c": "save",
+		"f5":     "refresh",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []KeyMapConflict{
+		{Key: "ctrl+c", Existing: "quit", Override: "save"},
+		{Key: "up", Existing: "moveUp", Override: ""},
+	}, conflicts)
+
+	bindings := comp.KeyBindings()
+	assert.NotContains(t, bindings, "up")
+	assert.Equal(t, "Move up", bindings["w"][0].Description, "description copied from original binding")
+
+	_, cmd := comp.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	assert.Nil(t, cmd)
+	comp.Update(tea.KeyMsg{Type: tea.KeyUp})
+	comp.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	comp.Update(tea.KeyMsg{Type: tea.KeyF5})
+	assert.Equal(t, []string{"moveUp", "save", "refresh"}, events)
+
+	// Invalid overrides change nothing
+	before := comp.KeyBindings()
+	_, err = ApplyKeyMap(comp, KeyMap{"z": "unknown", "w": ""})
+	assert.ErrorIs(t, err, ErrUnknownKeyMapEvent)
+	assert.Equal(t, before, comp.KeyBindings())
+
+	_, err = ApplyKeyMap(LazyComponent(nil), KeyMap{})
+	assert.ErrorIs(t, err, ErrKeyMapUnsupported)
+}
@@ -0,0 +1,217 @@
+package bubbly
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Measurable is implemented by components that report their rendered size
+// and screen position. Components built with NewComponent implement it, as
+// do the wrappers Lazy, KeepAlive and router.View. It is separate from
+// Component so custom Component implementations need not provide it; use
+// Measure and Bounds to query any component.
+type Measurable interface {
+	// Measure returns the width and height, in terminal cells, of the
+	// component's most recent View() output.
+	Measure() (width, height int)
+
+	// Bounds returns the component's screen position and size, in terminal
+	// cells, as resolved during the most recent frame's layout.
+	Bounds() (x, y, w, h int)
+}
+
+// Measure returns the rendered size of c, or (0, 0) if c does not
+// implement Measurable.
+//
+// Example:
+//
+//	w, h := bubbly.Measure(child)
+func Measure(c Component) (width, height int) {
+	if m, ok := c.(Measurable); ok {
+		return m.Measure()
+	}
+	return 0, 0
+}
+
+// Bounds returns the screen bounds of c, or zeros if c does not implement
+// Measurable.
+//
+// Example:
+//
+//	x, y, _, h := bubbly.Bounds(field)
+func Bounds(c Component) (x, y, w, h int) {
+	if m, ok := c.(Measurable); ok {
+		return m.Bounds()
+	}
+	return 0, 0, 0, 0
+}
+
+// layoutInfo records a component's last output, its size, and its resolved
+// screen position. The size is measured lazily from output.
+type layoutInfo struct {
+	output    string
+	rendered  bool
+	measured  bool
+	width     int
+	height    int
+	bounds    Rect
+	hasBounds bool
+}
+
+// Measure returns the width and height, in terminal cells, of the
+// component's most recent View() output. Widths are measured with lipgloss,
+// so ANSI styling and wide runes are handled correctly.
+//
+// The output is measured on the first call after a render, not by View()
+// itself. Both values are 0 until the component has rendered at least once.
+//
+// Example:
+//
+//	w, h := child.Measure()
+//	if h > availableHeight {
+//	    // content overflows, enable scrolling
+//	}
+func (c *componentImpl) Measure() (width, height int) {
+	c.layoutMu.Lock()
+	defer c.layoutMu.Unlock()
+	return c.measureLocked()
+}
+
+// Bounds returns the component's position and size on screen, in terminal
+// cells, as resolved by the most recent frame.
+//
+// Positions are resolved by the root wrapper (Wrap or Run) while mouse zones
+// are enabled; see EnableMouseZones. Until a position has been resolved,
+// x and y are 0 and w and h match Measure().
+//
+// Example:
+//
+//	x, y, w, h := child.Bounds()
+//	overlay := lipgloss.Place(w, h, lipgloss.Center, lipgloss.Center, tooltip)
+func (c *componentImpl) Bounds() (x, y, w, h int) {
+	c.layoutMu.Lock()
+	defer c.layoutMu.Unlock()
+	if !c.layout.hasBounds {
+		w, h = c.measureLocked()
+		return 0, 0, w, h
+	}
+	b := c.layout.bounds
+	return b.X, b.Y, b.Width, b.Height
+}
+
+// setOutput records a freshly rendered view; it is measured on demand.
+func (c *componentImpl) setOutput(output string) {
+	c.layoutMu.Lock()
+	// Unchanged output (such as a memoized render) keeps its measurement
+	if !c.layout.rendered || c.layout.output != output {
+		c.layout.output = output
+		c.layout.rendered = true
+		c.layout.measured = false
+	}
+	c.layoutMu.Unlock()
+}
+
+// measureLocked returns the size of the last output, measuring it if
+// needed. Must be called with c.layoutMu held.
+func (c *componentImpl) measureLocked() (width, height int) {
+	if c.layout.rendered && !c.layout.measured {
+		c.layout.width = lipgloss.Width(c.layout.output)
+		c.layout.height = lipgloss.Height(c.layout.output)
+		c.layout.measured = true
+	}
+	return c.layout.width, c.layout.height
+}
+
+// setBounds records the resolved screen bounds of the component.
+func (c *componentImpl) setBounds(r Rect) {
+	c.layoutMu.Lock()
+	c.layout.bounds = r
+	c.layout.hasBounds = true
+	c.layoutMu.Unlock()
+}
+
+// activePositionRenders counts RenderWithPositions calls in progress, so
+// views skip the ancestor walk when there are none.
+var activePositionRenders atomic.Int32
+
+// RenderWithPositions renders c like c.View() and also returns the position
+// and size of c and of every descendant rendered into the output, relative
+// to the top-left corner of the output. Containers that clip or move their
+// content, such as a scroll view, use it to locate descendants they do not
+// display.
+//
+// Positions are resolved from the same invisible markers that mouse zones
+// use, so they are available whether or not mouse zones are enabled. A
+// descendant is a component whose parent chain (see Context.ExposeComponent)
+// leads to c. Components not created by NewComponent are rendered without
+// positions.
+//
+// Example:
+//
+//	output, positions := bubbly.RenderWithPositions(content)
+//	if r, ok := positions[child]; ok {
+//	    firstLine := r.Y
+//	}
+func RenderWithPositions(c Component) (string, map[Component]Rect) {
+	positions := make(map[Component]Rect)
+	impl, ok := c.(*componentImpl)
+	if !ok || impl == nil {
+		if c == nil {
+			return "", positions
+		}
+		return c.View(), positions
+	}
+
+	activePositionRenders.Add(1)
+	impl.tracking.Add(1)
+	output := c.View()
+	impl.tracking.Add(-1)
+	activePositionRenders.Add(-1)
+
+	s := globalMouseZones
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lines := strings.Split(output, "\n")
+	for y, line := range lines {
+		matches := zoneMarkerPattern.FindAllStringSubmatchIndex(line, -1)
+		for _, m := range matches {
+			id, err := strconv.Atoi(line[m[2]:m[3]])
+			if err != nil {
+				continue
+			}
+			target, ok := s.pending[id]
+			if !ok || target.component == nil {
+				continue
+			}
+			x := lipgloss.Width(line[:m[0]])
+			positions[target.component] = Rect{X: x, Y: y, Width: target.width, Height: target.height}
+			if !s.enabled {
+				delete(s.pending, id)
+			}
+		}
+	}
+
+	// Without mouse zones no root scan strips the markers, so strip them here
+	if !s.enabled {
+		output = zoneMarkerPattern.ReplaceAllString(output, "")
+	}
+	return output, positions
+}
+
+// positionsTracked reports whether c is being rendered by
+// RenderWithPositions, directly or as a descendant.
+func (c *componentImpl) positionsTracked() bool {
+	if activePositionRenders.Load() == 0 {
+		return false
+	}
+	for p := c; p != nil; p = p.parent {
+		if p.tracking.Load() > 0 {
+			return true
+		}
+	}
+	return false
+}
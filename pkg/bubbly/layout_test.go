@@ -0,0 +1,162 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestComponent_Measure tests that Measure reports the size of the last render
+func TestComponent_Measure(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "single line", output: "hello", wantWidth: 5, wantHeight: 1},
+		{name: "multi line uses widest line", output: "ab\nabcd\na", wantWidth: 4, wantHeight: 3},
+		{name: "styled output ignores ANSI", output: lipgloss.NewStyle().Bold(true).Render("bold"), wantWidth: 4, wantHeight: 1},
+		{name: "wide runes", output: "日本", wantWidth: 4, wantHeight: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := tt.output
+			c, err := NewComponent("Measured").
+				Template(func(ctx RenderContext) string { return output }).
+				Build()
+			require.NoError(t, err)
+
+			w, h := Measure(c)
+			assert.Equal(t, 0, w, "unrendered width")
+			assert.Equal(t, 0, h, "unrendered height")
+
+			c.View()
+			w, h = Measure(c)
+			assert.Equal(t, tt.wantWidth, w)
+			assert.Equal(t, tt.wantHeight, h)
+		})
+	}
+}
+
+// TestComponent_Measure_Lazy tests that output is measured on demand and a
+// repeated render keeps its measurement
+func TestComponent_Measure_Lazy(t *testing.T) {
+	c, err := NewComponent("Lazy").
+		Template(func(ctx RenderContext) string { return "abc\nde" }).
+		Build()
+	require.NoError(t, err)
+	impl := c.(*componentImpl)
+
+	c.View()
+	assert.False(t, impl.layout.measured, "View does not measure")
+
+	w, h := Measure(c)
+	assert.Equal(t, []int{3, 2}, []int{w, h})
+	assert.True(t, impl.layout.measured)
+
+	c.View()
+	assert.True(t, impl.layout.measured, "unchanged output keeps its measurement")
+}
+
+// TestMeasure_NotMeasurable tests the helpers with a component that does
+// not implement Measurable
+func TestMeasure_NotMeasurable(t *testing.T) {
+	w, h := Measure(nil)
+	assert.Equal(t, []int{0, 0}, []int{w, h})
+
+	x, y, w, h := Bounds(nil)
+	assert.Equal(t, []int{0, 0, 0, 0}, []int{x, y, w, h})
+}
+
+// TestComponent_Bounds_Unresolved tests Bounds before layout has been resolved
+func TestComponent_Bounds_Unresolved(t *testing.T) {
+	c, err := NewComponent("Box").
+		Template(func(ctx RenderContext) string { return "abc\nde" }).
+		Build()
+	require.NoError(t, err)
+	c.View()
+
+	x, y, w, h := Bounds(c)
+	assert.Equal(t, []int{0, 0, 3, 2}, []int{x, y, w, h})
+}
+
+// TestComponent_Bounds_Resolved tests Bounds after the root view is scanned
+func TestComponent_Bounds_Resolved(t *testing.T) {
+	EnableMouseZones(true)
+	defer EnableMouseZones(false)
+
+	parent := newMouseTestTree(t, func(string, MouseEvent) {})
+	ScanMouseZones(parent.View())
+
+	child := parent.(*componentImpl).children[0]
+	x, y, w, h := Bounds(child)
+	assert.Equal(t, []int{2, 1, 6, 1}, []int{x, y, w, h})
+
+	x, y, w, h = Bounds(parent)
+	assert.Equal(t, []int{0, 0, 8, 2}, []int{x, y, w, h})
+}
+
+// TestRenderWithPositions tests resolving descendant positions within a
+// component's output
+func TestRenderWithPositions(t *testing.T) {
+	tests := []struct {
+		name  string
+		zones bool
+	}{
+		{name: "mouse zones disabled", zones: false},
+		{name: "mouse zones enabled", zones: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			EnableMouseZones(tt.zones)
+			defer EnableMouseZones(false)
+
+			parent := newMouseTestTree(t, func(string, MouseEvent) {})
+			child := parent.(*componentImpl).children[0]
+
+			output, positions := RenderWithPositions(parent)
+			assert.Equal(t, Rect{X: 2, Y: 1, Width: 6, Height: 1}, positions[child])
+			assert.Equal(t, Rect{X: 0, Y: 0, Width: 8, Height: 2}, positions[parent])
+			assert.Equal(t, "Header\n  [ OK ]", ScanMouseZones(output))
+
+			if !tt.zones {
+				assert.Equal(t, "Header\n  [ OK ]", output, "markers are stripped without mouse zones")
+				assert.Empty(t, globalMouseZones.pending, "tracked zones are released")
+				assert.Equal(t, "Header\n  [ OK ]", parent.View(), "plain views stay unmarked")
+			}
+		})
+	}
+}
+
+// TestRenderWithPositions_Memoized tests that memoized components neither
+// hide descendants from a tracked render nor cache its markers
+func TestRenderWithPositions_Memoized(t *testing.T) {
+	child, err := NewComponent("Child").
+		Template(func(ctx RenderContext) string { return "child" }).
+		Build()
+	require.NoError(t, err)
+	parent, err := NewComponent("Parent").
+		Memo().
+		Setup(func(ctx *Context) {
+			require.NoError(t, ctx.ExposeComponent("child", child))
+		}).
+		Template(func(ctx RenderContext) string {
+			return "top\n" + ctx.Get("child").(Component).View()
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	assert.Equal(t, "top\nchild", parent.View(), "cached without markers")
+
+	output, positions := RenderWithPositions(parent)
+	assert.Equal(t, "top\nchild", output)
+	assert.Equal(t, Rect{X: 0, Y: 1, Width: 5, Height: 1}, positions[child])
+
+	assert.Equal(t, "top\nchild", parent.View(), "tracked markers are not cached")
+}
@@ -0,0 +1,295 @@
+package bubbly
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// parentAware is implemented by Component wrappers that are not
+// componentImpl but still need to know their parent, so the components they
+// wrap can Inject from and bubble events to the surrounding tree.
+type parentAware interface {
+	setParent(parent *componentImpl)
+}
+
+// LazyOption configures a Lazy component.
+type LazyOption func(*Lazy)
+
+// WithDisposeAfter unmounts and discards the wrapped component once it has
+// not been rendered for the given duration. It is rebuilt from the factory
+// the next time it is rendered.
+//
+// Idle time is checked whenever the Lazy component receives a message, so
+// disposal happens on the first Update after the duration elapses.
+//
+// Example:
+//
+//	settings := bubbly.LazyComponent(CreateSettings, bubbly.WithDisposeAfter(5*time.Minute))
+func WithDisposeAfter(d time.Duration) LazyOption {
+	return func(l *Lazy) {
+		if d > 0 {
+			l.disposeAfter = d
+		}
+	}
+}
+
+// Lazy is a Component that defers building and initializing the component it
+// wraps until it is first rendered. See LazyComponent.
+//
+// Lazy is thread-safe and can be used concurrently.
+type Lazy struct {
+	factory      func() Component
+	disposeAfter time.Duration
+	id           string
+
+	mu         sync.Mutex
+	inner      Component
+	parent     *componentImpl
+	handlers   map[string][]EventHandler
+	pendingCmd tea.Cmd
+	lastView   time.Time
+}
+
+// LazyComponent wraps a component factory so the component is built and
+// Init'd only when first rendered (its first View call).
+//
+// Use it for heavy, rarely-visited subtrees such as inactive tabs or
+// secondary screens, so they cost nothing at startup. Until the component
+// is loaded, Lazy renders nothing, has no key bindings, and ignores
+// messages and emitted events. Handlers registered with On are kept and
+// attached to the component each time it is built.
+//
+// The wrapped component joins the surrounding tree: it can Inject values
+// provided by the Lazy's parent, and its events bubble to that parent.
+// Any command returned by its Init is delivered on the next Update.
+//
+// Example:
+//
+//	reports := bubbly.LazyComponent(func() bubbly.Component {
+//	    return CreateReportsScreen() // Expensive setup runs on first view
+//	})
+//
+//	tabs := components.Tabs(components.TabsProps{
+//	    Tabs: []components.Tab{
+//	        {Label: "Home", Component: home},
+//	        {Label: "Reports", Component: reports},
+//	    },
+//	})
+func LazyComponent(factory func() Component, opts ...LazyOption) *Lazy {
+	l := &Lazy{
+		factory:  factory,
+		id:       fmt.Sprintf("lazy-%d", componentIDCounter.Add(1)),
+		handlers: make(map[string][]EventHandler),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// IsLoaded reports whether the wrapped component has been built.
+func (l *Lazy) IsLoaded() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inner != nil
+}
+
+// Dispose unmounts and discards the wrapped component, if loaded.
+// It is rebuilt from the factory on the next View.
+func (l *Lazy) Dispose() {
+	l.mu.Lock()
+	inner := l.inner
+	l.inner = nil
+	l.pendingCmd = nil
+	l.mu.Unlock()
+
+	if inner == nil {
+		return
+	}
+	if unmounter, ok := inner.(interface{ Unmount() }); ok {
+		unmounter.Unmount()
+	}
+}
+
+// Unmount disposes the wrapped component.
+// Called automatically when the Lazy's parent unmounts.
+func (l *Lazy) Unmount() {
+	l.Dispose()
+}
+
+// load builds and initializes the wrapped component if needed.
+// Must be called with l.mu held.
+func (l *Lazy) load() Component {
+	if l.inner != nil || l.factory == nil {
+		return l.inner
+	}
+
+	inner := l.factory()
+	if inner == nil {
+		return nil
+	}
+
+	// Join the surrounding tree before Init so Setup can Inject
+	if impl, ok := inner.(*componentImpl); ok && l.parent != nil {
+		impl.parent = l.parent
+	}
+	for event, handlers := range l.handlers {
+		for _, handler := range handlers {
+			inner.On(event, handler)
+		}
+	}
+
+	if !inner.IsInitialized() {
+		l.pendingCmd = inner.Init()
+	}
+	l.inner = inner
+	return inner
+}
+
+// current returns the wrapped component without loading it.
+func (l *Lazy) current() Component {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inner
+}
+
+// setParent implements parentAware.
+func (l *Lazy) setParent(parent *componentImpl) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.parent = parent
+	if impl, ok := l.inner.(*componentImpl); ok {
+		impl.parent = parent
+	}
+}
+
+// Init implements tea.Model. Loading is deferred, so it does nothing.
+func (l *Lazy) Init() tea.Cmd {
+	return nil
+}
+
+// Update forwards msg to the wrapped component once it is loaded, and
+// disposes it if it has been idle longer than WithDisposeAfter.
+func (l *Lazy) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	l.mu.Lock()
+	inner := l.inner
+	pending := l.pendingCmd
+	l.pendingCmd = nil
+	idle := l.disposeAfter > 0 && inner != nil && time.Since(l.lastView) > l.disposeAfter
+	l.mu.Unlock()
+
+	if inner == nil {
+		return l, nil
+	}
+	if idle {
+		l.Dispose()
+		return l, nil
+	}
+
+	_, cmd := inner.Update(msg)
+	if pending != nil {
+		cmd = tea.Batch(pending, cmd)
+	}
+	return l, cmd
+}
+
+// View renders the wrapped component, building it on first call.
+func (l *Lazy) View() string {
+	l.mu.Lock()
+	inner := l.load()
+	l.lastView = time.Now()
+	l.mu.Unlock()
+
+	if inner == nil {
+		return ""
+	}
+	return inner.View()
+}
+
+// Name returns the wrapped component's name, or "Lazy" before it loads.
+func (l *Lazy) Name() string {
+	if inner := l.current(); inner != nil {
+		return inner.Name()
+	}
+	return "Lazy"
+}
+
+// ID returns the Lazy wrapper's own stable identifier.
+func (l *Lazy) ID() string {
+	return l.id
+}
+
+// Props returns the wrapped component's props, or nil before it loads.
+func (l *Lazy) Props() interface{} {
+	if inner := l.current(); inner != nil {
+		return inner.Props()
+	}
+	return nil
+}
+
+// Emit forwards the event to the wrapped component.
+// Events emitted before the component loads are dropped.
+func (l *Lazy) Emit(event string, data interface{}) {
+	if inner := l.current(); inner != nil {
+		inner.Emit(event, data)
+	}
+}
+
+// On registers a handler on the wrapped component, now if it is loaded
+// and again each time it is (re)built.
+func (l *Lazy) On(event string, handler EventHandler) {
+	l.mu.Lock()
+	l.handlers[event] = append(l.handlers[event], handler)
+	inner := l.inner
+	l.mu.Unlock()
+
+	if inner != nil {
+		inner.On(event, handler)
+	}
+}
+
+// KeyBindings returns the wrapped component's key bindings, or nil before it loads.
+func (l *Lazy) KeyBindings() map[string][]KeyBinding {
+	if inner := l.current(); inner != nil {
+		return inner.KeyBindings()
+	}
+	return nil
+}
+
+// HelpText returns the wrapped component's help text, or "" before it loads.
+func (l *Lazy) HelpText() string {
+	if inner := l.current(); inner != nil {
+		return inner.HelpText()
+	}
+	return ""
+}
+
+// IsInitialized reports whether the wrapped component is loaded and initialized.
+func (l *Lazy) IsInitialized() bool {
+	if inner := l.current(); inner != nil {
+		return inner.IsInitialized()
+	}
+	return false
+}
+
+// Measure returns the wrapped component's last rendered size, or (0, 0)
+// before it loads.
+func (l *Lazy) Measure() (width, height int) {
+	if inner := l.current(); inner != nil {
+		return Measure(inner)
+	}
+	return 0, 0
+}
+
+// Bounds returns the wrapped component's screen rectangle, or zeros before
+// it loads.
+func (l *Lazy) Bounds() (x, y, w, h int) {
+	if inner := l.current(); inner != nil {
+		return Bounds(inner)
+	}
+	return 0, 0, 0, 0
+}
@@ -0,0 +1,153 @@
+package bubbly
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLazyTestFactory returns a factory that counts builds and unmounts.
+func newLazyTestFactory(t *testing.T, builds, unmounts *int) func() Component {
+	t.Helper()
+	return func() Component {
+		*builds++
+		comp, err := NewComponent("Heavy").
+			Setup(func(ctx *Context) {
+				ctx.Expose("theme", ctx.Inject("theme", "none"))
+				ctx.OnUnmounted(func() { *unmounts++ })
+			}).
+			Template(func(ctx RenderContext) string {
+				return "heavy:" + ctx.Get("theme").(string)
+			}).
+			WithKeyBinding("r", "refresh", "Refresh").
+			Build()
+		require.NoError(t, err)
+		return comp
+	}
+}
+
+// TestLazyComponent_DefersUntilView tests that nothing is built before the first View
+func TestLazyComponent_DefersUntilView(t *testing.T) {
+	builds, unmounts := 0, 0
+	lazy := LazyComponent(newLazyTestFactory(t, &builds, &unmounts))
+
+	var _ Component = lazy
+	assert.Nil(t, lazy.Init())
+	lazy.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	assert.Equal(t, 0, builds)
+	assert.False(t, lazy.IsLoaded())
+	assert.False(t, lazy.IsInitialized())
+	assert.Equal(t, "Lazy", lazy.Name())
+	assert.Nil(t, lazy.KeyBindings())
+	assert.Empty(t, lazy.HelpText())
+
+	assert.Equal(t, "heavy:none", lazy.View())
+	assert.Equal(t, "heavy:none", lazy.View())
+	assert.Equal(t, 1, builds, "built once")
+	assert.True(t, lazy.IsInitialized())
+	assert.Equal(t, "Heavy", lazy.Name())
+	assert.Contains(t, lazy.KeyBindings(), "r")
+	assert.NotEmpty(t, lazy.ID())
+}
+
+// TestLazyComponent_JoinsTree tests injection and event bubbling through the parent
+func TestLazyComponent_JoinsTree(t *testing.T) {
+	builds, unmounts := 0, 0
+	lazy := LazyComponent(newLazyTestFactory(t, &builds, &unmounts))
+
+	var bubbled []interface{}
+	parent, err := NewComponent("Parent").
+		Setup(func(ctx *Context) {
+			ctx.Provide("theme", "dark")
+			require.NoError(t, ctx.ExposeComponent("tab", lazy))
+			ctx.On("saved", func(data interface{}) { bubbled = append(bubbled, data) })
+		}).
+		Template(func(ctx RenderContext) string {
+			return ctx.Get("tab").(Component).View()
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+	assert.Equal(t, 0, builds, "exposing does not build")
+
+	assert.Equal(t, "heavy:dark", parent.View())
+
+	lazy.Emit("saved", "doc")
+	assert.Equal(t, []interface{}{"doc"}, bubbled)
+
+	parent.(interface{ Unmount() }).Unmount()
+	assert.Equal(t, 1, unmounts, "parent unmount reaches the wrapped component")
+	assert.False(t, lazy.IsLoaded())
+}
+
+// TestLazyComponent_HandlersSurviveRebuild tests On before load and after dispose
+func TestLazyComponent_HandlersSurviveRebuild(t *testing.T) {
+	builds, unmounts := 0, 0
+	lazy := LazyComponent(newLazyTestFactory(t, &builds, &unmounts))
+
+	calls := 0
+	lazy.On("ping", func(_ interface{}) { calls++ })
+	lazy.Emit("ping", nil) // dropped: not loaded
+	assert.Equal(t, 0, calls)
+
+	lazy.View()
+	lazy.Emit("ping", nil)
+	assert.Equal(t, 1, calls)
+
+	lazy.Dispose()
+	assert.Equal(t, 1, unmounts)
+	lazy.View()
+	lazy.Emit("ping", nil)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, builds)
+}
+
+// TestLazyComponent_DisposeAfter tests idle disposal
+func TestLazyComponent_DisposeAfter(t *testing.T) {
+	builds, unmounts := 0, 0
+	lazy := LazyComponent(newLazyTestFactory(t, &builds, &unmounts), WithDisposeAfter(20*time.Millisecond))
+
+	lazy.View()
+	lazy.Update(nil)
+	assert.True(t, lazy.IsLoaded(), "recently viewed")
+
+	time.Sleep(40 * time.Millisecond)
+	lazy.Update(nil)
+	assert.False(t, lazy.IsLoaded(), "disposed after idling")
+	assert.Equal(t, 1, unmounts)
+
+	lazy.View()
+	assert.Equal(t, 2, builds)
+}
+
+// TestLazyComponent_InitCommand tests that the wrapped Init command is delivered on Update
+func TestLazyComponent_InitCommand(t *testing.T) {
+	type loadedMsg struct{}
+
+	lazy := LazyComponent(func() Component {
+		return &lazyInitCmdComponent{cmd: func() tea.Msg { return loadedMsg{} }}
+	})
+	lazy.View()
+
+	_, cmd := lazy.Update(nil)
+	require.NotNil(t, cmd)
+
+	assert.IsType(t, loadedMsg{}, cmd())
+
+	_, cmd = lazy.Update(nil)
+	assert.Nil(t, cmd, "delivered only once")
+}
+
+// lazyInitCmdComponent is a minimal component whose Init returns a command.
+type lazyInitCmdComponent struct {
+	componentImpl
+	cmd tea.Cmd
+}
+
+func (c *lazyInitCmdComponent) Init() tea.Cmd                       { return c.cmd }
+func (c *lazyInitCmdComponent) IsInitialized() bool                 { return false }
+func (c *lazyInitCmdComponent) Update(tea.Msg) (tea.Model, tea.Cmd) { return c, nil }
+func (c *lazyInitCmdComponent) View() string                        { return "" }
@@ -10,6 +10,12 @@
 // OnUpdated: Executes after the component re-renders due to state changes.
 // Supports dependency tracking to run only when specific values change.
 //
+// OnBeforeUpdate: Executes at the start of each Update, before the message
+// is handled. Like OnUpdated, it never runs before the first render.
+//
+// OnUpdatedValues: Like OnUpdated with dependencies, but receives the
+// dependency values from before and after the change.
+//
 // OnUnmounted: Executes when the component is being removed.
 // Use for cleanup, canceling requests, and releasing resources.
 //
@@ -178,6 +184,10 @@ type lifecycleHook struct {
 	//nolint:unused // Will be used in Task 2.2 (Dependency tracking)
 	lastValues []any
 
+	// valuesCallback, when set, replaces callback for "updated" hooks and
+	// receives the dependency values before and after the change.
+	valuesCallback func(prev, next []any)
+
 	// order is the registration order of this hook.
 	// Hooks execute in registration order.
 	//nolint:unused // Will be used in Task 2.1 (Hook execution)
@@ -212,7 +222,8 @@ type LifecycleManager struct {
 	component *componentImpl
 
 	// hooks stores registered lifecycle hooks by type.
-	// Keys: "mounted", "beforeUpdate", "updated", "beforeUnmount", "unmounted"
+	// Keys: "mounted", "beforeUpdate", "updated", "beforeUnmount", "unmounted",
+	// "activated", "deactivated"
 	// Values: slices of hooks in registration order
 	hooks map[string][]lifecycleHook
 
@@ -220,6 +231,9 @@ type LifecycleManager struct {
 	// Executed in reverse order (LIFO).
 	cleanups []CleanupFunc
 
+	// errorHooks stores OnErrorCaptured hooks in registration order.
+	errorHooks []ErrorCapturedHook
+
 	// watchers stores watcher cleanup functions for auto-cleanup.
 	// All watchers are automatically cleaned up when component unmounts.
 	watchers []watcherCleanup
@@ -432,6 +446,13 @@ func (lm *LifecycleManager) safeExecuteHook(hookType string, hook lifecycleHook)
 
 				reporter.ReportPanic(panicErr, ctx)
 			}
+
+			// Let error boundaries above the component know
+			lm.component.captureError(&ComponentPanicError{
+				ComponentName: lm.component.name,
+				Phase:         fmt.Sprintf("lifecycle:%s", hookType),
+				PanicValue:    r,
+			})
 		}
 	}()
 
@@ -439,6 +460,20 @@ func (lm *LifecycleManager) safeExecuteHook(hookType string, hook lifecycleHook)
 	hook.callback()
 }
 
+// executeBeforeUpdate executes all registered onBeforeUpdate hooks.
+// Like onUpdated hooks, they only run once the component is mounted, so
+// updates before the first render don't trigger them.
+//
+// Example:
+//
+//	lm.executeBeforeUpdate()  // Execute all onBeforeUpdate hooks
+func (lm *LifecycleManager) executeBeforeUpdate() {
+	if !lm.IsMounted() {
+		return
+	}
+	lm.executeHooks("beforeUpdate")
+}
+
 // executeUpdated executes all registered onUpdated hooks with dependency tracking.
 // This method should be called after the component updates (after state changes).
 //
@@ -517,8 +552,14 @@ func (lm *LifecycleManager) executeUpdated() {
 		shouldExecute := lm.shouldExecuteHook(hook)
 
 		if shouldExecute {
-			// Execute the hook
-			lm.safeExecuteHook("updated", *hook)
+			// Execute the hook, handing value hooks their before/after values
+			run := *hook
+			if hook.valuesCallback != nil {
+				prev := append([]any(nil), hook.lastValues...)
+				next := dependencyValues(hook.dependencies)
+				run.callback = func() { hook.valuesCallback(prev, next) }
+			}
+			lm.safeExecuteHook("updated", run)
 
 			// Update lastValues after execution if hook has dependencies
 			if len(hook.dependencies) > 0 {
@@ -561,6 +602,15 @@ func (lm *LifecycleManager) shouldExecuteHook(hook *lifecycleHook) bool {
 	return false
 }
 
+// dependencyValues returns the current value of each dependency.
+func dependencyValues(deps []Dependency) []any {
+	values := make([]any, len(deps))
+	for i, dep := range deps {
+		values[i] = dep.Get()
+	}
+	return values
+}
+
 // updateLastValues updates the lastValues slice with current dependency values.
 // This is called after a hook executes to track the values for next comparison.
 func (lm *LifecycleManager) updateLastValues(hook *lifecycleHook) {
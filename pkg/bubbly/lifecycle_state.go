@@ -0,0 +1,92 @@
+package bubbly
+
+import "fmt"
+
+// LifecycleState is a snapshot of where a component is in its lifecycle,
+// for debugging, tests, and tooling such as the devtools inspector.
+type LifecycleState struct {
+	// Initialized reports whether Init() has run (setup has executed).
+	Initialized bool
+
+	// Mounted reports whether the component has rendered and has not been
+	// unmounted since.
+	Mounted bool
+
+	// Disposed reports whether Unmount() has run.
+	Disposed bool
+
+	// Renders is the number of View() calls since mount, including the
+	// render that mounted the component.
+	Renders int64
+
+	// Updates is the number of Update() calls since mount.
+	Updates int64
+}
+
+// Phase returns the most advanced lifecycle phase reached: "created",
+// "initialized", "mounted", or "disposed".
+func (s LifecycleState) Phase() string {
+	switch {
+	case s.Disposed:
+		return "disposed"
+	case s.Mounted:
+		return "mounted"
+	case s.Initialized:
+		return "initialized"
+	default:
+		return "created"
+	}
+}
+
+// String returns the phase with render and update counts, e.g.
+// "mounted (renders: 3, updates: 5)".
+func (s LifecycleState) String() string {
+	return fmt.Sprintf("%s (renders: %d, updates: %d)", s.Phase(), s.Renders, s.Updates)
+}
+
+// lifecycleInspector is implemented by components that expose their
+// lifecycle state.
+type lifecycleInspector interface {
+	LifecycleState() LifecycleState
+}
+
+// LifecycleState returns a snapshot of the component's lifecycle state.
+// It implements lifecycleInspector; use GetLifecycleState to query any
+// Component.
+func (c *componentImpl) LifecycleState() LifecycleState {
+	rendered := c.rendered.Load()
+	disposed := c.disposed.Load()
+	return LifecycleState{
+		Initialized: c.IsInitialized(),
+		Mounted:     rendered && !disposed,
+		Disposed:    disposed,
+		Renders:     c.renders.Load(),
+		Updates:     c.updates.Load(),
+	}
+}
+
+// LifecycleState returns the lifecycle state of the wrapped component,
+// or the zero state (phase "created") until it loads.
+func (l *Lazy) LifecycleState() LifecycleState {
+	return GetLifecycleState(l.current())
+}
+
+// GetLifecycleState returns a snapshot of c's lifecycle state: whether it
+// is initialized, mounted, or disposed, and how many times it has rendered
+// and updated since mount.
+//
+// Components that do not track their lifecycle (such as test doubles)
+// report the zero state.
+//
+// Example:
+//
+//	state := bubbly.GetLifecycleState(child)
+//	if !state.Mounted {
+//	    // child has not rendered yet
+//	}
+func GetLifecycleState(c Component) LifecycleState {
+	if inspector, ok := c.(lifecycleInspector); ok {
+		return inspector.LifecycleState()
+	}
+	return LifecycleState{}
+}
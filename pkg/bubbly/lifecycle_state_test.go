@@ -0,0 +1,90 @@
+package bubbly
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLifecycleState_Phase(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    LifecycleState
+		expected string
+	}{
+		{"zero state", LifecycleState{}, "created"},
+		{"initialized", LifecycleState{Initialized: true}, "initialized"},
+		{"mounted", LifecycleState{Initialized: true, Mounted: true}, "mounted"},
+		{"disposed", LifecycleState{Initialized: true, Disposed: true}, "disposed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.state.Phase())
+		})
+	}
+}
+
+func TestLifecycleState_String(t *testing.T) {
+	state := LifecycleState{Initialized: true, Mounted: true, Renders: 3, Updates: 5}
+	assert.Equal(t, "mounted (renders: 3, updates: 5)", state.String())
+}
+
+func TestGetLifecycleState_Component(t *testing.T) {
+	c, err := NewComponent("Counter").
+		Setup(func(ctx *Context) {
+			ctx.Expose("count", ctx.Ref(0))
+		}).
+		Template(func(ctx RenderContext) string { return "count" }).
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, LifecycleState{}, GetLifecycleState(c))
+
+	c.Init()
+	assert.Equal(t, LifecycleState{Initialized: true}, GetLifecycleState(c))
+
+	// Updates before the first render are not counted
+	c.Update(tea.KeyMsg{})
+	assert.Equal(t, int64(0), GetLifecycleState(c).Updates)
+
+	c.View()
+	c.Update(tea.KeyMsg{})
+	c.Update(tea.KeyMsg{})
+	c.View()
+	c.View()
+	assert.Equal(t, LifecycleState{Initialized: true, Mounted: true, Renders: 3, Updates: 2}, GetLifecycleState(c))
+
+	c.(*componentImpl).Unmount()
+	c.Update(tea.KeyMsg{})
+	state := GetLifecycleState(c)
+	assert.True(t, state.Disposed)
+	assert.False(t, state.Mounted)
+	assert.Equal(t, "disposed", state.Phase())
+	assert.Equal(t, int64(2), state.Updates)
+}
+
+func TestGetLifecycleState_Lazy(t *testing.T) {
+	lazy := LazyComponent(func() Component {
+		c, err := NewComponent("Heavy").
+			Template(func(ctx RenderContext) string { return "heavy" }).
+			Build()
+		require.NoError(t, err)
+		return c
+	})
+
+	assert.Equal(t, "created", GetLifecycleState(lazy).Phase())
+
+	lazy.Init()
+	lazy.View()
+
+	state := GetLifecycleState(lazy)
+	assert.True(t, state.Mounted)
+	assert.Equal(t, int64(1), state.Renders)
+}
+
+func TestGetLifecycleState_Untracked(t *testing.T) {
+	assert.Equal(t, LifecycleState{}, GetLifecycleState(nil))
+}
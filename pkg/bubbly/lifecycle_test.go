@@ -5,7 +5,11 @@ import (
 	"sync"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly/observability"
 )
 
 // TestNewLifecycleManager tests the creation of a new LifecycleManager.
@@ -1250,6 +1254,75 @@ func TestLifecycleManager_ExecuteUpdated_PanicRecovery(t *testing.T) {
 	}
 }
 
+// TestComponent_Update_BeforeUpdateHooks tests that onBeforeUpdate and onUpdated
+// wrap each Update once mounted, and that a panicking hook is reported.
+func TestComponent_Update_BeforeUpdateHooks(t *testing.T) {
+	var calls []string
+	var reported []string
+	observability.SetErrorReporter(&mockErrorReporter{
+		reportPanicFn: func(err *observability.HandlerPanicError, _ *observability.ErrorContext) {
+			reported = append(reported, err.EventName)
+		},
+	})
+	defer observability.SetErrorReporter(nil)
+
+	component, err := NewComponent("Test").
+		Setup(func(ctx *Context) {
+			ctx.OnBeforeUpdate(func() { panic("boom") })
+			ctx.OnBeforeUpdate(func() { calls = append(calls, "beforeUpdate") })
+			ctx.OnUpdated(func() { calls = append(calls, "updated") })
+		}).
+		WithMessageHandler(func(Component, tea.Msg) tea.Cmd {
+			calls = append(calls, "handler")
+			return nil
+		}).
+		Template(func(RenderContext) string { return "test" }).
+		Build()
+	require.NoError(t, err)
+	component.Init()
+
+	component.Update(nil)
+	assert.Equal(t, []string{"handler"}, calls, "hooks don't run before mount")
+
+	calls = nil
+	component.View()
+	component.Update(nil)
+	assert.Equal(t, []string{"beforeUpdate", "handler", "updated"}, calls)
+	assert.Equal(t, []string{"lifecycle:beforeUpdate"}, reported)
+
+	calls = nil
+	component.Update(StateChangedMsg{ComponentID: "other"})
+	assert.Equal(t, []string{"handler"}, calls, "state changes of other components don't count")
+}
+
+// TestContext_OnUpdatedValues tests that value hooks receive before and after values.
+func TestContext_OnUpdatedValues(t *testing.T) {
+	type change struct{ prev, next []any }
+	var changes []change
+
+	ctx := NewTestContext()
+	count := ctx.Ref(0)
+	label := ctx.Ref("a")
+	ctx.OnUpdatedValues(func(prev, next []any) {
+		changes = append(changes, change{prev, next})
+	}, count, label)
+	TriggerMount(ctx)
+
+	TriggerUpdate(ctx)
+	assert.Empty(t, changes, "no change, no call")
+
+	count.Set(1)
+	TriggerUpdate(ctx)
+	label.Set("b")
+	count.Set(2)
+	TriggerUpdate(ctx)
+
+	assert.Equal(t, []change{
+		{prev: []any{0, "a"}, next: []any{1, "a"}},
+		{prev: []any{1, "a"}, next: []any{2, "b"}},
+	}, changes)
+}
+
 // TestLifecycleManager_ExecuteUnmounted tests the executeUnmounted method.
 func TestLifecycleManager_ExecuteUnmounted(t *testing.T) {
 	tests := []struct {
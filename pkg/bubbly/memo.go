@@ -0,0 +1,219 @@
+package bubbly
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Memo makes the component skip its Template when its inputs are unchanged
+// and return the output of its previous render instead.
+//
+// The cached output is reused until one of these changes:
+//   - A Ref or Computed the template read during its last render (tracked
+//     automatically, like a Computed's dependencies), including values read
+//     by non-memoized children rendered from the template
+//   - The props, compared with reflect.DeepEqual (see WithShouldUpdate)
+//   - The output of a memoized child (its invalidation propagates up)
+//   - The children (AddChild, RemoveChild) or the template (ReloadTemplate)
+//
+// Templates of memoized components must be pure functions of those inputs:
+// values that are not reactive, such as plain exposed values mutated in
+// place or time.Now(), do not invalidate the cache. Skipped renders are
+// reported to framework hooks implementing RenderSkipHook, such as the
+// profiler's HookAdapter.
+//
+// While mouse zones are enabled, or positions are tracked (see
+// RenderWithPositions), outputs containing the zones of children are not
+// cached, since zones are resolved per frame. Tracked renders also bypass
+// the cache so that every descendant is located.
+//
+// Example:
+//
+//	row, _ := bubbly.NewComponent("Row").
+//	    Memo().
+//	    Setup(func(ctx *bubbly.Context) {
+//	        ctx.Expose("item", item)
+//	    }).
+//	    Template(func(ctx bubbly.RenderContext) string {
+//	        return renderExpensiveRow(bubbly.GetRef[Item](ctx, "item").GetTyped())
+//	    }).
+//	    Build()
+//
+// Returns:
+//   - *ComponentBuilder: The builder for method chaining
+func (b *ComponentBuilder) Memo() *ComponentBuilder {
+	if b.component.memo == nil {
+		b.component.memo = &memoState{owner: b.component}
+	}
+	return b
+}
+
+// WithShouldUpdate memoizes the component like Memo, deciding whether a
+// change of props requires a render with shouldUpdate instead of
+// reflect.DeepEqual. shouldUpdate receives the props of the cached render
+// and the current props, and returns true to render again.
+//
+// Reactive dependencies still invalidate the cache regardless of
+// shouldUpdate.
+//
+// Example:
+//
+//	NewComponent("Row").
+//	    WithShouldUpdate(func(prev, next interface{}) bool {
+//	        return prev.(RowProps).Version != next.(RowProps).Version
+//	    })
+//
+// Returns:
+//   - *ComponentBuilder: The builder for method chaining
+func (b *ComponentBuilder) WithShouldUpdate(shouldUpdate func(prevProps, nextProps interface{}) bool) *ComponentBuilder {
+	b.Memo()
+	b.component.memo.shouldUpdate = shouldUpdate
+	return b
+}
+
+// memoState is the render cache of a memoized component. It is the
+// Dependency the template's reads are tracked against, so it is invalidated
+// when any of them changes.
+type memoState struct {
+	owner        *componentImpl
+	shouldUpdate func(prevProps, nextProps interface{}) bool
+
+	mu      sync.Mutex
+	valid   bool
+	version uint64 // Incremented by every invalidation
+	output  string
+	props   interface{}
+}
+
+// lookup returns the cached output if it is still valid for props.
+func (m *memoState) lookup(props interface{}) (string, bool) {
+	m.mu.Lock()
+	valid, output, cachedProps := m.valid, m.output, m.props
+	m.mu.Unlock()
+
+	if !valid || m.propsChanged(cachedProps, props) {
+		return "", false
+	}
+	return output, true
+}
+
+// propsChanged reports whether props differ from the cached render's.
+func (m *memoState) propsChanged(cached, props interface{}) bool {
+	if m.shouldUpdate != nil {
+		return m.shouldUpdate(cached, props)
+	}
+	return !reflect.DeepEqual(cached, props)
+}
+
+// render runs template while tracking its reactive reads and caches the
+// output for props, unless the cache was invalidated during the render.
+func (m *memoState) render(props interface{}, template func() string) string {
+	m.mu.Lock()
+	version := m.version
+	m.mu.Unlock()
+	teleports := teleportSeq()
+
+	// A render that cannot be tracked (e.g., nested too deeply) is not cached
+	if err := globalTracker.BeginTracking(m); err != nil {
+		return template()
+	}
+	output, deps := m.trackedRender(template)
+	for _, dep := range deps {
+		dep.AddDependent(m)
+	}
+
+	if (MouseZonesEnabled() || activePositionRenders.Load() > 0) && containsZoneMarker(output) {
+		return output
+	}
+
+	// Overlays only show on frames that call Teleport, so reusing the
+	// output would drop them
+	if teleportSeq() != teleports {
+		return output
+	}
+
+	m.mu.Lock()
+	if m.version == version {
+		m.valid = true
+		m.output = output
+		m.props = props
+	}
+	m.mu.Unlock()
+
+	return output
+}
+
+// trackedRender runs template with tracking begun, ending tracking even if
+// the template panics.
+func (m *memoState) trackedRender(template func() string) (output string, deps []Dependency) {
+	completed := false
+	defer func() {
+		if !completed {
+			globalTracker.EndTracking()
+		}
+	}()
+
+	output = template()
+	deps = globalTracker.EndTracking()
+	completed = true
+	return output, deps
+}
+
+// invalidate drops the cached output of this component and of its memoized
+// ancestors, whose output embeds it.
+func (m *memoState) invalidate() {
+	for c := m.owner; c != nil; c = c.parent {
+		if c.memo == nil {
+			continue
+		}
+		c.memo.mu.Lock()
+		c.memo.valid = false
+		c.memo.version++
+		c.memo.output = ""
+		c.memo.mu.Unlock()
+	}
+}
+
+// Get implements Dependency. A memo cache has no value of its own.
+func (m *memoState) Get() any {
+	return nil
+}
+
+// Invalidate implements Dependency: a value the template read changed.
+func (m *memoState) Invalidate() {
+	m.invalidate()
+}
+
+// AddDependent implements Dependency (no-op: nothing depends on a memo cache).
+func (m *memoState) AddDependent(dep Dependency) {}
+
+// invalidateMemo drops the cached output of c, if it is memoized, and of
+// its memoized ancestors.
+func (c *componentImpl) invalidateMemo() {
+	for p := c; p != nil; p = p.parent {
+		if p.memo != nil {
+			p.memo.invalidate()
+			return
+		}
+	}
+}
+
+// containsZoneMarker reports whether output holds a mouse zone marker
+// ("\x1b[<id>z") added by a child's View.
+func containsZoneMarker(output string) bool {
+	for {
+		i := strings.Index(output, "\x1b[")
+		if i < 0 {
+			return false
+		}
+		output = output[i+2:]
+		j := 0
+		for j < len(output) && output[j] >= '0' && output[j] <= '9' {
+			j++
+		}
+		if j > 0 && j < len(output) && output[j] == 'z' {
+			return true
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package bubbly
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoTestComponent builds and initializes a component rendering its props
+// and count, and returns it with its template call counter.
+func memoTestComponent(t *testing.T, builder func(*ComponentBuilder) *ComponentBuilder, count *Ref[int]) (*componentImpl, *atomic.Int32) {
+	t.Helper()
+
+	renders := &atomic.Int32{}
+	b := NewComponent("Row").
+		Template(func(ctx RenderContext) string {
+			renders.Add(1)
+			return fmt.Sprintf("%v:%d", ctx.Props(), count.GetTyped())
+		})
+	component, err := builder(b).Build()
+	require.NoError(t, err)
+	component.Init()
+	return component.(*componentImpl), renders
+}
+
+func TestMemo_SkipsUnchangedRenders(t *testing.T) {
+	count := NewRef(1)
+	unread := NewRef(0)
+	row, renders := memoTestComponent(t, func(b *ComponentBuilder) *ComponentBuilder {
+		return b.Memo().Props("a")
+	}, count)
+
+	assert.Equal(t, "a:1", row.View())
+	assert.Equal(t, "a:1", row.View())
+	assert.Equal(t, int32(1), renders.Load(), "unchanged inputs should reuse the output")
+
+	unread.Set(5)
+	assert.Equal(t, "a:1", row.View())
+	assert.Equal(t, int32(1), renders.Load(), "refs the template did not read are ignored")
+
+	count.Set(2)
+	assert.Equal(t, "a:2", row.View())
+	assert.Equal(t, int32(2), renders.Load())
+
+	require.NoError(t, row.SetProps("b"))
+	assert.Equal(t, "b:2", row.View())
+	assert.Equal(t, int32(3), renders.Load())
+}
+
+func TestMemo_ComputedDependency(t *testing.T) {
+	count := NewRef(1)
+	doubled := NewComputed(func() int { return count.GetTyped() * 2 })
+	renders := 0
+	component, err := NewComponent("Doubled").
+		Memo().
+		Template(func(RenderContext) string {
+			renders++
+			return fmt.Sprint(doubled.GetTyped())
+		}).
+		Build()
+	require.NoError(t, err)
+	component.Init()
+
+	assert.Equal(t, "2", component.View())
+	component.View()
+	count.Set(3)
+	assert.Equal(t, "6", component.View())
+	assert.Equal(t, 2, renders)
+}
+
+func TestWithShouldUpdate(t *testing.T) {
+	type rowProps struct {
+		Version int
+		Label   string
+	}
+	count := NewRef(1)
+	row, renders := memoTestComponent(t, func(b *ComponentBuilder) *ComponentBuilder {
+		return b.Props(rowProps{Version: 1, Label: "a"}).
+			WithShouldUpdate(func(prev, next interface{}) bool {
+				return prev.(rowProps).Version != next.(rowProps).Version
+			})
+	}, count)
+
+	row.View()
+	require.NoError(t, row.SetProps(rowProps{Version: 1, Label: "ignored"}))
+	row.View()
+	assert.Equal(t, int32(1), renders.Load(), "shouldUpdate returned false")
+
+	require.NoError(t, row.SetProps(rowProps{Version: 2, Label: "b"}))
+	row.View()
+	assert.Equal(t, int32(2), renders.Load())
+
+	count.Set(2)
+	row.View()
+	assert.Equal(t, int32(3), renders.Load(), "reactive changes bypass shouldUpdate")
+}
+
+func TestMemo_Children(t *testing.T) {
+	childCount := NewRef(1)
+	plainCount := NewRef(1)
+	childRenders := 0
+	child, err := NewComponent("Child").
+		Memo().
+		Template(func(RenderContext) string {
+			childRenders++
+			return fmt.Sprint(childCount.GetTyped())
+		}).
+		Build()
+	require.NoError(t, err)
+	plain, err := NewComponent("Plain").
+		Template(func(RenderContext) string { return fmt.Sprint(plainCount.GetTyped()) }).
+		Build()
+	require.NoError(t, err)
+
+	parentRenders := 0
+	parent, err := NewComponent("Parent").
+		Memo().
+		Children(child, plain).
+		Template(func(ctx RenderContext) string {
+			parentRenders++
+			return ctx.RenderChildren(",")
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	assert.Equal(t, "1,1", parent.View())
+	parent.View()
+	assert.Equal(t, 1, parentRenders)
+
+	// A memoized child's change invalidates its memoized parent
+	childCount.Set(2)
+	assert.Equal(t, "2,1", parent.View())
+	assert.Equal(t, 2, parentRenders)
+	assert.Equal(t, 2, childRenders)
+
+	// Reads by a non-memoized child are tracked by the parent
+	plainCount.Set(2)
+	assert.Equal(t, "2,2", parent.View())
+	assert.Equal(t, 3, parentRenders)
+	assert.Equal(t, 2, childRenders, "the unchanged memoized child is skipped")
+
+	// Adding a child invalidates the parent
+	extra, err := NewComponent("Extra").Template(func(RenderContext) string { return "x" }).Build()
+	require.NoError(t, err)
+	require.NoError(t, parent.(*componentImpl).AddChild(extra))
+	assert.Equal(t, "2,2,x", parent.View())
+}
+
+func TestMemo_ReloadTemplate(t *testing.T) {
+	component, err := NewComponent("Title").
+		Memo().
+		Template(func(RenderContext) string { return "old" }).
+		Build()
+	require.NoError(t, err)
+	component.Init()
+
+	assert.Equal(t, "old", component.View())
+	require.NoError(t, ReloadTemplate(component, func(RenderContext) string { return "new" }))
+	assert.Equal(t, "new", component.View())
+}
+
+// renderSkipHook records skipped renders on top of mockHook.
+type renderSkipHook struct {
+	mockHook
+	skipped atomic.Int32
+}
+
+func (h *renderSkipHook) OnRenderSkipped(componentID string) {
+	h.skipped.Add(1)
+}
+
+func TestMemo_NotifiesRenderSkipHook(t *testing.T) {
+	hook := &renderSkipHook{}
+	require.NoError(t, RegisterHook(hook))
+	defer func() { _ = UnregisterHook() }()
+
+	count := NewRef(1)
+	row, _ := memoTestComponent(t, func(b *ComponentBuilder) *ComponentBuilder {
+		return b.Memo()
+	}, count)
+
+	row.View()
+	row.View()
+	row.View()
+
+	assert.Equal(t, int32(1), hook.renderCalls.Load())
+	assert.Equal(t, int32(2), hook.skipped.Load())
+}
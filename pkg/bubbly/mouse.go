@@ -0,0 +1,455 @@
+package bubbly
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Mouse event names emitted by mouse hit-testing.
+//
+// When mouse zones are enabled, DispatchMouse translates a tea.MouseMsg into
+// one or more of these events and emits them on the innermost component whose
+// rendered output lies under the pointer. Events bubble to parents like any
+// other component event, and handlers receive a MouseEvent as data.
+const (
+	// MouseEventClick fires when a button is pressed and released on the same target.
+	MouseEventClick = "click"
+
+	// MouseEventDown fires when a mouse button is pressed.
+	MouseEventDown = "mousedown"
+
+	// MouseEventUp fires when a mouse button is released.
+	MouseEventUp = "mouseup"
+
+	// MouseEventMove fires when the pointer moves within a target.
+	MouseEventMove = "mousemove"
+
+	// MouseEventOver fires when the pointer enters a target.
+	MouseEventOver = "mouseover"
+
+	// MouseEventOut fires when the pointer leaves a target.
+	MouseEventOut = "mouseout"
+
+	// MouseEventWheel fires for scroll wheel input over a target.
+	MouseEventWheel = "wheel"
+)
+
+// MouseEvent is the payload delivered to mouse event handlers.
+//
+// X and Y are absolute screen cells; LocalX and LocalY are relative to the
+// top-left corner of the target's rendered bounds.
+//
+// Example:
+//
+//	ctx.On(bubbly.MouseEventClick, func(data interface{}) {
+//	    ev := data.(bubbly.MouseEvent)
+//	    fmt.Printf("clicked at column %d\n", ev.LocalX)
+//	})
+type MouseEvent struct {
+	// Name is the mouse event name (e.g., MouseEventClick).
+	Name string
+
+	// X and Y are the absolute screen coordinates of the pointer.
+	X, Y int
+
+	// LocalX and LocalY are the coordinates relative to the target bounds.
+	LocalX, LocalY int
+
+	// Button is the mouse button involved in the event.
+	Button tea.MouseButton
+
+	// Action is the raw Bubbletea mouse action.
+	Action tea.MouseAction
+
+	// Modifier keys held during the event.
+	Shift, Alt, Ctrl bool
+
+	// Target is the component that was hit, or nil for directive zones.
+	Target Component
+}
+
+// Rect describes a rectangular screen region in terminal cells.
+type Rect struct {
+	X, Y          int
+	Width, Height int
+}
+
+// Contains reports whether the cell (x, y) lies inside the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// area returns the number of cells covered by the rectangle.
+func (r Rect) area() int {
+	return r.Width * r.Height
+}
+
+// zoneTarget is a pending mouse zone registered during rendering.
+type zoneTarget struct {
+	component *componentImpl
+	event     string
+	handler   func(MouseEvent)
+	width     int
+	height    int
+}
+
+// zoneRegion is a zone whose screen position has been resolved.
+type zoneRegion struct {
+	target zoneTarget
+	bounds Rect
+	order  int
+}
+
+// maxPendingZones bounds the pending zone table when views are rendered
+// but never scanned, preventing unbounded growth.
+const maxPendingZones = 10000
+
+// zoneMarkerPattern matches zone start markers.
+// Markers are private CSI sequences ("ESC [ n z") that are zero-width to
+// lipgloss, so layout is unaffected until ScanMouseZones strips them.
+var zoneMarkerPattern = regexp.MustCompile("\x1b\\[([0-9]+)z")
+
+// mouseZoneState holds all mouse hit-testing state.
+type mouseZoneState struct {
+	mu      sync.Mutex
+	enabled bool
+	nextID  int
+	pending map[int]zoneTarget
+	regions []zoneRegion
+
+	// Pointer tracking for click and hover synthesis
+	pressed   bool
+	pressX    int
+	pressY    int
+	pressComp *componentImpl
+	hoverComp *componentImpl
+	hoverRect Rect
+	lastX     int
+	lastY     int
+}
+
+// globalMouseZones is the process-wide mouse zone registry.
+var globalMouseZones = &mouseZoneState{
+	pending: make(map[int]zoneTarget),
+	lastX:   -1,
+	lastY:   -1,
+}
+
+// EnableMouseZones turns mouse hit-testing on or off.
+//
+// When enabled, every component wraps its View() output in an invisible zone
+// marker, and the root wrapper (Wrap or Run) resolves marker positions each
+// frame and routes tea.MouseMsg to the component under the pointer.
+//
+// Run enables mouse zones automatically when WithMouseCellMotion or
+// WithMouseAllMotion is used.
+func EnableMouseZones(enabled bool) {
+	s := globalMouseZones
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enabled = enabled
+	if !enabled {
+		s.pending = make(map[int]zoneTarget)
+		s.regions = nil
+		s.nextID = 0
+		s.pressComp = nil
+		s.hoverComp = nil
+		s.pressed = false
+	}
+}
+
+// MouseZonesEnabled reports whether mouse hit-testing is enabled.
+func MouseZonesEnabled() bool {
+	s := globalMouseZones
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.enabled
+}
+
+// MouseZone marks content as a mouse hit region for a single event.
+// The handler is invoked when the named event occurs within the content's
+// rendered bounds. When mouse zones are disabled, content is returned unchanged.
+//
+// This is the primitive behind directives.On(...).RenderZone(); most code
+// should use the directive or component-level handlers instead.
+//
+// Example:
+//
+//	bubbly.MouseZone("[ OK ]", bubbly.MouseEventClick, func(ev bubbly.MouseEvent) {
+//	    confirm()
+//	})
+func MouseZone(content, event string, handler func(MouseEvent)) string {
+	if !MouseZonesEnabled() {
+		return content
+	}
+	return globalMouseZones.mark(content, zoneTarget{
+		event:   event,
+		handler: handler,
+		width:   lipgloss.Width(content),
+		height:  lipgloss.Height(content),
+	})
+}
+
+// mark registers a pending zone and prefixes content with its marker.
+// The target's width and height must already describe content.
+func (s *mouseZoneState) mark(content string, target zoneTarget) string {
+	s.mu.Lock()
+	if !s.enabled {
+		s.mu.Unlock()
+		return content
+	}
+	id := s.addPendingLocked(target)
+	s.mu.Unlock()
+
+	return zoneMarker(id) + content
+}
+
+// register is mark for RenderWithPositions: the zone is recorded whether or
+// not mouse zones are enabled.
+func (s *mouseZoneState) register(content string, target zoneTarget) string {
+	s.mu.Lock()
+	id := s.addPendingLocked(target)
+	s.mu.Unlock()
+
+	return zoneMarker(id) + content
+}
+
+// addPendingLocked records a pending zone and returns its ID.
+// Must be called with s.mu held.
+func (s *mouseZoneState) addPendingLocked(target zoneTarget) int {
+	if len(s.pending) >= maxPendingZones {
+		s.pending = make(map[int]zoneTarget)
+	}
+	s.nextID++
+	s.pending[s.nextID] = target
+	return s.nextID
+}
+
+// zoneMarker returns the start marker for a zone ID.
+func zoneMarker(id int) string {
+	return "\x1b[" + strconv.Itoa(id) + "z"
+}
+
+// ScanMouseZones resolves the screen positions of all zones in a fully
+// rendered view, records them for hit-testing and Component.Bounds(), and
+// returns the view with zone markers removed.
+//
+// The root wrapper calls this once per frame. It is only needed when driving
+// a component without Wrap or Run.
+func ScanMouseZones(view string) string {
+	s := globalMouseZones
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.enabled {
+		return view
+	}
+
+	var regions []zoneRegion
+	lines := strings.Split(view, "\n")
+	for y, line := range lines {
+		matches := zoneMarkerPattern.FindAllStringSubmatchIndex(line, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		var clean strings.Builder
+		clean.Grow(len(line))
+		prev := 0
+		for _, m := range matches {
+			clean.WriteString(line[prev:m[0]])
+			prev = m[1]
+
+			id, err := strconv.Atoi(line[m[2]:m[3]])
+			if err != nil {
+				continue
+			}
+			target, ok := s.pending[id]
+			if !ok {
+				continue
+			}
+			x := lipgloss.Width(clean.String())
+			regions = append(regions, zoneRegion{
+				target: target,
+				bounds: Rect{X: x, Y: y, Width: target.width, Height: target.height},
+				order:  len(regions),
+			})
+		}
+		clean.WriteString(line[prev:])
+		lines[y] = clean.String()
+	}
+
+	// Publish resolved positions for Component.Bounds()
+	for _, r := range regions {
+		if r.target.component != nil {
+			r.target.component.setBounds(r.bounds)
+		}
+	}
+
+	s.regions = regions
+	s.pending = make(map[int]zoneTarget)
+	s.nextID = 0
+
+	return strings.Join(lines, "\n")
+}
+
+// hitComponent returns the innermost component region containing (x, y).
+// Smaller regions win; ties go to the region that started later (deeper).
+// Must be called with s.mu held.
+func (s *mouseZoneState) hitComponent(x, y int) (zoneRegion, bool) {
+	var best zoneRegion
+	found := false
+	for _, r := range s.regions {
+		if r.target.component == nil || !r.bounds.Contains(x, y) {
+			continue
+		}
+		if !found || r.bounds.area() < best.bounds.area() ||
+			(r.bounds.area() == best.bounds.area() && r.order > best.order) {
+			best = r
+			found = true
+		}
+	}
+	return best, found
+}
+
+// mouseEventName returns the primary event name for a mouse message.
+func mouseEventName(msg tea.MouseMsg) string {
+	if tea.MouseEvent(msg).IsWheel() {
+		return MouseEventWheel
+	}
+	switch msg.Action {
+	case tea.MouseActionPress:
+		return MouseEventDown
+	case tea.MouseActionRelease:
+		return MouseEventUp
+	default:
+		return MouseEventMove
+	}
+}
+
+// newMouseEvent builds a MouseEvent for a target region.
+func newMouseEvent(name string, msg tea.MouseMsg, bounds Rect, target Component) MouseEvent {
+	return MouseEvent{
+		Name:   name,
+		X:      msg.X,
+		Y:      msg.Y,
+		LocalX: msg.X - bounds.X,
+		LocalY: msg.Y - bounds.Y,
+		Button: msg.Button,
+		Action: msg.Action,
+		Shift:  msg.Shift,
+		Alt:    msg.Alt,
+		Ctrl:   msg.Ctrl,
+		Target: target,
+	}
+}
+
+// pendingDispatch is a handler call collected under the lock and run after it.
+type pendingDispatch struct {
+	comp    *componentImpl
+	handler func(MouseEvent)
+	event   MouseEvent
+}
+
+// DispatchMouse routes a mouse message to the components and directive zones
+// under the pointer, using the bounds resolved by the last ScanMouseZones.
+//
+// Presses emit "mousedown"; releases emit "mouseup" and, when the press began
+// on the same target, "click". Motion emits "mousemove" plus "mouseover" and
+// "mouseout" as the pointer crosses target boundaries. Wheel input emits "wheel".
+//
+// Returns true if at least one component or zone received an event.
+func DispatchMouse(msg tea.MouseMsg) bool {
+	s := globalMouseZones
+	s.mu.Lock()
+	if !s.enabled {
+		s.mu.Unlock()
+		return false
+	}
+
+	name := mouseEventName(msg)
+	var calls []pendingDispatch
+
+	// Component-level dispatch (innermost component wins, events bubble)
+	hit, ok := s.hitComponent(msg.X, msg.Y)
+	var hitComp *componentImpl
+	if ok {
+		hitComp = hit.target.component
+		calls = append(calls, pendingDispatch{comp: hitComp, event: newMouseEvent(name, msg, hit.bounds, hitComp)})
+	}
+
+	switch name {
+	case MouseEventDown:
+		s.pressed = true
+		s.pressX, s.pressY = msg.X, msg.Y
+		s.pressComp = hitComp
+	case MouseEventUp:
+		if s.pressed && hitComp != nil && hitComp == s.pressComp {
+			calls = append(calls, pendingDispatch{comp: hitComp, event: newMouseEvent(MouseEventClick, msg, hit.bounds, hitComp)})
+		}
+	case MouseEventMove:
+		if hitComp != s.hoverComp {
+			if s.hoverComp != nil {
+				calls = append(calls, pendingDispatch{comp: s.hoverComp, event: newMouseEvent(MouseEventOut, msg, s.hoverRect, s.hoverComp)})
+			}
+			if hitComp != nil {
+				calls = append(calls, pendingDispatch{comp: hitComp, event: newMouseEvent(MouseEventOver, msg, hit.bounds, hitComp)})
+			}
+			s.hoverComp = hitComp
+			s.hoverRect = hit.bounds
+		}
+	}
+
+	// Directive zone dispatch (every matching zone under the pointer fires)
+	for _, r := range s.regions {
+		if r.target.handler == nil {
+			continue
+		}
+		inside := r.bounds.Contains(msg.X, msg.Y)
+		fire := ""
+		switch r.target.event {
+		case name:
+			if inside {
+				fire = name
+			}
+		case MouseEventClick:
+			if name == MouseEventUp && inside && s.pressed && r.bounds.Contains(s.pressX, s.pressY) {
+				fire = MouseEventClick
+			}
+		case MouseEventOver:
+			if name == MouseEventMove && inside && !r.bounds.Contains(s.lastX, s.lastY) {
+				fire = MouseEventOver
+			}
+		case MouseEventOut:
+			if name == MouseEventMove && !inside && r.bounds.Contains(s.lastX, s.lastY) {
+				fire = MouseEventOut
+			}
+		}
+		if fire != "" {
+			calls = append(calls, pendingDispatch{handler: r.target.handler, event: newMouseEvent(fire, msg, r.bounds, nil)})
+		}
+	}
+
+	if name == MouseEventUp {
+		s.pressed = false
+		s.pressComp = nil
+	}
+	s.lastX, s.lastY = msg.X, msg.Y
+	s.mu.Unlock()
+
+	// Run handlers outside the lock so they may render or re-enter safely
+	for _, call := range calls {
+		if call.comp != nil {
+			call.comp.Emit(call.event.Name, call.event)
+		} else {
+			call.handler(call.event)
+		}
+	}
+
+	return len(calls) > 0
+}
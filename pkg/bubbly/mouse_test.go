@@ -0,0 +1,137 @@
+package bubbly
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMouseTestTree builds a parent that renders a header line followed by a child.
+func newMouseTestTree(t *testing.T, onChild func(name string, ev MouseEvent)) Component {
+	t.Helper()
+
+	child, err := NewComponent("Child").
+		Setup(func(ctx *Context) {
+			for _, name := range []string{MouseEventClick, MouseEventDown, MouseEventOver, MouseEventOut, MouseEventWheel} {
+				name := name
+				ctx.On(name, func(data interface{}) {
+					onChild(name, data.(MouseEvent))
+				})
+			}
+		}).
+		Template(func(ctx RenderContext) string { return "[ OK ]" }).
+		Build()
+	require.NoError(t, err)
+
+	parent, err := NewComponent("Parent").
+		Setup(func(ctx *Context) {
+			require.NoError(t, ctx.ExposeComponent("child", child))
+		}).
+		Template(func(ctx RenderContext) string {
+			return "Header\n  " + ctx.Get("child").(Component).View()
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+	return parent
+}
+
+// TestMouseZones_DisabledLeavesOutputUntouched tests that views are unchanged without mouse support
+func TestMouseZones_DisabledLeavesOutputUntouched(t *testing.T) {
+	EnableMouseZones(false)
+	parent := newMouseTestTree(t, func(string, MouseEvent) {})
+
+	assert.Equal(t, "Header\n  [ OK ]", parent.View())
+	assert.False(t, DispatchMouse(tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}))
+}
+
+// TestMouseZones_ClickHitsChild tests press+release over a child emits click with local coordinates
+func TestMouseZones_ClickHitsChild(t *testing.T) {
+	EnableMouseZones(true)
+	defer EnableMouseZones(false)
+
+	var got []MouseEvent
+	parent := newMouseTestTree(t, func(name string, ev MouseEvent) {
+		got = append(got, ev)
+	})
+
+	view := ScanMouseZones(parent.View())
+	assert.Equal(t, "Header\n  [ OK ]", view, "markers are stripped from the final view")
+
+	assert.True(t, DispatchMouse(tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}))
+	assert.True(t, DispatchMouse(tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}))
+
+	require.Len(t, got, 2)
+	assert.Equal(t, MouseEventDown, got[0].Name)
+	assert.Equal(t, MouseEventClick, got[1].Name)
+	assert.Equal(t, 2, got[1].LocalX)
+	assert.Equal(t, 0, got[1].LocalY)
+	assert.Equal(t, "Child", got[1].Target.Name())
+}
+
+// TestMouseZones_ClickOutsideChild tests that clicks outside the child don't reach it
+func TestMouseZones_ClickOutsideChild(t *testing.T) {
+	EnableMouseZones(true)
+	defer EnableMouseZones(false)
+
+	var got []string
+	parent := newMouseTestTree(t, func(name string, ev MouseEvent) {
+		got = append(got, name)
+	})
+	ScanMouseZones(parent.View())
+
+	DispatchMouse(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	DispatchMouse(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	assert.Empty(t, got)
+}
+
+// TestMouseZones_HoverAndWheel tests mouseover/mouseout synthesis and wheel events
+func TestMouseZones_HoverAndWheel(t *testing.T) {
+	EnableMouseZones(true)
+	defer EnableMouseZones(false)
+
+	var got []string
+	parent := newMouseTestTree(t, func(name string, ev MouseEvent) {
+		got = append(got, name)
+	})
+	ScanMouseZones(parent.View())
+
+	DispatchMouse(tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionMotion})
+	DispatchMouse(tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionMotion})
+	DispatchMouse(tea.MouseMsg{X: 4, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+	DispatchMouse(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionMotion})
+
+	assert.Equal(t, []string{MouseEventOver, MouseEventWheel, MouseEventOut}, got)
+}
+
+// TestMouseZone_HandlerZone tests directive-style handler zones
+func TestMouseZone_HandlerZone(t *testing.T) {
+	EnableMouseZones(true)
+	defer EnableMouseZones(false)
+
+	clicks := 0
+	view := "a " + MouseZone("btn", MouseEventClick, func(ev MouseEvent) {
+		clicks++
+		assert.Nil(t, ev.Target)
+	})
+	assert.Equal(t, "a btn", ScanMouseZones(view))
+
+	DispatchMouse(tea.MouseMsg{X: 2, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	DispatchMouse(tea.MouseMsg{X: 4, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	assert.Equal(t, 1, clicks)
+
+	DispatchMouse(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	DispatchMouse(tea.MouseMsg{X: 3, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	assert.Equal(t, 1, clicks, "press outside the zone does not click")
+}
+
+// TestRect_Contains tests rectangle hit-testing bounds
+func TestRect_Contains(t *testing.T) {
+	r := Rect{X: 2, Y: 1, Width: 3, Height: 2}
+	assert.True(t, r.Contains(2, 1))
+	assert.True(t, r.Contains(4, 2))
+	assert.False(t, r.Contains(5, 1))
+	assert.False(t, r.Contains(2, 3))
+}
@@ -3,6 +3,7 @@ package profiler
 
 import (
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 )
@@ -363,6 +364,140 @@ func (da *DataAggregator) AggregateAll(
 	return data
 }
 
+// WindowRollup contains render statistics for one time window of a session.
+type WindowRollup struct {
+	// Start is the beginning of the window (inclusive)
+	Start time.Time
+
+	// End is the end of the window (exclusive)
+	End time.Time
+
+	// RenderCount is the number of frames rendered in the window
+	RenderCount int
+
+	// DroppedCount is the number of dropped frames in the window
+	DroppedCount int
+
+	// Mean is the average frame duration
+	Mean time.Duration
+
+	// P95 is the 95th percentile frame duration
+	P95 time.Duration
+
+	// Max is the longest frame duration
+	Max time.Duration
+}
+
+// WindowSeries is a time-ordered series of WindowRollups with no gaps:
+// windows without frames are included with a zero RenderCount.
+type WindowSeries []*WindowRollup
+
+// RenderCounts returns the render count of each window, for plotting.
+func (s WindowSeries) RenderCounts() []float64 {
+	values := make([]float64, len(s))
+	for i, w := range s {
+		values[i] = float64(w.RenderCount)
+	}
+	return values
+}
+
+// P95Millis returns the P95 frame duration of each window in milliseconds,
+// for plotting.
+func (s WindowSeries) P95Millis() []float64 {
+	values := make([]float64, len(s))
+	for i, w := range s {
+		values[i] = float64(w.P95) / float64(time.Millisecond)
+	}
+	return values
+}
+
+// AggregateByWindow rolls up the frames recorded by a RenderProfiler into
+// consecutive time windows of the given size, showing how render
+// performance evolves over a session rather than just in total.
+//
+// Windows are aligned to multiples of window and span from the first to
+// the last recorded frame. Returns nil if profiler is nil, window is not
+// positive, or no frames were recorded. Only frames still retained by the
+// profiler (see RenderConfig.MaxFrames) are included.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+//
+// Example:
+//
+//	series := da.AggregateByWindow(renderProfiler, time.Second)
+//	for _, w := range series {
+//	    fmt.Printf("%s: %d renders, p95 %v\n", w.Start.Format("15:04:05"), w.RenderCount, w.P95)
+//	}
+func (da *DataAggregator) AggregateByWindow(profiler *RenderProfiler, window time.Duration) WindowSeries {
+	da.mu.RLock()
+	defer da.mu.RUnlock()
+
+	if profiler == nil {
+		return nil
+	}
+	return aggregateFramesByWindow(profiler.GetFrames(), window)
+}
+
+// aggregateFramesByWindow buckets frames into windows of the given size.
+func aggregateFramesByWindow(frames []FrameInfo, window time.Duration) WindowSeries {
+	if window <= 0 || len(frames) == 0 {
+		return nil
+	}
+
+	first, last := frames[0].Timestamp, frames[0].Timestamp
+	for _, f := range frames {
+		if f.Timestamp.Before(first) {
+			first = f.Timestamp
+		}
+		if f.Timestamp.After(last) {
+			last = f.Timestamp
+		}
+	}
+	start := first.Truncate(window)
+	count := int(last.Sub(start)/window) + 1
+
+	buckets := make([][]time.Duration, count)
+	series := make(WindowSeries, count)
+	for i := range series {
+		series[i] = &WindowRollup{
+			Start: start.Add(time.Duration(i) * window),
+			End:   start.Add(time.Duration(i+1) * window),
+		}
+	}
+
+	for _, f := range frames {
+		i := int(f.Timestamp.Sub(start) / window)
+		buckets[i] = append(buckets[i], f.Duration)
+		if f.Dropped {
+			series[i].DroppedCount++
+		}
+	}
+
+	for i, durations := range buckets {
+		if len(durations) == 0 {
+			continue
+		}
+		sort.Slice(durations, func(a, b int) bool {
+			return durations[a] < durations[b]
+		})
+
+		var total time.Duration
+		for _, d := range durations {
+			total += d
+		}
+
+		w := series[i]
+		w.RenderCount = len(durations)
+		w.Mean = total / time.Duration(len(durations))
+		w.P95 = durations[percentileIndex(len(durations), 95)]
+		w.Max = durations[len(durations)-1]
+	}
+
+	return series
+}
+
 // CalculateSummary generates a Summary from AggregatedData.
 //
 // The summary includes total operations, memory usage, goroutine count,
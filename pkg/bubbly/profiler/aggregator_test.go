@@ -803,3 +803,120 @@ func TestDataAggregator_AggregateAllocations_NilTracker(t *testing.T) {
 
 	assert.Len(t, data.Allocations, 0)
 }
+
+// TestAggregateFramesByWindow tests time-bucketed rollups of frames.
+func TestAggregateFramesByWindow(t *testing.T) {
+	base := time.Date(2024, 11, 29, 10, 0, 0, 0, time.UTC)
+	frame := func(offset, duration time.Duration, dropped bool) FrameInfo {
+		return FrameInfo{Timestamp: base.Add(offset), Duration: duration, Dropped: dropped}
+	}
+
+	tests := []struct {
+		name     string
+		frames   []FrameInfo
+		window   time.Duration
+		expected []WindowRollup
+	}{
+		{
+			name:     "no frames",
+			frames:   nil,
+			window:   time.Second,
+			expected: nil,
+		},
+		{
+			name:     "non-positive window",
+			frames:   []FrameInfo{frame(0, time.Millisecond, false)},
+			window:   0,
+			expected: nil,
+		},
+		{
+			name: "frames bucketed per window with gaps kept",
+			frames: []FrameInfo{
+				frame(100*time.Millisecond, 2*time.Millisecond, false),
+				frame(500*time.Millisecond, 4*time.Millisecond, false),
+				frame(900*time.Millisecond, 30*time.Millisecond, true),
+				frame(2500*time.Millisecond, 6*time.Millisecond, false),
+			},
+			window: time.Second,
+			expected: []WindowRollup{
+				{
+					Start: base, End: base.Add(time.Second),
+					RenderCount: 3, DroppedCount: 1,
+					Mean: 12 * time.Millisecond, P95: 30 * time.Millisecond, Max: 30 * time.Millisecond,
+				},
+				{
+					Start: base.Add(time.Second), End: base.Add(2 * time.Second),
+				},
+				{
+					Start: base.Add(2 * time.Second), End: base.Add(3 * time.Second),
+					RenderCount: 1,
+					Mean:        6 * time.Millisecond, P95: 6 * time.Millisecond, Max: 6 * time.Millisecond,
+				},
+			},
+		},
+		{
+			name: "windows aligned to the window size",
+			frames: []FrameInfo{
+				frame(1700*time.Millisecond, time.Millisecond, false),
+			},
+			window: time.Second,
+			expected: []WindowRollup{
+				{
+					Start: base.Add(time.Second), End: base.Add(2 * time.Second),
+					RenderCount: 1,
+					Mean:        time.Millisecond, P95: time.Millisecond, Max: time.Millisecond,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			series := aggregateFramesByWindow(tt.frames, tt.window)
+
+			if tt.expected == nil {
+				assert.Nil(t, series)
+				return
+			}
+			require.Len(t, series, len(tt.expected))
+			for i, w := range series {
+				assert.Equal(t, tt.expected[i], *w, "window %d", i)
+			}
+		})
+	}
+}
+
+// TestWindowSeries_Values tests the plotting helpers of WindowSeries.
+func TestWindowSeries_Values(t *testing.T) {
+	series := WindowSeries{
+		{RenderCount: 3, P95: 30 * time.Millisecond},
+		{RenderCount: 0},
+		{RenderCount: 1, P95: 1500 * time.Microsecond},
+	}
+
+	assert.Equal(t, []float64{3, 0, 1}, series.RenderCounts())
+	assert.Equal(t, []float64{30, 0, 1.5}, series.P95Millis())
+	assert.Empty(t, WindowSeries(nil).RenderCounts())
+}
+
+// TestDataAggregator_AggregateByWindow tests rollups from a RenderProfiler.
+func TestDataAggregator_AggregateByWindow(t *testing.T) {
+	da := NewDataAggregator()
+	assert.Nil(t, da.AggregateByWindow(nil, time.Second))
+
+	rp := NewRenderProfiler()
+	assert.Nil(t, da.AggregateByWindow(rp, time.Second))
+
+	for i := 0; i < 10; i++ {
+		rp.RecordFrame(time.Duration(i) * time.Millisecond)
+	}
+
+	series := da.AggregateByWindow(rp, time.Hour)
+	require.NotEmpty(t, series)
+
+	total := 0
+	for _, w := range series {
+		total += w.RenderCount
+	}
+	assert.Equal(t, 10, total)
+}
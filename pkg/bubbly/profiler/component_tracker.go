@@ -104,8 +104,8 @@ func (ct *ComponentTracker) RecordRender(id, name string, duration time.Duration
 	metrics.RenderCount++
 	metrics.TotalRenderTime += duration
 
-	// Update min/max
-	if duration < metrics.MinRenderTime {
+	// Update min/max (an entry created by RecordSkippedRender has no minimum yet)
+	if duration < metrics.MinRenderTime || metrics.RenderCount == 1 {
 		metrics.MinRenderTime = duration
 	}
 	if duration > metrics.MaxRenderTime {
@@ -116,6 +116,33 @@ func (ct *ComponentTracker) RecordRender(id, name string, duration time.Duration
 	metrics.AvgRenderTime = time.Duration(int64(metrics.TotalRenderTime) / metrics.RenderCount)
 }
 
+// RecordSkippedRender records a render that a memoized component skipped,
+// returning its cached output. It increments SkippedRenderCount without
+// affecting the render timing statistics.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+//
+// Example:
+//
+//	ct.RecordSkippedRender("comp-1", "Row")
+func (ct *ComponentTracker) RecordSkippedRender(id, name string) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	metrics, ok := ct.components[id]
+	if !ok {
+		metrics = &ComponentMetrics{
+			ComponentID:   id,
+			ComponentName: name,
+		}
+		ct.components[id] = metrics
+	}
+
+	metrics.SkippedRenderCount++
+}
+
 // GetMetrics returns metrics for a specific component.
 //
 // Returns nil if the component has not been tracked.
@@ -166,14 +193,15 @@ func (ct *ComponentTracker) GetMetricsSnapshot(id string) *ComponentMetrics {
 
 	// Return a copy
 	return &ComponentMetrics{
-		ComponentID:     metrics.ComponentID,
-		ComponentName:   metrics.ComponentName,
-		RenderCount:     metrics.RenderCount,
-		TotalRenderTime: metrics.TotalRenderTime,
-		AvgRenderTime:   metrics.AvgRenderTime,
-		MaxRenderTime:   metrics.MaxRenderTime,
-		MinRenderTime:   metrics.MinRenderTime,
-		MemoryUsage:     metrics.MemoryUsage,
+		ComponentID:        metrics.ComponentID,
+		ComponentName:      metrics.ComponentName,
+		RenderCount:        metrics.RenderCount,
+		SkippedRenderCount: metrics.SkippedRenderCount,
+		TotalRenderTime:    metrics.TotalRenderTime,
+		AvgRenderTime:      metrics.AvgRenderTime,
+		MaxRenderTime:      metrics.MaxRenderTime,
+		MinRenderTime:      metrics.MinRenderTime,
+		MemoryUsage:        metrics.MemoryUsage,
 	}
 }
 
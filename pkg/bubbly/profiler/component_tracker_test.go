@@ -470,3 +470,18 @@ func TestComponentTracker_TotalRenderCount_Empty(t *testing.T) {
 
 	assert.Equal(t, int64(0), ct.TotalRenderCount())
 }
+
+func TestComponentTracker_RecordSkippedRender(t *testing.T) {
+	ct := NewComponentTracker()
+
+	ct.RecordSkippedRender("comp-1", "Row")
+	ct.RecordRender("comp-1", "Row", 4*time.Millisecond)
+	ct.RecordSkippedRender("comp-1", "Row")
+
+	metrics := ct.GetMetricsSnapshot("comp-1")
+	require.NotNil(t, metrics)
+	assert.Equal(t, int64(2), metrics.SkippedRenderCount)
+	assert.Equal(t, int64(1), metrics.RenderCount)
+	assert.Equal(t, 4*time.Millisecond, metrics.MinRenderTime)
+	assert.Equal(t, 4*time.Millisecond, metrics.AvgRenderTime)
+}
@@ -245,6 +245,13 @@
 //	html := tg.GenerateHTML(events)
 //	os.WriteFile("timeline.html", []byte(html), 0644)
 //
+// Nest events to see where a frame's time went; children are drawn
+// indented beneath their parent:
+//
+//	frame := profiler.AddEvent("App.Render", profiler.EventTypeRender, start, 12*time.Millisecond)
+//	frame.AddChild(profiler.AddEvent("List.Render", profiler.EventTypeRender, start, 9*time.Millisecond))
+//	html = tg.GenerateHTML([]*profiler.TimedEvent{frame})
+//
 // # Benchmarking
 //
 // Integration with Go's testing benchmarks:
@@ -328,6 +335,14 @@
 //	wrapped := inst.InstrumentComponent(component)
 //	output := wrapped.View() // Automatically timed
 //
+// For always-on instrumentation, record only slow renders. Adaptive mode
+// learns each component's typical render time and records renders that are
+// well above it:
+//
+//	config := profiler.DefaultRenderSamplingConfig()
+//	config.Adaptive = true
+//	inst.SetRenderSampling(config)
+//
 // # Thread Safety
 //
 // All profiler types are thread-safe and can be used concurrently from
@@ -99,6 +99,20 @@ func (h *HookAdapter) OnRenderComplete(componentID string, duration time.Duratio
 	h.componentTracker.RecordRender(componentID, name, duration)
 }
 
+// OnRenderSkipped counts renders skipped by memoized components.
+// It implements bubbly.RenderSkipHook.
+func (h *HookAdapter) OnRenderSkipped(componentID string) {
+	h.mu.RLock()
+	name := h.componentNames[componentID]
+	h.mu.RUnlock()
+
+	if name == "" {
+		name = "Unknown"
+	}
+
+	h.componentTracker.RecordSkippedRender(componentID, name)
+}
+
 // OnComputedChange is called when a computed value changes.
 func (h *HookAdapter) OnComputedChange(id string, oldValue, newValue interface{}) {}
 
@@ -231,6 +245,17 @@ func (c *CompositeHook) OnRenderComplete(componentID string, duration time.Durat
 	}
 }
 
+// OnRenderSkipped forwards to all hooks implementing bubbly.RenderSkipHook.
+func (c *CompositeHook) OnRenderSkipped(componentID string) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, h := range c.hooks {
+		if skipHook, ok := h.(bubbly.RenderSkipHook); ok {
+			skipHook.OnRenderSkipped(componentID)
+		}
+	}
+}
+
 // OnComputedChange forwards to all hooks.
 func (c *CompositeHook) OnComputedChange(id string, oldValue, newValue interface{}) {
 	c.mu.RLock()
@@ -56,6 +56,22 @@ func TestHookAdapter_OnRenderComplete_UnknownComponent(t *testing.T) {
 	assert.Equal(t, "Unknown", metrics.ComponentName)
 }
 
+func TestHookAdapter_OnRenderSkipped(t *testing.T) {
+	prof := New(WithEnabled(true))
+	adapter := NewHookAdapter(prof)
+	adapter.OnComponentMount("comp-1", "Row")
+
+	composite := NewCompositeHook(adapter, &mockHook{})
+	composite.OnRenderSkipped("comp-1")
+	composite.OnRenderSkipped("comp-1")
+
+	metrics := adapter.GetComponentTracker().GetMetrics("comp-1")
+	require.NotNil(t, metrics)
+	assert.Equal(t, "Row", metrics.ComponentName)
+	assert.Equal(t, int64(2), metrics.SkippedRenderCount)
+	assert.Equal(t, int64(0), metrics.RenderCount)
+}
+
 func TestCompositeHook_ForwardsToAllHooks(t *testing.T) {
 	// Create mock hooks that track calls
 	hook1Calls := 0
@@ -101,6 +101,9 @@ type Instrumentor struct {
 	// enabled indicates whether instrumentation is active
 	enabled atomic.Bool
 
+	// sampler filters recorded renders; nil records every render
+	sampler atomic.Pointer[renderSampler]
+
 	// mu protects access to internal state
 	mu sync.RWMutex
 }
@@ -163,7 +166,9 @@ func (i *Instrumentor) IsEnabled() bool {
 // InstrumentRender starts timing a render operation for a component.
 //
 // Returns a stop function that must be called when the render completes.
-// The stop function records the render duration to the component tracker.
+// The stop function records the render duration to the component tracker,
+// unless slow-render sampling is configured and the render was fast
+// (see SetRenderSampling).
 //
 // If the instrumentor is disabled or component is nil, returns a no-op function.
 //
@@ -193,7 +198,7 @@ func (i *Instrumentor) InstrumentRender(component Component) func() {
 
 	return func() {
 		duration := time.Since(start)
-		i.componentTracker.RecordRender(id, name, duration)
+		i.recordRender(id, name, duration)
 	}
 }
 
@@ -293,6 +298,9 @@ func (i *Instrumentor) Reset() {
 
 	i.componentTracker.Reset()
 	i.collector.Reset()
+	if s := i.sampler.Load(); s != nil {
+		s.reset()
+	}
 }
 
 // instrumentedComponent wraps a Component with automatic instrumentation.
@@ -343,7 +351,7 @@ func (ic *instrumentedComponent) View() string {
 	result := ic.original.View()
 
 	duration := time.Since(start)
-	ic.instrumentor.componentTracker.RecordRender(id, name, duration)
+	ic.instrumentor.recordRender(id, name, duration)
 
 	return result
 }
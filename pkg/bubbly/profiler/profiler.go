@@ -204,6 +204,10 @@ type ComponentMetrics struct {
 	// RenderCount is total number of renders
 	RenderCount int64
 
+	// SkippedRenderCount is the number of renders skipped by a memoized
+	// component returning its cached output (see bubbly's Memo)
+	SkippedRenderCount int64
+
 	// TotalRenderTime is cumulative render duration
 	TotalRenderTime time.Duration
 
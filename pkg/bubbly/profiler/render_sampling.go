@@ -0,0 +1,216 @@
+// Package profiler provides comprehensive performance profiling for BubblyUI applications.
+package profiler
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RenderSamplingConfig configures slow-render (tail) sampling for an Instrumentor.
+//
+// With sampling active, every render is still timed, but only renders slower
+// than the component's threshold are recorded to the component tracker. This
+// cuts data volume and bookkeeping overhead while still capturing jank, which
+// makes always-on instrumentation viable in larger applications.
+type RenderSamplingConfig struct {
+	// Threshold is the fixed minimum duration a render must exceed to be
+	// recorded. Zero means no fixed threshold.
+	Threshold time.Duration
+
+	// Adaptive enables per-component thresholds learned from each
+	// component's typical render time. A render is recorded when it exceeds
+	// both Threshold and AdaptiveFactor times the component's baseline.
+	Adaptive bool
+
+	// AdaptiveFactor is how many times slower than its baseline a render
+	// must be to count as slow. Values <= 1 use the default of 2.
+	AdaptiveFactor float64
+
+	// WarmupRenders is the number of renders observed per component before
+	// its adaptive threshold applies. Until then only Threshold is used.
+	WarmupRenders int
+}
+
+// DefaultRenderSamplingConfig returns a RenderSamplingConfig with sensible defaults.
+//
+// Default values:
+//   - Threshold: 16ms (60 FPS frame budget)
+//   - Adaptive: false
+//   - AdaptiveFactor: 2
+//   - WarmupRenders: 20
+func DefaultRenderSamplingConfig() *RenderSamplingConfig {
+	return &RenderSamplingConfig{
+		Threshold:      16 * time.Millisecond, // 60 FPS frame budget
+		Adaptive:       false,
+		AdaptiveFactor: 2,
+		WarmupRenders:  20,
+	}
+}
+
+// renderBaselineAlpha is the smoothing factor of the per-component
+// exponentially weighted moving average of render durations.
+const renderBaselineAlpha = 0.1
+
+// renderBaseline is the learned typical render time of one component.
+type renderBaseline struct {
+	// average is the EWMA of observed render durations, in nanoseconds
+	average float64
+
+	// observed is the number of renders seen so far
+	observed int
+}
+
+// renderSampler decides which renders an Instrumentor records.
+type renderSampler struct {
+	// config is a private copy of the sampling configuration
+	config RenderSamplingConfig
+
+	// baselines holds the learned baseline per component ID
+	baselines map[string]*renderBaseline
+
+	// skipped counts renders that were timed but not recorded
+	skipped atomic.Int64
+
+	// mu protects baselines
+	mu sync.Mutex
+}
+
+// newRenderSampler creates a sampler from config, applying defaults.
+func newRenderSampler(config *RenderSamplingConfig) *renderSampler {
+	s := &renderSampler{
+		config:    *config,
+		baselines: make(map[string]*renderBaseline),
+	}
+	if s.config.AdaptiveFactor <= 1 {
+		s.config.AdaptiveFactor = 2
+	}
+	if s.config.WarmupRenders < 0 {
+		s.config.WarmupRenders = 0
+	}
+	return s
+}
+
+// shouldRecord reports whether a render of the component taking duration
+// is slow enough to record. In adaptive mode it also feeds duration into the
+// component's baseline, after the decision so a slow render is judged
+// against the baseline that preceded it.
+func (s *renderSampler) shouldRecord(id string, duration time.Duration) bool {
+	slow := duration > s.config.Threshold
+
+	if s.config.Adaptive {
+		s.mu.Lock()
+		b, ok := s.baselines[id]
+		if !ok {
+			b = &renderBaseline{average: float64(duration)}
+			s.baselines[id] = b
+		}
+		if slow && b.observed >= s.config.WarmupRenders {
+			slow = float64(duration) > b.average*s.config.AdaptiveFactor
+		}
+		if ok {
+			b.average += renderBaselineAlpha * (float64(duration) - b.average)
+		}
+		b.observed++
+		s.mu.Unlock()
+	}
+
+	if !slow {
+		s.skipped.Add(1)
+	}
+	return slow
+}
+
+// baseline returns the learned baseline of a component, or 0 if none.
+func (s *renderSampler) baseline(id string) time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b, ok := s.baselines[id]; ok {
+		return time.Duration(b.average)
+	}
+	return 0
+}
+
+// reset clears learned baselines and the skipped count.
+func (s *renderSampler) reset() {
+	s.mu.Lock()
+	s.baselines = make(map[string]*renderBaseline)
+	s.mu.Unlock()
+	s.skipped.Store(0)
+}
+
+// SetRenderSampling enables slow-render sampling with the given configuration.
+//
+// Passing nil disables sampling so that every render is recorded again.
+// Changing the configuration discards learned adaptive baselines.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+//
+// Example:
+//
+//	config := profiler.DefaultRenderSamplingConfig()
+//	config.Threshold = 5 * time.Millisecond
+//	config.Adaptive = true
+//	inst.SetRenderSampling(config)
+func (i *Instrumentor) SetRenderSampling(config *RenderSamplingConfig) {
+	if config == nil {
+		i.sampler.Store(nil)
+		return
+	}
+	i.sampler.Store(newRenderSampler(config))
+}
+
+// GetRenderSampling returns a copy of the active sampling configuration,
+// or nil if every render is recorded.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+func (i *Instrumentor) GetRenderSampling() *RenderSamplingConfig {
+	s := i.sampler.Load()
+	if s == nil {
+		return nil
+	}
+	config := s.config
+	return &config
+}
+
+// SkippedRenders returns the number of renders timed but not recorded
+// because they were faster than the sampling threshold.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+func (i *Instrumentor) SkippedRenders() int64 {
+	s := i.sampler.Load()
+	if s == nil {
+		return 0
+	}
+	return s.skipped.Load()
+}
+
+// RenderBaseline returns the typical render time learned for a component in
+// adaptive sampling mode, or 0 if the component has not rendered yet or
+// adaptive sampling is off.
+//
+// Thread Safety:
+//
+//	Safe to call concurrently from multiple goroutines.
+func (i *Instrumentor) RenderBaseline(id string) time.Duration {
+	s := i.sampler.Load()
+	if s == nil {
+		return 0
+	}
+	return s.baseline(id)
+}
+
+// recordRender records a render to the component tracker, subject to
+// slow-render sampling when it is configured.
+func (i *Instrumentor) recordRender(id, name string, duration time.Duration) {
+	if s := i.sampler.Load(); s != nil && !s.shouldRecord(id, duration) {
+		return
+	}
+	i.componentTracker.RecordRender(id, name, duration)
+}
@@ -0,0 +1,159 @@
+// Package profiler provides comprehensive performance profiling for BubblyUI applications.
+package profiler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultRenderSamplingConfig(t *testing.T) {
+	config := DefaultRenderSamplingConfig()
+
+	assert.Equal(t, 16*time.Millisecond, config.Threshold)
+	assert.False(t, config.Adaptive)
+	assert.Equal(t, 2.0, config.AdaptiveFactor)
+	assert.Equal(t, 20, config.WarmupRenders)
+}
+
+func TestInstrumentor_RenderSampling_FixedThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		durations []time.Duration
+		recorded  int64
+		skipped   int64
+	}{
+		{
+			name:      "all fast renders are skipped",
+			durations: []time.Duration{time.Millisecond, 2 * time.Millisecond},
+			recorded:  0,
+			skipped:   2,
+		},
+		{
+			name:      "only slow renders are recorded",
+			durations: []time.Duration{time.Millisecond, 20 * time.Millisecond, 3 * time.Millisecond, 30 * time.Millisecond},
+			recorded:  2,
+			skipped:   2,
+		},
+		{
+			name:      "render equal to threshold is skipped",
+			durations: []time.Duration{10 * time.Millisecond},
+			recorded:  0,
+			skipped:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inst := NewInstrumentor(nil)
+			inst.SetRenderSampling(&RenderSamplingConfig{Threshold: 10 * time.Millisecond})
+
+			for _, d := range tt.durations {
+				inst.recordRender("comp-1", "Comp", d)
+			}
+
+			assert.Equal(t, tt.recorded, inst.GetComponentTracker().TotalRenderCount())
+			assert.Equal(t, tt.skipped, inst.SkippedRenders())
+		})
+	}
+}
+
+func TestInstrumentor_RenderSampling_Disabled(t *testing.T) {
+	inst := NewInstrumentor(nil)
+	assert.Nil(t, inst.GetRenderSampling())
+
+	inst.SetRenderSampling(DefaultRenderSamplingConfig())
+	require.NotNil(t, inst.GetRenderSampling())
+
+	inst.SetRenderSampling(nil)
+	assert.Nil(t, inst.GetRenderSampling())
+
+	inst.recordRender("comp-1", "Comp", time.Microsecond)
+	assert.Equal(t, int64(1), inst.GetComponentTracker().TotalRenderCount())
+	assert.Equal(t, int64(0), inst.SkippedRenders())
+}
+
+func TestInstrumentor_RenderSampling_Adaptive(t *testing.T) {
+	inst := NewInstrumentor(nil)
+	inst.SetRenderSampling(&RenderSamplingConfig{
+		Adaptive:       true,
+		AdaptiveFactor: 3,
+		WarmupRenders:  5,
+	})
+
+	// A component that typically renders in 10ms: during warmup only the
+	// (zero) fixed threshold applies, so everything is recorded
+	for n := 0; n < 5; n++ {
+		inst.recordRender("heavy", "Heavy", 10*time.Millisecond)
+	}
+	assert.Equal(t, int64(5), inst.GetComponentTracker().TotalRenderCount())
+	assert.Equal(t, 10*time.Millisecond, inst.RenderBaseline("heavy"))
+
+	// After warmup, typical renders are skipped...
+	inst.recordRender("heavy", "Heavy", 12*time.Millisecond)
+	assert.Equal(t, int64(5), inst.GetComponentTracker().TotalRenderCount())
+	assert.Equal(t, int64(1), inst.SkippedRenders())
+
+	// ...and renders well above the baseline are recorded
+	inst.recordRender("heavy", "Heavy", 50*time.Millisecond)
+	assert.Equal(t, int64(6), inst.GetComponentTracker().TotalRenderCount())
+
+	// Baselines are learned per component
+	for n := 0; n < 5; n++ {
+		inst.recordRender("light", "Light", time.Millisecond)
+	}
+	inst.recordRender("light", "Light", 5*time.Millisecond)
+	metrics := inst.GetComponentTracker().GetMetricsSnapshot("light")
+	require.NotNil(t, metrics)
+	assert.Equal(t, int64(6), metrics.RenderCount)
+	assert.Equal(t, time.Duration(0), inst.RenderBaseline("unknown"))
+}
+
+func TestInstrumentor_RenderSampling_AdaptiveRespectsThreshold(t *testing.T) {
+	inst := NewInstrumentor(nil)
+	inst.SetRenderSampling(&RenderSamplingConfig{
+		Threshold:     16 * time.Millisecond,
+		Adaptive:      true,
+		WarmupRenders: 0,
+	})
+
+	// 3x the baseline, but still within the fixed frame budget
+	inst.recordRender("comp-1", "Comp", time.Millisecond)
+	inst.recordRender("comp-1", "Comp", 3*time.Millisecond)
+
+	assert.Equal(t, int64(0), inst.GetComponentTracker().TotalRenderCount())
+	assert.Equal(t, int64(2), inst.SkippedRenders())
+	assert.Equal(t, 2.0, inst.GetRenderSampling().AdaptiveFactor)
+}
+
+func TestInstrumentor_RenderSampling_InstrumentComponent(t *testing.T) {
+	inst := NewInstrumentor(nil)
+	inst.Enable()
+	inst.SetRenderSampling(&RenderSamplingConfig{Threshold: time.Hour})
+
+	wrapped := inst.InstrumentComponent(newMockComponent("comp-1", "Comp"))
+	wrapped.View()
+
+	stop := inst.InstrumentRender(wrapped)
+	stop()
+
+	assert.Equal(t, int64(0), inst.GetComponentTracker().TotalRenderCount())
+	assert.Equal(t, int64(2), inst.SkippedRenders())
+}
+
+func TestInstrumentor_RenderSampling_Reset(t *testing.T) {
+	inst := NewInstrumentor(nil)
+	inst.SetRenderSampling(&RenderSamplingConfig{Threshold: time.Hour, Adaptive: true})
+
+	inst.recordRender("comp-1", "Comp", time.Millisecond)
+	require.Equal(t, int64(1), inst.SkippedRenders())
+	require.NotZero(t, inst.RenderBaseline("comp-1"))
+
+	inst.Reset()
+
+	assert.Equal(t, int64(0), inst.SkippedRenders())
+	assert.Zero(t, inst.RenderBaseline("comp-1"))
+	assert.NotNil(t, inst.GetRenderSampling())
+}
@@ -31,6 +31,9 @@ const (
 
 	// minEventWidth is the minimum width for an event bar.
 	minEventWidth = 2
+
+	// timelineIndentWidth is the label indentation per nesting level.
+	timelineIndentWidth = 12
 )
 
 // EventType categorizes timeline events.
@@ -59,6 +62,9 @@ const (
 // TimedEvent represents an event with timing information for timeline visualization.
 //
 // Each event has a start time, duration, and metadata for display.
+// Events can be nested (e.g., a parent render containing child renders
+// and watcher fires) with AddChild; the timeline then shows children
+// indented beneath their parent.
 //
 // Example:
 //
@@ -86,15 +92,31 @@ type TimedEvent struct {
 
 	// Metadata contains additional event data
 	Metadata map[string]string
+
+	// Parent is the enclosing event, or nil for a top-level event.
+	// It is excluded from JSON to keep the encoding acyclic.
+	Parent *TimedEvent `json:"-"`
+
+	// Children are the events nested inside this one
+	Children []*TimedEvent
 }
 
 // TimelineData contains processed timeline data for visualization.
 //
 // It includes sorted events, time range, and computed layout information.
 type TimelineData struct {
-	// Events is the sorted list of events
+	// Events is the list of all events, including nested ones, in display
+	// order: top-level events sorted by start time, each followed by its
+	// descendants (depth-first, siblings sorted by start time)
 	Events []*TimedEvent
 
+	// Depths holds the nesting depth of each entry in Events
+	// (0 for top-level events)
+	Depths []int
+
+	// MaxDepth is the deepest nesting level in the timeline
+	MaxDepth int
+
 	// StartTime is the earliest event start time
 	StartTime time.Time
 
@@ -177,7 +199,9 @@ func NewTimelineGeneratorWithDimensions(width, height int) *TimelineGenerator {
 // Generate processes events and returns structured timeline data.
 //
 // Events are sorted by start time, and time range is calculated.
-// Returns nil if events slice is nil or empty.
+// Nested events are included after their parent; an event reachable as a
+// descendant of another event in the slice is only listed once, under its
+// parent. Returns nil if events slice is nil or empty.
 //
 // Thread Safety:
 //
@@ -206,12 +230,15 @@ func (tg *TimelineGenerator) Generate(events []*TimedEvent) *TimelineData {
 		return nil
 	}
 
-	// Sort events by start time
-	sortedEvents := make([]*TimedEvent, len(validEvents))
-	copy(sortedEvents, validEvents)
-	sort.Slice(sortedEvents, func(i, j int) bool {
-		return sortedEvents[i].StartTime.Before(sortedEvents[j].StartTime)
-	})
+	// Sort events by start time, nesting descendants under their parents
+	sortedEvents, depths := flattenTimelineEvents(validEvents)
+	if len(sortedEvents) == 0 {
+		return nil // Only possible with cyclic nesting
+	}
+	maxDepth := 0
+	for _, d := range depths {
+		maxDepth = max(maxDepth, d)
+	}
 
 	// Calculate time range
 	startTime := sortedEvents[0].StartTime
@@ -232,6 +259,8 @@ func (tg *TimelineGenerator) Generate(events []*TimedEvent) *TimelineData {
 
 	return &TimelineData{
 		Events:        sortedEvents,
+		Depths:        depths,
+		MaxDepth:      maxDepth,
 		StartTime:     startTime,
 		EndTime:       endTime,
 		TotalDuration: endTime.Sub(startTime),
@@ -240,11 +269,65 @@ func (tg *TimelineGenerator) Generate(events []*TimedEvent) *TimelineData {
 	}
 }
 
+// flattenTimelineEvents returns events and their descendants in display
+// order, with the nesting depth of each. Events that are descendants of
+// other events in the list are only visited from their ancestors.
+func flattenTimelineEvents(events []*TimedEvent) ([]*TimedEvent, []int) {
+	nested := make(map[*TimedEvent]bool)
+	var markNested func(e *TimedEvent)
+	markNested = func(e *TimedEvent) {
+		for _, child := range e.Children {
+			if child != nil && !nested[child] {
+				nested[child] = true
+				markNested(child)
+			}
+		}
+	}
+	for _, e := range events {
+		markNested(e)
+	}
+
+	roots := make([]*TimedEvent, 0, len(events))
+	for _, e := range events {
+		if !nested[e] {
+			roots = append(roots, e)
+		}
+	}
+
+	flat := make([]*TimedEvent, 0, len(events)+len(nested))
+	depths := make([]int, 0, cap(flat))
+	visited := make(map[*TimedEvent]bool)
+
+	var visit func(siblings []*TimedEvent, depth int)
+	visit = func(siblings []*TimedEvent, depth int) {
+		sorted := make([]*TimedEvent, 0, len(siblings))
+		for _, e := range siblings {
+			if e != nil && !visited[e] {
+				visited[e] = true
+				sorted = append(sorted, e)
+			}
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return sorted[i].StartTime.Before(sorted[j].StartTime)
+		})
+		for _, e := range sorted {
+			flat = append(flat, e)
+			depths = append(depths, depth)
+			visit(e.Children, depth+1)
+		}
+	}
+	visit(roots, 0)
+
+	return flat, depths
+}
+
 // GenerateHTML generates an HTML timeline visualization.
 //
 // Returns an HTML string containing an SVG timeline that can be embedded
-// in a web page or saved to a file. If events is nil or empty, returns
-// an HTML page with a "No events" message.
+// in a web page or saved to a file. Nested events are drawn as a
+// hierarchical swimlane: each child gets its own row, indented beneath its
+// parent, and tooltips show self time (duration not spent in children).
+// If events is nil or empty, returns an HTML page with a "No events" message.
 //
 // Thread Safety:
 //
@@ -380,6 +463,13 @@ func (tg *TimelineGenerator) GenerateHTML(events []*TimedEvent) string {
                     <div class="stat-value">%s</div>
                 </div>
 `, formatTimelineDuration(data.TotalDuration)))
+	if data.MaxDepth > 0 {
+		html.WriteString(fmt.Sprintf(`                <div class="stat">
+                    <div class="stat-label">Max Depth</div>
+                    <div class="stat-value">%d</div>
+                </div>
+`, data.MaxDepth))
+	}
 
 	// Type counts
 	for eventType, count := range data.TypeCounts {
@@ -432,8 +522,8 @@ func (tg *TimelineGenerator) GenerateHTML(events []*TimedEvent) string {
 
 	// Events
 	y := timelineHeaderHeight
-	for _, event := range data.Events {
-		tg.renderEvent(&html, event, timelineStart, y, timelineWidth, data)
+	for i, event := range data.Events {
+		tg.renderEvent(&html, event, data.Depths[i], timelineStart, y, timelineWidth, data)
 		y += timelineRowHeight
 	}
 
@@ -467,12 +557,13 @@ func (tg *TimelineGenerator) renderTimeAxis(html *strings.Builder, x, width, y i
 	}
 }
 
-// renderEvent renders a single event bar.
-func (tg *TimelineGenerator) renderEvent(html *strings.Builder, event *TimedEvent, timelineX, y, timelineWidth int, data *TimelineData) {
-	// Event label
-	labelX := timelineMargin
+// renderEvent renders a single event bar, indented by its nesting depth.
+func (tg *TimelineGenerator) renderEvent(html *strings.Builder, event *TimedEvent, depth, timelineX, y, timelineWidth int, data *TimelineData) {
+	// Event label, indented under its parent
+	indent := min(depth*timelineIndentWidth, timelineLabelWidth/2)
+	labelX := timelineMargin + indent
 	labelY := y + timelineRowHeight/2 + 4
-	label := truncateTimelineLabel(event.Name, timelineLabelWidth)
+	label := truncateTimelineLabel(event.Name, timelineLabelWidth-indent)
 	html.WriteString(fmt.Sprintf(`            <text x="%d" y="%d" class="event-label">%s</text>
 `, labelX, labelY, escapeHTML(label)))
 
@@ -501,8 +592,13 @@ func (tg *TimelineGenerator) renderEvent(html *strings.Builder, event *TimedEven
 	html.WriteString(fmt.Sprintf(`                <title>%s
 Type: %s
 Duration: %s
-Start: %s</title>
-`, escapeHTML(event.Name), escapeHTML(string(event.Type)), formatTimelineDuration(event.Duration), event.StartTime.Format("15:04:05.000")))
+`, escapeHTML(event.Name), escapeHTML(string(event.Type)), formatTimelineDuration(event.Duration)))
+	if len(event.Children) > 0 {
+		html.WriteString(fmt.Sprintf(`Self: %s
+`, formatTimelineDuration(event.SelfDuration())))
+	}
+	html.WriteString(fmt.Sprintf(`Start: %s</title>
+`, event.StartTime.Format("15:04:05.000")))
 	html.WriteString(`            </rect>
 `)
 }
@@ -659,6 +755,42 @@ func AddEventWithComponent(name string, eventType EventType, startTime time.Time
 	}
 }
 
+// AddChild nests child inside the event and returns child.
+//
+// Example:
+//
+//	frame := AddEvent("App.Render", EventTypeRender, start, 12*time.Millisecond)
+//	frame.AddChild(AddEvent("List.Render", EventTypeRender, start.Add(time.Millisecond), 8*time.Millisecond))
+func (e *TimedEvent) AddChild(child *TimedEvent) *TimedEvent {
+	if child == nil {
+		return nil
+	}
+	child.Parent = e
+	e.Children = append(e.Children, child)
+	return child
+}
+
+// Depth returns how many ancestors the event has (0 for a top-level event).
+func (e *TimedEvent) Depth() int {
+	depth := 0
+	for p := e.Parent; p != nil; p = p.Parent {
+		depth++
+	}
+	return depth
+}
+
+// SelfDuration returns the part of the event's duration not spent in its
+// direct children. It is never negative.
+func (e *TimedEvent) SelfDuration() time.Duration {
+	self := e.Duration
+	for _, child := range e.Children {
+		if child != nil {
+			self -= child.Duration
+		}
+	}
+	return max(self, 0)
+}
+
 // GetEndTime returns the end time of the event.
 func (e *TimedEvent) GetEndTime() time.Time {
 	return e.StartTime.Add(e.Duration)
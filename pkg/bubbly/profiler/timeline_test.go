@@ -2,6 +2,7 @@
 package profiler
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -731,6 +732,105 @@ func TestTimelineGenerator_TooltipContent(t *testing.T) {
 	assert.Contains(t, html, "Start:")
 }
 
+func TestTimedEvent_Nesting(t *testing.T) {
+	baseTime := time.Date(2024, 11, 29, 10, 0, 0, 0, time.UTC)
+	parent := AddEvent("App.Render", EventTypeRender, baseTime, 20*time.Millisecond)
+	child := parent.AddChild(AddEvent("List.Render", EventTypeRender, baseTime.Add(2*time.Millisecond), 8*time.Millisecond))
+	grandchild := child.AddChild(AddEvent("watch:items", EventTypeEvent, baseTime.Add(3*time.Millisecond), 2*time.Millisecond))
+	parent.AddChild(AddEvent("Footer.Render", EventTypeRender, baseTime.Add(12*time.Millisecond), 3*time.Millisecond))
+
+	assert.Nil(t, parent.AddChild(nil))
+	assert.Same(t, parent, child.Parent)
+	assert.Same(t, child, grandchild.Parent)
+	assert.Len(t, parent.Children, 2)
+
+	assert.Equal(t, 0, parent.Depth())
+	assert.Equal(t, 1, child.Depth())
+	assert.Equal(t, 2, grandchild.Depth())
+
+	assert.Equal(t, 9*time.Millisecond, parent.SelfDuration())
+	assert.Equal(t, 6*time.Millisecond, child.SelfDuration())
+	assert.Equal(t, 2*time.Millisecond, grandchild.SelfDuration())
+
+	// Children reported longer than the parent never yield negative self time
+	short := AddEvent("Short", EventTypeRender, baseTime, time.Millisecond)
+	short.AddChild(AddEvent("Long", EventTypeRender, baseTime, 5*time.Millisecond))
+	assert.Equal(t, time.Duration(0), short.SelfDuration())
+}
+
+func TestTimelineGenerator_Generate_Nested(t *testing.T) {
+	baseTime := time.Date(2024, 11, 29, 10, 0, 0, 0, time.UTC)
+
+	frame := AddEvent("App.Render", EventTypeRender, baseTime, 20*time.Millisecond)
+	// Children added out of order are displayed by start time
+	footer := frame.AddChild(AddEvent("Footer.Render", EventTypeRender, baseTime.Add(12*time.Millisecond), 3*time.Millisecond))
+	list := frame.AddChild(AddEvent("List.Render", EventTypeRender, baseTime.Add(2*time.Millisecond), 8*time.Millisecond))
+	watcher := list.AddChild(AddEvent("watch:items", EventTypeEvent, baseTime.Add(3*time.Millisecond), 2*time.Millisecond))
+	later := AddEvent("Cmd", EventTypeCommand, baseTime.Add(30*time.Millisecond), 5*time.Millisecond)
+
+	tests := []struct {
+		name   string
+		events []*TimedEvent
+	}{
+		{
+			name:   "roots only",
+			events: []*TimedEvent{later, frame},
+		},
+		{
+			name:   "descendants also listed are not duplicated",
+			events: []*TimedEvent{watcher, later, list, frame},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := NewTimelineGenerator().Generate(tt.events)
+			require.NotNil(t, data)
+
+			assert.Equal(t, []*TimedEvent{frame, list, watcher, footer, later}, data.Events)
+			assert.Equal(t, []int{0, 1, 2, 1, 0}, data.Depths)
+			assert.Equal(t, 2, data.MaxDepth)
+			assert.Equal(t, 5, data.EventCount)
+			assert.Equal(t, map[EventType]int{EventTypeRender: 3, EventTypeEvent: 1, EventTypeCommand: 1}, data.TypeCounts)
+			assert.Equal(t, 35*time.Millisecond, data.TotalDuration)
+		})
+	}
+}
+
+func TestTimelineGenerator_Generate_CyclicNesting(t *testing.T) {
+	baseTime := time.Now()
+	a := AddEvent("A", EventTypeRender, baseTime, time.Millisecond)
+	b := AddEvent("B", EventTypeRender, baseTime, time.Millisecond)
+	a.Children = []*TimedEvent{b}
+	b.Children = []*TimedEvent{a}
+
+	assert.Nil(t, NewTimelineGenerator().Generate([]*TimedEvent{a, b}))
+}
+
+func TestTimelineGenerator_GenerateHTML_Nested(t *testing.T) {
+	baseTime := time.Date(2024, 11, 29, 10, 0, 0, 0, time.UTC)
+	frame := AddEvent("App.Render", EventTypeRender, baseTime, 20*time.Millisecond)
+	list := frame.AddChild(AddEvent("List.Render", EventTypeRender, baseTime.Add(2*time.Millisecond), 8*time.Millisecond))
+	list.AddChild(AddEvent("watch:items", EventTypeEvent, baseTime.Add(3*time.Millisecond), 2*time.Millisecond))
+
+	html := NewTimelineGenerator().GenerateHTML([]*TimedEvent{frame})
+
+	// One row per event, labels indented by depth
+	assert.Contains(t, html, fmt.Sprintf(`<text x="%d" y="%d" class="event-label">App.Render</text>`,
+		timelineMargin, timelineHeaderHeight+timelineRowHeight/2+4))
+	assert.Contains(t, html, fmt.Sprintf(`<text x="%d" y="%d" class="event-label">List.Render</text>`,
+		timelineMargin+timelineIndentWidth, timelineHeaderHeight+timelineRowHeight*3/2+4))
+	assert.Contains(t, html, fmt.Sprintf(`<text x="%d" y="%d" class="event-label">watch:items</text>`,
+		timelineMargin+2*timelineIndentWidth, timelineHeaderHeight+timelineRowHeight*5/2+4))
+
+	// Self time is shown only for events with children
+	assert.Contains(t, html, "Self: 12.0ms")
+	assert.Contains(t, html, "Self: 6.0ms")
+	assert.Equal(t, 2, strings.Count(html, "Self:"))
+
+	assert.Contains(t, html, "Max Depth")
+}
+
 func BenchmarkTimelineGenerator_Generate(b *testing.B) {
 	baseTime := time.Now()
 	events := make([]*TimedEvent, 1000)
@@ -21,6 +21,13 @@ type watcher[T any] struct {
 // It performs deep comparison if enabled and handles flush modes.
 // This is a shared helper to avoid code duplication between Ref and Computed.
 func notifyWatcher[T any](w *watcher[T], newVal, oldVal T) {
+	// Inside Batch, notify once when the batch ends
+	if inBatch() && deferInBatch(w, newVal, oldVal, func(newVal, oldVal T) {
+		notifyWatcher(w, newVal, oldVal)
+	}) {
+		return
+	}
+
 	// Task 8.8: Notify framework hook BEFORE callback execution
 	// This allows dev tools to track the reactive cascade
 	watcherID := fmt.Sprintf("watch-%p", w)
@@ -202,9 +209,15 @@ func (r *Ref[T]) Set(value T) {
 		r.notifyWatchers(value, oldValue, watchersCopy)
 	}
 
-	// Call setHook if registered (for automatic command generation)
+	// Call setHook if registered (for automatic command generation),
+	// once per Ref inside Batch
 	if hook != nil {
-		hook(oldValue, value)
+		deferred := inBatch() && deferInBatch(r, value, oldValue, func(newVal, oldVal T) {
+			hook(oldVal, newVal)
+		})
+		if !deferred {
+			hook(oldValue, value)
+		}
 	}
 }
 
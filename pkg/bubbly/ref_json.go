@@ -0,0 +1,54 @@
+package bubbly
+
+import "encoding/json"
+
+// MarshalJSON implements json.Marshaler by encoding the Ref's current value.
+//
+// A Ref serializes transparently as its underlying value, so structs holding
+// Refs (including Refs nested inside other Refs) produce the same JSON as
+// their plain counterparts. A nil *Ref encodes as null.
+//
+// Marshaling does not register the Ref as a dependency of a computed value.
+//
+// Example:
+//
+//	type Settings struct {
+//	    Theme    *bubbly.Ref[string] `json:"theme"`
+//	    FontSize *bubbly.Ref[int]    `json:"fontSize"`
+//	}
+//
+//	s := Settings{Theme: bubbly.NewRef("dark"), FontSize: bubbly.NewRef(14)}
+//	data, _ := json.Marshal(s) // {"theme":"dark","fontSize":14}
+func (r *Ref[T]) MarshalJSON() ([]byte, error) {
+	r.mu.RLock()
+	value := r.value
+	r.mu.RUnlock()
+
+	return json.Marshal(value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler by decoding data and calling Set
+// with the result, so watchers fire and dependent computed values update.
+//
+// The value is decoded into a fresh T, replacing the current value rather
+// than merging into it. If decoding fails, the Ref is left unchanged.
+//
+// When unmarshaling into a struct whose Ref fields are nil, encoding/json
+// allocates new Refs; existing Refs are updated in place, which makes
+// loading saved state reactively update any UI bound to them.
+//
+// Example:
+//
+//	var s Settings // Refs allocated by encoding/json
+//	_ = json.Unmarshal(data, &s)
+//
+//	// Or reload into live state, notifying watchers
+//	_ = json.Unmarshal(saved, &liveSettings)
+func (r *Ref[T]) UnmarshalJSON(data []byte) error {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	r.Set(value)
+	return nil
+}
@@ -0,0 +1,93 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type refJSONSettings struct {
+	Theme    *Ref[string]            `json:"theme"`
+	FontSize *Ref[int]               `json:"fontSize"`
+	Tags     *Ref[[]string]          `json:"tags"`
+	Nested   *Ref[*Ref[bool]]        `json:"nested"`
+	Extra    *Ref[map[string]string] `json:"extra,omitempty"`
+}
+
+// TestRef_MarshalJSON tests that Refs encode as their underlying values
+func TestRef_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "int", value: NewRef(42), expected: `42`},
+		{name: "string", value: NewRef("hi"), expected: `"hi"`},
+		{name: "slice", value: NewRef([]int{1, 2}), expected: `[1,2]`},
+		{name: "struct", value: NewRef(struct {
+			A int `json:"a"`
+		}{A: 1}), expected: `{"a":1}`},
+		{name: "nested ref", value: NewRef(NewRef("inner")), expected: `"inner"`},
+		{name: "nil ref", value: (*Ref[int])(nil), expected: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.expected, string(data))
+		})
+	}
+}
+
+// TestRef_JSONRoundTrip tests that a struct of Refs round-trips through JSON
+func TestRef_JSONRoundTrip(t *testing.T) {
+	original := refJSONSettings{
+		Theme:    NewRef("dark"),
+		FontSize: NewRef(14),
+		Tags:     NewRef([]string{"a", "b"}),
+		Nested:   NewRef(NewRef(true)),
+	}
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"theme":"dark","fontSize":14,"tags":["a","b"],"nested":true}`, string(data))
+
+	var decoded refJSONSettings
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "dark", decoded.Theme.GetTyped())
+	assert.Equal(t, 14, decoded.FontSize.GetTyped())
+	assert.Equal(t, []string{"a", "b"}, decoded.Tags.GetTyped())
+	assert.True(t, decoded.Nested.GetTyped().GetTyped())
+	assert.Nil(t, decoded.Extra)
+}
+
+// TestRef_UnmarshalJSON_TriggersWatchers tests that loading state notifies watchers
+func TestRef_UnmarshalJSON_TriggersWatchers(t *testing.T) {
+	theme := NewRef("light")
+	upper := NewComputed(func() int { return len(theme.GetTyped()) })
+	assert.Equal(t, 5, upper.GetTyped())
+
+	var got []string
+	cleanup := Watch(theme, func(newVal, oldVal string) {
+		got = append(got, oldVal+"->"+newVal)
+	})
+	defer cleanup()
+
+	settings := refJSONSettings{Theme: theme, FontSize: NewRef(12)}
+	require.NoError(t, json.Unmarshal([]byte(`{"theme":"dark"}`), &settings))
+
+	assert.Equal(t, []string{"light->dark"}, got)
+	assert.Equal(t, 4, upper.GetTyped(), "computed values update")
+	assert.Equal(t, 12, settings.FontSize.GetTyped(), "absent fields are untouched")
+}
+
+// TestRef_UnmarshalJSON_Error tests that invalid input leaves the Ref unchanged
+func TestRef_UnmarshalJSON_Error(t *testing.T) {
+	count := NewRef(7)
+	err := json.Unmarshal([]byte(`"not a number"`), count)
+	assert.Error(t, err)
+	assert.Equal(t, 7, count.GetTyped())
+}
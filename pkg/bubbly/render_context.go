@@ -51,12 +51,16 @@ type RenderContext struct {
 }
 
 // Get retrieves a value from the component's state map.
-// Returns nil if the key doesn't exist.
 //
 // This provides read-only access to values that were exposed during setup
 // using ctx.Expose(). The returned value should be type-asserted to the
 // expected type.
 //
+// Get never panics: if nothing was exposed under key, it returns nil, which
+// is indistinguishable from a value exposed as nil. The type assertion that
+// usually follows is what panics on a missing key, so use GetOr (or
+// GetRefOr) for values that may not be exposed.
+//
 // Example:
 //
 //	count := ctx.Get("count").(*Ref[int])
@@ -71,6 +75,24 @@ func (ctx RenderContext) Get(key string) interface{} {
 	return ctx.component.state[key]
 }
 
+// GetOr retrieves a value from the component's state map like Get, but
+// returns fallback if nothing was exposed under key. A value exposed as nil
+// is returned as nil, not replaced by fallback.
+//
+// Use it in shared templates whose optional values may or may not have been
+// exposed by Setup.
+//
+// Example:
+//
+//	title := ctx.GetOr("title", "Untitled").(string)
+func (ctx RenderContext) GetOr(key string, fallback interface{}) interface{} {
+	value, ok := ctx.component.state[key]
+	if !ok {
+		return fallback
+	}
+	return value
+}
+
 // Props returns the component's props (configuration data).
 // Props are immutable from the component's perspective and are
 // passed down from parent components.
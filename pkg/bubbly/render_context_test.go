@@ -80,6 +80,50 @@ func TestRenderContext_Get(t *testing.T) {
 	}
 }
 
+// TestRenderContext_GetOr tests that RenderContext.GetOr falls back only for
+// keys that were not exposed
+func TestRenderContext_GetOr(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    map[string]interface{}
+		key      string
+		expected interface{}
+	}{
+		{
+			name:     "exposed value",
+			state:    map[string]interface{}{"title": "Inbox"},
+			key:      "title",
+			expected: "Inbox",
+		},
+		{
+			name:     "missing key",
+			state:    map[string]interface{}{"title": "Inbox"},
+			key:      "subtitle",
+			expected: "fallback",
+		},
+		{
+			name:     "value exposed as nil",
+			state:    map[string]interface{}{"subtitle": nil},
+			key:      "subtitle",
+			expected: nil,
+		},
+		{
+			name:     "nil state",
+			state:    nil,
+			key:      "title",
+			expected: "fallback",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := RenderContext{component: &componentImpl{name: "TestComponent", state: tt.state}}
+
+			assert.Equal(t, tt.expected, ctx.GetOr(tt.key, "fallback"))
+		})
+	}
+}
+
 // TestRenderContext_Props tests that RenderContext.Props returns component props
 func TestRenderContext_Props(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,155 @@
+package bubbly
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoRequestHandler is returned by Request when the component has no
+// handler registered for the request name.
+var ErrNoRequestHandler = errors.New("no request handler registered")
+
+// ErrRequestTimeout is returned by RequestWithTimeout when the handler does
+// not respond in time.
+var ErrRequestTimeout = errors.New("request timed out")
+
+// RequestHandler answers a request sent to a component with Request.
+// It receives the request data and returns a result or an error.
+type RequestHandler func(data interface{}) (interface{}, error)
+
+// requestTarget is implemented by components that can answer requests.
+type requestTarget interface {
+	requestHandler(name string) (RequestHandler, bool)
+}
+
+// OnRequest registers the handler that answers requests named name sent to
+// this component with Request. A component has at most one handler per
+// request name; registering again replaces the previous handler.
+//
+// Unlike events, requests do not bubble: they are answered by the component
+// they are sent to. Handlers are removed when the component unmounts.
+//
+// Example:
+//
+//	ctx.OnRequest("canSubmit", func(_ interface{}) (interface{}, error) {
+//	    return form.IsValid.GetTyped(), nil
+//	})
+func (ctx *Context) OnRequest(name string, handler RequestHandler) {
+	c := ctx.component
+	c.requestHandlersMu.Lock()
+	defer c.requestHandlersMu.Unlock()
+
+	if c.requestHandlers == nil {
+		c.requestHandlers = make(map[string]RequestHandler)
+	}
+	c.requestHandlers[name] = handler
+}
+
+// requestHandler implements requestTarget.
+func (c *componentImpl) requestHandler(name string) (RequestHandler, bool) {
+	c.requestHandlersMu.RLock()
+	defer c.requestHandlersMu.RUnlock()
+
+	handler, ok := c.requestHandlers[name]
+	return handler, ok
+}
+
+// requestHandler implements requestTarget by delegating to the wrapped
+// component. Requests fail with ErrNoRequestHandler until it loads.
+func (l *Lazy) requestHandler(name string) (RequestHandler, bool) {
+	if target, ok := l.current().(requestTarget); ok {
+		return target.requestHandler(name)
+	}
+	return nil, false
+}
+
+// Request sends a synchronous query to c and returns the answer of the
+// handler c registered with Context.OnRequest.
+//
+// It replaces the pattern of passing a callback in an event's data:
+//
+//	// Instead of: form.Emit("getSubmitStatus", func(ok bool) { ... })
+//	ok, err := bubbly.Request(form, "canSubmit", nil)
+//
+// Request returns ErrNoRequestHandler if c has no handler for name. If the
+// handler panics, the panic is recovered and returned as a *HandlerPanicError.
+// Use RequestWithTimeout when the handler may block.
+func Request(c Component, name string, data interface{}) (interface{}, error) {
+	handler, err := lookupRequestHandler(c, name)
+	if err != nil {
+		return nil, err
+	}
+	return callRequestHandler(c, name, handler, data)
+}
+
+// RequestWithTimeout is like Request, but gives up and returns
+// ErrRequestTimeout if the handler does not respond within timeout.
+// The handler runs on its own goroutine and is not interrupted on timeout;
+// its late result is discarded. A timeout <= 0 means no timeout.
+//
+// Example:
+//
+//	status, err := bubbly.RequestWithTimeout(worker, "status", nil, 100*time.Millisecond)
+//	if errors.Is(err, bubbly.ErrRequestTimeout) {
+//	    status = "busy"
+//	}
+func RequestWithTimeout(c Component, name string, data interface{}, timeout time.Duration) (interface{}, error) {
+	if timeout <= 0 {
+		return Request(c, name, data)
+	}
+
+	handler, err := lookupRequestHandler(c, name)
+	if err != nil {
+		return nil, err
+	}
+
+	type response struct {
+		result interface{}
+		err    error
+	}
+	done := make(chan response, 1) // Buffered so a late handler never blocks
+	go func() {
+		result, err := callRequestHandler(c, name, handler, data)
+		done <- response{result: result, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case resp := <-done:
+		return resp.result, resp.err
+	case <-timer.C:
+		return nil, fmt.Errorf("%w: %q on component %s after %v", ErrRequestTimeout, name, c.Name(), timeout)
+	}
+}
+
+// lookupRequestHandler finds c's handler for name.
+func lookupRequestHandler(c Component, name string) (RequestHandler, error) {
+	if target, ok := c.(requestTarget); ok {
+		if handler, ok := target.requestHandler(name); ok && handler != nil {
+			return handler, nil
+		}
+	}
+	componentName := "<nil>"
+	if c != nil {
+		componentName = c.Name()
+	}
+	return nil, fmt.Errorf("%w: %q on component %s", ErrNoRequestHandler, name, componentName)
+}
+
+// callRequestHandler invokes handler, converting a panic into an error.
+func callRequestHandler(c Component, name string, handler RequestHandler, data interface{}) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = &HandlerPanicError{
+				ComponentName: c.Name(),
+				EventName:     name,
+				PanicValue:    r,
+			}
+		}
+	}()
+	return handler(data)
+}
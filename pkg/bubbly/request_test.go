@@ -0,0 +1,117 @@
+package bubbly
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRequestTestComponent builds an initialized component answering a few requests.
+func newRequestTestComponent(t *testing.T) Component {
+	t.Helper()
+	comp, err := NewComponent("Form").
+		Setup(func(ctx *Context) {
+			valid := ctx.Ref(true)
+			ctx.OnRequest("canSubmit", func(_ interface{}) (interface{}, error) {
+				return valid.Get(), nil
+			})
+			ctx.OnRequest("double", func(data interface{}) (interface{}, error) {
+				n, ok := data.(int)
+				if !ok {
+					return nil, errors.New("expected int")
+				}
+				return n * 2, nil
+			})
+			ctx.OnRequest("slow", func(_ interface{}) (interface{}, error) {
+				time.Sleep(50 * time.Millisecond)
+				return "late", nil
+			})
+			ctx.OnRequest("broken", func(_ interface{}) (interface{}, error) {
+				panic("boom")
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "form" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	return comp
+}
+
+// TestRequest tests synchronous request/response dispatch
+func TestRequest(t *testing.T) {
+	comp := newRequestTestComponent(t)
+
+	tests := []struct {
+		name       string
+		request    string
+		data       interface{}
+		want       interface{}
+		wantErr    error
+		wantErrMsg string
+	}{
+		{name: "value", request: "canSubmit", want: true},
+		{name: "uses data", request: "double", data: 21, want: 42},
+		{name: "handler error", request: "double", data: "x", wantErrMsg: "expected int"},
+		{name: "no handler", request: "missing", wantErr: ErrNoRequestHandler},
+		{name: "panic recovered", request: "broken", wantErrMsg: "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Request(comp, tt.request, tt.data)
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErrMsg != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrMsg)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+
+	var panicErr *HandlerPanicError
+	_, err := Request(comp, "broken", nil)
+	assert.True(t, errors.As(err, &panicErr))
+}
+
+// TestRequestWithTimeout tests timeouts on slow handlers
+func TestRequestWithTimeout(t *testing.T) {
+	comp := newRequestTestComponent(t)
+
+	_, err := RequestWithTimeout(comp, "slow", nil, 5*time.Millisecond)
+	assert.ErrorIs(t, err, ErrRequestTimeout)
+
+	got, err := RequestWithTimeout(comp, "slow", nil, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "late", got)
+
+	got, err = RequestWithTimeout(comp, "double", 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 4, got)
+
+	_, err = RequestWithTimeout(comp, "missing", nil, time.Second)
+	assert.ErrorIs(t, err, ErrNoRequestHandler)
+}
+
+// TestRequest_LifecycleAndLazy tests unmount cleanup and Lazy delegation
+func TestRequest_LifecycleAndLazy(t *testing.T) {
+	lazy := LazyComponent(func() Component { return newRequestTestComponent(t) })
+	_, err := Request(lazy, "canSubmit", nil)
+	assert.ErrorIs(t, err, ErrNoRequestHandler, "not loaded yet")
+
+	lazy.View()
+	got, err := Request(lazy, "canSubmit", nil)
+	require.NoError(t, err)
+	assert.Equal(t, true, got)
+
+	comp := newRequestTestComponent(t)
+	comp.(interface{ Unmount() }).Unmount()
+	_, err = Request(comp, "canSubmit", nil)
+	assert.ErrorIs(t, err, ErrNoRequestHandler, "handlers removed on unmount")
+}
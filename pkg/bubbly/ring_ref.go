@@ -0,0 +1,154 @@
+package bubbly
+
+import "sync"
+
+// RingRef is a reactive, fixed-capacity circular buffer for rolling history
+// such as log lines or metric samples.
+//
+// Push appends values and, once the buffer is full, drops the oldest ones,
+// so memory stays bounded no matter how long the stream runs. Every Push
+// publishes the new contents, oldest first, invalidating dependent
+// computed values and notifying watchers with the old and new slices.
+//
+// RingRef can be used anywhere a Watchable[[]T] or Dependency is accepted:
+//
+//	logs := bubbly.NewRingRef[string](100)
+//	bubbly.Watch(logs, func(newLines, oldLines []string) { ... })
+//	lastLine := bubbly.NewComputed(func() string {
+//	    lines := logs.Items()
+//	    if len(lines) == 0 {
+//	        return ""
+//	    }
+//	    return lines[len(lines)-1]
+//	})
+//
+// Thread Safety:
+// RingRef is safe for concurrent use. Pushes are serialized, including the
+// notification of synchronous watchers, so such watchers must not push to
+// the same RingRef.
+type RingRef[T any] struct {
+	mu    sync.Mutex // Serializes writes and their notifications
+	buf   []T        // Backing storage, len == capacity
+	start int        // Index of the oldest value
+	size  int        // Number of values stored
+	ref   *Ref[[]T]  // Published contents, oldest first
+}
+
+// NewRingRef creates an empty RingRef holding at most capacity values.
+//
+// Panics if capacity is zero or negative.
+//
+// Example:
+//
+//	samples := bubbly.NewRingRef[float64](60) // Last 60 samples
+//	samples.Push(cpuUsage())
+func NewRingRef[T any](capacity int) *RingRef[T] {
+	if capacity <= 0 {
+		panic("NewRingRef: capacity must be positive")
+	}
+	return &RingRef[T]{
+		buf: make([]T, capacity),
+		ref: NewRef([]T{}),
+	}
+}
+
+// Push appends values, dropping the oldest once the buffer is full, and
+// notifies watchers once for the whole call. Pushing no values is a no-op.
+func (r *RingRef[T]) Push(values ...T) {
+	if len(values) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	capacity := len(r.buf)
+	for _, v := range values {
+		if r.size < capacity {
+			r.buf[(r.start+r.size)%capacity] = v
+			r.size++
+			continue
+		}
+		// Full: overwrite the oldest value
+		r.buf[r.start] = v
+		r.start = (r.start + 1) % capacity
+	}
+
+	r.ref.Set(r.snapshotLocked())
+}
+
+// Clear removes all values and notifies watchers.
+func (r *RingRef[T]) Clear() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var zero T
+	for i := range r.buf {
+		r.buf[i] = zero
+	}
+	r.start = 0
+	r.size = 0
+
+	r.ref.Set([]T{})
+}
+
+// Items returns the current values, oldest first. The returned slice is
+// never modified by later pushes. Reading Items inside a computed value
+// registers the RingRef as a dependency.
+func (r *RingRef[T]) Items() []T {
+	return r.ref.GetTyped()
+}
+
+// GetTyped returns the current values, oldest first. It is equivalent to
+// Items and makes RingRef a Watchable[[]T].
+func (r *RingRef[T]) GetTyped() []T {
+	return r.ref.GetTyped()
+}
+
+// Get returns the current values as any, implementing the Dependency
+// interface.
+func (r *RingRef[T]) Get() any {
+	return r.ref.Get()
+}
+
+// Len returns the number of values stored.
+func (r *RingRef[T]) Len() int {
+	return len(r.ref.GetTyped())
+}
+
+// Cap returns the maximum number of values the buffer holds.
+func (r *RingRef[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Invalidate marks all dependents as needing recomputation.
+// Implements the Dependency interface.
+func (r *RingRef[T]) Invalidate() {
+	r.ref.Invalidate()
+}
+
+// AddDependent registers a computed value that depends on this RingRef.
+// Implements the Dependency interface.
+func (r *RingRef[T]) AddDependent(dep Dependency) {
+	r.ref.AddDependent(dep)
+}
+
+// addWatcher registers a watcher on the published contents.
+func (r *RingRef[T]) addWatcher(w *watcher[[]T]) {
+	r.ref.addWatcher(w)
+}
+
+// removeWatcher unregisters a watcher.
+func (r *RingRef[T]) removeWatcher(w *watcher[[]T]) {
+	r.ref.removeWatcher(w)
+}
+
+// snapshotLocked copies the buffer contents, oldest first.
+// The caller must hold r.mu.
+func (r *RingRef[T]) snapshotLocked() []T {
+	items := make([]T, r.size)
+	for i := range items {
+		items[i] = r.buf[(r.start+i)%len(r.buf)]
+	}
+	return items
+}
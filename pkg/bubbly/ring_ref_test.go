@@ -0,0 +1,100 @@
+package bubbly
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRingRef_PanicsOnInvalidCapacity(t *testing.T) {
+	assert.PanicsWithValue(t, "NewRingRef: capacity must be positive", func() {
+		NewRingRef[int](0)
+	})
+}
+
+func TestRingRef_Push(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		pushes   [][]int
+		expected []int
+	}{
+		{name: "empty", capacity: 3, expected: []int{}},
+		{name: "below capacity", capacity: 3, pushes: [][]int{{1}, {2}}, expected: []int{1, 2}},
+		{name: "exactly full", capacity: 3, pushes: [][]int{{1, 2, 3}}, expected: []int{1, 2, 3}},
+		{name: "drops oldest", capacity: 3, pushes: [][]int{{1, 2, 3}, {4}, {5}}, expected: []int{3, 4, 5}},
+		{name: "batch larger than capacity", capacity: 2, pushes: [][]int{{1, 2, 3, 4, 5}}, expected: []int{4, 5}},
+		{name: "capacity one", capacity: 1, pushes: [][]int{{1}, {2}}, expected: []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := NewRingRef[int](tt.capacity)
+			for _, values := range tt.pushes {
+				ring.Push(values...)
+			}
+			assert.Equal(t, tt.expected, ring.Items())
+			assert.Equal(t, len(tt.expected), ring.Len())
+			assert.Equal(t, tt.capacity, ring.Cap())
+		})
+	}
+}
+
+func TestRingRef_ItemsAreSnapshots(t *testing.T) {
+	ring := NewRingRef[string](2)
+	ring.Push("a", "b")
+	items := ring.Items()
+
+	ring.Push("c")
+
+	assert.Equal(t, []string{"a", "b"}, items)
+	assert.Equal(t, []string{"b", "c"}, ring.Items())
+}
+
+func TestRingRef_WatchAndComputed(t *testing.T) {
+	ring := NewRingRef[int](2)
+	last := NewComputed(func() int {
+		items := ring.Items()
+		if len(items) == 0 {
+			return -1
+		}
+		return items[len(items)-1]
+	})
+	assert.Equal(t, -1, last.GetTyped())
+
+	var notifications [][]int
+	cleanup := Watch[[]int](ring, func(newVal, oldVal []int) {
+		notifications = append(notifications, newVal)
+	})
+	defer cleanup()
+
+	ring.Push(1, 2)
+	ring.Push(3)
+	assert.Equal(t, 3, last.GetTyped())
+
+	ring.Clear()
+	assert.Equal(t, -1, last.GetTyped())
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {}}, notifications)
+
+	ring.Push()
+	assert.Len(t, notifications, 3, "empty push does not notify")
+}
+
+func TestRingRef_ConcurrentPush(t *testing.T) {
+	ring := NewRingRef[int](50)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				ring.Push(j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, ring.Len())
+}
@@ -256,6 +256,41 @@ func (rv *View) IsInitialized() bool {
 	return true
 }
 
+// Measure returns the rendered size of the currently matched component.
+// Delegates to the component at this View's depth.
+//
+// Returns:
+//   - width, height: Size of the last render, or 0, 0 if no component matches
+func (rv *View) Measure() (width, height int) {
+	if component := rv.matchedComponent(); component != nil {
+		return bubbly.Measure(component)
+	}
+	return 0, 0
+}
+
+// Bounds returns the screen bounds of the currently matched component.
+// Delegates to the component at this View's depth.
+//
+// Returns:
+//   - x, y, w, h: Resolved bounds, or all zeros if no component matches
+func (rv *View) Bounds() (x, y, w, h int) {
+	if component := rv.matchedComponent(); component != nil {
+		return bubbly.Bounds(component)
+	}
+	return 0, 0, 0, 0
+}
+
+// matchedComponent returns the component matched at this View's depth, or nil.
+func (rv *View) matchedComponent() bubbly.Component {
+	route := rv.router.CurrentRoute()
+	if route == nil || rv.depth >= len(route.Matched) {
+		return nil
+	}
+	component, _ := route.Matched[rv.depth].Component.(bubbly.Component)
+	return component
+}
+
 // Ensure View implements required interfaces
 var _ tea.Model = (*View)(nil)
 var _ bubbly.Component = (*View)(nil)
+var _ bubbly.Measurable = (*View)(nil)
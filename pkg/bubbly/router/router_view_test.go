@@ -58,6 +58,14 @@ func (m *mockComponent) IsInitialized() bool {
 	return true
 }
 
+func (m *mockComponent) Measure() (int, int) {
+	return len(m.content), 1
+}
+
+func (m *mockComponent) Bounds() (int, int, int, int) {
+	return 0, 0, len(m.content), 1
+}
+
 // Ensure mockComponent implements bubbly.Component
 var _ bubbly.Component = (*mockComponent)(nil)
 
@@ -218,6 +226,34 @@ func TestRouterView_HandlesNoMatch(t *testing.T) {
 	assert.Equal(t, "", output)
 }
 
+// TestRouterView_MeasureDelegates tests that layout queries reach the matched component
+func TestRouterView_MeasureDelegates(t *testing.T) {
+	router := NewRouter()
+	rv := NewRouterView(router, 0)
+
+	// No current route
+	w, h := rv.Measure()
+	assert.Equal(t, 0, w)
+	assert.Equal(t, 0, h)
+
+	homeComponent := &mockComponent{name: "Home", content: "Home Page"}
+	route := &RouteRecord{Path: "/", Name: "home", Component: homeComponent}
+	require.NoError(t, router.matcher.AddRouteRecord(route))
+	match, err := router.matcher.Match("/")
+	require.NoError(t, err)
+
+	router.mu.Lock()
+	router.currentRoute = &Route{Path: "/", Name: "home", Matched: match.Matched}
+	router.mu.Unlock()
+
+	w, h = rv.Measure()
+	assert.Equal(t, 9, w)
+	assert.Equal(t, 1, h)
+
+	x, y, bw, bh := rv.Bounds()
+	assert.Equal(t, []int{0, 0, 9, 1}, []int{x, y, bw, bh})
+}
+
 // TestRouterView_HandlesDepthOutOfBounds tests behavior when depth exceeds matched routes
 func TestRouterView_HandlesDepthOutOfBounds(t *testing.T) {
 	router := NewRouter()
@@ -82,6 +82,12 @@ func Run(component Component, opts ...RunOption) error {
 		model = Wrap(component)
 	}
 
+	// Mouse tracking enables component hit-testing
+	if cfg.mouseAllMotion || cfg.mouseCellMotion {
+		EnableMouseZones(true)
+		defer EnableMouseZones(false)
+	}
+
 	// Build Bubbletea program options
 	teaOpts := buildTeaOptions(cfg)
 
@@ -181,6 +187,11 @@ func (m *asyncWrapperModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, m.tickCmd())
 	}
 
+	// Route mouse events to the component under the pointer
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok {
+		DispatchMouse(mouseMsg)
+	}
+
 	// Forward message to component
 	updated, cmd := m.component.Update(msg)
 	m.component = updated.(Component)
@@ -200,8 +211,9 @@ func (m *asyncWrapperModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // It forwards the View() call to the wrapped component and applies
 // any global view renderer (e.g., DevTools overlay).
 func (m *asyncWrapperModel) View() string {
-	// Get component view
-	appView := m.component.View()
+	// Get component view with teleported overlays drawn on top, resolving
+	// mouse zone positions for hit-testing
+	appView := ScanMouseZones(RenderLayers(m.component.View()))
 
 	// Apply global view renderer if set (e.g., DevTools)
 	if globalViewRenderer != nil {
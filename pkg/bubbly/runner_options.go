@@ -20,6 +20,8 @@ func WithAltScreen() RunOption {
 
 // WithMouseAllMotion enables mouse support with all motion events.
 // This captures all mouse movements, clicks, and scroll events.
+// Component hit-testing is enabled as well (see EnableMouseZones), so
+// clicks are delivered to the component under the pointer.
 //
 // Example:
 //
@@ -35,6 +37,7 @@ func WithMouseAllMotion() RunOption {
 
 // WithMouseCellMotion enables mouse support with cell motion events.
 // This captures mouse events only when the mouse moves between cells.
+// Component hit-testing is enabled as well (see EnableMouseZones).
 //
 // Example:
 //
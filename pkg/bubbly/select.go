@@ -0,0 +1,34 @@
+package bubbly
+
+// Select derives a Computed holding one part of a Ref's value, typically a
+// field of a large state struct, that only notifies when that part changes.
+//
+// A plain Computed invalidates its dependents on every change to its
+// source, so consumers of one field re-run whenever any field changes. The
+// Computed returned by Select instead recomputes the selected value as soon
+// as source changes and compares it with the previous one using ==. If it
+// is unchanged, dependents are not invalidated and watchers are not
+// notified.
+//
+// The selector runs eagerly on each source change once the Computed has
+// been read, so it should be cheap: a field access or a small derivation.
+//
+// Example:
+//
+//	state := bubbly.NewRef(AppState{User: "ada", Count: 0})
+//	user := bubbly.Select(state, func(s AppState) string { return s.User })
+//
+//	greeting := bubbly.NewComputed(func() string {
+//	    return "Hello, " + user.GetTyped()
+//	})
+//
+//	state.Set(AppState{User: "ada", Count: 1}) // greeting is not invalidated
+func Select[T any, F comparable](source *Ref[T], selector func(T) F) *Computed[F] {
+	c := NewComputed(func() F {
+		return selector(source.GetTyped())
+	})
+	c.equal = func(a, b F) bool {
+		return a == b
+	}
+	return c
+}
@@ -0,0 +1,86 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type selectTestState struct {
+	User  string
+	Count int
+}
+
+// TestSelect_ReturnsSelectedValue tests that Select tracks the selected field
+func TestSelect_ReturnsSelectedValue(t *testing.T) {
+	state := NewRef(selectTestState{User: "ada", Count: 1})
+	user := Select(state, func(s selectTestState) string { return s.User })
+
+	assert.Equal(t, "ada", user.GetTyped())
+
+	state.Set(selectTestState{User: "grace", Count: 1})
+	assert.Equal(t, "grace", user.GetTyped())
+}
+
+// TestSelect_OnlyPropagatesSelectedChanges tests that dependents and watchers
+// ignore changes to unselected fields
+func TestSelect_OnlyPropagatesSelectedChanges(t *testing.T) {
+	tests := []struct {
+		name        string
+		next        selectTestState
+		wantEvals   int
+		wantWatches int
+	}{
+		{
+			name:        "unrelated field change",
+			next:        selectTestState{User: "ada", Count: 2},
+			wantEvals:   1,
+			wantWatches: 0,
+		},
+		{
+			name:        "selected field change",
+			next:        selectTestState{User: "grace", Count: 1},
+			wantEvals:   2,
+			wantWatches: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := NewRef(selectTestState{User: "ada", Count: 1})
+			user := Select(state, func(s selectTestState) string { return s.User })
+
+			evals := 0
+			greeting := NewComputed(func() string {
+				evals++
+				return "Hello, " + user.GetTyped()
+			})
+			assert.Equal(t, "Hello, ada", greeting.GetTyped())
+
+			watches := 0
+			cleanup := Watch(user, func(_, _ string) { watches++ })
+			defer cleanup()
+
+			state.Set(tt.next)
+			greeting.GetTyped()
+
+			assert.Equal(t, tt.wantEvals, evals, "dependent evaluations")
+			assert.Equal(t, tt.wantWatches, watches, "watcher notifications")
+		})
+	}
+}
+
+// TestSelect_BeforeFirstRead tests that a never-read selector computes lazily
+func TestSelect_BeforeFirstRead(t *testing.T) {
+	state := NewRef(selectTestState{User: "ada"})
+	calls := 0
+	user := Select(state, func(s selectTestState) string {
+		calls++
+		return s.User
+	})
+
+	state.Set(selectTestState{User: "grace"})
+	assert.Equal(t, 0, calls, "selector should not run before the first read")
+	assert.Equal(t, "grace", user.GetTyped())
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,221 @@
+package bubbly
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SlotFunc renders the content of a slot. It receives the RenderContext of
+// the component that declares the slot, so the content can read that
+// component's exposed state (like a Vue scoped slot) as well as anything
+// captured from the consumer's scope.
+type SlotFunc func(ctx RenderContext) string
+
+// WithSlot fills the named slot of the component with a render fragment.
+//
+// Slots let a reusable layout component (a Card, a Modal) leave positions
+// such as "header", "body", and "footer" for its consumers to fill, while
+// keeping the frame, borders, and spacing itself. The layout's template
+// places each fragment with ctx.Slot; consumers fill them with WithSlot.
+// Filling a slot twice keeps the last fragment, and a nil render leaves the
+// slot unfilled.
+//
+// Example:
+//
+//	// The layout declares "header" and "body" slots
+//	func NewCard() *bubbly.ComponentBuilder {
+//	    return bubbly.NewComponent("Card").
+//	        Template(func(ctx bubbly.RenderContext) string {
+//	            return border.Render(ctx.SlotOr("header", "Untitled") + "\n" + ctx.Slot("body"))
+//	        })
+//	}
+//
+//	// A consumer fills them
+//	card, _ := NewCard().
+//	    WithSlot("header", func(bubbly.RenderContext) string { return "Settings" }).
+//	    WithSlot("body", func(bubbly.RenderContext) string {
+//	        return renderSettings(settings.GetTyped())
+//	    }).
+//	    Build()
+//
+// Parameters:
+//   - name: The slot name used by the template
+//   - render: Renders the slot content on every render of the component
+//
+// Returns:
+//   - *ComponentBuilder: The builder for method chaining
+func (b *ComponentBuilder) WithSlot(name string, render SlotFunc) *ComponentBuilder {
+	if render == nil {
+		delete(b.component.slots, name)
+		return b
+	}
+	if b.component.slots == nil {
+		b.component.slots = make(map[string]SlotFunc)
+	}
+	b.component.slots[name] = render
+	return b
+}
+
+// Slot renders the content a consumer supplied for the named slot with
+// WithSlot, or returns an empty string if the slot was not filled.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    return ctx.Slot("header") + "\n" + ctx.Slot("body")
+//	})
+func (ctx RenderContext) Slot(name string) string {
+	return ctx.SlotOr(name, "")
+}
+
+// SlotOr renders the named slot like Slot, but returns fallback if the slot
+// was not filled.
+//
+// Example:
+//
+//	footer := ctx.SlotOr("footer", "Press q to close")
+func (ctx RenderContext) SlotOr(name, fallback string) string {
+	render, ok := ctx.component.slots[name]
+	if !ok {
+		return fallback
+	}
+	return render(ctx)
+}
+
+// HasSlot reports whether the named slot or scoped slot was filled, so a
+// template can leave out the frame around an empty slot (e.g., a footer
+// separator) or fall back to its own rendering.
+//
+// Example:
+//
+//	if ctx.HasSlot("footer") {
+//	    parts = append(parts, separator, ctx.Slot("footer"))
+//	}
+func (ctx RenderContext) HasSlot(name string) bool {
+	if _, ok := ctx.component.slots[name]; ok {
+		return true
+	}
+	_, ok := ctx.component.scopedSlots[name]
+	return ok
+}
+
+// ScopedSlot is the content of a scoped slot: a render fragment that
+// receives data from the component rendering it, such as each item of a
+// list. Create it with Scoped.
+type ScopedSlot struct {
+	render   func(ctx RenderContext, data interface{}) (string, bool)
+	dataType string
+}
+
+// Scoped creates the content of a scoped slot from a render function typed
+// by the data the component passes, for use with WithScopedSlot.
+//
+// # Type Parameters
+//
+// Components are not generic, so slot data crosses the component boundary
+// as interface{}: the component passes a value to ctx.ScopedSlot, and the
+// render function given to Scoped states its type T once. Inside render,
+// data is fully typed. The two must agree; ctx.ScopedSlot panics with the
+// slot name, the type passed, and T if they do not. A nil value is passed
+// as the zero T when T is a pointer, interface, slice, map, channel, or
+// function type.
+//
+// Reusable components document the data type of each scoped slot (e.g.,
+// "item" receives a ListRow[T]), typically as an exported type.
+//
+// Example:
+//
+//	bubbly.Scoped(func(ctx bubbly.RenderContext, todo Todo) string {
+//	    return "• " + todo.Title
+//	})
+func Scoped[T any](render func(ctx RenderContext, data T) string) ScopedSlot {
+	return ScopedSlot{
+		render: func(ctx RenderContext, data interface{}) (string, bool) {
+			typed, ok := data.(T)
+			if !ok {
+				if data != nil || !nilable(reflect.TypeFor[T]()) {
+					return "", false
+				}
+			}
+			return render(ctx, typed), true
+		},
+		dataType: reflect.TypeFor[T]().String(),
+	}
+}
+
+// nilable reports whether nil is a valid value of t.
+func nilable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
+
+// WithScopedSlot fills the named scoped slot of the component. Unlike a
+// plain slot, the component calls a scoped slot with data, once per use,
+// so consumers can take over the rendering of parts the component
+// iterates over, like rows, while the component keeps layout, selection,
+// and scrolling. See Scoped for how the data type is stated.
+//
+// Filling a scoped slot twice keeps the last content, and content created
+// without Scoped (the zero ScopedSlot) leaves the slot unfilled.
+//
+// Example:
+//
+//	// The component renders each todo through the "item" scoped slot
+//	todoList, _ := bubbly.NewComponent("TodoList").
+//	    Template(func(ctx bubbly.RenderContext) string {
+//	        var rows []string
+//	        for _, todo := range todos.GetTyped() {
+//	            rows = append(rows, ctx.ScopedSlot("item", todo))
+//	        }
+//	        return strings.Join(rows, "\n")
+//	    }).
+//	    WithScopedSlot("item", bubbly.Scoped(func(_ bubbly.RenderContext, todo Todo) string {
+//	        return checkbox(todo.Done) + " " + todo.Title
+//	    })).
+//	    Build()
+//
+// Parameters:
+//   - name: The scoped slot name used by the template
+//   - slot: The content, created with Scoped
+//
+// Returns:
+//   - *ComponentBuilder: The builder for method chaining
+func (b *ComponentBuilder) WithScopedSlot(name string, slot ScopedSlot) *ComponentBuilder {
+	if slot.render == nil {
+		delete(b.component.scopedSlots, name)
+		return b
+	}
+	if b.component.scopedSlots == nil {
+		b.component.scopedSlots = make(map[string]ScopedSlot)
+	}
+	b.component.scopedSlots[name] = slot
+	return b
+}
+
+// ScopedSlot renders the named scoped slot with data, or returns an empty
+// string if the slot was not filled (check HasSlot to fall back to the
+// component's own rendering).
+//
+// It panics if data is not of the type the slot content was created for
+// (see Scoped), with a message naming the slot and both types.
+//
+// Example:
+//
+//	for i, item := range items {
+//	    rows = append(rows, ctx.ScopedSlot("item", ListRow[T]{Item: item, Index: i}))
+//	}
+func (ctx RenderContext) ScopedSlot(name string, data interface{}) string {
+	slot, ok := ctx.component.scopedSlots[name]
+	if !ok {
+		return ""
+	}
+	output, ok := slot.render(ctx, data)
+	if !ok {
+		panic(fmt.Sprintf("bubbly: ScopedSlot(%q): data is %T, but the slot content renders %s",
+			name, data, slot.dataType))
+	}
+	return output
+}
@@ -0,0 +1,185 @@
+package bubbly
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCardBuilder returns a layout component with "header", "body", and
+// "footer" slots.
+func newCardBuilder() *ComponentBuilder {
+	return NewComponent("Card").
+		Setup(func(ctx *Context) {
+			ctx.Expose("width", 20)
+		}).
+		Template(func(ctx RenderContext) string {
+			parts := []string{ctx.SlotOr("header", "Untitled"), ctx.Slot("body")}
+			if ctx.HasSlot("footer") {
+				parts = append(parts, "--", ctx.Slot("footer"))
+			}
+			return strings.Join(parts, "|")
+		})
+}
+
+func TestSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		slots    map[string]SlotFunc
+		expected string
+	}{
+		{
+			name:     "unfilled slots render empty or default",
+			expected: "Untitled|",
+		},
+		{
+			name: "filled slots",
+			slots: map[string]SlotFunc{
+				"header": func(RenderContext) string { return "Settings" },
+				"body":   func(RenderContext) string { return "content" },
+			},
+			expected: "Settings|content",
+		},
+		{
+			name: "optional slot",
+			slots: map[string]SlotFunc{
+				"footer": func(RenderContext) string { return "q: close" },
+			},
+			expected: "Untitled||--|q: close",
+		},
+		{
+			name: "slot reads the layout's state",
+			slots: map[string]SlotFunc{
+				"body": func(ctx RenderContext) string {
+					return strings.Repeat("=", ctx.Get("width").(int)/10)
+				},
+			},
+			expected: "Untitled|==",
+		},
+		{
+			name: "nil render leaves the slot unfilled",
+			slots: map[string]SlotFunc{
+				"header": nil,
+			},
+			expected: "Untitled|",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := newCardBuilder()
+			for name, render := range tt.slots {
+				builder.WithSlot(name, render)
+			}
+			card, err := builder.Build()
+			require.NoError(t, err)
+			card.Init()
+
+			assert.Equal(t, tt.expected, card.View())
+		})
+	}
+}
+
+func TestSlots_ReactiveContent(t *testing.T) {
+	title := NewRef("Draft")
+	card, err := newCardBuilder().
+		WithSlot("header", func(RenderContext) string { return title.GetTyped() }).
+		WithSlot("header", func(RenderContext) string { return "[" + title.GetTyped() + "]" }).
+		Build()
+	require.NoError(t, err)
+	card.Init()
+
+	assert.Equal(t, "[Draft]|", card.View(), "the last fragment for a slot wins")
+
+	title.Set("Published")
+	assert.Equal(t, "[Published]|", card.View())
+}
+
+// todoRow is the data the test list passes to its "item" scoped slot.
+type todoRow struct {
+	Title string
+	Index int
+}
+
+// newTodoListBuilder returns a component rendering each title through the
+// "item" scoped slot, or as-is when the slot is unfilled.
+func newTodoListBuilder(titles ...string) *ComponentBuilder {
+	return NewComponent("TodoList").
+		Template(func(ctx RenderContext) string {
+			rows := make([]string, len(titles))
+			for i, title := range titles {
+				if !ctx.HasSlot("item") {
+					rows[i] = title
+					continue
+				}
+				rows[i] = ctx.ScopedSlot("item", todoRow{Title: title, Index: i})
+			}
+			return strings.Join(rows, ",")
+		})
+}
+
+func TestScopedSlots(t *testing.T) {
+	tests := []struct {
+		name     string
+		slot     ScopedSlot
+		expected string
+	}{
+		{
+			name:     "zero content leaves the slot unfilled",
+			expected: "a,b",
+		},
+		{
+			name: "filled scoped slot receives typed data",
+			slot: Scoped(func(_ RenderContext, row todoRow) string {
+				return fmt.Sprintf("%d.%s", row.Index+1, row.Title)
+			}),
+			expected: "1.a,2.b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := newTodoListBuilder("a", "b").
+				WithScopedSlot("item", tt.slot).
+				Build()
+			require.NoError(t, err)
+			list.Init()
+
+			assert.Equal(t, tt.expected, list.View())
+		})
+	}
+}
+
+func TestScopedSlot_TypeMismatch(t *testing.T) {
+	list, err := newTodoListBuilder("a").
+		WithScopedSlot("item", Scoped(func(_ RenderContext, title string) string { return title })).
+		Build()
+	require.NoError(t, err)
+	list.Init()
+
+	assert.PanicsWithValue(t,
+		`bubbly: ScopedSlot("item"): data is bubbly.todoRow, but the slot content renders string`,
+		func() { list.View() })
+}
+
+func TestScopedSlot_NilData(t *testing.T) {
+	impl := newComponentImpl("Nil")
+	impl.scopedSlots = map[string]ScopedSlot{
+		"pointer": Scoped(func(_ RenderContext, row *todoRow) string {
+			if row == nil {
+				return "none"
+			}
+			return row.Title
+		}),
+		"value": Scoped(func(_ RenderContext, row todoRow) string { return row.Title }),
+	}
+	ctx := RenderContext{component: impl}
+
+	assert.Equal(t, "none", ctx.ScopedSlot("pointer", nil))
+	assert.Equal(t, "x", ctx.ScopedSlot("pointer", &todoRow{Title: "x"}))
+	assert.Panics(t, func() { ctx.ScopedSlot("value", nil) })
+	assert.Equal(t, "", ctx.ScopedSlot("missing", todoRow{}))
+}
@@ -0,0 +1,174 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// StateSnapshot is a serializable capture of the exposed Refs of a
+// component tree, as returned by SnapshotState.
+//
+// It maps component paths to the JSON values of their exposed Refs. A path
+// names each component from the root down, with the position among
+// same-named siblings, so it stays stable across restarts of the same app:
+//
+//	{
+//	    "App":                      {"tab": 1},
+//	    "App/TodoList[0]":          {"todos": [...], "filter": "active"},
+//	    "App/TodoList[0]/Input[0]": {"value": "buy mi"}
+//	}
+//
+// Encode it with encoding/json to save it to disk.
+type StateSnapshot map[string]map[string]json.RawMessage
+
+// snapshotValue is an exposed value SnapshotState can capture and restore.
+// Refs implement it (see Ref.MarshalJSON).
+type snapshotValue interface {
+	json.Marshaler
+	json.Unmarshaler
+}
+
+// childrenProvider is implemented by components that have children.
+type childrenProvider interface {
+	Children() []Component
+}
+
+// SnapshotState captures the current value of every exposed Ref in the tree
+// rooted at root, for save/resume or debugging.
+//
+// Only exposed values that round-trip through JSON are captured (Refs, and
+// any other value implementing both json.Marshaler and json.Unmarshaler);
+// Computed values, functions, and plain values are skipped, since they are
+// derived or rebuilt by Setup.
+//
+// Refs whose value cannot be encoded are left out and reported in the
+// returned error; the snapshot holds everything else and is usable even
+// when the error is non-nil.
+//
+// Example:
+//
+//	snapshot, err := bubbly.SnapshotState(app)
+//	if err != nil {
+//	    log.Printf("snapshot incomplete: %v", err)
+//	}
+//	data, _ := json.Marshal(snapshot)
+//	os.WriteFile("session.json", data, 0644)
+func SnapshotState(root Component) (StateSnapshot, error) {
+	snapshot := make(StateSnapshot)
+	var errs []error
+
+	walkStateTree(root, func(path string, impl *componentImpl) {
+		for _, exposed := range exposedSnapshotValues(impl) {
+			data, err := exposed.value.MarshalJSON()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("snapshot %s.%s: %w", path, exposed.key, err))
+				continue
+			}
+			if snapshot[path] == nil {
+				snapshot[path] = make(map[string]json.RawMessage)
+			}
+			snapshot[path][exposed.key] = data
+		}
+	})
+
+	return snapshot, errors.Join(errs...)
+}
+
+// RestoreState writes the values in snapshot back to the exposed Refs of the
+// tree rooted at root. Each Ref is Set with its saved value, so watchers
+// fire and computed values and the UI update.
+//
+// The tree may have changed since the snapshot was taken: components and
+// Refs missing from the snapshot keep their current values, and snapshot
+// entries without a matching component or Ref are ignored. Components
+// created later (e.g., when a tab is first opened) can be restored by
+// calling RestoreState again.
+//
+// Values that fail to decode (for instance, because the Ref's type changed)
+// are skipped and reported in the returned error; all other values are
+// still restored.
+//
+// Example:
+//
+//	data, err := os.ReadFile("session.json")
+//	if err == nil {
+//	    var snapshot bubbly.StateSnapshot
+//	    if err := json.Unmarshal(data, &snapshot); err == nil {
+//	        _ = bubbly.RestoreState(app, snapshot)
+//	    }
+//	}
+func RestoreState(root Component, snapshot StateSnapshot) error {
+	var errs []error
+
+	walkStateTree(root, func(path string, impl *componentImpl) {
+		saved, ok := snapshot[path]
+		if !ok {
+			return
+		}
+		for _, exposed := range exposedSnapshotValues(impl) {
+			data, ok := saved[exposed.key]
+			if !ok {
+				continue
+			}
+			if err := exposed.value.UnmarshalJSON(data); err != nil {
+				errs = append(errs, fmt.Errorf("restore %s.%s: %w", path, exposed.key, err))
+			}
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// walkStateTree calls visit for every component in the tree rooted at root,
+// parents before children, with the component's snapshot path.
+func walkStateTree(root Component, visit func(path string, impl *componentImpl)) {
+	if root == nil {
+		return
+	}
+	var walk func(c Component, path string)
+	walk = func(c Component, path string) {
+		if impl, ok := c.(*componentImpl); ok {
+			visit(path, impl)
+		}
+		provider, ok := c.(childrenProvider)
+		if !ok {
+			return
+		}
+		seen := make(map[string]int)
+		for _, child := range provider.Children() {
+			if child == nil {
+				continue
+			}
+			name := child.Name()
+			walk(child, fmt.Sprintf("%s/%s[%d]", path, name, seen[name]))
+			seen[name]++
+		}
+	}
+	walk(root, root.Name())
+}
+
+// exposedSnapshotValue is an exposed value that can be captured.
+type exposedSnapshotValue struct {
+	key   string
+	value snapshotValue
+}
+
+// exposedSnapshotValues returns the exposed values of impl that can be
+// captured, sorted by key.
+func exposedSnapshotValues(impl *componentImpl) []exposedSnapshotValue {
+	impl.stateMu.RLock()
+	values := make([]exposedSnapshotValue, 0, len(impl.state))
+	for key, value := range impl.state {
+		if v, ok := value.(snapshotValue); ok {
+			values = append(values, exposedSnapshotValue{key: key, value: v})
+		}
+	}
+	impl.stateMu.RUnlock()
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].key < values[j].key
+	})
+	return values
+}
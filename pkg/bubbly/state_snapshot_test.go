@@ -0,0 +1,170 @@
+package bubbly
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSnapshotItem builds an initialized component exposing a "text" Ref and
+// a derived Computed.
+func newSnapshotItem(t *testing.T, text string) Component {
+	t.Helper()
+	comp, err := NewComponent("Item").
+		Setup(func(ctx *Context) {
+			value := NewRef(text)
+			ctx.Expose("text", value)
+			ctx.Expose("length", NewComputed(func() int { return len(value.GetTyped()) }))
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	return comp
+}
+
+// newSnapshotApp builds an initialized App with a "tab" Ref and the given
+// children.
+func newSnapshotApp(t *testing.T, children ...Component) (Component, *Ref[int]) {
+	t.Helper()
+	tab := NewRef(0)
+	app, err := NewComponent("App").
+		Children(children...).
+		Setup(func(ctx *Context) {
+			ctx.Expose("tab", tab)
+			ctx.Expose("title", "static")
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	app.Init()
+	return app, tab
+}
+
+func exposedText(c Component) *Ref[string] {
+	return c.(*componentImpl).state["text"].(*Ref[string])
+}
+
+// TestSnapshotState tests Refs across the tree are captured by path
+func TestSnapshotState(t *testing.T) {
+	app, tab := newSnapshotApp(t, newSnapshotItem(t, "first"), newSnapshotItem(t, "second"))
+	tab.Set(2)
+
+	snapshot, err := SnapshotState(app)
+
+	require.NoError(t, err)
+	data, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"App": {"tab": 2},
+		"App/Item[0]": {"text": "first"},
+		"App/Item[1]": {"text": "second"}
+	}`, string(data), "Only Refs should be captured")
+}
+
+// TestRestoreState tests saved values are written back and fire watchers
+func TestRestoreState(t *testing.T) {
+	first, second := newSnapshotItem(t, "first"), newSnapshotItem(t, "second")
+	app, tab := newSnapshotApp(t, first, second)
+	tab.Set(2)
+	exposedText(second).Set("edited")
+
+	snapshot, err := SnapshotState(app)
+	require.NoError(t, err)
+
+	tab.Set(0)
+	exposedText(second).Set("changed again")
+	var watched []string
+	Watch(exposedText(second), func(newVal, _ string) { watched = append(watched, newVal) })
+
+	err = RestoreState(app, snapshot)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, tab.GetTyped())
+	assert.Equal(t, "first", exposedText(first).GetTyped())
+	assert.Equal(t, "edited", exposedText(second).GetTyped())
+	assert.Equal(t, []string{"edited"}, watched, "Restoring should notify watchers")
+}
+
+// TestRestoreState_ChangedTree tests components that come and go between
+// snapshot and restore
+func TestRestoreState_ChangedTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot StateSnapshot
+		expected []string
+	}{
+		{
+			name: "component removed since snapshot",
+			snapshot: StateSnapshot{
+				"App/Item[0]": {"text": json.RawMessage(`"saved"`)},
+				"App/Item[5]": {"text": json.RawMessage(`"gone"`)},
+			},
+			expected: []string{"saved", "b"},
+		},
+		{
+			name: "component added since snapshot",
+			snapshot: StateSnapshot{
+				"App/Item[1]": {"text": json.RawMessage(`"saved"`)},
+			},
+			expected: []string{"a", "saved"},
+		},
+		{
+			name: "ref added or removed since snapshot",
+			snapshot: StateSnapshot{
+				"App/Item[0]": {"removed": json.RawMessage(`1`)},
+			},
+			expected: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := []Component{newSnapshotItem(t, "a"), newSnapshotItem(t, "b")}
+			app, _ := newSnapshotApp(t, items...)
+
+			err := RestoreState(app, tt.snapshot)
+
+			require.NoError(t, err)
+			for i, item := range items {
+				assert.Equal(t, tt.expected[i], exposedText(item).GetTyped())
+			}
+		})
+	}
+}
+
+// TestRestoreState_DecodeError tests mismatched values are skipped and reported
+func TestRestoreState_DecodeError(t *testing.T) {
+	item := newSnapshotItem(t, "a")
+	app, tab := newSnapshotApp(t, item)
+
+	err := RestoreState(app, StateSnapshot{
+		"App":         {"tab": json.RawMessage(`"not a number"`)},
+		"App/Item[0]": {"text": json.RawMessage(`"restored"`)},
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "App.tab")
+	assert.Equal(t, 0, tab.GetTyped())
+	assert.Equal(t, "restored", exposedText(item).GetTyped(), "Other values should still be restored")
+}
+
+// TestSnapshotState_EncodeError tests unencodable Refs are skipped and reported
+func TestSnapshotState_EncodeError(t *testing.T) {
+	comp, err := NewComponent("App").
+		Setup(func(ctx *Context) {
+			ctx.Expose("callback", NewRef(func() {}))
+			ctx.Expose("count", NewRef(3))
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+
+	snapshot, err := SnapshotState(comp)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "App.callback")
+	assert.Equal(t, StateSnapshot{"App": {"count": json.RawMessage(`3`)}}, snapshot)
+}
@@ -0,0 +1,121 @@
+package bubbly
+
+import "github.com/charmbracelet/lipgloss"
+
+// StyleCond is a style applied by MergeStyles only when When is true,
+// similar to a conditional class binding in Vue.
+type StyleCond struct {
+	// When enables the style.
+	When bool
+
+	// Style is merged over the base when When is true.
+	Style lipgloss.Style
+}
+
+// StyleIf returns a StyleCond applying style when when is true.
+//
+// Example:
+//
+//	bubbly.StyleIf(focused, lipgloss.NewStyle().BorderForeground(theme.Primary))
+func StyleIf(when bool, style lipgloss.Style) StyleCond {
+	return StyleCond{When: when, Style: style}
+}
+
+// MergeStyles returns base with the style of every enabled condition merged
+// over it, in order.
+//
+// Properties set on a condition's style override the same properties from
+// base and earlier conditions; properties it leaves unset are kept. Padding
+// and margins are overridden as a whole when the condition sets any side of
+// them to a non-zero value. base itself is not modified.
+//
+// Example:
+//
+//	style := bubbly.MergeStyles(
+//	    lipgloss.NewStyle().Padding(0, 1).Border(lipgloss.RoundedBorder()),
+//	    bubbly.StyleIf(focused, lipgloss.NewStyle().BorderForeground(theme.Primary)),
+//	    bubbly.StyleIf(hasError, lipgloss.NewStyle().BorderForeground(theme.Error)),
+//	    bubbly.StyleIf(disabled, lipgloss.NewStyle().Foreground(theme.Muted)),
+//	)
+func MergeStyles(base lipgloss.Style, conds ...StyleCond) lipgloss.Style {
+	merged := base
+	for _, cond := range conds {
+		if cond.When {
+			merged = mergeStyle(merged, cond.Style)
+		}
+	}
+	return merged
+}
+
+// mergeStyle returns overlay with the properties it leaves unset taken from
+// base. lipgloss's Inherit never inherits spacing, so padding and margins
+// are carried over explicitly.
+func mergeStyle(base, overlay lipgloss.Style) lipgloss.Style {
+	merged := overlay.Inherit(base)
+
+	top, right, bottom, left := overlay.GetPadding()
+	if top == 0 && right == 0 && bottom == 0 && left == 0 {
+		top, right, bottom, left = base.GetPadding()
+	}
+	merged = merged.Padding(top, right, bottom, left)
+
+	top, right, bottom, left = overlay.GetMargin()
+	if top == 0 && right == 0 && bottom == 0 && left == 0 {
+		top, right, bottom, left = base.GetMargin()
+	}
+	return merged.Margin(top, right, bottom, left)
+}
+
+// styleProvideKeyPrefix namespaces styles in the provide/inject map.
+const styleProvideKeyPrefix = "style:"
+
+// ProvideStyle makes a named style available to this component and its
+// descendants via UseStyle, similar to a CSS rule scoped to a subtree.
+//
+// Provided styles cascade: a style provided under the same key further down
+// the tree is merged over the ones provided above it (see MergeStyles), so
+// a subtree only needs to override the properties it changes.
+//
+// Example:
+//
+//	// App root: every card gets a rounded border and padding
+//	ctx.ProvideStyle("card", lipgloss.NewStyle().
+//	    Border(lipgloss.RoundedBorder()).
+//	    Padding(0, 1))
+//
+//	// Sidebar: cards keep border and padding, but use a muted color
+//	ctx.ProvideStyle("card", lipgloss.NewStyle().BorderForeground(theme.Muted))
+func (ctx *Context) ProvideStyle(key string, style lipgloss.Style) {
+	ctx.Provide(styleProvideKeyPrefix+key, style)
+}
+
+// UseStyle returns the named style as cascaded down to this component: the
+// styles provided under key by every ancestor, and by this component
+// itself, merged from the root down. If no component provided the key, an
+// empty style is returned.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    ctx.Expose("cardStyle", ctx.UseStyle("card"))
+//	})
+func (ctx *Context) UseStyle(key string) lipgloss.Style {
+	key = styleProvideKeyPrefix + key
+
+	// Collect provided styles, nearest first
+	var chain []lipgloss.Style
+	for c := ctx.component; c != nil; c = c.parent {
+		c.providesMu.RLock()
+		style, ok := c.provides[key].(lipgloss.Style)
+		c.providesMu.RUnlock()
+		if ok {
+			chain = append(chain, style)
+		}
+	}
+
+	merged := lipgloss.NewStyle()
+	for i := len(chain) - 1; i >= 0; i-- {
+		merged = mergeStyle(merged, chain[i])
+	}
+	return merged
+}
@@ -0,0 +1,126 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeStyles(t *testing.T) {
+	base := lipgloss.NewStyle().
+		Padding(0, 1).
+		Foreground(lipgloss.Color("1")).
+		Border(lipgloss.RoundedBorder())
+
+	tests := []struct {
+		name        string
+		conds       []StyleCond
+		wantFg      lipgloss.TerminalColor
+		wantBold    bool
+		wantPadding [4]int
+		wantMargin  [4]int
+	}{
+		{
+			name:        "no conditions returns base",
+			wantFg:      lipgloss.Color("1"),
+			wantPadding: [4]int{0, 1, 0, 1},
+		},
+		{
+			name:        "disabled condition is skipped",
+			conds:       []StyleCond{StyleIf(false, lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Bold(true))},
+			wantFg:      lipgloss.Color("1"),
+			wantPadding: [4]int{0, 1, 0, 1},
+		},
+		{
+			name:        "enabled condition overrides and keeps unset properties",
+			conds:       []StyleCond{StyleIf(true, lipgloss.NewStyle().Foreground(lipgloss.Color("2")).Bold(true))},
+			wantFg:      lipgloss.Color("2"),
+			wantBold:    true,
+			wantPadding: [4]int{0, 1, 0, 1},
+		},
+		{
+			name: "later conditions win",
+			conds: []StyleCond{
+				StyleIf(true, lipgloss.NewStyle().Foreground(lipgloss.Color("2"))),
+				StyleIf(true, lipgloss.NewStyle().Foreground(lipgloss.Color("3"))),
+			},
+			wantFg:      lipgloss.Color("3"),
+			wantPadding: [4]int{0, 1, 0, 1},
+		},
+		{
+			name:        "spacing overrides",
+			conds:       []StyleCond{{When: true, Style: lipgloss.NewStyle().Padding(1).Margin(0, 2)}},
+			wantFg:      lipgloss.Color("1"),
+			wantPadding: [4]int{1, 1, 1, 1},
+			wantMargin:  [4]int{0, 2, 0, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeStyles(base, tt.conds...)
+
+			assert.Equal(t, tt.wantFg, merged.GetForeground())
+			assert.Equal(t, tt.wantBold, merged.GetBold())
+			assert.Equal(t, lipgloss.RoundedBorder(), merged.GetBorderStyle(), "base border should be kept")
+
+			top, right, bottom, left := merged.GetPadding()
+			assert.Equal(t, tt.wantPadding, [4]int{top, right, bottom, left})
+			top, right, bottom, left = merged.GetMargin()
+			assert.Equal(t, tt.wantMargin, [4]int{top, right, bottom, left})
+		})
+	}
+
+	assert.Equal(t, lipgloss.Color("1"), base.GetForeground(), "base should not be modified")
+}
+
+func TestProvideStyle_Cascade(t *testing.T) {
+	var leafStyle, siblingStyle, rootStyle lipgloss.Style
+	newComponent := func(name string, setup SetupFunc, children ...Component) Component {
+		c, err := NewComponent(name).
+			Children(children...).
+			Setup(setup).
+			Template(func(ctx RenderContext) string { return "" }).
+			Build()
+		assert.NoError(t, err)
+		return c
+	}
+
+	leaf := newComponent("Leaf", func(ctx *Context) {
+		leafStyle = ctx.UseStyle("card")
+	})
+	sidebar := newComponent("Sidebar", func(ctx *Context) {
+		ctx.ProvideStyle("card", lipgloss.NewStyle().BorderForeground(lipgloss.Color("240")))
+	}, leaf)
+
+	sibling := newComponent("Sibling", func(ctx *Context) {
+		siblingStyle = ctx.UseStyle("card")
+	})
+
+	root, err := NewComponent("App").
+		Children(sidebar, sibling).
+		Setup(func(ctx *Context) {
+			ctx.ProvideStyle("card", lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("35")).
+				Padding(0, 1))
+			rootStyle = ctx.UseStyle("other")
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	assert.NoError(t, err)
+	root.Init()
+
+	// Sibling inherits the root style unchanged
+	assert.Equal(t, lipgloss.RoundedBorder(), siblingStyle.GetBorderStyle())
+	assert.Equal(t, lipgloss.Color("35"), siblingStyle.GetBorderTopForeground())
+
+	// Leaf inherits border and padding, with the sidebar's color override
+	assert.Equal(t, lipgloss.RoundedBorder(), leafStyle.GetBorderStyle())
+	assert.Equal(t, lipgloss.Color("240"), leafStyle.GetBorderTopForeground())
+	assert.Equal(t, 1, leafStyle.GetPaddingLeft())
+
+	// Unknown keys yield an empty style
+	assert.Equal(t, lipgloss.NewStyle().Render("x"), rootStyle.Render("x"))
+}
@@ -0,0 +1,248 @@
+package bubbly
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Built-in overlay layers for Teleport, bottom to top.
+const (
+	// LayerPopover is for dropdowns, menus and tooltips.
+	LayerPopover = "popover"
+	// LayerModal is for dialogs, drawn above popovers.
+	LayerModal = "modal"
+	// LayerToast is for notifications, drawn above everything else.
+	LayerToast = "toast"
+)
+
+// maxPendingTeleports bounds the overlays collected when views are rendered
+// but RenderLayers is never called, preventing unbounded growth.
+const maxPendingTeleports = 1000
+
+// TeleportOption configures where a Teleport overlay is placed.
+type TeleportOption func(*teleportEntry)
+
+// TeleportAlign places the overlay relative to the screen, e.g.
+// (lipgloss.Right, lipgloss.Bottom) for a toast. The default is centered.
+//
+// Example:
+//
+//	bubbly.Teleport(bubbly.LayerToast, renderToast,
+//	    bubbly.TeleportAlign(lipgloss.Right, lipgloss.Bottom))
+func TeleportAlign(horizontal, vertical lipgloss.Position) TeleportOption {
+	return func(e *teleportEntry) {
+		e.absolute = false
+		e.hAlign = horizontal
+		e.vAlign = vertical
+	}
+}
+
+// TeleportAt places the overlay's top-left corner at column x, row y of the
+// screen, e.g. under the field a dropdown belongs to.
+//
+// Example:
+//
+//	x, y, _, h := bubbly.Bounds(field)
+//	bubbly.Teleport(bubbly.LayerPopover, renderMenu, bubbly.TeleportAt(x, y+h))
+func TeleportAt(x, y int) TeleportOption {
+	return func(e *teleportEntry) {
+		e.absolute = true
+		e.x = x
+		e.y = y
+	}
+}
+
+// teleportEntry is an overlay collected for the current frame.
+type teleportEntry struct {
+	layer    string
+	content  string
+	absolute bool
+	x, y     int
+	hAlign   lipgloss.Position
+	vAlign   lipgloss.Position
+}
+
+// teleportState collects overlays between RenderLayers calls.
+type teleportState struct {
+	mu      sync.Mutex
+	entries []*teleportEntry
+	seq     uint64 // Counts Teleport calls, so memoized renders can tell
+	order   map[string]int
+}
+
+// globalTeleports is the process-wide overlay registry.
+var globalTeleports = &teleportState{
+	order: map[string]int{
+		LayerPopover: 100,
+		LayerModal:   200,
+		LayerToast:   300,
+	},
+}
+
+// Teleport renders content into a named overlay layer instead of in place.
+// Overlays are collected while the tree renders and drawn on top of the
+// whole view by RenderLayers, so a modal declared deep in the tree still
+// covers everything around it. Wrap and Run call RenderLayers for you.
+//
+// Call Teleport from a template on every render the overlay should be
+// shown; it is drawn for that frame only. render is called right away, so
+// overlays teleported while it runs (a dropdown inside a modal) stack above
+// it. Overlays are centered unless TeleportAlign or TeleportAt says
+// otherwise, and grow the view if they do not fit.
+//
+// Layers stack by SetLayerOrder: LayerPopover below LayerModal below
+// LayerToast, and layers without an order (0) below all three. Within a
+// layer, later Teleport calls draw on top of earlier ones.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    if ctx.Get("confirming").(*bubbly.Ref[bool]).GetTyped() {
+//	        bubbly.Teleport(bubbly.LayerModal, func() string {
+//	            return dialog.View()
+//	        })
+//	    }
+//	    return list.View()
+//	})
+func Teleport(layer string, render func() string, opts ...TeleportOption) {
+	if render == nil {
+		return
+	}
+
+	entry := &teleportEntry{
+		layer:  layer,
+		hAlign: lipgloss.Center,
+		vAlign: lipgloss.Center,
+	}
+	for _, opt := range opts {
+		opt(entry)
+	}
+
+	// Reserve the slot first so nested overlays land after (above) this one
+	s := globalTeleports
+	s.mu.Lock()
+	if len(s.entries) >= maxPendingTeleports {
+		s.entries = nil
+	}
+	s.entries = append(s.entries, entry)
+	s.seq++
+	s.mu.Unlock()
+
+	content := render()
+
+	s.mu.Lock()
+	entry.content = content
+	s.mu.Unlock()
+}
+
+// SetLayerOrder sets the stacking order of a layer: higher orders draw on
+// top. Use it to add layers or to move the built-in ones (100, 200 and 300).
+//
+// Example:
+//
+//	bubbly.SetLayerOrder("command-palette", 250) // Above modals, below toasts
+func SetLayerOrder(layer string, order int) {
+	s := globalTeleports
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.order[layer] = order
+}
+
+// RenderLayers draws the overlays collected by Teleport on top of base,
+// bottom layer first, and clears them for the next frame. It returns base
+// unchanged when there are none.
+//
+// Wrap and Run call it once per frame; call it yourself when driving the
+// root component without them.
+//
+// Example:
+//
+//	func (m model) View() string {
+//	    return bubbly.RenderLayers(m.root.View())
+//	}
+func RenderLayers(base string) string {
+	s := globalTeleports
+	s.mu.Lock()
+	entries := make([]teleportEntry, len(s.entries))
+	orders := make([]int, len(s.entries))
+	for i, e := range s.entries {
+		entries[i] = *e
+		orders[i] = s.order[e.layer]
+	}
+	s.entries = nil
+	s.mu.Unlock()
+
+	if len(entries) == 0 {
+		return base
+	}
+
+	// Stable, so equal layers keep declaration order
+	indexes := make([]int, len(entries))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return orders[indexes[a]] < orders[indexes[b]]
+	})
+
+	canvas := strings.Split(base, "\n")
+	width := lipgloss.Width(base)
+	height := len(canvas)
+	for _, i := range indexes {
+		entry := entries[i]
+		box := entry.content
+		if box == "" {
+			continue
+		}
+
+		x, y := entry.x, entry.y
+		if !entry.absolute {
+			x = alignOffset(width, lipgloss.Width(box), entry.hAlign)
+			y = alignOffset(height, lipgloss.Height(box), entry.vAlign)
+		}
+		canvas = overlayLines(canvas, box, max(x, 0), max(y, 0))
+	}
+
+	return strings.Join(canvas, "\n")
+}
+
+// teleportSeq returns the number of Teleport calls so far.
+func teleportSeq() uint64 {
+	s := globalTeleports
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seq
+}
+
+// alignOffset returns where content of size fits in space at pos
+// (0 = start, 0.5 = center, 1 = end), never negative.
+func alignOffset(space, size int, pos lipgloss.Position) int {
+	return max(int(float64(space-size)*float64(pos)), 0)
+}
+
+// overlayLines draws box over canvas with its top-left corner at (x, y),
+// adding rows and padding columns as needed. The visible parts of each row
+// keep their escape sequences, so their styles stay intact.
+func overlayLines(canvas []string, box string, x, y int) []string {
+	boxLines := strings.Split(box, "\n")
+	boxWidth := lipgloss.Width(box)
+
+	for len(canvas) < y+len(boxLines) {
+		canvas = append(canvas, "")
+	}
+
+	for i, boxLine := range boxLines {
+		row := canvas[y+i]
+		left := PadVisible(ansi.Truncate(row, x, ""), x)
+		right := ""
+		if VisibleWidth(row) > x+boxWidth {
+			right = ansi.TruncateLeft(row, x+boxWidth, "")
+		}
+		canvas[y+i] = left + PadVisible(boxLine, boxWidth) + right
+	}
+	return canvas
+}
@@ -0,0 +1,127 @@
+package bubbly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// teleportTestBase is a 10x4 background of dots.
+var teleportTestBase = strings.Repeat("..........\n", 3) + ".........."
+
+// TestRenderLayers_Placement tests alignment, absolute positions and growth
+func TestRenderLayers_Placement(t *testing.T) {
+	RenderLayers("") // Drop overlays left by other tests
+
+	tests := []struct {
+		name     string
+		teleport func()
+		want     string
+	}{
+		{
+			name:     "no overlays",
+			teleport: func() {},
+			want:     teleportTestBase,
+		},
+		{
+			name: "centered by default",
+			teleport: func() {
+				Teleport(LayerModal, func() string { return "AB\nCD" })
+			},
+			want: "..........\n....AB....\n....CD....\n..........",
+		},
+		{
+			name: "aligned bottom right",
+			teleport: func() {
+				Teleport(LayerToast, func() string { return "ok" }, TeleportAlign(lipgloss.Right, lipgloss.Bottom))
+			},
+			want: "..........\n..........\n..........\n........ok",
+		},
+		{
+			name: "absolute position grows the view",
+			teleport: func() {
+				Teleport(LayerPopover, func() string { return "menu\nitem" }, TeleportAt(8, 3))
+			},
+			want: "..........\n..........\n..........\n........menu\n        item",
+		},
+		{
+			name: "empty content draws nothing",
+			teleport: func() {
+				Teleport(LayerModal, func() string { return "" })
+				Teleport(LayerModal, nil)
+			},
+			want: teleportTestBase,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.teleport()
+			assert.Equal(t, tt.want, RenderLayers(teleportTestBase))
+			assert.Equal(t, teleportTestBase, RenderLayers(teleportTestBase), "overlays last one frame")
+		})
+	}
+}
+
+// TestRenderLayers_Stacking tests layer order and declaration order within a layer
+func TestRenderLayers_Stacking(t *testing.T) {
+	RenderLayers("")
+	SetLayerOrder("palette", 250)
+	t.Cleanup(func() {
+		globalTeleports.mu.Lock()
+		delete(globalTeleports.order, "palette")
+		globalTeleports.mu.Unlock()
+	})
+
+	at := TeleportAt(0, 0)
+	Teleport(LayerToast, func() string { return "T" }, at)
+	Teleport("palette", func() string { return "PP" }, at)
+	Teleport(LayerModal, func() string { return "MMM" }, at)
+	Teleport(LayerPopover, func() string { return "OOOO" }, at)
+	Teleport("unordered", func() string { return "UUUUU" }, at)
+	assert.Equal(t, "TPMOU.....", RenderLayers(".........."))
+
+	// Overlays teleported by an overlay's render stack above it
+	Teleport(LayerModal, func() string {
+		Teleport(LayerModal, func() string { return "x" }, at)
+		return "dialog"
+	}, at)
+	Teleport(LayerModal, func() string { return "yy" }, at)
+	assert.Equal(t, "yyalog....", RenderLayers(".........."))
+}
+
+// TestTeleport_FromComponents tests overlays declared deep in a memoized tree
+func TestTeleport_FromComponents(t *testing.T) {
+	RenderLayers("")
+
+	open := NewRef(true)
+	dialog, err := NewComponent("Dialog").
+		Template(func(RenderContext) string {
+			if open.GetTyped() {
+				Teleport(LayerModal, func() string { return "[ok]" })
+			}
+			return "row"
+		}).
+		Memo().
+		Build()
+	require.NoError(t, err)
+
+	root, err := NewComponent("Root").
+		Children(dialog).
+		Template(func(ctx RenderContext) string {
+			return "........\n" + ctx.Children()[0].View() + "\n........"
+		}).
+		Build()
+	require.NoError(t, err)
+
+	model := Wrap(root)
+	model.Init()
+	assert.Equal(t, "........\nro[ok]\n........", model.View())
+	assert.Equal(t, "........\nro[ok]\n........", model.View(), "memoized render keeps its overlay")
+
+	open.Set(false)
+	assert.Equal(t, "........\nrow\n........", model.View())
+}
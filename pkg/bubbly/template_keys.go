@@ -0,0 +1,118 @@
+package bubbly
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// KeyAnyRune is the key pattern matching any printable character typed
+// without Alt, for RenderContext.OnKey.
+const KeyAnyRune = "<rune>"
+
+// TemplateKeyEventPrefix prefixes the names of the events emitted for keys
+// registered with RenderContext.OnKey: a press of "ctrl+s" emits
+// "key:ctrl+s" with a TemplateKeyEvent as data.
+const TemplateKeyEventPrefix = "key:"
+
+// TemplateKeyEvent is the data of events emitted for keys registered with
+// RenderContext.OnKey. Parents receive it when the event bubbles up.
+type TemplateKeyEvent struct {
+	// Key is the registered key pattern that matched.
+	Key string
+
+	// Msg is the key press.
+	Msg tea.KeyMsg
+
+	// source is the component whose template registered the key
+	source *componentImpl
+}
+
+// OnKey registers a key handler from the template. When the component
+// receives a matching tea.KeyMsg in Update, it emits a
+// TemplateKeyEventPrefix+key event, which runs handler through the regular
+// event system (framework hooks, middleware, and bubbling to parents).
+//
+// Keys use Bubbletea's tea.KeyMsg.String() format, as in KeyBinding:
+// "enter", "esc", "ctrl+s", "alt+a", or a single character. KeyAnyRune
+// matches any printable character for which no exact pattern is registered.
+//
+// Registrations only last until the next render, so keys registered inside
+// conditional template branches are only active while the branch renders.
+// Key bindings declared with WithKeyBinding are processed first; both fire
+// if a key has a binding and a template handler.
+//
+// This is the primitive behind directives.OnKey; most templates should use
+// the directive.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    ctx.OnKey("ctrl+s", func(tea.KeyMsg) { save() })
+//	    return "Press ctrl+s to save"
+//	})
+func (ctx RenderContext) OnKey(key string, handler func(tea.KeyMsg)) {
+	if handler == nil {
+		return
+	}
+	c := ctx.component
+
+	c.templateKeysMu.Lock()
+	if c.templateKeys == nil {
+		c.templateKeys = make(map[string]func(tea.KeyMsg))
+	}
+	c.templateKeys[key] = handler
+	registered := c.templateKeyEvents[key]
+	if !registered {
+		if c.templateKeyEvents == nil {
+			c.templateKeyEvents = make(map[string]bool)
+		}
+		c.templateKeyEvents[key] = true
+	}
+	c.templateKeysMu.Unlock()
+
+	if !registered {
+		c.On(TemplateKeyEventPrefix+key, c.runTemplateKey)
+	}
+}
+
+// resetTemplateKeys forgets the key handlers of the previous render.
+func (c *componentImpl) resetTemplateKeys() {
+	c.templateKeysMu.Lock()
+	defer c.templateKeysMu.Unlock()
+
+	clear(c.templateKeys)
+}
+
+// handleTemplateKeys emits the event for the template key matching keyMsg,
+// preferring an exact pattern over KeyAnyRune.
+func (c *componentImpl) handleTemplateKeys(keyMsg tea.KeyMsg) {
+	c.templateKeysMu.Lock()
+	key := keyMsg.String()
+	_, found := c.templateKeys[key]
+	if !found && keyMsg.Type == tea.KeyRunes && !keyMsg.Alt {
+		key = KeyAnyRune
+		_, found = c.templateKeys[key]
+	}
+	c.templateKeysMu.Unlock()
+
+	if found {
+		c.Emit(TemplateKeyEventPrefix+key, TemplateKeyEvent{Key: key, Msg: keyMsg, source: c})
+	}
+}
+
+// runTemplateKey is the event handler running the current template handler
+// for a key event emitted by this component.
+func (c *componentImpl) runTemplateKey(data interface{}) {
+	event, ok := data.(TemplateKeyEvent)
+	if !ok || event.source != c {
+		// Bubbled up from a child, or emitted manually
+		return
+	}
+
+	c.templateKeysMu.Lock()
+	handler := c.templateKeys[event.Key]
+	c.templateKeysMu.Unlock()
+
+	if handler != nil {
+		handler(event.Msg)
+	}
+}
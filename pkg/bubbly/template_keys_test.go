@@ -0,0 +1,114 @@
+package bubbly
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTemplateKeysComponent builds an initialized component whose template
+// registers keys with register and records pressed keys in pressed.
+func newTemplateKeysComponent(t *testing.T, register func(ctx RenderContext, pressed *[]string)) (Component, *[]string) {
+	t.Helper()
+	pressed := &[]string{}
+	comp, err := NewComponent("Keys").
+		Template(func(ctx RenderContext) string {
+			register(ctx, pressed)
+			return "keys"
+		}).
+		Build()
+	require.NoError(t, err)
+	comp.Init()
+	comp.View()
+	return comp, pressed
+}
+
+// TestRenderContext_OnKey tests key matching of template key handlers
+func TestRenderContext_OnKey(t *testing.T) {
+	register := func(ctx RenderContext, pressed *[]string) {
+		for _, key := range []string{"enter", "ctrl+s", "q", KeyAnyRune} {
+			ctx.OnKey(key, func(msg tea.KeyMsg) {
+				*pressed = append(*pressed, key+"="+msg.String())
+			})
+		}
+	}
+
+	tests := []struct {
+		name     string
+		msg      tea.KeyMsg
+		expected []string
+	}{
+		{name: "named key", msg: tea.KeyMsg{Type: tea.KeyEnter}, expected: []string{"enter=enter"}},
+		{name: "modifier combo", msg: tea.KeyMsg{Type: tea.KeyCtrlS}, expected: []string{"ctrl+s=ctrl+s"}},
+		{name: "exact rune wins over wildcard", msg: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}, expected: []string{"q=q"}},
+		{name: "wildcard rune", msg: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}, expected: []string{KeyAnyRune + "=x"}},
+		{name: "alt rune is not a wildcard match", msg: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x"), Alt: true}, expected: []string{}},
+		{name: "unregistered key", msg: tea.KeyMsg{Type: tea.KeyEsc}, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp, pressed := newTemplateKeysComponent(t, register)
+
+			comp.Update(tt.msg)
+
+			assert.Equal(t, tt.expected, *pressed)
+		})
+	}
+}
+
+// TestRenderContext_OnKey_LastsOneRender tests keys not re-registered stop firing
+func TestRenderContext_OnKey_LastsOneRender(t *testing.T) {
+	active := true
+	comp, pressed := newTemplateKeysComponent(t, func(ctx RenderContext, pressed *[]string) {
+		if active {
+			ctx.OnKey("enter", func(tea.KeyMsg) { *pressed = append(*pressed, "enter") })
+		}
+	})
+
+	comp.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	active = false
+	comp.View()
+	comp.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	active = true
+	comp.View()
+	comp.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.Equal(t, []string{"enter", "enter"}, *pressed, "Re-registering must not add duplicate handlers")
+}
+
+// TestRenderContext_OnKey_EmitsEvent tests keys go through the event system
+func TestRenderContext_OnKey_EmitsEvent(t *testing.T) {
+	var childKeys, parentEvents []string
+
+	child, err := NewComponent("Child").
+		Template(func(ctx RenderContext) string {
+			ctx.OnKey("enter", func(tea.KeyMsg) { childKeys = append(childKeys, "child") })
+			return "child"
+		}).
+		Build()
+	require.NoError(t, err)
+
+	parent, err := NewComponent("Parent").
+		Children(child).
+		Setup(func(ctx *Context) {
+			ctx.On(TemplateKeyEventPrefix+"enter", func(data interface{}) {
+				parentEvents = append(parentEvents, data.(TemplateKeyEvent).Key)
+			})
+		}).
+		Template(func(ctx RenderContext) string {
+			ctx.OnKey("enter", func(tea.KeyMsg) { childKeys = append(childKeys, "parent") })
+			return ctx.RenderChildren("\n")
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+	parent.View()
+
+	parent.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	assert.ElementsMatch(t, []string{"parent", "child"}, childKeys, "Each template handler should fire once")
+	assert.Equal(t, []string{"enter", "enter"}, parentEvents, "Setup handlers should see both key events")
+}
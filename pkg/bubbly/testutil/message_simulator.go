@@ -1,6 +1,8 @@
 package testutil
 
 import (
+	"strings"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -97,6 +99,7 @@ var keyTypeMap = map[string]tea.KeyType{
 	"end":       tea.KeyEnd,
 	"pgup":      tea.KeyPgUp,
 	"pgdown":    tea.KeyPgDown,
+	"shift+tab": tea.KeyShiftTab,
 	// Control keys
 	"ctrl+c": tea.KeyCtrlC,
 	"ctrl+d": tea.KeyCtrlD,
@@ -131,6 +134,13 @@ func createKeyMsg(key string) tea.KeyMsg {
 		return tea.KeyMsg{Type: keyType}
 	}
 
+	// Alt combinations: "alt+left", "alt+a"
+	if rest, ok := strings.CutPrefix(key, "alt+"); ok && rest != "" {
+		msg := createKeyMsg(rest)
+		msg.Alt = true
+		return msg
+	}
+
 	// Default: treat as runes (character input)
 	return tea.KeyMsg{
 		Type:  tea.KeyRunes,
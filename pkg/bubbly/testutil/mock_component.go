@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
 )
@@ -18,6 +19,7 @@ import (
 //   - Event system (Emit, On)
 //   - Key bindings (KeyBindings, HelpText)
 //   - Lifecycle (IsInitialized)
+//   - Layout (Measure, Bounds)
 //
 // Call tracking:
 //   - initCalled: Whether Init() was called
@@ -32,6 +34,7 @@ import (
 //   - props: Props returned by Props() method
 //   - keyBindings: Key bindings returned by KeyBindings() method
 //   - helpText: Help text returned by HelpText() method
+//   - bounds: Position returned by Bounds() method
 //
 // Example:
 //
@@ -60,6 +63,7 @@ type MockComponent struct {
 	viewOutput  string
 	keyBindings map[string][]bubbly.KeyBinding
 	helpText    string
+	bounds      bubbly.Rect
 
 	// Call tracking
 	initCalled    bool
@@ -250,6 +254,32 @@ func (mc *MockComponent) IsInitialized() bool {
 	return mc.initCalled
 }
 
+// Measure returns the size of the configured view output.
+func (mc *MockComponent) Measure() (width, height int) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	return lipgloss.Width(mc.viewOutput), lipgloss.Height(mc.viewOutput)
+}
+
+// SetBounds sets the position and size returned by Bounds().
+//
+// Example:
+//
+//	mock := NewMockComponent("Button")
+//	mock.SetBounds(bubbly.Rect{X: 2, Y: 1, Width: 10, Height: 1})
+func (mc *MockComponent) SetBounds(bounds bubbly.Rect) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	mc.bounds = bounds
+}
+
+// Bounds returns the bounds configured with SetBounds.
+func (mc *MockComponent) Bounds() (x, y, w, h int) {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	return mc.bounds.X, mc.bounds.Y, mc.bounds.Width, mc.bounds.Height
+}
+
 // tea.Model interface implementation
 
 // Init marks the component as initialized and returns nil.
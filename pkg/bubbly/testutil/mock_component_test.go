@@ -69,6 +69,20 @@ func TestMockComponent_ComponentInterface(t *testing.T) {
 	mock.On("test", func(data interface{}) {})
 }
 
+// TestMockComponent_Layout tests Measure and configurable Bounds
+func TestMockComponent_Layout(t *testing.T) {
+	mock := NewMockComponent("Box")
+	mock.SetViewOutput("abc\nde")
+
+	w, h := mock.Measure()
+	assert.Equal(t, 3, w)
+	assert.Equal(t, 2, h)
+
+	mock.SetBounds(bubbly.Rect{X: 4, Y: 2, Width: 3, Height: 2})
+	x, y, bw, bh := mock.Bounds()
+	assert.Equal(t, []int{4, 2, 3, 2}, []int{x, y, bw, bh})
+}
+
 // TestMockComponent_Init tests Init() method and tracking
 func TestMockComponent_Init(t *testing.T) {
 	mock := NewMockComponent("Test")
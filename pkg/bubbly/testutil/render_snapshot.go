@@ -0,0 +1,101 @@
+package testutil
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// VisibleText returns the text a user would see for rendered output.
+//
+// It strips all ANSI escape sequences (colors, styles, mouse zone markers)
+// and trailing whitespace on each line, so assertions and golden files
+// don't depend on styling or padding.
+//
+// Example:
+//
+//	assert.Equal(t, "[ OK ]", testutil.VisibleText(button.View()))
+func VisibleText(output string) string {
+	lines := strings.Split(ansi.Strip(output), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RenderSnapshot renders component and returns its VisibleText.
+// The component is initialized first if needed.
+//
+// Use it for stable golden comparisons that ignore colors and styles:
+//
+//	func TestCard(t *testing.T) {
+//	    card := components.Card(components.CardProps{Title: "Hello"})
+//	    testutil.MatchSnapshot(t, testutil.RenderSnapshot(card))
+//	}
+func RenderSnapshot(component bubbly.Component) string {
+	if !component.IsInitialized() {
+		component.Init()
+	}
+	return VisibleText(component.View())
+}
+
+// MatchRenderSnapshot compares a component's RenderSnapshot against the
+// test's golden file (see MatchSnapshot).
+//
+// Example:
+//
+//	testutil.MatchRenderSnapshot(t, card)
+func MatchRenderSnapshot(t *testing.T, component bubbly.Component) {
+	t.Helper()
+	MatchSnapshot(t, RenderSnapshot(component))
+}
+
+// KeyMsg builds the tea.KeyMsg for a key string in the format used by key
+// bindings ("enter", "ctrl+c", "alt+left", "a", ...).
+//
+// Example:
+//
+//	component.Update(testutil.KeyMsg("ctrl+s"))
+func KeyMsg(key string) tea.KeyMsg {
+	return createKeyMsg(key)
+}
+
+// SimulateKey sends a key press to component through its Update method,
+// driving the same path as a real key press: key bindings fire their
+// events, handlers run, and child components receive the message.
+// The component is initialized first if needed.
+//
+// Returns the command produced by Update (tea.Quit for "quit" bindings).
+//
+// Example:
+//
+//	counter := CreateCounter()
+//	testutil.SimulateKey(counter, "up")
+//	assert.Contains(t, testutil.RenderSnapshot(counter), "Count: 1")
+func SimulateKey(component bubbly.Component, key string) tea.Cmd {
+	if !component.IsInitialized() {
+		component.Init()
+	}
+	_, cmd := component.Update(createKeyMsg(key))
+	return cmd
+}
+
+// SimulateKeys sends each key in order with SimulateKey and returns the
+// non-nil commands produced.
+//
+// Example:
+//
+//	testutil.SimulateKeys(form, "tab", "tab", "enter")
+func SimulateKeys(component bubbly.Component, keys ...string) []tea.Cmd {
+	var cmds []tea.Cmd
+	for _, key := range keys {
+		if cmd := SimulateKey(component, key); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
+}
@@ -0,0 +1,85 @@
+package testutil
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestVisibleText tests ANSI and trailing whitespace removal
+func TestVisibleText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"plain", "hello", "hello"},
+		{"colors", "\x1b[31mred\x1b[0m and \x1b[1mbold\x1b[0m", "red and bold"},
+		{"mouse zone markers", "\x1b[12zbutton\x1b[12z", "button"},
+		{"trailing padding per line", "a   \nbb\t\n  c  ", "a\nbb\n  c"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, VisibleText(tt.input))
+		})
+	}
+}
+
+// TestRenderSnapshot tests rendering a styled component to stable text
+func TestRenderSnapshot(t *testing.T) {
+	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205")).Width(10)
+	comp, err := bubbly.NewComponent("Styled").
+		Template(func(ctx bubbly.RenderContext) string {
+			return style.Render("Title") + "\nbody"
+		}).
+		Build()
+	require.NoError(t, err)
+
+	// Not initialized yet: RenderSnapshot handles it
+	assert.Equal(t, "Title\nbody", RenderSnapshot(comp))
+	assert.True(t, comp.IsInitialized())
+}
+
+// TestSimulateKey tests driving key bindings and events
+func TestSimulateKey(t *testing.T) {
+	count := bubbly.NewRef(0)
+	comp, err := bubbly.NewComponent("Counter").
+		WithKeyBinding("up", "increment", "Increment").
+		WithKeyBinding("alt+up", "jump", "Jump").
+		WithKeyBinding("ctrl+c", "quit", "Quit").
+		Setup(func(ctx *bubbly.Context) {
+			ctx.On("increment", func(_ interface{}) { count.Set(count.GetTyped() + 1) })
+			ctx.On("jump", func(_ interface{}) { count.Set(count.GetTyped() + 10) })
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			return fmt.Sprintf("Count: %d", count.GetTyped())
+		}).
+		Build()
+	require.NoError(t, err)
+
+	SimulateKey(comp, "up")
+	assert.Equal(t, "Count: 1", RenderSnapshot(comp))
+
+	cmds := SimulateKeys(comp, "up", "alt+up", "x")
+	assert.Empty(t, cmds)
+	assert.Equal(t, "Count: 12", RenderSnapshot(comp))
+
+	cmd := SimulateKey(comp, "ctrl+c")
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+// TestKeyMsg tests key string parsing
+func TestKeyMsg(t *testing.T) {
+	for _, key := range []string{"enter", "ctrl+c", "alt+left", "alt+a", "shift+tab", "a", "f5"} {
+		assert.Equal(t, key, KeyMsg(key).String())
+	}
+}
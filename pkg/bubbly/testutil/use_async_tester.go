@@ -68,8 +68,8 @@ type UseAsyncTester struct {
 //
 //	comp, err := bubbly.NewComponent("TestAsync").
 //	    Setup(func(ctx *bubbly.Context) {
-//	        async := composables.UseAsync(ctx, func() (*User, error) {
-//	            return fetchUser()
+//	        async := composables.UseAsync(ctx, func(c context.Context) (*User, error) {
+//	            return fetchUser(c)
 //	        })
 //	        ctx.Expose("data", async.Data)
 //	        ctx.Expose("loading", async.Loading)
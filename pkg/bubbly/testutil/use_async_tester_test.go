@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -41,7 +42,7 @@ func TestUseAsyncTester_BasicAsync(t *testing.T) {
 			// Create component with UseAsync
 			comp, err := bubbly.NewComponent("TestAsync").
 				Setup(func(ctx *bubbly.Context) {
-					async := composables.UseAsync(ctx, func() (*string, error) {
+					async := composables.UseAsync(ctx, func(_ context.Context) (*string, error) {
 						return tt.fetchResult, tt.fetchError
 					})
 
@@ -94,7 +95,7 @@ func TestUseAsyncTester_BasicAsync(t *testing.T) {
 func TestUseAsyncTester_LoadingState(t *testing.T) {
 	comp, err := bubbly.NewComponent("TestAsync").
 		Setup(func(ctx *bubbly.Context) {
-			async := composables.UseAsync(ctx, func() (*string, error) {
+			async := composables.UseAsync(ctx, func(_ context.Context) (*string, error) {
 				time.Sleep(50 * time.Millisecond)
 				return stringPtr("data"), nil
 			})
@@ -137,7 +138,7 @@ func TestUseAsyncTester_MultipleExecutions(t *testing.T) {
 
 	comp, err := bubbly.NewComponent("TestAsync").
 		Setup(func(ctx *bubbly.Context) {
-			async := composables.UseAsync(ctx, func() (*int, error) {
+			async := composables.UseAsync(ctx, func(_ context.Context) (*int, error) {
 				callCount++
 				return &callCount, nil
 			})
@@ -178,7 +179,7 @@ func TestUseAsyncTester_ErrorClearing(t *testing.T) {
 
 	comp, err := bubbly.NewComponent("TestAsync").
 		Setup(func(ctx *bubbly.Context) {
-			async := composables.UseAsync(ctx, func() (*string, error) {
+			async := composables.UseAsync(ctx, func(_ context.Context) (*string, error) {
 				if shouldFail {
 					return nil, errors.New("error")
 				}
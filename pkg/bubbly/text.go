@@ -0,0 +1,83 @@
+package bubbly
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// truncateTail is the marker TruncateVisible appends to shortened text.
+const truncateTail = "..."
+
+// VisibleWidth returns the number of terminal cells s occupies.
+//
+// Unlike len(s) or a rune count, it ignores ANSI escape sequences (colors,
+// styles) and counts wide characters such as emoji and CJK as two cells.
+//
+// Example:
+//
+//	VisibleWidth("héllo")                   // 5
+//	VisibleWidth("🚀 go")                    // 5
+//	VisibleWidth("\x1b[31mred\x1b[0m")      // 3
+func VisibleWidth(s string) int {
+	return ansi.StringWidth(s)
+}
+
+// TruncateVisible shortens s to at most width terminal cells, ending it
+// with "..." when it is cut. Rune boundaries and ANSI escape sequences are
+// respected, so styled text stays well-formed and multibyte characters are
+// never split. If width is 3 or less, s is cut without a marker.
+//
+// Strings that already fit are returned unchanged.
+//
+// Example:
+//
+//	TruncateVisible("Hello, 世界!", 8)  // "Hello..."
+//	TruncateVisible("\x1b[1mBold text\x1b[0m", 7) // "\x1b[1mBold...\x1b[0m"
+func TruncateVisible(s string, width int) string {
+	if width <= len(truncateTail) {
+		return TruncateVisibleWith(s, width, "")
+	}
+	return TruncateVisibleWith(s, width, truncateTail)
+}
+
+// TruncateVisibleWith is like TruncateVisible with a custom tail marker
+// (e.g., "…"). The tail counts toward width.
+//
+// Example:
+//
+//	TruncateVisibleWith(title, 20, "…")
+func TruncateVisibleWith(s string, width int, tail string) string {
+	if width <= 0 {
+		return ""
+	}
+	if VisibleWidth(s) <= width {
+		return s
+	}
+	return ansi.Truncate(s, width, tail)
+}
+
+// PadVisible pads s with spaces on the right to width terminal cells.
+// Strings that are already at least width cells wide are returned unchanged;
+// combine with TruncateVisible to fit text to an exact width.
+//
+// Example:
+//
+//	PadVisible("🚀", 4) // "🚀  "
+func PadVisible(s string, width int) string {
+	if pad := width - VisibleWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// FitVisible truncates or pads s to exactly width terminal cells, which is
+// what fixed-width columns need.
+//
+// Example:
+//
+//	FitVisible("Name", 8)               // "Name    "
+//	FitVisible("A very long name", 8)   // "A ver..."
+func FitVisible(s string, width int) string {
+	return PadVisible(TruncateVisible(s, width), width)
+}
@@ -0,0 +1,71 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestVisibleWidth tests cell width measurement
+func TestVisibleWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"ascii", "hello", 5},
+		{"multibyte", "héllo", 5},
+		{"emoji", "🚀 go", 5},
+		{"cjk", "世界", 4},
+		{"ansi", "\x1b[31mred\x1b[0m", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VisibleWidth(tt.in))
+		})
+	}
+}
+
+// TestTruncateVisible tests width-aware truncation
+func TestTruncateVisible(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"fits", "hello", 5, "hello"},
+		{"ascii", "hello world", 8, "hello..."},
+		{"cjk", "Hello, 世界!", 8, "Hello..."},
+		{"wide rune not split", "世界世界", 6, "世..."},
+		{"ansi kept well-formed", "\x1b[1mBold text\x1b[0m", 7, "\x1b[1mBold...\x1b[0m"},
+		{"narrow cuts without tail", "hello", 3, "hel"},
+		{"zero width", "hello", 0, ""},
+		{"negative width", "hello", -1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateVisible(tt.in, tt.width)
+			assert.Equal(t, tt.want, got)
+			if tt.width > 0 {
+				assert.LessOrEqual(t, VisibleWidth(got), tt.width)
+			}
+		})
+	}
+
+	assert.Equal(t, "hell…", TruncateVisibleWith("hello world", 5, "…"))
+}
+
+// TestPadAndFitVisible tests padding and fitting to a fixed width
+func TestPadAndFitVisible(t *testing.T) {
+	assert.Equal(t, "🚀  ", PadVisible("🚀", 4))
+	assert.Equal(t, "hello", PadVisible("hello", 3), "no truncation")
+	assert.Equal(t, "\x1b[31mok\x1b[0m  ", PadVisible("\x1b[31mok\x1b[0m", 4))
+
+	assert.Equal(t, "Name    ", FitVisible("Name", 8))
+	assert.Equal(t, "A ver...", FitVisible("A very long name", 8))
+	assert.Equal(t, "世... ", FitVisible("世界世界", 6), "wide rune leaves a gap that is padded")
+}
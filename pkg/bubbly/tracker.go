@@ -126,10 +126,8 @@ func (dt *DepTracker) BeginTracking(dep Dependency) error {
 	defer state.mu.Unlock()
 
 	// Check for circular dependency
-	for _, ctx := range state.stack {
-		if ctx.dep == dep {
-			return ErrCircularDependency
-		}
+	if err := state.cycleError(dep); err != nil {
+		return err
 	}
 
 	// Check max depth
@@ -149,6 +147,44 @@ func (dt *DepTracker) BeginTracking(dep Dependency) error {
 	return nil
 }
 
+// cycleError returns a *CycleError describing the chain from dep back to
+// itself if dep is already being evaluated on this stack, or nil.
+// Must be called with ts.mu held.
+func (ts *trackingState) cycleError(dep Dependency) error {
+	for i, ctx := range ts.stack {
+		if ctx.dep != dep {
+			continue
+		}
+		path := make([]string, 0, len(ts.stack)-i+1)
+		for _, inCycle := range ts.stack[i:] {
+			path = append(path, dependencyName(inCycle.dep))
+		}
+		path = append(path, dependencyName(dep))
+		return &CycleError{CycleReport: CycleReport{Path: path}}
+	}
+	return nil
+}
+
+// checkCycle returns a *CycleError if dep is already being evaluated on the
+// current goroutine. Computed calls it before taking its own lock, since a
+// cyclic re-entry would otherwise deadlock on that lock.
+func (dt *DepTracker) checkCycle(dep Dependency) error {
+	// Fast path: no evaluation in progress anywhere
+	if dt.activeTrackers.Load() == 0 {
+		return nil
+	}
+
+	state, ok := dt.states.Load(getGoroutineID())
+	if !ok {
+		return nil
+	}
+
+	ts := state.(*trackingState)
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.cycleError(dep)
+}
+
 // Track records a dependency access during evaluation.
 // This is called by Ref.GetTyped() when dependency tracking is active.
 func (dt *DepTracker) Track(dep Dependency) {
@@ -0,0 +1,90 @@
+package bubbly
+
+import "fmt"
+
+// GetRef returns the *Ref[T] exposed under key, replacing the type
+// assertion templates otherwise need:
+//
+//	count := ctx.Get("count").(*bubbly.Ref[int])  // Before
+//	count := bubbly.GetRef[int](ctx, "count")     // After
+//
+// It panics if nothing is exposed under key or if the exposed value is not
+// a *Ref[T]. The panic message names the key, the expected type, and the
+// type actually stored, e.g.:
+//
+//	bubbly: GetRef("count"): exposed value is *bubbly.Ref[string], not *bubbly.Ref[int]
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    count := bubbly.GetRef[int](ctx, "count")
+//	    return fmt.Sprintf("Count: %d", count.GetTyped())
+//	})
+func GetRef[T any](ctx RenderContext, key string) *Ref[T] {
+	value := ctx.Get(key)
+	ref, ok := value.(*Ref[T])
+	if !ok || ref == nil {
+		panic(exposedTypeMessage("GetRef", key, value, (*Ref[T])(nil)))
+	}
+	return ref
+}
+
+// GetRefOr returns the *Ref[T] exposed under key like GetRef, or fallback
+// if nothing was exposed under key, so a template can render whether or
+// not an optional Ref was exposed.
+//
+// Only a missing key falls back: a value of the wrong type still panics as
+// in GetRef, since it is a bug rather than an omission.
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    // Setup may expose "selected"; without it nothing is selected
+//	    selected := bubbly.GetRefOr(ctx, "selected", bubbly.NewRef(-1))
+//	    return renderList(items, selected.GetTyped())
+//	})
+func GetRefOr[T any](ctx RenderContext, key string, fallback *Ref[T]) *Ref[T] {
+	if _, ok := ctx.component.state[key]; !ok {
+		return fallback
+	}
+	return GetRef[T](ctx, key)
+}
+
+// GetComputed returns the *Computed[T] exposed under key, replacing the
+// type assertion templates otherwise need. A *WritableComputed[T] is
+// accepted too, since it is a Computed.
+//
+// It panics if nothing is exposed under key or if the exposed value is not
+// a *Computed[T], with a message naming the key, the expected type, and the
+// type actually stored (see GetRef).
+//
+// Example:
+//
+//	Template(func(ctx bubbly.RenderContext) string {
+//	    total := bubbly.GetComputed[float64](ctx, "total")
+//	    return fmt.Sprintf("Total: $%.2f", total.GetTyped())
+//	})
+func GetComputed[T any](ctx RenderContext, key string) *Computed[T] {
+	value := ctx.Get(key)
+	switch v := value.(type) {
+	case *Computed[T]:
+		if v != nil {
+			return v
+		}
+	case *WritableComputed[T]:
+		if v != nil {
+			return v.Computed
+		}
+	}
+	panic(exposedTypeMessage("GetComputed", key, value, (*Computed[T])(nil)))
+}
+
+// exposedTypeMessage describes a typed accessor call whose exposed value
+// is missing or of the wrong type.
+func exposedTypeMessage(accessor, key string, value, expected interface{}) string {
+	if value == nil {
+		return fmt.Sprintf("bubbly: %s(%q): no value exposed under this key (expected %T)",
+			accessor, key, expected)
+	}
+	return fmt.Sprintf("bubbly: %s(%q): exposed value is %T, not %T", accessor, key, value, expected)
+}
@@ -0,0 +1,93 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newAccessorContext returns a RenderContext exposing state.
+func newAccessorContext(state map[string]interface{}) RenderContext {
+	impl := newComponentImpl("Accessors")
+	impl.state = state
+	return RenderContext{component: impl}
+}
+
+func TestGetRef(t *testing.T) {
+	count := NewRef(42)
+	ctx := newAccessorContext(map[string]interface{}{"count": count})
+
+	assert.Same(t, count, GetRef[int](ctx, "count"))
+	assert.Equal(t, 42, GetRef[int](ctx, "count").GetTyped())
+}
+
+func TestGetComputed(t *testing.T) {
+	doubled := NewComputed(func() int { return 2 })
+	writable := NewWritableComputed(func() string { return "x" }, func(string) {})
+	ctx := newAccessorContext(map[string]interface{}{
+		"doubled":  doubled,
+		"writable": writable,
+	})
+
+	assert.Same(t, doubled, GetComputed[int](ctx, "doubled"))
+	assert.Same(t, writable.Computed, GetComputed[string](ctx, "writable"))
+}
+
+func TestTypedAccessors_Mismatch(t *testing.T) {
+	ctx := newAccessorContext(map[string]interface{}{
+		"count": NewRef("not an int"),
+		"total": NewComputed(func() float64 { return 0 }),
+		"title": "plain",
+	})
+
+	tests := []struct {
+		name    string
+		get     func()
+		message string
+	}{
+		{
+			name:    "ref of wrong type",
+			get:     func() { GetRef[int](ctx, "count") },
+			message: `bubbly: GetRef("count"): exposed value is *bubbly.Ref[string], not *bubbly.Ref[int]`,
+		},
+		{
+			name:    "ref is a computed",
+			get:     func() { GetRef[float64](ctx, "total") },
+			message: `bubbly: GetRef("total"): exposed value is *bubbly.Computed[float64], not *bubbly.Ref[float64]`,
+		},
+		{
+			name:    "computed is a plain value",
+			get:     func() { GetComputed[string](ctx, "title") },
+			message: `bubbly: GetComputed("title"): exposed value is string, not *bubbly.Computed[string]`,
+		},
+		{
+			name:    "missing key",
+			get:     func() { GetRef[int](ctx, "missing") },
+			message: `bubbly: GetRef("missing"): no value exposed under this key (expected *bubbly.Ref[int])`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.PanicsWithValue(t, tt.message, tt.get)
+		})
+	}
+}
+
+func TestGetRefOr(t *testing.T) {
+	selected := NewRef(3)
+	fallback := NewRef(-1)
+	ctx := newAccessorContext(map[string]interface{}{
+		"selected": selected,
+		"label":    "plain",
+	})
+
+	assert.Same(t, selected, GetRefOr(ctx, "selected", fallback))
+	assert.Same(t, fallback, GetRefOr(ctx, "missing", fallback))
+	assert.Same(t, fallback, GetRefOr(newAccessorContext(nil), "selected", fallback))
+
+	assert.PanicsWithValue(t,
+		`bubbly: GetRef("label"): exposed value is string, not *bubbly.Ref[int]`,
+		func() { GetRefOr(ctx, "label", fallback) },
+		"a value of the wrong type is not replaced by the fallback")
+}
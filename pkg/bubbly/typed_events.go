@@ -0,0 +1,94 @@
+package bubbly
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Emitter is anything events can be emitted from, such as a Component or a
+// setup Context.
+type Emitter interface {
+	Emit(event string, data interface{})
+}
+
+// TypedEvent is an event name bound to a payload type T. Declaring events
+// once as TypedEvents lets the compiler check both emitters and handlers.
+//
+// Example:
+//
+//	var Submitted = bubbly.NewTypedEvent[FormData]("submit")
+//
+//	// Child
+//	Submitted.Emit(ctx, FormData{Username: "user"})
+//
+//	// Parent
+//	Submitted.On(ctx, func(data FormData) {
+//	    save(data.Username)
+//	})
+type TypedEvent[T any] struct {
+	name string
+}
+
+// NewTypedEvent declares an event named name carrying a T payload.
+func NewTypedEvent[T any](name string) TypedEvent[T] {
+	return TypedEvent[T]{name: name}
+}
+
+// Name returns the event name.
+func (e TypedEvent[T]) Name() string {
+	return e.name
+}
+
+// On registers a handler for the event on the component being set up.
+// See OnTyped.
+func (e TypedEvent[T]) On(ctx *Context, handler func(data T)) {
+	OnTyped(ctx, e.name, handler)
+}
+
+// Emit emits the event with data from target.
+func (e TypedEvent[T]) Emit(target Emitter, data T) {
+	target.Emit(e.name, data)
+}
+
+// OnTyped registers a handler for the event whose payload is a T, so the
+// handler does not need to type-assert interface{} data.
+//
+// A nil payload is passed as T's zero value. Any other payload that is not a
+// T panics with a message naming the event and both types; like any handler
+// panic, it is recovered and reported through observability.
+//
+// Example:
+//
+//	bubbly.OnTyped(ctx, "select", func(index int) {
+//	    selected.Set(index)
+//	})
+func OnTyped[T any](ctx *Context, event string, handler func(data T)) {
+	ctx.On(event, func(data interface{}) {
+		handler(typedEventPayload[T](event, data))
+	})
+}
+
+// EmitTyped emits an event with a T payload from target. It pairs with
+// OnTyped; with an explicit type argument, the compiler rejects payloads of
+// the wrong type.
+//
+// Example:
+//
+//	bubbly.EmitTyped[int](ctx, "select", index)
+func EmitTyped[T any](target Emitter, event string, data T) {
+	target.Emit(event, data)
+}
+
+// typedEventPayload converts an event payload to T, panicking on mismatch.
+func typedEventPayload[T any](event string, data interface{}) T {
+	if data == nil {
+		var zero T
+		return zero
+	}
+	value, ok := data.(T)
+	if !ok {
+		panic(fmt.Sprintf("event %q: handler expects payload of type %s, got %T",
+			event, reflect.TypeOf((*T)(nil)).Elem(), data))
+	}
+	return value
+}
@@ -0,0 +1,98 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedEventFormData struct {
+	Username string
+}
+
+func TestTypedEventPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      interface{}
+		expected  int
+		wantPanic string
+	}{
+		{name: "matching type", data: 42, expected: 42},
+		{name: "nil payload is zero value", data: nil, expected: 0},
+		{name: "mismatch panics", data: "42", wantPanic: `event "select": handler expects payload of type int, got string`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic != "" {
+				assert.PanicsWithValue(t, tt.wantPanic, func() {
+					typedEventPayload[int]("select", tt.data)
+				})
+				return
+			}
+			assert.Equal(t, tt.expected, typedEventPayload[int]("select", tt.data))
+		})
+	}
+}
+
+func TestTypedEventPayload_InterfaceType(t *testing.T) {
+	assert.PanicsWithValue(t, `event "failed": handler expects payload of type error, got int`, func() {
+		typedEventPayload[error]("failed", 1)
+	})
+	assert.Nil(t, typedEventPayload[error]("failed", nil))
+}
+
+func TestOnTyped_EmitTyped(t *testing.T) {
+	var got []int
+	c, err := NewComponent("List").
+		Setup(func(ctx *Context) {
+			OnTyped(ctx, "select", func(index int) {
+				got = append(got, index)
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	c.Init()
+
+	EmitTyped(c, "select", 3)
+	EmitTyped[int](c, "select", 5)
+	// A mismatched payload panics inside the handler, which the event
+	// system recovers from; the handler body never runs
+	c.Emit("select", "oops")
+
+	assert.Equal(t, []int{3, 5}, got)
+}
+
+func TestTypedEvent(t *testing.T) {
+	submitted := NewTypedEvent[typedEventFormData]("submit")
+	assert.Equal(t, "submit", submitted.Name())
+
+	var got typedEventFormData
+	child, err := NewComponent("Form").
+		Setup(func(ctx *Context) {
+			ctx.On("send", func(interface{}) {
+				submitted.Emit(ctx, typedEventFormData{Username: "alice"})
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+
+	parent, err := NewComponent("Page").
+		Children(child).
+		Setup(func(ctx *Context) {
+			submitted.On(ctx, func(data typedEventFormData) {
+				got = data
+			})
+		}).
+		Template(func(ctx RenderContext) string { return "" }).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	child.Emit("send", nil)
+
+	assert.Equal(t, typedEventFormData{Username: "alice"}, got)
+}
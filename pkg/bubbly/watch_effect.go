@@ -51,7 +51,11 @@ import (
 //	    }
 //	})
 //
-// The effect automatically adapts to which dependencies are accessed in each run.
+// The effect automatically adapts to which dependencies are accessed in each run:
+// after toggle becomes false, changes to valueA no longer re-run it.
+//
+// Writes to reactive values made by the effect itself do not re-run it, so an
+// effect may write a ref it also reads without looping.
 func WatchEffect(effect func()) WatchCleanup {
 	// Create effect state
 	e := &watchEffect{
@@ -77,7 +81,7 @@ type watchEffect struct {
 	running   bool
 	stopped   bool
 	settingUp bool                                // Flag to prevent re-runs during initial setup
-	watchers  map[Dependency]*invalidationWatcher // Track watchers to avoid duplicates
+	watchers  map[Dependency]*invalidationWatcher // Every watcher registered, active or not
 }
 
 // run executes the effect and tracks dependencies
@@ -195,30 +199,23 @@ func (e *watchEffect) run() {
 		return
 	}
 
-	// Track new dependencies
-	newWatchers := make(map[Dependency]*invalidationWatcher)
-
+	// Only dependencies read in this run may re-run the effect. Watchers of
+	// dependencies no longer read stay registered as dependents (there is no
+	// RemoveDependent), but are deactivated and reused if read again.
+	for _, iw := range e.watchers {
+		iw.active = false
+	}
 	for _, dep := range deps {
-		// Check if we already have a watcher for this dependency
-		if iw, exists := e.watchers[dep]; exists {
-			// Reuse existing watcher
-			newWatchers[dep] = iw
-		} else {
-			// Create new watcher for this dependency
-			iw := &invalidationWatcher{effect: e}
-			newWatchers[dep] = iw
-
-			// Add ourselves as a dependent
+		iw, exists := e.watchers[dep]
+		if !exists {
+			// Create new watcher for this dependency and add it as a dependent
+			iw = &invalidationWatcher{effect: e}
+			e.watchers[dep] = iw
 			dep.AddDependent(iw)
 		}
+		iw.active = true
 	}
 
-	// Replace watchers map with new one
-	// Old watchers that are no longer needed will remain registered as dependents
-	// (we can't remove them without a RemoveDependent method)
-	// but they won't be in our map, so we won't track them
-	e.watchers = newWatchers
-
 	e.running = false
 	e.settingUp = false // Allow future re-runs
 }
@@ -226,6 +223,7 @@ func (e *watchEffect) run() {
 // invalidationWatcher implements Dependency interface to receive invalidation notifications
 type invalidationWatcher struct {
 	effect *watchEffect
+	active bool // Whether the last run read the dependency (guarded by effect.mu)
 }
 
 // Get implements Dependency interface (returns nil for watchers)
@@ -236,7 +234,13 @@ func (iw *invalidationWatcher) Get() any {
 
 // Invalidate is called when a watched dependency changes
 func (iw *invalidationWatcher) Invalidate() {
-	iw.effect.run()
+	iw.effect.mu.Lock()
+	active := iw.active
+	iw.effect.mu.Unlock()
+
+	if active {
+		iw.effect.run()
+	}
 }
 
 // AddDependent implements Dependency interface (no-op for watchers)
@@ -155,14 +155,44 @@ func TestWatchEffect_ConditionalDependencies(t *testing.T) {
 		assert.Equal(t, 4, callCount)
 		assert.Equal(t, 300, result)
 
-		// Note: valueA changes will still trigger because we can't remove old dependents
-		// This is a known limitation - old watchers remain registered
-		// The effect will re-run but won't use valueA, which is acceptable
+		// valueA is no longer read, so its changes don't trigger
 		valueA.Set(99)
-		assert.GreaterOrEqual(t, callCount, 4, "May trigger due to old watcher (known limitation)")
+		assert.Equal(t, 4, callCount, "Should not trigger for dropped dependency")
+
+		// Switching back tracks valueA again
+		toggle.Set(true)
+		assert.Equal(t, 5, callCount)
+		assert.Equal(t, 99, result)
+		valueA.Set(100)
+		assert.Equal(t, 6, callCount)
+		valueB.Set(400)
+		assert.Equal(t, 6, callCount)
 	})
 }
 
+// TestWatchEffect_WritesReadRef tests that an effect writing a ref it reads
+// does not loop
+func TestWatchEffect_WritesReadRef(t *testing.T) {
+	count := NewRef(0)
+	var callCount int
+
+	cleanup := WatchEffect(func() {
+		callCount++
+		if value := count.GetTyped(); value < 10 {
+			count.Set(value + 1)
+		}
+	})
+	defer cleanup()
+
+	assert.Equal(t, 1, callCount)
+	assert.Equal(t, 1, count.GetTyped())
+
+	// An outside write re-runs the effect once
+	count.Set(5)
+	assert.Equal(t, 2, callCount)
+	assert.Equal(t, 6, count.GetTyped())
+}
+
 // TestWatchEffect_Cleanup tests cleanup functionality
 func TestWatchEffect_Cleanup(t *testing.T) {
 	t.Run("cleanup stops effect", func(t *testing.T) {
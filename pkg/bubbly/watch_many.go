@@ -0,0 +1,122 @@
+package bubbly
+
+// WatchSource is a reactive value of any type that WatchMany can observe.
+// Ref[T], Computed[T], and RingRef[T] implement it, so sources of different
+// types can be watched together.
+type WatchSource interface {
+	// watchUntyped registers onChange to run whenever the value changes
+	// (internal method)
+	watchUntyped(onChange func(), opts WatchOptions) WatchCleanup
+}
+
+// watchUntyped attaches a synchronous watcher calling onChange to source.
+// Deep watching applies per source, using opts.DeepCompare for sources of
+// the matching type and reflect.DeepEqual for the others.
+func watchUntyped[T any](source Watchable[T], onChange func(), opts WatchOptions) WatchCleanup {
+	w := &watcher[T]{
+		callback: func(_, _ T) { onChange() },
+		options: WatchOptions{
+			Flush:       "sync",
+			Deep:        opts.Deep,
+			DeepCompare: opts.DeepCompare,
+		},
+	}
+	if opts.Deep {
+		currentVal := source.GetTyped()
+		w.prevValue = &currentVal
+	}
+
+	source.addWatcher(w)
+	return func() {
+		source.removeWatcher(w)
+	}
+}
+
+// watchUntyped implements WatchSource.
+func (r *Ref[T]) watchUntyped(onChange func(), opts WatchOptions) WatchCleanup {
+	return watchUntyped[T](r, onChange, opts)
+}
+
+// watchUntyped implements WatchSource.
+func (c *Computed[T]) watchUntyped(onChange func(), opts WatchOptions) WatchCleanup {
+	return watchUntyped[T](c, onChange, opts)
+}
+
+// watchUntyped implements WatchSource.
+func (r *RingRef[T]) watchUntyped(onChange func(), opts WatchOptions) WatchCleanup {
+	return watchUntyped[[]T](r, onChange, opts)
+}
+
+// manyWatcher identifies a WatchMany call in the post-flush queue, so
+// changes to any of its sources share one queued callback.
+type manyWatcher struct {
+	callback func()
+}
+
+// WatchMany creates a watcher that executes callback whenever any of the
+// sources changes, for effects that depend on several values. It returns a
+// single cleanup function that stops watching all of them.
+//
+// The callback takes no arguments; read the sources' current values inside
+// it. The options of Watch apply:
+//   - WithFlush("sync") (default): callback runs once per change, right after it
+//   - WithFlush("post"): changes are queued until FlushWatchers, and any
+//     number of changes across the sources run callback once
+//   - WithImmediate(): callback also runs once when the watcher is created
+//   - WithDeep() / WithDeepCompare(): a source only counts as changed when
+//     its value differs deeply from the previous one (a custom comparator
+//     applies to the sources of its type)
+//
+// Example:
+//
+//	firstName := NewRef("Ada")
+//	lastName := NewRef("Lovelace")
+//	age := NewRef(36)
+//
+//	cleanup := WatchMany([]WatchSource{firstName, lastName, age}, func() {
+//	    fmt.Printf("%s %s (%d)\n", firstName.GetTyped(), lastName.GetTyped(), age.GetTyped())
+//	}, WithFlush("post"))
+//	defer cleanup()
+//
+//	firstName.Set("Grace")
+//	lastName.Set("Hopper")
+//	FlushWatchers() // Prints once: Grace Hopper (36)
+func WatchMany(sources []WatchSource, callback func(), options ...WatchOption) WatchCleanup {
+	// Validate callback is not nil
+	if callback == nil {
+		panic(ErrNilCallback)
+	}
+
+	// Build options
+	opts := WatchOptions{
+		Flush: "sync", // Default to synchronous execution
+	}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	mw := &manyWatcher{callback: callback}
+	onChange := callback
+	if opts.Flush == "post" {
+		onChange = func() {
+			globalScheduler.enqueue(mw, mw.callback)
+		}
+	}
+
+	cleanups := make([]WatchCleanup, 0, len(sources))
+	for _, source := range sources {
+		if source != nil {
+			cleanups = append(cleanups, source.watchUntyped(onChange, opts))
+		}
+	}
+
+	if opts.Immediate {
+		callback()
+	}
+
+	return func() {
+		for _, cleanup := range cleanups {
+			cleanup()
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package bubbly
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWatchMany_FiresOnAnySource tests that a change to any source runs the callback
+func TestWatchMany_FiresOnAnySource(t *testing.T) {
+	name := NewRef("Ada")
+	age := NewRef(36)
+	tags := NewRingRef[string](3)
+	initials := NewComputed(func() string { return name.GetTyped()[:1] })
+
+	calls := 0
+	cleanup := WatchMany([]WatchSource{name, age, tags, initials}, func() { calls++ })
+	defer cleanup()
+
+	age.Set(37)
+	assert.Equal(t, 1, calls)
+
+	tags.Push("math")
+	assert.Equal(t, 2, calls)
+
+	// name changes both name and initials
+	name.Set("Grace")
+	assert.Equal(t, 4, calls)
+}
+
+// TestWatchMany_PostFlushCoalesces tests that changes across sources run the
+// callback once per flush
+func TestWatchMany_PostFlushCoalesces(t *testing.T) {
+	first := NewRef("Ada")
+	last := NewRef("Lovelace")
+	FlushWatchers()
+
+	var seen []string
+	cleanup := WatchMany([]WatchSource{first, last}, func() {
+		seen = append(seen, first.GetTyped()+" "+last.GetTyped())
+	}, WithFlush("post"))
+	defer cleanup()
+
+	first.Set("Grace")
+	last.Set("Hopper")
+	first.Set("Grace B.")
+	assert.Empty(t, seen)
+	assert.Equal(t, 1, PendingCallbacks())
+
+	FlushWatchers()
+	assert.Equal(t, []string{"Grace B. Hopper"}, seen)
+}
+
+// TestWatchMany_Options tests the immediate and deep options
+func TestWatchMany_Options(t *testing.T) {
+	t.Run("immediate runs once on creation", func(t *testing.T) {
+		calls := 0
+		cleanup := WatchMany([]WatchSource{NewRef(1), NewRef(2)}, func() { calls++ }, WithImmediate())
+		defer cleanup()
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("deep ignores equal values", func(t *testing.T) {
+		items := NewRef([]int{1, 2})
+		count := NewRef(0)
+
+		calls := 0
+		cleanup := WatchMany([]WatchSource{items, count}, func() { calls++ }, WithDeep())
+		defer cleanup()
+
+		items.Set([]int{1, 2})
+		assert.Equal(t, 0, calls)
+		items.Set([]int{1, 2, 3})
+		assert.Equal(t, 1, calls)
+	})
+}
+
+// TestWatchMany_Cleanup tests that cleanup detaches from every source
+func TestWatchMany_Cleanup(t *testing.T) {
+	a := NewRef(0)
+	b := NewRef(0)
+
+	calls := 0
+	cleanup := WatchMany([]WatchSource{a, b}, func() { calls++ })
+	cleanup()
+
+	a.Set(1)
+	b.Set(1)
+	assert.Equal(t, 0, calls)
+}
+
+// TestWatchMany_NilCallback tests that a nil callback panics like Watch
+func TestWatchMany_NilCallback(t *testing.T) {
+	assert.PanicsWithValue(t, ErrNilCallback, func() {
+		WatchMany([]WatchSource{NewRef(0)}, nil)
+	})
+}
@@ -206,6 +206,11 @@ func (m *autoWrapperModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Route mouse events to the component under the pointer
+	if mouseMsg, ok := msg.(tea.MouseMsg); ok {
+		DispatchMouse(mouseMsg)
+	}
+
 	// Forward message to component
 	updated, cmd := m.component.Update(msg)
 
@@ -240,8 +245,9 @@ func (m *autoWrapperModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 //	view := model.View()
 //	// view contains the rendered component UI (+ DevTools if enabled)
 func (m *autoWrapperModel) View() string {
-	// Get component view
-	appView := m.component.View()
+	// Get component view with teleported overlays drawn on top, resolving
+	// mouse zone positions for hit-testing
+	appView := ScanMouseZones(RenderLayers(m.component.View()))
 
 	// Apply global view renderer if set (e.g., DevTools)
 	if globalViewRenderer != nil {
@@ -0,0 +1,62 @@
+package bubbly
+
+// WritableComputed is a Computed value that can also be set, like Vue's
+// writable computed. Reads behave exactly like a Computed (cached and
+// dependency-tracked); Set passes the value to a setter that typically
+// writes the underlying refs, which in turn invalidates the cache.
+//
+// Because it embeds *Computed[T], a WritableComputed can be watched,
+// read inside other computed values, and exposed to templates like any
+// Computed.
+//
+// Thread Safety:
+// WritableComputed is as safe for concurrent use as Computed; Set is as
+// safe as the setter.
+type WritableComputed[T any] struct {
+	*Computed[T]
+	set func(T)
+}
+
+// NewWritableComputed creates a computed value with a getter and a setter.
+//
+// get is evaluated lazily and cached like NewComputed's function. set is
+// called by Set and should write the refs get reads; it runs inside Batch,
+// so watchers of the refs and of the computed value see one change with the
+// final state rather than one per ref written.
+//
+// It panics with ErrNilComputeFn if get is nil and ErrNilCallback if set is
+// nil.
+//
+// Example:
+//
+//	first := bubbly.NewRef("Ada")
+//	last := bubbly.NewRef("Lovelace")
+//	fullName := bubbly.NewWritableComputed(
+//	    func() string { return first.GetTyped() + " " + last.GetTyped() },
+//	    func(name string) {
+//	        f, l, _ := strings.Cut(name, " ")
+//	        first.Set(f)
+//	        last.Set(l)
+//	    },
+//	)
+//
+//	fullName.Set("Grace Hopper") // first = "Grace", last = "Hopper"
+//	fullName.GetTyped()          // "Grace Hopper"
+func NewWritableComputed[T any](get func() T, set func(T), opts ...ComputedOption) *WritableComputed[T] {
+	if set == nil {
+		panic(ErrNilCallback)
+	}
+	return &WritableComputed[T]{
+		Computed: NewComputed(get, opts...),
+		set:      set,
+	}
+}
+
+// Set writes value through the setter. The computed value itself is not
+// assigned: the next read recomputes it from the refs the setter wrote, so
+// a setter that normalizes or rejects the value is reflected in reads.
+func (w *WritableComputed[T]) Set(value T) {
+	Batch(func() {
+		w.set(value)
+	})
+}
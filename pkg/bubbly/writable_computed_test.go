@@ -0,0 +1,88 @@
+package bubbly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFullName returns a writable "first last" computed over two refs.
+func newFullName(first, last *Ref[string]) *WritableComputed[string] {
+	return NewWritableComputed(
+		func() string { return first.GetTyped() + " " + last.GetTyped() },
+		func(name string) {
+			f, l, _ := strings.Cut(name, " ")
+			first.Set(f)
+			last.Set(l)
+		},
+	)
+}
+
+func TestWritableComputed_Set(t *testing.T) {
+	first := NewRef("Ada")
+	last := NewRef("Lovelace")
+	fullName := newFullName(first, last)
+
+	assert.Equal(t, "Ada Lovelace", fullName.GetTyped())
+
+	fullName.Set("Grace Hopper")
+	assert.Equal(t, "Grace", first.GetTyped())
+	assert.Equal(t, "Hopper", last.GetTyped())
+	assert.Equal(t, "Grace Hopper", fullName.GetTyped())
+}
+
+func TestWritableComputed_InvalidatesOnSourceChange(t *testing.T) {
+	first := NewRef("Ada")
+	last := NewRef("Lovelace")
+	calls := 0
+	fullName := NewWritableComputed(
+		func() string {
+			calls++
+			return first.GetTyped() + " " + last.GetTyped()
+		},
+		func(string) {},
+	)
+
+	assert.Equal(t, "Ada Lovelace", fullName.GetTyped())
+	assert.Equal(t, "Ada Lovelace", fullName.GetTyped())
+	assert.Equal(t, 1, calls, "reads should be cached")
+
+	last.Set("Byron")
+	assert.Equal(t, "Ada Byron", fullName.GetTyped())
+	assert.Equal(t, 2, calls)
+
+	// A setter that ignores the value leaves reads unchanged
+	fullName.Set("Grace Hopper")
+	assert.Equal(t, "Ada Byron", fullName.GetTyped())
+}
+
+func TestWritableComputed_WatchAndDependents(t *testing.T) {
+	first := NewRef("Ada")
+	last := NewRef("Lovelace")
+	fullName := newFullName(first, last)
+	greeting := NewComputed(func() string {
+		return "Hello, " + fullName.GetTyped()
+	})
+
+	var changes []string
+	defer Watch(fullName, func(newVal, oldVal string) {
+		changes = append(changes, oldVal+" -> "+newVal)
+	})()
+
+	assert.Equal(t, "Hello, Ada Lovelace", greeting.GetTyped())
+
+	fullName.Set("Grace Hopper")
+	assert.Equal(t, "Hello, Grace Hopper", greeting.GetTyped())
+	assert.Equal(t, []string{"Ada Lovelace -> Grace Hopper"}, changes,
+		"writes to both refs should notify once")
+}
+
+func TestNewWritableComputed_NilFunctions(t *testing.T) {
+	assert.PanicsWithValue(t, ErrNilComputeFn, func() {
+		NewWritableComputed(nil, func(int) {})
+	})
+	assert.PanicsWithValue(t, ErrNilCallback, func() {
+		NewWritableComputed(func() int { return 0 }, nil)
+	})
+}
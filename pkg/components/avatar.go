@@ -0,0 +1,117 @@
+package components
+
+import (
+	"hash/fnv"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// AvatarProps defines the configuration properties for an Avatar component.
+//
+// Example usage:
+//
+//	avatar := components.Avatar(components.AvatarProps{
+//	    Name: "Ada Lovelace",
+//	})
+type AvatarProps struct {
+	// Name is the person or entity the avatar represents.
+	// Its initials are displayed: the first letters of the first and last
+	// words ("Ada Lovelace" -> "AL"), or of the only word ("ada" -> "A").
+	// Required - an empty name renders "?".
+	Name string
+
+	// Color sets the avatar's background color.
+	// Optional - if not specified, a theme color is picked from a hash of
+	// Name, so the same name always gets the same color.
+	Color lipgloss.Color
+
+	// Common props for all components
+	CommonProps
+}
+
+// avatarInitials returns the uppercased initials of name.
+func avatarInitials(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return "?"
+	}
+
+	first := []rune(words[0])[0]
+	if len(words) == 1 {
+		return string(unicode.ToUpper(first))
+	}
+	last := []rune(words[len(words)-1])[0]
+	return string(unicode.ToUpper(first)) + string(unicode.ToUpper(last))
+}
+
+// avatarColor picks a theme color for name, stable across renders and runs.
+func avatarColor(name string, theme Theme) lipgloss.Color {
+	palette := []lipgloss.Color{
+		theme.Primary,
+		theme.Secondary,
+		theme.Success,
+		theme.Warning,
+		theme.Danger,
+		theme.Info,
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return palette[h.Sum32()%uint32(len(palette))]
+}
+
+// Avatar creates a new Avatar atom component.
+//
+// Avatar renders a name's initials on a colored background, for user lists,
+// comment threads, and detail panes.
+//
+// The avatar component automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	avatar := components.Avatar(components.AvatarProps{
+//	    Name:  "Grace Hopper",
+//	    Color: lipgloss.Color("99"),
+//	})
+//
+//	avatar.Init()
+//	view := avatar.View() // " GH " on purple
+func Avatar(props AvatarProps) bubbly.Component {
+	component, err := bubbly.NewComponent("Avatar").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(AvatarProps)
+			theme := ctx.Get("theme").(Theme)
+
+			bgColor := p.Color
+			if bgColor == "" {
+				bgColor = avatarColor(p.Name, theme)
+			}
+
+			style := lipgloss.NewStyle().
+				Padding(0, 1).
+				Bold(true).
+				Background(bgColor).
+				Foreground(lipgloss.Color("230")) // Light text for contrast
+			if p.Style != nil {
+				style = style.Inherit(*p.Style)
+			}
+
+			return style.Render(avatarInitials(p.Name))
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
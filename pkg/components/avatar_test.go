@@ -0,0 +1,43 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvatar_Initials(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "first and last name", input: "Ada Lovelace", expected: "AL"},
+		{name: "middle names skipped", input: "Grace Brewster Murray Hopper", expected: "GH"},
+		{name: "single word", input: "ada", expected: "A"},
+		{name: "extra whitespace", input: "  linus   torvalds ", expected: "LT"},
+		{name: "unicode", input: "émile zola", expected: "ÉZ"},
+		{name: "empty", input: "", expected: "?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			avatar := Avatar(AvatarProps{Name: tt.input})
+			avatar.Init()
+
+			assert.Equal(t, tt.expected, avatarInitials(tt.input))
+			assert.Contains(t, ansi.Strip(avatar.View()), tt.expected)
+		})
+	}
+}
+
+func TestAvatar_Color(t *testing.T) {
+	assert.Equal(t, avatarColor("Ada Lovelace", DefaultTheme), avatarColor("Ada Lovelace", DefaultTheme),
+		"the same name should always get the same color")
+
+	avatar := Avatar(AvatarProps{Name: "Ada", Color: lipgloss.Color("99")})
+	avatar.Init()
+	assert.Contains(t, ansi.Strip(avatar.View()), "A")
+}
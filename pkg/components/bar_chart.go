@@ -0,0 +1,185 @@
+package components
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// barChartPartials are the fractional cell widths used by BarChart, from
+// 1/8 to a full cell.
+var barChartPartials = []rune("▏▎▍▌▋▊▉█")
+
+// Bar is a single labelled value of a BarChart.
+type Bar struct {
+	// Label names the bar (e.g., "web-01").
+	Label string
+
+	// Value is the bar length in data units. Negative values render as
+	// an empty bar.
+	Value float64
+
+	// Color overrides the chart's bar color for this bar.
+	// Optional - defaults to BarChartProps.Color.
+	Color lipgloss.Color
+}
+
+// BarChartProps defines the configuration properties for a BarChart component.
+//
+// Example usage:
+//
+//	chart := components.BarChart(components.BarChartProps{
+//	    Bars: []components.Bar{
+//	        {Label: "GET", Value: 120},
+//	        {Label: "POST", Value: 45},
+//	    },
+//	    ShowValues: true,
+//	})
+type BarChartProps struct {
+	// Bars are the rows to display, in order.
+	// Ignored when Source is set.
+	Bars []Bar
+
+	// Source supplies the bars reactively, re-read on every render.
+	// Optional - takes precedence over Bars.
+	Source bubbly.Watchable[[]Bar]
+
+	// Width is the maximum bar length in characters.
+	// Optional - defaults to 20.
+	Width int
+
+	// Max is the value drawn as a full-width bar. Larger values are clamped.
+	// Optional - defaults to the largest value.
+	Max float64
+
+	// ShowValues appends each bar's value after the bar.
+	// Default: false.
+	ShowValues bool
+
+	// Color sets the foreground color of the bars.
+	// Optional - if not specified, uses theme primary color.
+	Color lipgloss.Color
+
+	// Common props for all components
+	CommonProps
+}
+
+// barChartRender renders one labelled horizontal bar per row.
+func barChartRender(props BarChartProps, theme Theme) string {
+	bars := props.Bars
+	if props.Source != nil {
+		bars = props.Source.GetTyped()
+	}
+	if len(bars) == 0 {
+		return ""
+	}
+
+	width := props.Width
+	if width <= 0 {
+		width = 20
+	}
+
+	maxValue := props.Max
+	labelWidth := 0
+	for _, bar := range bars {
+		if props.Max <= 0 {
+			maxValue = math.Max(maxValue, bar.Value)
+		}
+		labelWidth = max(labelWidth, bubbly.VisibleWidth(bar.Label))
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Foreground)
+	valueStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	defaultColor := props.Color
+	if defaultColor == "" {
+		defaultColor = theme.Primary
+	}
+
+	rows := make([]string, 0, len(bars))
+	for _, bar := range bars {
+		color := bar.Color
+		if color == "" {
+			color = defaultColor
+		}
+
+		row := labelStyle.Render(bubbly.FitVisible(bar.Label, labelWidth)) + " " +
+			lipgloss.NewStyle().Foreground(color).Render(barChartBar(bar.Value, maxValue, width))
+		if props.ShowValues {
+			row += " " + valueStyle.Render(strconv.FormatFloat(bar.Value, 'f', -1, 64))
+		}
+		rows = append(rows, row)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// barChartBar draws value scaled to width cells at 1/8-cell resolution,
+// padded to width so values line up.
+func barChartBar(value, maxValue float64, width int) string {
+	eighths := 0
+	if maxValue > 0 && value > 0 {
+		eighths = int(math.Round(math.Min(value, maxValue) / maxValue * float64(width*8)))
+	}
+
+	full, rest := eighths/8, eighths%8
+	bar := strings.Repeat(string(barChartPartials[7]), full)
+	if rest > 0 {
+		bar += string(barChartPartials[rest-1])
+		full++
+	}
+	return bar + strings.Repeat(" ", width-full)
+}
+
+// BarChart creates a new BarChart atom component.
+//
+// BarChart draws labelled horizontal bars scaled to a common maximum, for
+// comparing quantities such as per-server load or request counts. Labels
+// are aligned in a column, and bar ends use fractional block characters
+// for smooth scaling. An empty chart renders nothing.
+//
+// The component automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	chart := components.BarChart(components.BarChartProps{
+//	    Bars: []components.Bar{
+//	        {Label: "web-01", Value: 75},
+//	        {Label: "web-02", Value: 30, Color: theme.Success},
+//	    },
+//	    Width:      10,
+//	    Max:        100,
+//	    ShowValues: true,
+//	})
+//
+//	chart.Init()
+//	// web-01 ███████▌   75
+//	// web-02 ███        30
+func BarChart(props BarChartProps) bubbly.Component {
+	component, err := bubbly.NewComponent("BarChart").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(BarChartProps)
+			theme := ctx.Get("theme").(Theme)
+
+			content := barChartRender(p, theme)
+			if p.Style != nil {
+				content = p.Style.Render(content)
+			}
+			return content
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
@@ -0,0 +1,72 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+func TestBarChart_Rendering(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    BarChartProps
+		expected []string
+	}{
+		{
+			name: "scaled to largest value",
+			props: BarChartProps{
+				Bars:  []Bar{{Label: "a", Value: 8}, {Label: "bb", Value: 4}},
+				Width: 4,
+			},
+			expected: []string{"a  ████", "bb ██  "},
+		},
+		{
+			name: "fractional cells and values",
+			props: BarChartProps{
+				Bars:       []Bar{{Label: "web", Value: 75}, {Label: "db", Value: 0}},
+				Width:      10,
+				Max:        100,
+				ShowValues: true,
+			},
+			expected: []string{"web ███████▌   75", "db             0"},
+		},
+		{
+			name: "clamps and ignores negatives",
+			props: BarChartProps{
+				Bars:  []Bar{{Label: "hi", Value: 200}, {Label: "lo", Value: -5}},
+				Width: 2,
+				Max:   100,
+			},
+			expected: []string{"hi ██", "lo   "},
+		},
+		{
+			name:     "empty",
+			props:    BarChartProps{},
+			expected: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chart := BarChart(tt.props)
+			chart.Init()
+
+			assert.Equal(t, tt.expected, strings.Split(ansi.Strip(chart.View()), "\n"))
+		})
+	}
+}
+
+func TestBarChart_ReactiveSource(t *testing.T) {
+	bars := bubbly.NewRef([]Bar{{Label: "x", Value: 1}})
+	chart := BarChart(BarChartProps{Source: bars, Width: 2})
+	chart.Init()
+
+	assert.Equal(t, "x ██", ansi.Strip(chart.View()))
+
+	bars.Set([]Bar{{Label: "x", Value: 1}, {Label: "y", Value: 2}})
+	assert.Equal(t, "x █ \ny ██", ansi.Strip(chart.View()))
+}
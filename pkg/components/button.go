@@ -141,37 +141,26 @@ func Button(props ButtonProps) bubbly.Component {
 			// Get variant color from theme
 			variantColor := theme.GetVariantColor(Variant(props.Variant))
 
-			// Build button style based on state
-			buttonStyle := lipgloss.NewStyle().
-				Padding(0, 2).
-				Bold(true)
-
-			// Style based on disabled state
+			borderColor := variantColor
 			if props.Disabled {
-				// Disabled state: muted colors, no background
-				buttonStyle = buttonStyle.
-					Foreground(theme.Muted)
-
-				// Add border unless NoBorder is true
-				if !props.NoBorder {
-					buttonStyle = buttonStyle.
-						Border(theme.GetBorderStyle()).
-						BorderForeground(theme.Muted)
-				}
-			} else {
-				// Enabled state: variant colors with background
-				buttonStyle = buttonStyle.
-					Foreground(lipgloss.Color("230")). // Light text
-					Background(variantColor)
-
-				// Add border unless NoBorder is true
-				if !props.NoBorder {
-					buttonStyle = buttonStyle.
-						Border(theme.GetBorderStyle()).
-						BorderForeground(variantColor)
-				}
+				borderColor = theme.Muted
 			}
 
+			// Build button style based on state:
+			// disabled buttons use muted colors with no background,
+			// enabled buttons use variant colors with background
+			buttonStyle := bubbly.MergeStyles(
+				lipgloss.NewStyle().Padding(0, 2).Bold(true),
+				bubbly.StyleIf(props.Disabled, lipgloss.NewStyle().
+					Foreground(theme.Muted)),
+				bubbly.StyleIf(!props.Disabled, lipgloss.NewStyle().
+					Foreground(lipgloss.Color("230")). // Light text
+					Background(variantColor)),
+				bubbly.StyleIf(!props.NoBorder, lipgloss.NewStyle().
+					Border(theme.GetBorderStyle()).
+					BorderForeground(borderColor)),
+			)
+
 			// Apply custom style if provided
 			if props.Style != nil {
 				// Custom style overrides
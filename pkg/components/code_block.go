@@ -0,0 +1,436 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// CodeOverflow controls how a CodeBlock handles lines wider than its Width.
+type CodeOverflow string
+
+// CodeOverflow modes.
+const (
+	// CodeOverflowClip cuts long lines at Width (the default).
+	CodeOverflowClip CodeOverflow = "clip"
+
+	// CodeOverflowWrap wraps long lines onto continuation lines.
+	CodeOverflowWrap CodeOverflow = "wrap"
+
+	// CodeOverflowScroll shows a horizontal window of Width columns that moves
+	// with the scrollLeft and scrollRight events.
+	CodeOverflowScroll CodeOverflow = "scroll"
+)
+
+// codeScrollStep is the number of columns moved per horizontal scroll event.
+const codeScrollStep = 4
+
+// CodeBlockProps defines the configuration properties for a CodeBlock component.
+//
+// Example usage:
+//
+//	code := components.CodeBlock(components.CodeBlockProps{
+//	    Code:            source,
+//	    Language:        "go",
+//	    Width:           80,
+//	    ShowLineNumbers: true,
+//	})
+type CodeBlockProps struct {
+	// Code is the source code to display.
+	// Required - an empty string renders nothing.
+	Code string
+
+	// Language selects the highlighting rules (e.g., "go", "python", "js", "json").
+	// Unknown or empty languages render without keyword highlighting.
+	// Optional - defaults to plain text.
+	Language string
+
+	// Width is the maximum width of the code area in characters, excluding
+	// line numbers. Lines wider than Width are handled according to Overflow.
+	// Optional - if 0, lines are never cut or wrapped.
+	Width int
+
+	// ShowLineNumbers displays a line number gutter.
+	// Default: false.
+	ShowLineNumbers bool
+
+	// Overflow controls long line handling when Width is set.
+	// Optional - defaults to CodeOverflowClip.
+	Overflow CodeOverflow
+
+	// ScrollX is a reactive reference to the horizontal scroll offset used
+	// with CodeOverflowScroll.
+	// Optional - an internal Ref is created if nil.
+	ScrollX *bubbly.Ref[int]
+
+	// Common props for all components
+	CommonProps
+}
+
+// codeTokenKind classifies a highlighted token.
+type codeTokenKind int
+
+const (
+	codeTokenText codeTokenKind = iota
+	codeTokenKeyword
+	codeTokenType
+	codeTokenString
+	codeTokenNumber
+	codeTokenComment
+)
+
+// codeToken is a run of source text with a single highlight kind.
+type codeToken struct {
+	kind codeTokenKind
+	text string
+}
+
+// codeLanguage describes the lexical rules for a language.
+type codeLanguage struct {
+	keywords     map[string]bool
+	types        map[string]bool
+	lineComments []string
+	blockComment [2]string
+	quotes       string
+}
+
+// codeWords builds a lookup set from a space-separated word list.
+func codeWords(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// codeLanguages maps language names and aliases to highlighting rules.
+var codeLanguages = func() map[string]*codeLanguage {
+	goLang := &codeLanguage{
+		keywords: codeWords("break case chan const continue default defer else fallthrough for func go goto if " +
+			"import interface map package range return select struct switch type var nil true false iota"),
+		types: codeWords("bool byte complex64 complex128 error float32 float64 int int8 int16 int32 int64 rune " +
+			"string uint uint8 uint16 uint32 uint64 uintptr any comparable"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+	}
+	python := &codeLanguage{
+		keywords: codeWords("and as assert async await break class continue def del elif else except finally for " +
+			"from global if import in is lambda nonlocal not or pass raise return try while with yield None True False"),
+		types:        codeWords("int float str bool list dict set tuple bytes object self"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+	javascript := &codeLanguage{
+		keywords: codeWords("async await break case catch class const continue debugger default delete do else " +
+			"export extends finally for from function if import in instanceof let new of return super switch this " +
+			"throw try typeof var void while yield null undefined true false"),
+		types:        codeWords("string number boolean any unknown never object interface type enum"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+	}
+	rust := &codeLanguage{
+		keywords: codeWords("as async await break const continue crate else enum extern fn for if impl in let loop " +
+			"match mod move mut pub ref return self Self static struct super trait type unsafe use where while true false"),
+		types: codeWords("i8 i16 i32 i64 i128 isize u8 u16 u32 u64 u128 usize f32 f64 bool char str String " +
+			"Vec Option Result Box"),
+		lineComments: []string{"//"},
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"",
+	}
+	shell := &codeLanguage{
+		keywords: codeWords("if then else elif fi for while until do done case esac in function return local " +
+			"export echo exit"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+	data := &codeLanguage{
+		keywords:     codeWords("true false null"),
+		lineComments: []string{"#"},
+		quotes:       "\"'",
+	}
+
+	return map[string]*codeLanguage{
+		"go":         goLang,
+		"golang":     goLang,
+		"python":     python,
+		"py":         python,
+		"javascript": javascript,
+		"js":         javascript,
+		"typescript": javascript,
+		"ts":         javascript,
+		"rust":       rust,
+		"rs":         rust,
+		"bash":       shell,
+		"sh":         shell,
+		"shell":      shell,
+		"json":       data,
+		"yaml":       data,
+		"yml":        data,
+		"toml":       data,
+	}
+}()
+
+// codeHighlighter tokenizes source lines, tracking block comments across lines.
+type codeHighlighter struct {
+	lang           *codeLanguage
+	inBlockComment bool
+}
+
+// tokenize splits a single line into highlight tokens.
+//
+//nolint:gocyclo // Lexing is a single linear scan over the line
+func (h *codeHighlighter) tokenize(line string) []codeToken {
+	if h.lang == nil {
+		return []codeToken{{kind: codeTokenText, text: line}}
+	}
+
+	var tokens []codeToken
+	emit := func(kind codeTokenKind, text string) {
+		if text == "" {
+			return
+		}
+		if n := len(tokens); n > 0 && tokens[n-1].kind == kind {
+			tokens[n-1].text += text
+			return
+		}
+		tokens = append(tokens, codeToken{kind: kind, text: text})
+	}
+
+	open, closing := h.lang.blockComment[0], h.lang.blockComment[1]
+	i := 0
+	for i < len(line) {
+		rest := line[i:]
+
+		// Continue or start a block comment
+		if h.inBlockComment {
+			end := strings.Index(rest, closing)
+			if end < 0 {
+				emit(codeTokenComment, rest)
+				return tokens
+			}
+			emit(codeTokenComment, rest[:end+len(closing)])
+			i += end + len(closing)
+			h.inBlockComment = false
+			continue
+		}
+		if open != "" && strings.HasPrefix(rest, open) {
+			h.inBlockComment = true
+			emit(codeTokenComment, open)
+			i += len(open)
+			continue
+		}
+
+		// Line comments run to the end of the line
+		isComment := false
+		for _, marker := range h.lang.lineComments {
+			if strings.HasPrefix(rest, marker) {
+				isComment = true
+				break
+			}
+		}
+		if isComment {
+			emit(codeTokenComment, rest)
+			return tokens
+		}
+
+		ch := rest[0]
+		switch {
+		case strings.IndexByte(h.lang.quotes, ch) >= 0:
+			end := 1
+			for end < len(rest) && rest[end] != ch {
+				if rest[end] == '\\' && ch != '`' {
+					end++
+				}
+				end++
+			}
+			if end < len(rest) {
+				end++
+			} else {
+				end = len(rest)
+			}
+			emit(codeTokenString, rest[:end])
+			i += end
+
+		case ch >= '0' && ch <= '9':
+			end := 0
+			for end < len(rest) && (isCodeWordByte(rest[end]) || rest[end] == '.') {
+				end++
+			}
+			emit(codeTokenNumber, rest[:end])
+			i += end
+
+		case isCodeWordByte(ch):
+			end := 0
+			for end < len(rest) && isCodeWordByte(rest[end]) {
+				end++
+			}
+			word := rest[:end]
+			switch {
+			case h.lang.keywords[word]:
+				emit(codeTokenKeyword, word)
+			case h.lang.types[word]:
+				emit(codeTokenType, word)
+			default:
+				emit(codeTokenText, word)
+			}
+			i += end
+
+		default:
+			emit(codeTokenText, rest[:1])
+			i++
+		}
+	}
+	return tokens
+}
+
+// isCodeWordByte reports whether b can appear in an identifier.
+// Non-ASCII bytes are treated as identifier characters so multi-byte runes stay intact.
+func isCodeWordByte(b byte) bool {
+	return b == '_' || b >= 0x80 || unicode.IsLetter(rune(b)) || unicode.IsDigit(rune(b))
+}
+
+// codeBlockStyles returns the token styles for a theme.
+func codeBlockStyles(theme Theme) map[codeTokenKind]lipgloss.Style {
+	return map[codeTokenKind]lipgloss.Style{
+		codeTokenText:    lipgloss.NewStyle().Foreground(theme.Foreground),
+		codeTokenKeyword: lipgloss.NewStyle().Foreground(theme.Primary).Bold(true),
+		codeTokenType:    lipgloss.NewStyle().Foreground(theme.Info),
+		codeTokenString:  lipgloss.NewStyle().Foreground(theme.Success),
+		codeTokenNumber:  lipgloss.NewStyle().Foreground(theme.Warning),
+		codeTokenComment: lipgloss.NewStyle().Foreground(theme.Muted).Italic(true),
+	}
+}
+
+// highlightCode returns the highlighted lines of code for a language.
+func highlightCode(code, language string, theme Theme) []string {
+	h := &codeHighlighter{lang: codeLanguages[strings.ToLower(language)]}
+	styles := codeBlockStyles(theme)
+
+	lines := strings.Split(strings.ReplaceAll(code, "\t", "    "), "\n")
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		var b strings.Builder
+		for _, tok := range h.tokenize(line) {
+			b.WriteString(styles[tok.kind].Render(tok.text))
+		}
+		out[i] = b.String()
+	}
+	return out
+}
+
+// codeBlockFitLine applies the overflow mode to a highlighted line and returns
+// one or more display lines.
+func codeBlockFitLine(line string, width, scrollX int, overflow CodeOverflow) []string {
+	if width <= 0 || (overflow != CodeOverflowScroll && ansi.StringWidth(line) <= width) {
+		return []string{line}
+	}
+	switch overflow {
+	case CodeOverflowWrap:
+		return strings.Split(ansi.Hardwrap(line, width, true), "\n")
+	case CodeOverflowScroll:
+		return []string{ansi.Cut(line, scrollX, scrollX+width)}
+	default:
+		return []string{ansi.Truncate(line, width, "")}
+	}
+}
+
+// codeBlockMaxWidth returns the widest line of code, after tab expansion.
+func codeBlockMaxWidth(code string) int {
+	return lipgloss.Width(strings.ReplaceAll(code, "\t", "    "))
+}
+
+// CodeBlock creates a syntax-highlighted code display component.
+//
+// Highlighting is token based: keywords, built-in types, strings, numbers,
+// and comments are colored from the injected theme. Supported languages
+// include Go, Python, JavaScript/TypeScript, Rust, shell, JSON, YAML, and
+// TOML; other languages render as plain text.
+//
+// Long lines are clipped, wrapped, or horizontally scrolled depending on
+// Overflow. In scroll mode the component handles "scrollLeft" and
+// "scrollRight" events, and the offset is available via the ScrollX prop.
+//
+// Example:
+//
+//	code := components.CodeBlock(components.CodeBlockProps{
+//	    Code:            snippet,
+//	    Language:        "python",
+//	    Width:           60,
+//	    ShowLineNumbers: true,
+//	    Overflow:        components.CodeOverflowScroll,
+//	})
+//
+//	// Forward arrow keys from the parent
+//	ctx.On("right", func(_ interface{}) { code.Emit("scrollRight", nil) })
+func CodeBlock(props CodeBlockProps) bubbly.Component {
+	if props.ScrollX == nil {
+		props.ScrollX = bubbly.NewRef(0)
+	}
+
+	component, _ := bubbly.NewComponent("CodeBlock").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+			ctx.Expose("scrollX", props.ScrollX)
+
+			maxScroll := func() int {
+				if props.Width <= 0 {
+					return 0
+				}
+				if m := codeBlockMaxWidth(props.Code) - props.Width; m > 0 {
+					return m
+				}
+				return 0
+			}
+			ctx.On("scrollLeft", func(_ interface{}) {
+				props.ScrollX.Set(max(0, props.ScrollX.GetTyped()-codeScrollStep))
+			})
+			ctx.On("scrollRight", func(_ interface{}) {
+				props.ScrollX.Set(min(maxScroll(), props.ScrollX.GetTyped()+codeScrollStep))
+			})
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(CodeBlockProps)
+			theme := ctx.Get("theme").(Theme)
+			scrollX := ctx.Get("scrollX").(*bubbly.Ref[int]).GetTyped()
+
+			if p.Code == "" {
+				return ""
+			}
+
+			lines := highlightCode(strings.TrimSuffix(p.Code, "\n"), p.Language, theme)
+			gutterWidth := len(fmt.Sprint(len(lines)))
+			gutterStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+
+			var out []string
+			for i, line := range lines {
+				for j, part := range codeBlockFitLine(line, p.Width, scrollX, p.Overflow) {
+					if p.ShowLineNumbers {
+						number := strings.Repeat(" ", gutterWidth)
+						if j == 0 {
+							number = fmt.Sprintf("%*d", gutterWidth, i+1)
+						}
+						part = gutterStyle.Render(number+" │ ") + part
+					}
+					out = append(out, part)
+				}
+			}
+
+			result := strings.Join(out, "\n")
+			if p.Style != nil {
+				result = p.Style.Render(result)
+			}
+			return result
+		}).
+		Build()
+
+	return component
+}
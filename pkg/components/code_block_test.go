@@ -0,0 +1,169 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestCodeHighlighter_Tokenize tests token classification.
+func TestCodeHighlighter_Tokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		line     string
+		expected []codeToken
+	}{
+		{
+			name:     "go keywords types and strings",
+			language: "go",
+			line:     `func f(s string) { return "x" }`,
+			expected: []codeToken{
+				{codeTokenKeyword, "func"},
+				{codeTokenText, " f(s "},
+				{codeTokenType, "string"},
+				{codeTokenText, ") { "},
+				{codeTokenKeyword, "return"},
+				{codeTokenText, " "},
+				{codeTokenString, `"x"`},
+				{codeTokenText, " }"},
+			},
+		},
+		{
+			name:     "line comment",
+			language: "python",
+			line:     "x = 42  # answer",
+			expected: []codeToken{
+				{codeTokenText, "x = "},
+				{codeTokenNumber, "42"},
+				{codeTokenText, "  "},
+				{codeTokenComment, "# answer"},
+			},
+		},
+		{
+			name:     "escaped quote stays in string",
+			language: "js",
+			line:     `'it\'s'`,
+			expected: []codeToken{{codeTokenString, `'it\'s'`}},
+		},
+		{
+			name:     "unknown language is plain",
+			language: "cobol",
+			line:     "func x",
+			expected: []codeToken{{codeTokenText, "func x"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &codeHighlighter{lang: codeLanguages[tt.language]}
+			assert.Equal(t, tt.expected, h.tokenize(tt.line))
+		})
+	}
+}
+
+// TestCodeHighlighter_BlockComment tests block comments spanning lines.
+func TestCodeHighlighter_BlockComment(t *testing.T) {
+	h := &codeHighlighter{lang: codeLanguages["go"]}
+
+	assert.Equal(t, []codeToken{{codeTokenText, "x "}, {codeTokenComment, "/* start"}}, h.tokenize("x /* start"))
+	assert.Equal(t, []codeToken{{codeTokenComment, "still comment"}}, h.tokenize("still comment"))
+	assert.Equal(t, []codeToken{{codeTokenComment, "end */"}, {codeTokenText, " "}, {codeTokenKeyword, "var"}}, h.tokenize("end */ var"))
+}
+
+// TestCodeBlock_Rendering tests line numbers and overflow handling.
+func TestCodeBlock_Rendering(t *testing.T) {
+	code := "package main\n\nfunc main() {}"
+
+	tests := []struct {
+		name     string
+		props    CodeBlockProps
+		expected string
+	}{
+		{
+			name:     "plain",
+			props:    CodeBlockProps{Code: code, Language: "go"},
+			expected: code,
+		},
+		{
+			name:     "line numbers",
+			props:    CodeBlockProps{Code: "a\nb", ShowLineNumbers: true},
+			expected: "1 │ a\n2 │ b",
+		},
+		{
+			name:     "clip",
+			props:    CodeBlockProps{Code: "abcdefgh", Width: 4},
+			expected: "abcd",
+		},
+		{
+			name:     "wrap",
+			props:    CodeBlockProps{Code: "abcdefgh", Width: 4, Overflow: CodeOverflowWrap},
+			expected: "abcd\nefgh",
+		},
+		{
+			name:     "wrap with line numbers leaves continuation gutter blank",
+			props:    CodeBlockProps{Code: "abcdef", Width: 3, Overflow: CodeOverflowWrap, ShowLineNumbers: true},
+			expected: "1 │ abc\n  │ def",
+		},
+		{
+			name:     "tabs expanded",
+			props:    CodeBlockProps{Code: "\tx"},
+			expected: "    x",
+		},
+		{
+			name:     "empty",
+			props:    CodeBlockProps{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cb := CodeBlock(tt.props)
+			cb.Init()
+			assert.Equal(t, tt.expected, ansi.Strip(cb.View()))
+		})
+	}
+}
+
+// TestCodeBlock_HorizontalScroll tests scroll events in scroll mode.
+func TestCodeBlock_HorizontalScroll(t *testing.T) {
+	scrollX := bubbly.NewRef(0)
+	cb := CodeBlock(CodeBlockProps{
+		Code:     "0123456789",
+		Width:    4,
+		Overflow: CodeOverflowScroll,
+		ScrollX:  scrollX,
+	})
+	cb.Init()
+	assert.Equal(t, "0123", ansi.Strip(cb.View()))
+
+	cb.Emit("scrollRight", nil)
+	assert.Equal(t, codeScrollStep, scrollX.GetTyped())
+	assert.Equal(t, "4567", ansi.Strip(cb.View()))
+
+	cb.Emit("scrollRight", nil)
+	assert.Equal(t, 6, scrollX.GetTyped(), "clamped to the widest line")
+
+	cb.Emit("scrollLeft", nil)
+	cb.Emit("scrollLeft", nil)
+	assert.Equal(t, 0, scrollX.GetTyped())
+}
+
+// TestCodeBlock_UsesThemeColors tests that token colors come from the theme.
+func TestCodeBlock_UsesThemeColors(t *testing.T) {
+	styles := codeBlockStyles(DefaultTheme)
+	assert.Equal(t, lipgloss.TerminalColor(DefaultTheme.Primary), styles[codeTokenKeyword].GetForeground())
+	assert.Equal(t, lipgloss.TerminalColor(DefaultTheme.Muted), styles[codeTokenComment].GetForeground())
+}
+
+// TestMarkdown_HighlightsFencedCode tests that markdown code fences use the highlighter.
+func TestMarkdown_HighlightsFencedCode(t *testing.T) {
+	r := &markdownRenderer{styles: MarkdownStyles{}, theme: DefaultTheme}
+	assert.Equal(t, "return 1", ansi.Strip(r.codeBlock([]string{"return 1"}, "go")))
+	assert.Equal(t, "x\ny", ansi.Strip(r.codeBlock([]string{"x", "y"}, "")))
+}
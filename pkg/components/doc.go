@@ -13,9 +13,9 @@ applications.
 
 Components are organized into four levels following atomic design principles:
 
-  - Atoms: Basic building blocks (Button, Text, Icon, Spacer, Badge, Spinner)
+  - Atoms: Basic building blocks (Button, Text, Icon, Spacer, Badge, Spinner, Sparkline, BarChart)
   - Molecules: Simple combinations (Input, Checkbox, Select, TextArea, Radio, Toggle)
-  - Organisms: Complex features (Form, Table, List, Modal, Card, Menu, Tabs, Accordion)
+  - Organisms: Complex features (Form, Table, List, Modal, Card, Menu, Tabs, Accordion, Stepper)
   - Templates: Layout structures (AppLayout, PageLayout, PanelLayout, GridLayout)
 
 # Quick Start
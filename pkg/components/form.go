@@ -1,6 +1,8 @@
 package components
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -27,6 +29,66 @@ type FormField struct {
 	Component bubbly.Component
 }
 
+// FormArrayField is a repeatable group of inputs in a form, such as a list
+// of phone numbers. Each item is rendered with its number, its validation
+// error, and a remove affordance, followed by an add affordance.
+//
+// Items are added and removed by emitting "addItem" and "removeItem" on the
+// form. Use OnAdd and OnRemove to keep form data in sync, for example with
+// UseForm's AddItem and RemoveItem.
+type FormArrayField struct {
+	// Name is the name of the slice field. Item errors are looked up under
+	// "Name[index]", the key produced by composables.FormItemKey.
+	// Required - must be unique within the form.
+	Name string
+
+	// Label is the display text shown above the items.
+	// Optional - if empty, no label is displayed.
+	Label string
+
+	// NewItem creates the input component for the item at index.
+	// Required - called for each initial item and on "addItem".
+	NewItem func(index int) bubbly.Component
+
+	// Count is the number of items shown initially.
+	// Default: 0.
+	Count int
+
+	// OnAdd is called with the index of a newly added item.
+	// Optional - if nil, no callback is executed.
+	OnAdd func(index int)
+
+	// OnRemove is called with the index of a removed item, before later
+	// items shift down.
+	// Optional - if nil, no callback is executed.
+	OnRemove func(index int)
+
+	// AddLabel is the text of the add affordance.
+	// Optional - defaults to "+ Add".
+	AddLabel string
+}
+
+// FormItemEvent is the payload of the Form "removeItem" event.
+type FormItemEvent struct {
+	// Field is the Name of the FormArrayField.
+	Field string
+
+	// Index is the index of the item.
+	Index int
+}
+
+// formArrayItem is a rendered item of a FormArrayField.
+type formArrayItem struct {
+	key       string // State key the component is exposed under
+	component bubbly.Component
+}
+
+// formItemKey returns the error key of an array field item. It matches
+// composables.FormItemKey.
+func formItemKey(field string, index int) string {
+	return fmt.Sprintf("%s[%d]", field, index)
+}
+
 // FormProps defines the configuration properties for a Form component.
 //
 // Form is a generic component that works with any struct type T.
@@ -104,6 +166,10 @@ type FormProps[T any] struct {
 	// Required - should not be empty for usability.
 	Fields []FormField
 
+	// ArrayFields are repeatable field groups, displayed after Fields.
+	// Optional - if empty, no array fields are displayed.
+	ArrayFields []FormArrayField
+
 	// Common props for all components
 	CommonProps
 }
@@ -170,10 +236,15 @@ type FormProps[T any] struct {
 //   - Field collection with labels
 //   - Validation with error display per field
 //   - Submit/cancel handlers
+//   - Repeatable array fields with per-item errors
 //   - Integration with UseForm composable
 //   - Theme integration
 //   - Custom style override
 //
+// Array field events:
+//   - "addItem" (string): add an item to the array field with that name
+//   - "removeItem" (FormItemEvent): remove an item from an array field
+//
 // Keyboard interaction:
 //   - Tab: Navigate between fields
 //   - Enter: Submit form (if valid)
@@ -238,9 +309,93 @@ func Form[T any](props FormProps[T]) bubbly.Component {
 				}
 			})
 
+			// Array field items, keyed by field name
+			arrayItems := bubbly.NewRef(make(map[string][]formArrayItem))
+			itemSeq := 0
+
+			addItem := func(field FormArrayField) bool {
+				index := len(arrayItems.GetTyped()[field.Name])
+				item := field.NewItem(index)
+				if item == nil {
+					return false
+				}
+				itemSeq++
+				key := fmt.Sprintf("arrayItem:%s:%d", field.Name, itemSeq)
+				if err := ctx.ExposeComponent(key, item); err != nil {
+					return false
+				}
+
+				list := arrayItems.GetTyped()[field.Name]
+				arrayItems.Set(withFormArrayItems(arrayItems.GetTyped(), field.Name,
+					append(slices.Clone(list), formArrayItem{key: key, component: item})))
+				return true
+			}
+
+			for _, field := range props.ArrayFields {
+				for i := 0; i < field.Count; i++ {
+					addItem(field)
+				}
+			}
+
+			findArrayField := func(name string) (FormArrayField, bool) {
+				for _, field := range props.ArrayFields {
+					if field.Name == name {
+						return field, true
+					}
+				}
+				return FormArrayField{}, false
+			}
+
+			ctx.On("addItem", func(data interface{}) {
+				name, _ := data.(string)
+				field, ok := findArrayField(name)
+				if !ok || !addItem(field) {
+					return
+				}
+				if field.OnAdd != nil {
+					field.OnAdd(len(arrayItems.GetTyped()[name]) - 1)
+				}
+			})
+
+			ctx.On("removeItem", func(data interface{}) {
+				event, ok := data.(FormItemEvent)
+				if !ok {
+					return
+				}
+				field, ok := findArrayField(event.Field)
+				list := arrayItems.GetTyped()[event.Field]
+				if !ok || event.Index < 0 || event.Index >= len(list) {
+					return
+				}
+
+				removed := list[event.Index]
+				if unmounter, ok := removed.component.(interface{ Unmount() }); ok {
+					unmounter.Unmount()
+				}
+				_ = ctx.RemoveComponent(removed.component)
+				ctx.Expose(removed.key, nil)
+
+				arrayItems.Set(withFormArrayItems(arrayItems.GetTyped(), event.Field,
+					slices.Delete(slices.Clone(list), event.Index, event.Index+1)))
+
+				// Item errors refer to the old indices
+				errorMap := make(map[string]string)
+				for key, message := range errors.GetTyped() {
+					if !strings.HasPrefix(key, event.Field+"[") {
+						errorMap[key] = message
+					}
+				}
+				errors.Set(errorMap)
+
+				if field.OnRemove != nil {
+					field.OnRemove(event.Index)
+				}
+			})
+
 			// Expose state
 			ctx.Expose("errors", errors)
 			ctx.Expose("submitting", submitting)
+			ctx.Expose("arrayItems", arrayItems)
 			ctx.Expose("theme", theme)
 		}).
 		Template(func(ctx bubbly.RenderContext) string {
@@ -289,6 +444,13 @@ func Form[T any](props FormProps[T]) bubbly.Component {
 				output.WriteString("\n")
 			}
 
+			// Render array fields
+			arrayItems := ctx.Get("arrayItems").(*bubbly.Ref[map[string][]formArrayItem]).GetTyped()
+			for _, field := range p.ArrayFields {
+				output.WriteString(formArrayFieldView(field, arrayItems[field.Name], errors.GetTyped(), theme))
+				output.WriteString("\n\n")
+			}
+
 			// Buttons
 			submitLabel := "Submit"
 			if submitting.Get().(bool) {
@@ -326,3 +488,58 @@ func Form[T any](props FormProps[T]) bubbly.Component {
 
 	return comp
 }
+
+// withFormArrayItems returns a copy of items with the list of field replaced,
+// so watchers see distinct old and new values.
+func withFormArrayItems(items map[string][]formArrayItem, field string, list []formArrayItem) map[string][]formArrayItem {
+	updated := make(map[string][]formArrayItem, len(items)+1)
+	for name, existing := range items {
+		updated[name] = existing
+	}
+	updated[field] = list
+	return updated
+}
+
+// formArrayFieldView renders an array field's label, its numbered items
+// with their errors and remove affordances, and the add affordance.
+func formArrayFieldView(field FormArrayField, items []formArrayItem, errorMap map[string]string, theme Theme) string {
+	var output strings.Builder
+
+	if field.Label != "" {
+		labelStyle := lipgloss.NewStyle().
+			Foreground(theme.Foreground).
+			Bold(true)
+		output.WriteString(labelStyle.Render(field.Label + ":"))
+		output.WriteString("\n")
+	}
+
+	indexStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	removeStyle := lipgloss.NewStyle().Foreground(theme.Danger)
+	errorStyle := lipgloss.NewStyle().
+		Foreground(theme.Danger).
+		Italic(true).
+		MarginLeft(2)
+
+	for i, item := range items {
+		output.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+			indexStyle.Render(fmt.Sprintf("%d. ", i+1)),
+			item.component.View(),
+			removeStyle.Render("  ✕ remove"),
+		))
+		output.WriteString("\n")
+
+		if err, ok := errorMap[formItemKey(field.Name, i)]; ok && err != "" {
+			output.WriteString(errorStyle.Render("⚠ " + err))
+			output.WriteString("\n")
+		}
+	}
+
+	addLabel := field.AddLabel
+	if addLabel == "" {
+		addLabel = "+ Add"
+	}
+	addStyle := lipgloss.NewStyle().Foreground(theme.Primary)
+	output.WriteString(addStyle.Render(addLabel))
+
+	return output.String()
+}
@@ -1,8 +1,11 @@
 package components
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -454,3 +457,83 @@ func TestForm_PropsAccessibility(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, "John", formProps.Initial.Name)
 }
+
+func TestForm_ArrayFields(t *testing.T) {
+	// Arrange
+	var added, removed []int
+	phoneField := FormArrayField{
+		Name:  "Phones",
+		Label: "Phone Numbers",
+		Count: 2,
+		NewItem: func(index int) bubbly.Component {
+			return Text(TextProps{Content: fmt.Sprintf("phone-%d", index)})
+		},
+		OnAdd:    func(index int) { added = append(added, index) },
+		OnRemove: func(index int) { removed = append(removed, index) },
+		AddLabel: "+ Add phone",
+	}
+	form := Form(FormProps[TestFormData]{
+		Initial:     TestFormData{},
+		ArrayFields: []FormArrayField{phoneField},
+	})
+
+	// Act & Assert: initial items
+	form.Init()
+	view := ansi.Strip(form.View())
+	assert.Contains(t, view, "Phone Numbers:")
+	assert.Contains(t, view, "1. phone-0")
+	assert.Contains(t, view, "2. phone-1")
+	assert.Contains(t, view, "✕ remove")
+	assert.Contains(t, view, "+ Add phone")
+	assert.Empty(t, added, "Initial items should not call OnAdd")
+
+	// Act & Assert: add an item
+	form.Emit("addItem", "Phones")
+	view = ansi.Strip(form.View())
+	assert.Contains(t, view, "3. phone-2")
+	assert.Equal(t, []int{2}, added)
+
+	// Act & Assert: remove the first item, later items are renumbered
+	form.Emit("removeItem", FormItemEvent{Field: "Phones", Index: 0})
+	view = ansi.Strip(form.View())
+	assert.NotContains(t, view, "phone-0")
+	assert.Contains(t, view, "1. phone-1")
+	assert.Contains(t, view, "2. phone-2")
+	assert.Equal(t, []int{0}, removed)
+
+	// Unknown fields and out-of-range indices are ignored
+	form.Emit("addItem", "Emails")
+	form.Emit("removeItem", FormItemEvent{Field: "Phones", Index: 5})
+	assert.Len(t, added, 1)
+	assert.Len(t, removed, 1)
+}
+
+func TestForm_ArrayFields_ItemErrors(t *testing.T) {
+	// Arrange
+	form := Form(FormProps[TestFormData]{
+		Initial: TestFormData{},
+		Validate: func(data TestFormData) map[string]string {
+			return map[string]string{"Phones[1]": "Invalid phone number"}
+		},
+		ArrayFields: []FormArrayField{{
+			Name:  "Phones",
+			Count: 2,
+			NewItem: func(index int) bubbly.Component {
+				return Text(TextProps{Content: fmt.Sprintf("phone-%d", index)})
+			},
+		}},
+	})
+
+	// Act
+	form.Init()
+	form.Emit("submit", nil)
+	view := ansi.Strip(form.View())
+
+	// Assert: error shown under the second item
+	require.Contains(t, view, "⚠ Invalid phone number")
+	assert.Less(t, strings.Index(view, "phone-1"), strings.Index(view, "Invalid phone number"))
+
+	// Stale item errors are cleared when items shift
+	form.Emit("removeItem", FormItemEvent{Field: "Phones", Index: 0})
+	assert.NotContains(t, ansi.Strip(form.View()), "Invalid phone number")
+}
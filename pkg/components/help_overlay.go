@@ -0,0 +1,338 @@
+package components
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// HelpToggleEvent shows or hides a HelpOverlay.
+//
+// HelpOverlay also handles the ScrollView scroll events (ScrollUpEvent,
+// ScrollDownEvent, ScrollPageUpEvent, ScrollPageDownEvent, ScrollTopEvent,
+// ScrollBottomEvent) to scroll long help panels.
+const HelpToggleEvent = "toggleHelp"
+
+// HelpOverlayProps defines the configuration properties for a HelpOverlay component.
+//
+// Example usage:
+//
+//	help := components.HelpOverlay(components.HelpOverlayProps{
+//	    Target:  app,
+//	    Columns: 2,
+//	    GroupOf: func(b bubbly.KeyBinding) string {
+//	        if strings.HasPrefix(b.Event, "nav") {
+//	            return "Navigation"
+//	        }
+//	        return "Actions"
+//	    },
+//	})
+type HelpOverlayProps struct {
+	// Target is the component whose key bindings are listed.
+	// Bindings are read on every render, so runtime changes are reflected.
+	// Required - a nil Target renders an empty panel.
+	Target bubbly.Component
+
+	// Visible controls whether the overlay is shown.
+	// Optional - an internal Ref (initially false) is created if nil.
+	Visible *bubbly.Ref[bool]
+
+	// Filter is a search query; only bindings whose key, description, or
+	// group contains it (case-insensitive) are listed.
+	// Optional - if nil or empty, all bindings are listed.
+	Filter *bubbly.Ref[string]
+
+	// GroupOf returns the group heading a binding is listed under.
+	// Optional - if nil, all bindings are listed in a single untitled group.
+	GroupOf func(binding bubbly.KeyBinding) string
+
+	// Title is the panel heading.
+	// Optional - defaults to "Keyboard Shortcuts".
+	Title string
+
+	// Columns is the number of columns entries are laid out in.
+	// Optional - defaults to 1.
+	Columns int
+
+	// Height is the maximum number of body lines shown before scrolling.
+	// Optional - defaults to 15.
+	Height int
+
+	// Offset is a reactive reference to the scroll offset (first visible line).
+	// Optional - an internal Ref is created if nil.
+	Offset *bubbly.Ref[int]
+
+	// Common props for all components
+	CommonProps
+}
+
+// helpOverlayEntry is one line item: the keys bound to one action.
+type helpOverlayEntry struct {
+	keys        string
+	description string
+}
+
+// helpOverlayGroup is a titled set of entries.
+type helpOverlayGroup struct {
+	name    string
+	entries []helpOverlayEntry
+}
+
+// helpOverlayApplyDefaults sets default values for HelpOverlayProps.
+func helpOverlayApplyDefaults(props *HelpOverlayProps) {
+	if props.Visible == nil {
+		props.Visible = bubbly.NewRef(false)
+	}
+	if props.Offset == nil {
+		props.Offset = bubbly.NewRef(0)
+	}
+	if props.Title == "" {
+		props.Title = "Keyboard Shortcuts"
+	}
+	if props.Columns <= 0 {
+		props.Columns = 1
+	}
+	if props.Height <= 0 {
+		props.Height = 15
+	}
+}
+
+// helpOverlayGroups collects the target's described, currently active key
+// bindings. Keys triggering the same event with the same description are
+// merged into one entry ("up/k"). Groups are sorted by name, entries by keys.
+func helpOverlayGroups(props HelpOverlayProps) []helpOverlayGroup {
+	if props.Target == nil {
+		return nil
+	}
+
+	bindings := props.Target.KeyBindings()
+	keys := make([]string, 0, len(bindings))
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	query := ""
+	if props.Filter != nil {
+		query = strings.ToLower(strings.TrimSpace(props.Filter.GetTyped()))
+	}
+
+	type entryKey struct{ group, event, description string }
+	var order []entryKey
+	merged := make(map[entryKey][]string)
+
+	for _, key := range keys {
+		for _, binding := range bindings[key] {
+			if binding.Description == "" {
+				continue
+			}
+			if binding.Condition != nil && !binding.Condition() {
+				continue
+			}
+			group := ""
+			if props.GroupOf != nil {
+				group = props.GroupOf(binding)
+			}
+			if query != "" &&
+				!strings.Contains(strings.ToLower(key), query) &&
+				!strings.Contains(strings.ToLower(binding.Description), query) &&
+				!strings.Contains(strings.ToLower(group), query) {
+				continue
+			}
+
+			ek := entryKey{group: group, event: binding.Event, description: binding.Description}
+			if _, ok := merged[ek]; !ok {
+				order = append(order, ek)
+			}
+			merged[ek] = append(merged[ek], key)
+			break // Only the first described binding of a key is listed
+		}
+	}
+
+	byName := make(map[string]*helpOverlayGroup)
+	var groups []*helpOverlayGroup
+	for _, ek := range order {
+		g, ok := byName[ek.group]
+		if !ok {
+			g = &helpOverlayGroup{name: ek.group}
+			byName[ek.group] = g
+			groups = append(groups, g)
+		}
+		g.entries = append(g.entries, helpOverlayEntry{
+			keys:        strings.Join(merged[ek], "/"),
+			description: ek.description,
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].name < groups[j].name })
+	result := make([]helpOverlayGroup, len(groups))
+	for i, g := range groups {
+		sort.SliceStable(g.entries, func(a, b int) bool { return g.entries[a].keys < g.entries[b].keys })
+		result[i] = *g
+	}
+	return result
+}
+
+// helpOverlayLines renders the panel body: group headings followed by their
+// entries laid out in columns, with a blank line between groups.
+func helpOverlayLines(props HelpOverlayProps, theme Theme) []string {
+	groups := helpOverlayGroups(props)
+	if len(groups) == 0 {
+		return []string{lipgloss.NewStyle().Foreground(theme.Muted).Render("No matching keys")}
+	}
+
+	headingStyle := lipgloss.NewStyle().Foreground(theme.Secondary).Bold(true)
+	keyStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	descStyle := lipgloss.NewStyle().Foreground(theme.Foreground)
+
+	var lines []string
+	for gi, group := range groups {
+		if gi > 0 {
+			lines = append(lines, "")
+		}
+		if group.name != "" {
+			lines = append(lines, headingStyle.Render(group.name))
+		}
+
+		keyWidth, cellWidth := 0, 0
+		for _, e := range group.entries {
+			keyWidth = max(keyWidth, bubbly.VisibleWidth(e.keys))
+		}
+		cells := make([]string, len(group.entries))
+		for i, e := range group.entries {
+			cells[i] = keyStyle.Render(bubbly.PadVisible(e.keys, keyWidth)) + "  " + descStyle.Render(e.description)
+			cellWidth = max(cellWidth, bubbly.VisibleWidth(cells[i]))
+		}
+
+		for start := 0; start < len(cells); start += props.Columns {
+			end := min(start+props.Columns, len(cells))
+			row := make([]string, 0, end-start)
+			for i, cell := range cells[start:end] {
+				if i < end-start-1 {
+					cell = bubbly.PadVisible(cell, cellWidth)
+				}
+				row = append(row, cell)
+			}
+			lines = append(lines, strings.Join(row, "    "))
+		}
+	}
+	return lines
+}
+
+// helpOverlayScrollBy returns a handler that moves the offset by delta lines.
+func helpOverlayScrollBy(props HelpOverlayProps, theme Theme, delta int) func(interface{}) {
+	return func(_ interface{}) {
+		total := len(helpOverlayLines(props, theme))
+		props.Offset.Set(scrollViewClamp(props.Offset.GetTyped()+delta, total, props.Height))
+	}
+}
+
+// HelpOverlay creates a toggleable help panel generated from a component's
+// key bindings and their descriptions.
+//
+// The HelpOverlay component provides:
+//   - Entries built from Target.KeyBindings(), merging keys bound to the
+//     same action ("up/k")
+//   - Optional grouping under headings (see HelpOverlayProps.GroupOf)
+//   - A search filter over keys, descriptions, and groups
+//   - Multi-column layout
+//   - Scrolling with a scrollbar once the body exceeds Height lines
+//
+// Only bindings with a description whose Condition (if any) currently holds
+// are listed, so mode-specific keys appear only in their mode.
+//
+// Events:
+//   - toggleHelp: Show or hide the overlay
+//   - scrollUp / scrollDown / pageUp / pageDown / scrollTop / scrollBottom: Scroll
+//
+// The overlay renders nothing while hidden. Render it on top of or in place
+// of the main content while visible.
+//
+// Example:
+//
+//	help := components.HelpOverlay(components.HelpOverlayProps{Target: app})
+//
+//	// In the app's Setup, with .WithKeyBinding("?", "help", "Toggle help")
+//	ctx.On("help", func(_ interface{}) {
+//	    help.Emit(components.HelpToggleEvent, nil)
+//	})
+func HelpOverlay(props HelpOverlayProps) bubbly.Component {
+	helpOverlayApplyDefaults(&props)
+
+	component, err := bubbly.NewComponent("HelpOverlay").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+			ctx.Expose("visible", props.Visible)
+			ctx.Expose("scrollOffset", props.Offset)
+
+			ctx.On(HelpToggleEvent, func(_ interface{}) {
+				props.Visible.Set(!props.Visible.GetTyped())
+			})
+			ctx.On(ScrollUpEvent, helpOverlayScrollBy(props, theme, -1))
+			ctx.On(ScrollDownEvent, helpOverlayScrollBy(props, theme, 1))
+			ctx.On(ScrollPageUpEvent, helpOverlayScrollBy(props, theme, -props.Height))
+			ctx.On(ScrollPageDownEvent, helpOverlayScrollBy(props, theme, props.Height))
+			ctx.On(ScrollTopEvent, func(_ interface{}) {
+				props.Offset.Set(0)
+			})
+			ctx.On(ScrollBottomEvent, func(_ interface{}) {
+				total := len(helpOverlayLines(props, theme))
+				props.Offset.Set(scrollViewClamp(total, total, props.Height))
+			})
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(HelpOverlayProps)
+			theme := ctx.Get("theme").(Theme)
+
+			if !p.Visible.GetTyped() {
+				return ""
+			}
+
+			lines := helpOverlayLines(p, theme)
+			total := len(lines)
+			offset := scrollViewClamp(p.Offset.GetTyped(), total, p.Height)
+			visible := lines[offset:min(offset+p.Height, total)]
+
+			if total > p.Height {
+				width := 0
+				for _, line := range lines {
+					width = max(width, bubbly.VisibleWidth(line))
+				}
+				bar := scrollViewRenderScrollbar(offset, total, len(visible), theme)
+				for i := range visible {
+					visible[i] = bubbly.PadVisible(visible[i], width) + " " + bar[i]
+				}
+			}
+
+			var sb strings.Builder
+			sb.WriteString(lipgloss.NewStyle().Foreground(theme.Primary).Bold(true).Render(p.Title))
+			sb.WriteString("\n")
+			if p.Filter != nil && p.Filter.GetTyped() != "" {
+				sb.WriteString(lipgloss.NewStyle().Foreground(theme.Muted).Render("Filter: " + p.Filter.GetTyped()))
+				sb.WriteString("\n")
+			}
+			sb.WriteString("\n")
+			sb.WriteString(strings.Join(visible, "\n"))
+
+			boxStyle := lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(theme.Primary).
+				Padding(0, 1)
+			if p.Style != nil {
+				boxStyle = boxStyle.Inherit(*p.Style)
+			}
+			return boxStyle.Render(sb.String())
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
@@ -0,0 +1,165 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// helpOverlayTestTarget builds a component with a few described key bindings.
+func helpOverlayTestTarget(t *testing.T, editing *bool) bubbly.Component {
+	t.Helper()
+	target, err := bubbly.NewComponent("App").
+		WithKeyBinding("up", "navUp", "Move up").
+		WithKeyBinding("k", "navUp", "Move up").
+		WithKeyBinding("down", "navDown", "Move down").
+		WithKeyBinding("enter", "save", "Save item").
+		WithKeyBinding("ctrl+c", "quit", "Quit").
+		WithKeyBinding("x", "hidden", "").
+		WithConditionalKeyBinding(bubbly.KeyBinding{
+			Key:         "esc",
+			Event:       "cancel",
+			Description: "Cancel edit",
+			Condition:   func() bool { return *editing },
+		}).
+		Template(func(ctx bubbly.RenderContext) string { return "app" }).
+		Build()
+	require.NoError(t, err)
+	return target
+}
+
+// helpOverlayTestGroupOf groups navigation events apart from actions.
+func helpOverlayTestGroupOf(b bubbly.KeyBinding) string {
+	if strings.HasPrefix(b.Event, "nav") {
+		return "Navigation"
+	}
+	return "Actions"
+}
+
+// TestHelpOverlay_HiddenByDefault tests that the overlay renders nothing until toggled.
+func TestHelpOverlay_HiddenByDefault(t *testing.T) {
+	editing := false
+	help := HelpOverlay(HelpOverlayProps{Target: helpOverlayTestTarget(t, &editing)})
+	help.Init()
+
+	assert.Empty(t, help.View())
+
+	help.Emit(HelpToggleEvent, nil)
+	assert.Contains(t, ansi.Strip(help.View()), "Keyboard Shortcuts")
+
+	help.Emit(HelpToggleEvent, nil)
+	assert.Empty(t, help.View())
+}
+
+// TestHelpOverlay_Entries tests grouping, key merging, filtering, and conditions.
+func TestHelpOverlay_Entries(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		editing  bool
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "groups and merged keys",
+			contains: []string{"Actions", "Navigation", "k/up", "Move up", "ctrl+c", "Quit"},
+			excludes: []string{"Cancel edit", "hidden"},
+		},
+		{
+			name:     "active condition is listed",
+			editing:  true,
+			contains: []string{"esc", "Cancel edit"},
+		},
+		{
+			name:     "filter by description",
+			filter:   "save",
+			contains: []string{"Filter: save", "enter", "Save item"},
+			excludes: []string{"Move up", "Quit"},
+		},
+		{
+			name:     "filter by group",
+			filter:   "navig",
+			contains: []string{"Move up", "Move down"},
+			excludes: []string{"Save item"},
+		},
+		{
+			name:     "no matches",
+			filter:   "zzz",
+			contains: []string{"No matching keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			editing := tt.editing
+			help := HelpOverlay(HelpOverlayProps{
+				Target:  helpOverlayTestTarget(t, &editing),
+				Visible: bubbly.NewRef(true),
+				Filter:  bubbly.NewRef(tt.filter),
+				GroupOf: helpOverlayTestGroupOf,
+			})
+			help.Init()
+
+			view := ansi.Strip(help.View())
+			for _, s := range tt.contains {
+				assert.Contains(t, view, s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, view, s)
+			}
+		})
+	}
+}
+
+// TestHelpOverlay_Columns tests that entries are laid out in columns.
+func TestHelpOverlay_Columns(t *testing.T) {
+	editing := false
+	help := HelpOverlay(HelpOverlayProps{
+		Target:  helpOverlayTestTarget(t, &editing),
+		Visible: bubbly.NewRef(true),
+		Columns: 2,
+	})
+	help.Init()
+
+	var row string
+	for _, line := range strings.Split(ansi.Strip(help.View()), "\n") {
+		if strings.Contains(line, "Quit") {
+			row = line
+		}
+	}
+	assert.Contains(t, row, "Move down", "second entry should share the first entry's row")
+}
+
+// TestHelpOverlay_Scrolling tests that long panels scroll within Height.
+func TestHelpOverlay_Scrolling(t *testing.T) {
+	editing := false
+	offset := bubbly.NewRef(0)
+	help := HelpOverlay(HelpOverlayProps{
+		Target:  helpOverlayTestTarget(t, &editing),
+		Visible: bubbly.NewRef(true),
+		Height:  2,
+		Offset:  offset,
+	})
+	help.Init()
+
+	view := ansi.Strip(help.View())
+	assert.Contains(t, view, "ctrl+c")
+	assert.NotContains(t, view, "Save item")
+
+	help.Emit(ScrollBottomEvent, nil)
+	assert.Equal(t, 2, offset.GetTyped())
+	view = ansi.Strip(help.View())
+	assert.Contains(t, view, "Save item")
+	assert.NotContains(t, view, "ctrl+c")
+
+	help.Emit(ScrollDownEvent, nil)
+	assert.Equal(t, 2, offset.GetTyped(), "offset should clamp at the bottom")
+
+	help.Emit(ScrollTopEvent, nil)
+	assert.Equal(t, 0, offset.GetTyped())
+}
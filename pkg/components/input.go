@@ -1,6 +1,9 @@
 package components
 
 import (
+	"sync"
+	"time"
+
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -27,6 +30,29 @@ const (
 	InputEmail InputType = "email"
 )
 
+// ValidateTrigger controls when an Input runs its Validate function.
+type ValidateTrigger string
+
+// Validation trigger constants.
+const (
+	// ValidateOnChange validates on every value change (optionally debounced,
+	// see InputProps.ValidateDebounce). This is the default.
+	ValidateOnChange ValidateTrigger = "change"
+
+	// ValidateOnBlur validates when the input loses focus.
+	ValidateOnBlur ValidateTrigger = "blur"
+
+	// ValidateOnSubmit validates only when InputValidateEvent is emitted,
+	// typically when the surrounding form is submitted.
+	ValidateOnSubmit ValidateTrigger = "submit"
+)
+
+// InputValidateEvent validates an Input immediately and marks it touched,
+// regardless of its ValidateOn trigger. Emit it on form submission:
+//
+//	emailInput.Emit(components.InputValidateEvent, nil)
+const InputValidateEvent = "validate"
+
 // InputProps defines the configuration properties for an Input component.
 //
 // Example usage:
@@ -63,6 +89,26 @@ type InputProps struct {
 	// Optional - if nil, no validation is performed.
 	Validate func(string) error
 
+	// ValidateOn controls when Validate runs: on change, on blur, or only
+	// on InputValidateEvent. Once an error is shown, every change
+	// re-validates immediately so the error clears as soon as it is fixed.
+	// Optional - defaults to ValidateOnChange.
+	ValidateOn ValidateTrigger
+
+	// ValidateDebounce delays ValidateOnChange validation until the value
+	// has stopped changing for this long, so errors don't flash while typing.
+	// Optional - if 0, validation runs on every keystroke.
+	ValidateDebounce time.Duration
+
+	// Touched reports whether the user has interacted with the input. It is
+	// set when the input loses focus or receives InputValidateEvent.
+	// Optional - an internal Ref is created if nil.
+	Touched *bubbly.Ref[bool]
+
+	// HideErrorsUntilTouched hides validation errors until Touched is true.
+	// Optional - defaults to false (errors are shown as soon as they occur).
+	HideErrorsUntilTouched bool
+
 	// OnChange is a callback function executed when the value changes.
 	// Called after validation.
 	// Optional - if nil, no callback is executed.
@@ -126,6 +172,8 @@ type InputProps struct {
 // Features:
 //   - Reactive value binding with Ref[string]
 //   - Real-time validation with error display
+//   - Validation timing control (on change, debounced, on blur, on submit)
+//   - Touched tracking to hide errors until the user has interacted
 //   - Focus state management
 //   - Password masking
 //   - Placeholder support
@@ -153,24 +201,55 @@ type InputProps struct {
 //   - Error messages displayed inline
 //   - High contrast colors for error states
 //
-// inputSetupValidationWatch sets up validation watcher for input value changes.
-func inputSetupValidationWatch(props InputProps, errorRef *bubbly.Ref[error]) {
-	if props.Validate != nil {
+// inputSetupValidation sets up validation and OnChange callbacks for input
+// value changes according to props.ValidateOn. It returns a function that
+// validates the current value immediately.
+func inputSetupValidation(ctx *bubbly.Context, props InputProps, errorRef *bubbly.Ref[error]) func() {
+	validate := func() {
+		if props.Validate != nil {
+			errorRef.Set(props.Validate(props.Value.GetTyped()))
+		}
+	}
+
+	validateOnChange := validate
+	if props.ValidateDebounce > 0 {
+		var mu sync.Mutex
+		var timer *time.Timer
+		validateOnChange = func() {
+			mu.Lock()
+			defer mu.Unlock()
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(props.ValidateDebounce, validate)
+		}
+		ctx.OnUnmounted(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			if timer != nil {
+				timer.Stop()
+			}
+		})
+	}
+
+	if props.Validate != nil || props.OnChange != nil {
 		bubbly.Watch(props.Value, func(newVal, oldVal string) {
-			err := props.Validate(newVal)
-			errorRef.Set(err)
+			switch {
+			case props.ValidateOn == ValidateOnChange:
+				validateOnChange()
+			case errorRef.GetTyped() != nil:
+				// Re-validate so a shown error clears as soon as it's fixed
+				validate()
+			}
 
 			// Call OnChange callback if provided
 			if props.OnChange != nil {
 				props.OnChange(newVal)
 			}
 		})
-	} else if props.OnChange != nil {
-		// If no validation but OnChange provided, still watch for changes
-		bubbly.Watch(props.Value, func(newVal, oldVal string) {
-			props.OnChange(newVal)
-		})
 	}
+
+	return validate
 }
 
 // inputSetupTextInputSync sets up synchronization between value ref and textinput.
@@ -206,6 +285,12 @@ func inputApplyDefaults(props *InputProps) {
 	if props.Width == 0 {
 		props.Width = 30
 	}
+	if props.ValidateOn == "" {
+		props.ValidateOn = ValidateOnChange
+	}
+	if props.Touched == nil {
+		props.Touched = bubbly.NewRef(false)
+	}
 }
 
 // inputApplyThemeColors applies theme colors to textinput based on state.
@@ -266,9 +351,14 @@ func Input(props InputProps) bubbly.Component {
 			errorRef := bubbly.NewRef[error](nil)
 			focusedRef := bubbly.NewRef(false)
 
-			inputSetupValidationWatch(props, errorRef)
+			validate := inputSetupValidation(ctx, props, errorRef)
 			inputSetupTextInputSync(props, &ti)
 
+			ctx.On(InputValidateEvent, func(_ interface{}) {
+				props.Touched.Set(true)
+				validate()
+			})
+
 			ctx.On("input", func(data interface{}) {
 				if newValue, ok := data.(string); ok {
 					props.Value.Set(newValue)
@@ -284,6 +374,10 @@ func Input(props InputProps) bubbly.Component {
 			ctx.On("blur", func(_ interface{}) {
 				focusedRef.Set(false)
 				ti.Blur()
+				props.Touched.Set(true)
+				if props.ValidateOn == ValidateOnBlur {
+					validate()
+				}
 				if props.OnBlur != nil {
 					props.OnBlur()
 				}
@@ -317,6 +411,9 @@ func Input(props InputProps) bubbly.Component {
 			ti := ctx.Get("textInput").(*textinput.Model)
 
 			currentError := errorRef.GetTyped()
+			if props.HideErrorsUntilTouched && !props.Touched.GetTyped() {
+				currentError = nil
+			}
 			hasError := currentError != nil
 			isFocused := focusedRef.GetTyped()
 
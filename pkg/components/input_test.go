@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
@@ -842,3 +843,114 @@ func TestInput_NoBorder_Password(t *testing.T) {
 	assert.NotEmpty(t, view, "Password input should render without border")
 	assert.NotContains(t, view, "secret", "Password should be masked even without border")
 }
+
+func TestInput_ValidateOn(t *testing.T) {
+	validate := func(s string) error {
+		if len(s) < 3 {
+			return errors.New("too short")
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		validateOn ValidateTrigger
+		events     []string
+		wantError  bool
+	}{
+		{name: "change validates immediately", validateOn: ValidateOnChange, wantError: true},
+		{name: "blur waits for blur", validateOn: ValidateOnBlur, wantError: false},
+		{name: "blur validates on blur", validateOn: ValidateOnBlur, events: []string{"focus", "blur"}, wantError: true},
+		{name: "submit ignores blur", validateOn: ValidateOnSubmit, events: []string{"focus", "blur"}, wantError: false},
+		{name: "submit validates on validate event", validateOn: ValidateOnSubmit, events: []string{InputValidateEvent}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valueRef := bubbly.NewRef("")
+			input := Input(InputProps{
+				Value:      valueRef,
+				Validate:   validate,
+				ValidateOn: tt.validateOn,
+			})
+			input.Init()
+
+			valueRef.Set("ab")
+			for _, event := range tt.events {
+				input.Emit(event, nil)
+			}
+
+			if tt.wantError {
+				assert.Contains(t, input.View(), "too short")
+			} else {
+				assert.NotContains(t, input.View(), "too short")
+			}
+		})
+	}
+}
+
+func TestInput_ValidateOn_ClearsShownErrorOnChange(t *testing.T) {
+	valueRef := bubbly.NewRef("")
+	input := Input(InputProps{
+		Value: valueRef,
+		Validate: func(s string) error {
+			if s == "" {
+				return errors.New("required")
+			}
+			return nil
+		},
+		ValidateOn: ValidateOnSubmit,
+	})
+	input.Init()
+
+	input.Emit(InputValidateEvent, nil)
+	assert.Contains(t, input.View(), "required")
+
+	valueRef.Set("x")
+	assert.NotContains(t, input.View(), "required", "fixing the value should clear the error without resubmitting")
+}
+
+func TestInput_ValidateDebounce(t *testing.T) {
+	valueRef := bubbly.NewRef("")
+	input := Input(InputProps{
+		Value: valueRef,
+		Validate: func(s string) error {
+			if len(s) < 3 {
+				return errors.New("too short")
+			}
+			return nil
+		},
+		ValidateDebounce: 20 * time.Millisecond,
+	})
+	input.Init()
+
+	valueRef.Set("a")
+	valueRef.Set("ab")
+	assert.NotContains(t, input.View(), "too short", "validation should wait for typing to pause")
+
+	assert.Eventually(t, func() bool {
+		return strings.Contains(input.View(), "too short")
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestInput_HideErrorsUntilTouched(t *testing.T) {
+	valueRef := bubbly.NewRef("")
+	touched := bubbly.NewRef(false)
+	input := Input(InputProps{
+		Value: valueRef,
+		Validate: func(s string) error {
+			return errors.New("invalid")
+		},
+		Touched:                touched,
+		HideErrorsUntilTouched: true,
+	})
+	input.Init()
+
+	valueRef.Set("x")
+	assert.NotContains(t, input.View(), "invalid", "errors should be hidden before the field is touched")
+
+	input.Emit("focus", nil)
+	input.Emit("blur", nil)
+	assert.True(t, touched.GetTyped())
+	assert.Contains(t, input.View(), "invalid")
+}
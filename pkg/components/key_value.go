@@ -0,0 +1,125 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// KV is a single label/value row of a KeyValue list.
+type KV struct {
+	// Label names the value (e.g., "Status").
+	Label string
+
+	// Value is the displayed value. Multi-line values are indented to stay
+	// aligned with the value column.
+	Value string
+}
+
+// KeyValueProps defines the configuration properties for a KeyValue component.
+//
+// Example usage:
+//
+//	details := components.KeyValue(components.KeyValueProps{
+//	    Pairs: []components.KV{
+//	        {Label: "Server", Value: "web-01"},
+//	        {Label: "Status", Value: "online"},
+//	    },
+//	})
+type KeyValueProps struct {
+	// Pairs are the rows to display, in order.
+	// Required - an empty list renders nothing.
+	Pairs []KV
+
+	// LabelWidth is the width of the label column in characters, including
+	// the separator. Longer labels are truncated.
+	// Optional - defaults to the width of the widest label.
+	LabelWidth int
+
+	// Separator is appended to each label.
+	// Optional - defaults to ":".
+	Separator string
+
+	// Common props for all components
+	CommonProps
+}
+
+// keyValueRender renders pairs as aligned rows.
+func keyValueRender(props KeyValueProps, theme Theme) string {
+	separator := props.Separator
+	if separator == "" {
+		separator = ":"
+	}
+
+	labelWidth := props.LabelWidth
+	if labelWidth <= 0 {
+		for _, pair := range props.Pairs {
+			labelWidth = max(labelWidth, bubbly.VisibleWidth(pair.Label+separator))
+		}
+	}
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Muted).Bold(true)
+	valueStyle := lipgloss.NewStyle().Foreground(theme.Foreground)
+	indent := strings.Repeat(" ", labelWidth+1)
+
+	rows := make([]string, 0, len(props.Pairs))
+	for _, pair := range props.Pairs {
+		label := bubbly.FitVisible(pair.Label+separator, labelWidth)
+		valueLines := strings.Split(pair.Value, "\n")
+		for i, line := range valueLines {
+			valueLines[i] = valueStyle.Render(line)
+		}
+		rows = append(rows, labelStyle.Render(label)+" "+strings.Join(valueLines, "\n"+indent))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// KeyValue creates a new KeyValue molecule component.
+//
+// KeyValue renders a description list of "Label: value" rows with the
+// values aligned in a column, for detail panes and summary cards.
+//
+// The component automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	details := components.KeyValue(components.KeyValueProps{
+//	    Pairs: []components.KV{
+//	        {Label: "Server", Value: server.Name},
+//	        {Label: "CPU Usage", Value: fmt.Sprintf("%d%%", server.CPU)},
+//	        {Label: "Uptime", Value: server.Uptime},
+//	    },
+//	})
+//
+//	details.Init()
+//	// Server:    web-01
+//	// CPU Usage: 42%
+//	// Uptime:    3d 4h
+func KeyValue(props KeyValueProps) bubbly.Component {
+	component, err := bubbly.NewComponent("KeyValue").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(KeyValueProps)
+			theme := ctx.Get("theme").(Theme)
+
+			content := keyValueRender(p, theme)
+			if p.Style != nil {
+				content = p.Style.Render(content)
+			}
+			return content
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
@@ -0,0 +1,57 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyValue_Rendering(t *testing.T) {
+	pairs := []KV{
+		{Label: "Server", Value: "web-01"},
+		{Label: "CPU Usage", Value: "42%"},
+	}
+
+	tests := []struct {
+		name     string
+		props    KeyValueProps
+		expected []string
+	}{
+		{
+			name:     "aligned to widest label",
+			props:    KeyValueProps{Pairs: pairs},
+			expected: []string{"Server:    web-01", "CPU Usage: 42%"},
+		},
+		{
+			name:     "fixed label width truncates",
+			props:    KeyValueProps{Pairs: pairs, LabelWidth: 7},
+			expected: []string{"Server: web-01", "CPU ... 42%"},
+		},
+		{
+			name:     "custom separator",
+			props:    KeyValueProps{Pairs: pairs[:1], Separator: " ="},
+			expected: []string{"Server = web-01"},
+		},
+		{
+			name:     "multi-line value stays aligned",
+			props:    KeyValueProps{Pairs: []KV{{Label: "Notes", Value: "line one\nline two"}}},
+			expected: []string{"Notes: line one", "       line two"},
+		},
+		{
+			name:     "empty",
+			props:    KeyValueProps{},
+			expected: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := KeyValue(tt.props)
+			kv.Init()
+
+			assert.Equal(t, tt.expected, strings.Split(ansi.Strip(kv.View()), "\n"))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package components
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// HandleKey runs the key binding of comp that matches msg, exactly as
+// comp's Update would, and reports whether the key was consumed. A binding
+// whose Condition is false (such as a List that is not Focused) does not
+// consume the key.
+//
+// Use it to forward keys to a component from a parent that handles keys
+// itself, such as a List or Menu that navigates with the arrow keys,
+// without routing every message through the child's Update.
+//
+// Example:
+//
+//	case tea.KeyMsg:
+//	    if components.HandleKey(m.menu, msg) {
+//	        return m, nil
+//	    }
+//	    // Keys the menu does not use
+func HandleKey(comp bubbly.Component, msg tea.KeyMsg) bool {
+	if comp == nil {
+		return false
+	}
+
+	for _, binding := range comp.KeyBindings()[msg.String()] {
+		if binding.Condition != nil && !binding.Condition() {
+			continue
+		}
+		if binding.Event == "quit" {
+			return false
+		}
+		comp.Emit(binding.Event, binding.Data)
+		return true
+	}
+	return false
+}
+
+// navigateIndex returns the index delta items away from current among count
+// items, skipping items enabled rejects (nil enables all). Single steps wrap
+// around the ends when wrap is set; longer moves stop at the ends. With no
+// current item (-1), moving forward starts at the first item and moving
+// back at the last. It returns current when no enabled item is found.
+func navigateIndex(count, current, delta int, wrap bool, enabled func(int) bool) int {
+	if count == 0 || delta == 0 {
+		return current
+	}
+
+	step := 1
+	if delta < 0 {
+		step = -1
+	}
+	wrapping := wrap && (delta == 1 || delta == -1)
+
+	var target int
+	switch {
+	case current < 0 || current >= count:
+		current = -1
+		target = 0
+		if step < 0 {
+			target = count - 1
+		}
+		wrapping = false
+	case wrapping:
+		target = (current + delta + count) % count
+	default:
+		target = max(0, min(count-1, current+delta))
+	}
+
+	// The nearest enabled item at or beyond the target
+	for i := 0; i < count; i++ {
+		index := target + i*step
+		if wrapping {
+			index = (index%count + count) % count
+		} else if index < 0 || index >= count {
+			break
+		}
+		if enabled == nil || enabled(index) {
+			return index
+		}
+	}
+
+	// None beyond it: the nearest enabled item between current and target
+	for index := target - step; index != current && index >= 0 && index < count; index -= step {
+		if enabled == nil || enabled(index) {
+			return index
+		}
+	}
+	return current
+}
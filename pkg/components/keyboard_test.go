@@ -0,0 +1,81 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// TestNavigateIndex tests cursor movement, wraparound, and skipping disabled items
+func TestNavigateIndex(t *testing.T) {
+	disabled := func(indexes ...int) func(int) bool {
+		return func(i int) bool {
+			for _, d := range indexes {
+				if i == d {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	tests := []struct {
+		name     string
+		count    int
+		current  int
+		delta    int
+		wrap     bool
+		enabled  func(int) bool
+		expected int
+	}{
+		{name: "down", count: 5, current: 1, delta: 1, expected: 2},
+		{name: "up stops at first", count: 5, current: 0, delta: -1, expected: 0},
+		{name: "down stops at last", count: 5, current: 4, delta: 1, expected: 4},
+		{name: "up wraps", count: 5, current: 0, delta: -1, wrap: true, expected: 4},
+		{name: "down wraps", count: 5, current: 4, delta: 1, wrap: true, expected: 0},
+		{name: "page clamps even with wrap", count: 5, current: 3, delta: 10, wrap: true, expected: 4},
+		{name: "no cursor down starts at first", count: 5, current: -1, delta: 1, expected: 0},
+		{name: "no cursor up starts at last", count: 5, current: -1, delta: -1, expected: 4},
+		{name: "skips disabled", count: 5, current: 0, delta: 1, enabled: disabled(1, 2), expected: 3},
+		{name: "wrap skips disabled", count: 5, current: 3, delta: 1, wrap: true, enabled: disabled(4, 0), expected: 1},
+		{name: "page lands before disabled end", count: 5, current: 0, delta: 10, enabled: disabled(3, 4), expected: 2},
+		{name: "stays when nothing further is enabled", count: 5, current: 2, delta: 1, enabled: disabled(3, 4), expected: 2},
+		{name: "no cursor skips disabled first", count: 5, current: -1, delta: 1, enabled: disabled(0), expected: 1},
+		{name: "all disabled", count: 3, current: -1, delta: 1, enabled: disabled(0, 1, 2), expected: -1},
+		{name: "empty", count: 0, current: -1, delta: 1, expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, navigateIndex(tt.count, tt.current, tt.delta, tt.wrap, tt.enabled))
+		})
+	}
+}
+
+// TestHandleKey tests keys are forwarded to a component's key bindings
+func TestHandleKey(t *testing.T) {
+	focused := bubbly.NewRef(true)
+	cursor := bubbly.NewRef(-1)
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef([]string{"a", "b", "c"}),
+		RenderItem: func(item string, _ int) string { return item },
+		Selected:   cursor,
+		Focused:    focused,
+	})
+	list.Init()
+
+	assert.True(t, HandleKey(list, tea.KeyMsg{Type: tea.KeyDown}))
+	assert.True(t, HandleKey(list, tea.KeyMsg{Type: tea.KeyEnd}))
+	assert.Equal(t, 2, cursor.GetTyped())
+
+	assert.False(t, HandleKey(list, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}), "unbound key")
+
+	focused.Set(false)
+	assert.False(t, HandleKey(list, tea.KeyMsg{Type: tea.KeyUp}), "condition false")
+	assert.Equal(t, 2, cursor.GetTyped())
+
+	assert.False(t, HandleKey(nil, tea.KeyMsg{Type: tea.KeyUp}))
+}
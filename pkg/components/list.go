@@ -1,7 +1,9 @@
 package components
 
 import (
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/lipgloss"
 
@@ -60,11 +62,34 @@ type ListProps[T any] struct {
 	// Optional - defaults to false.
 	Virtual bool
 
-	// OnSelect is a callback function executed when an item is selected.
+	// OnSelect is a callback function executed when an item is selected
+	// with Enter or Space. Moving the cursor does not call it; watch
+	// Selected to follow the cursor.
 	// Receives the selected item and its index as parameters.
 	// Optional - if nil, no callback is executed.
 	OnSelect func(T, int)
 
+	// Selected is the reactive cursor index, -1 for no cursor. Set it to
+	// move the cursor programmatically; the list scrolls to keep it visible.
+	// Optional - an internal ref starting at -1 is used if nil.
+	Selected *bubbly.Ref[int]
+
+	// Focused, when set, limits the built-in key bindings to times when it
+	// is true, so several lists can share the screen.
+	// Optional - if nil, the list always handles its keys.
+	Focused *bubbly.Ref[bool]
+
+	// Wrap makes up on the first item move the cursor to the last, and
+	// down on the last to the first. Paging and Home/End never wrap.
+	// Optional - defaults to false (the cursor stops at the ends).
+	Wrap bool
+
+	// Highlights returns [start, end) byte ranges of the RenderItem text to
+	// emphasize, such as the parts matching a search query.
+	// composables.SearchableListReturn.Highlights fits here directly.
+	// Optional - if nil, nothing is highlighted.
+	Highlights func(T, int) [][2]int
+
 	// Common props for all components
 	CommonProps
 }
@@ -78,17 +103,22 @@ type ListProps[T any] struct {
 //   - Item selection with visual highlighting
 //   - Virtual scrolling for performance with large datasets
 //   - Custom item rendering via RenderItem function
+//   - Highlighting of matched text via Highlights
 //   - Theme integration for consistent styling
 //
-// Keyboard controls:
-//   - ↑/k: Move selection up
-//   - ↓/j: Move selection down
-//   - Enter/Space: Select current item
-//   - Home: Jump to first item
-//   - End: Jump to last item
+// Keyboard controls (built-in key bindings):
+//   - ↑/k: Move cursor up ("keyUp")
+//   - ↓/j: Move cursor down ("keyDown")
+//   - PgUp/PgDown: Move cursor by one page ("keyPageUp", "keyPageDown")
+//   - Home/g: Jump to first item ("keyHome")
+//   - End/G: Jump to last item ("keyEnd")
+//   - Enter/Space: Select current item ("keyEnter")
+//
+// The events in parentheses can also be emitted on the list directly, and
+// HandleKey forwards keys from a parent that handles keys itself.
 //
 // The component integrates with the framework's reactivity system,
-// automatically updating when the Items ref changes.
+// automatically updating when the Items or Selected refs change.
 //
 // Example:
 //
@@ -104,120 +134,62 @@ type ListProps[T any] struct {
 //	    },
 //	})
 //
-// listSelectItem selects an item and adjusts scroll offset for virtual scrolling.
-func listSelectItem[T any](props ListProps[T], selectedIndex, scrollOffset *bubbly.Ref[int], index int) {
-	items := props.Items.Get().([]T)
-	if index < 0 || index >= len(items) {
-		return
-	}
-
-	selectedIndex.Set(index)
-
-	// Adjust scroll offset for virtual scrolling
-	height := props.Height
-	if height <= 0 {
-		height = 10
-	}
-	offset := scrollOffset.Get().(int)
-
-	// Scroll down if selected item is below visible area
-	if index >= offset+height {
-		scrollOffset.Set(index - height + 1)
-	}
-
-	// Scroll up if selected item is above visible area
-	if index < offset {
-		scrollOffset.Set(index)
-	}
-
-	// Trigger callback
-	if props.OnSelect != nil {
-		props.OnSelect(items[index], index)
-	}
-}
-
-// listHandleKeyDown handles the keyDown event for moving selection down.
-func listHandleKeyDown[T any](props ListProps[T], selectedIndex, scrollOffset *bubbly.Ref[int]) func(interface{}) {
-	return func(_ interface{}) {
-		items := props.Items.Get().([]T)
-		if len(items) == 0 {
-			return
-		}
-
-		current := selectedIndex.Get().(int)
-		if current == -1 {
-			listSelectItem(props, selectedIndex, scrollOffset, 0)
-		} else if current < len(items)-1 {
-			listSelectItem(props, selectedIndex, scrollOffset, current+1)
-		}
-	}
-}
-
-// listHandleKeyUp handles the keyUp event for moving selection up.
-func listHandleKeyUp[T any](props ListProps[T], selectedIndex, scrollOffset *bubbly.Ref[int]) func(interface{}) {
-	return func(_ interface{}) {
-		items := props.Items.Get().([]T)
-		if len(items) == 0 {
-			return
-		}
-
-		current := selectedIndex.Get().(int)
-		if current == -1 {
-			listSelectItem(props, selectedIndex, scrollOffset, len(items)-1)
-		} else if current > 0 {
-			listSelectItem(props, selectedIndex, scrollOffset, current-1)
-		}
-	}
-}
-
-// listHandleKeyEnter handles the keyEnter event for selecting current item.
-func listHandleKeyEnter[T any](props ListProps[T], selectedIndex *bubbly.Ref[int]) func(interface{}) {
-	return func(_ interface{}) {
-		items := props.Items.Get().([]T)
-		current := selectedIndex.Get().(int)
-
-		if current >= 0 && current < len(items) && props.OnSelect != nil {
-			props.OnSelect(items[current], current)
-		}
-	}
-}
-
-// listHandleKeyHomeEnd handles the keyHome and keyEnd events for jumping to first/last.
-func listHandleKeyHomeEnd[T any](props ListProps[T], selectedIndex, scrollOffset *bubbly.Ref[int], toFirst bool) func(interface{}) {
-	return func(_ interface{}) {
-		items := props.Items.Get().([]T)
-		if len(items) > 0 {
-			if toFirst {
-				listSelectItem(props, selectedIndex, scrollOffset, 0)
-			} else {
-				listSelectItem(props, selectedIndex, scrollOffset, len(items)-1)
-			}
-		}
-	}
-}
-
+// Example with a controlled cursor:
+//
+//	cursor := bubbly.NewRef(0)
+//	list := components.List(components.ListProps[string]{
+//	    Items:      itemsRef,
+//	    RenderItem: func(item string, i int) string { return item },
+//	    Selected:   cursor,
+//	    Focused:    listFocused, // Keys only move this list while focused
+//	})
+//
+//	cursor.Set(42) // Moves the cursor and scrolls it into view
+//
+// Example with search highlighting:
+//
+//	search := composables.UseSearchableList(ctx, products,
+//	    composables.MatchSubstring(func(p Product) string { return p.Name }))
+//	list := components.List(components.ListProps[Product]{
+//	    Items:      search.Items,
+//	    RenderItem: func(p Product, _ int) string { return p.Name },
+//	    Highlights: search.Highlights, // Emphasizes the matched text
+//	})
 func List[T any](props ListProps[T]) bubbly.Component {
-	comp, err := bubbly.NewComponent("List").
+	builder := bubbly.NewComponent("List").
 		Props(props).
 		Setup(func(ctx *bubbly.Context) {
 			// Inject theme for styling
 			theme := ctx.Inject("theme", DefaultTheme).(Theme)
 
 			// Internal state
-			selectedIndex := bubbly.NewRef(-1) // Currently selected item index (-1 = none)
-			scrollOffset := bubbly.NewRef(0)   // Scroll position for virtual scrolling
+			selectedIndex := props.Selected // Cursor index (-1 = none)
+			if selectedIndex == nil {
+				selectedIndex = bubbly.NewRef(-1)
+			}
+			scrollOffset := bubbly.NewRef(0) // Scroll position for virtual scrolling
+
+			// Keep the cursor visible, however it moved
+			listScrollTo(props, scrollOffset, selectedIndex.GetTyped())
+			cleanup := bubbly.Watch(selectedIndex, func(index, _ int) {
+				listScrollTo(props, scrollOffset, index)
+			})
+			ctx.OnUnmounted(cleanup)
 
 			// Expose state for testing
 			ctx.Expose("selectedIndex", selectedIndex)
 			ctx.Expose("scrollOffset", scrollOffset)
 			ctx.Expose("theme", theme)
 
-			// Register keyboard navigation events using extracted handlers
-			ctx.On("keyDown", listHandleKeyDown(props, selectedIndex, scrollOffset))
-			ctx.On("keyUp", listHandleKeyUp(props, selectedIndex, scrollOffset))
+			// Register keyboard navigation events
+			height := listHeight(props)
+			ctx.On("keyDown", listHandleMove(props, selectedIndex, 1))
+			ctx.On("keyUp", listHandleMove(props, selectedIndex, -1))
+			ctx.On("keyPageDown", listHandleMove(props, selectedIndex, height))
+			ctx.On("keyPageUp", listHandleMove(props, selectedIndex, -height))
 			ctx.On("keyEnter", listHandleKeyEnter(props, selectedIndex))
-			ctx.On("keyHome", listHandleKeyHomeEnd(props, selectedIndex, scrollOffset, true))
-			ctx.On("keyEnd", listHandleKeyHomeEnd(props, selectedIndex, scrollOffset, false))
+			ctx.On("keyHome", listHandleKeyHomeEnd(props, selectedIndex, true))
+			ctx.On("keyEnd", listHandleKeyHomeEnd(props, selectedIndex, false))
 		}).
 		Template(func(ctx bubbly.RenderContext) string {
 			p := ctx.Props().(ListProps[T])
@@ -239,10 +211,7 @@ func List[T any](props ListProps[T]) bubbly.Component {
 			}
 
 			// Determine visible range
-			height := p.Height
-			if height <= 0 {
-				height = 10
-			}
+			height := listHeight(p)
 
 			var visibleItems []T
 			var startIndex int
@@ -293,6 +262,15 @@ func List[T any](props ListProps[T]) bubbly.Component {
 						Padding(0, 1)
 				}
 
+				if p.Highlights != nil {
+					textStyle := itemStyle.UnsetPadding()
+					matchStyle := textStyle.Underline(true).Bold(true)
+					if actualIndex != selectedIndex {
+						matchStyle = matchStyle.Foreground(theme.Primary)
+					}
+					itemText = listHighlight(itemText, p.Highlights(item, actualIndex), textStyle, matchStyle)
+				}
+
 				output.WriteString(itemStyle.Render(itemText))
 				output.WriteString("\n")
 			}
@@ -321,12 +299,145 @@ func List[T any](props ListProps[T]) bubbly.Component {
 			}
 
 			return result
-		}).
-		Build()
+		})
+
+	for _, binding := range listKeyBindings {
+		builder = builder.WithConditionalKeyBinding(bubbly.KeyBinding{
+			Key:         binding.Key,
+			Event:       binding.Event,
+			Description: binding.Description,
+			Condition:   listFocusCondition(props.Focused),
+		})
+	}
 
+	comp, err := builder.Build()
 	if err != nil {
 		panic(err) // Should never happen with valid setup
 	}
 
 	return comp
 }
+
+// listHighlight renders the ranges of text with matchStyle and the rest
+// with textStyle. Ranges are sorted and clipped to the text; empty ranges
+// and ranges that would split a character are skipped.
+func listHighlight(text string, ranges [][2]int, textStyle, matchStyle lipgloss.Style) string {
+	if len(ranges) == 0 || text == "" {
+		return text
+	}
+	ranges = slices.Clone(ranges)
+	slices.SortFunc(ranges, func(a, b [2]int) int { return a[0] - b[0] })
+
+	var output strings.Builder
+	pos := 0
+	for _, r := range ranges {
+		start, end := max(r[0], pos), min(r[1], len(text))
+		if start >= end || !utf8.RuneStart(text[start]) || (end < len(text) && !utf8.RuneStart(text[end])) {
+			continue
+		}
+		if start > pos {
+			output.WriteString(textStyle.Render(text[pos:start]))
+		}
+		output.WriteString(matchStyle.Render(text[start:end]))
+		pos = end
+	}
+	if pos == 0 {
+		return text
+	}
+	if pos < len(text) {
+		output.WriteString(textStyle.Render(text[pos:]))
+	}
+	return output.String()
+}
+
+// listKeyBindings are the built-in key bindings of List.
+var listKeyBindings = []bubbly.KeyBinding{
+	{Key: "up", Event: "keyUp", Description: "Previous item"},
+	{Key: "k", Event: "keyUp", Description: "Previous item"},
+	{Key: "down", Event: "keyDown", Description: "Next item"},
+	{Key: "j", Event: "keyDown", Description: "Next item"},
+	{Key: "pgup", Event: "keyPageUp", Description: "Previous page"},
+	{Key: "pgdown", Event: "keyPageDown", Description: "Next page"},
+	{Key: "home", Event: "keyHome", Description: "First item"},
+	{Key: "g", Event: "keyHome", Description: "First item"},
+	{Key: "end", Event: "keyEnd", Description: "Last item"},
+	{Key: "G", Event: "keyEnd", Description: "Last item"},
+	{Key: "enter", Event: "keyEnter", Description: "Select item"},
+	{Key: " ", Event: "keyEnter", Description: "Select item"},
+}
+
+// listFocusCondition returns the key binding condition for focused; nil
+// means the bindings are always active.
+func listFocusCondition(focused *bubbly.Ref[bool]) func() bool {
+	if focused == nil {
+		return nil
+	}
+	return focused.GetTyped
+}
+
+// listHeight returns the visible height of the list in lines.
+func listHeight[T any](props ListProps[T]) int {
+	if props.Height <= 0 {
+		return 10
+	}
+	return props.Height
+}
+
+// listScrollTo adjusts the scroll offset so index is visible.
+func listScrollTo[T any](props ListProps[T], scrollOffset *bubbly.Ref[int], index int) {
+	if index < 0 {
+		return
+	}
+	height := listHeight(props)
+	offset := scrollOffset.GetTyped()
+
+	// Scroll down if selected item is below visible area
+	if index >= offset+height {
+		scrollOffset.Set(index - height + 1)
+	}
+
+	// Scroll up if selected item is above visible area
+	if index < offset {
+		scrollOffset.Set(index)
+	}
+}
+
+// listHandleMove moves the cursor by delta items, clamped to the list or
+// wrapping with props.Wrap. With no cursor, moving down starts at the first
+// item and moving up at the last.
+func listHandleMove[T any](props ListProps[T], selectedIndex *bubbly.Ref[int], delta int) func(interface{}) {
+	return func(_ interface{}) {
+		current := selectedIndex.GetTyped()
+		next := navigateIndex(len(props.Items.GetTyped()), current, delta, props.Wrap, nil)
+		if next != current {
+			selectedIndex.Set(next)
+		}
+	}
+}
+
+// listHandleKeyEnter handles the keyEnter event for selecting current item.
+func listHandleKeyEnter[T any](props ListProps[T], selectedIndex *bubbly.Ref[int]) func(interface{}) {
+	return func(_ interface{}) {
+		items := props.Items.GetTyped()
+		current := selectedIndex.GetTyped()
+
+		if current >= 0 && current < len(items) && props.OnSelect != nil {
+			props.OnSelect(items[current], current)
+		}
+	}
+}
+
+// listHandleKeyHomeEnd handles the keyHome and keyEnd events for jumping to first/last.
+func listHandleKeyHomeEnd[T any](props ListProps[T], selectedIndex *bubbly.Ref[int], toFirst bool) func(interface{}) {
+	return func(_ interface{}) {
+		count := len(props.Items.GetTyped())
+		if count == 0 {
+			return
+		}
+		if toFirst {
+			selectedIndex.Set(0)
+		} else {
+			selectedIndex.Set(count - 1)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
@@ -675,3 +678,175 @@ func TestList_ScrollOffset_BeyondVisible(t *testing.T) {
 	output := list.View()
 	assert.NotEmpty(t, output, "Should handle extensive scroll operations")
 }
+
+// TestList_KeyBindings tests that the built-in key bindings move the cursor.
+func TestList_KeyBindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []tea.KeyMsg
+		expected int
+	}{
+		{name: "down arrow", keys: []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyDown}}, expected: 1},
+		{name: "vim keys", keys: []tea.KeyMsg{runeKey('j'), runeKey('j'), runeKey('k')}, expected: 0},
+		{name: "end", keys: []tea.KeyMsg{{Type: tea.KeyEnd}}, expected: 19},
+		{name: "G then home", keys: []tea.KeyMsg{runeKey('G'), {Type: tea.KeyHome}}, expected: 0},
+		{name: "page down", keys: []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyPgDown}}, expected: 5},
+		{name: "page up clamps", keys: []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyPgUp}}, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := make([]string, 20)
+			for i := range data {
+				data[i] = fmt.Sprintf("Item %d", i)
+			}
+			selected := bubbly.NewRef(-1)
+			list := List(ListProps[string]{
+				Items:      bubbly.NewRef(data),
+				RenderItem: func(item string, _ int) string { return item },
+				Height:     5,
+				Selected:   selected,
+			})
+			list.Init()
+
+			for _, key := range tt.keys {
+				list.Update(key)
+			}
+
+			assert.Equal(t, tt.expected, selected.GetTyped())
+		})
+	}
+}
+
+// TestList_Focused tests that key bindings only apply while focused.
+func TestList_Focused(t *testing.T) {
+	focused := bubbly.NewRef(false)
+	selected := bubbly.NewRef(0)
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef([]string{"a", "b", "c"}),
+		RenderItem: func(item string, _ int) string { return item },
+		Selected:   selected,
+		Focused:    focused,
+	})
+	list.Init()
+
+	list.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 0, selected.GetTyped(), "Unfocused list should ignore keys")
+
+	focused.Set(true)
+	list.Update(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, selected.GetTyped())
+}
+
+// TestList_ControlledSelected tests that setting Selected scrolls the cursor into view.
+func TestList_ControlledSelected(t *testing.T) {
+	data := make([]string, 50)
+	for i := range data {
+		data[i] = fmt.Sprintf("Item %d", i)
+	}
+	selected := bubbly.NewRef(-1)
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef(data),
+		RenderItem: func(item string, _ int) string { return item },
+		Height:     5,
+		Virtual:    true,
+		Selected:   selected,
+	})
+	list.Init()
+
+	selected.Set(30)
+	output := list.View()
+	assert.Contains(t, output, "Item 30")
+	assert.NotContains(t, output, "Item 25")
+
+	selected.Set(2)
+	output = list.View()
+	assert.Contains(t, output, "Item 2")
+	assert.NotContains(t, output, "Item 30")
+}
+
+// TestList_OnSelect_OnlyOnEnter tests that moving the cursor does not select.
+func TestList_OnSelect_OnlyOnEnter(t *testing.T) {
+	var calls []int
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef([]string{"a", "b", "c"}),
+		RenderItem: func(item string, _ int) string { return item },
+		OnSelect:   func(_ string, index int) { calls = append(calls, index) },
+	})
+	list.Init()
+
+	list.Emit("keyDown", nil)
+	list.Emit("keyDown", nil)
+	assert.Empty(t, calls)
+
+	list.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []int{1}, calls)
+}
+
+func runeKey(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestList_Highlight(t *testing.T) {
+	mark := lipgloss.NewStyle().Transform(func(s string) string { return "[" + s + "]" })
+	plain := lipgloss.NewStyle()
+
+	tests := []struct {
+		name     string
+		text     string
+		ranges   [][2]int
+		expected string
+	}{
+		{name: "no ranges", text: "keyboard", expected: "keyboard"},
+		{name: "single range", text: "keyboard", ranges: [][2]int{{3, 6}}, expected: "key[boa]rd"},
+		{name: "unsorted ranges", text: "banana", ranges: [][2]int{{3, 5}, {1, 3}}, expected: "b[an][an]a"},
+		{name: "overlap clipped", text: "banana", ranges: [][2]int{{1, 4}, {2, 5}}, expected: "b[ana][n]a"},
+		{name: "clipped to text", text: "cable", ranges: [][2]int{{3, 99}}, expected: "cab[le]"},
+		{name: "empty and invalid skipped", text: "cable", ranges: [][2]int{{2, 2}, {-5, -1}, {9, 12}}, expected: "cable"},
+		{name: "split character skipped", text: "café", ranges: [][2]int{{3, 4}, {0, 1}}, expected: "[c]afé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, listHighlight(tt.text, tt.ranges, plain, mark))
+		})
+	}
+}
+
+func TestList_Highlights(t *testing.T) {
+	var calls []int
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef([]string{"mouse", "monitor"}),
+		RenderItem: func(item string, _ int) string { return item },
+		Highlights: func(item string, index int) [][2]int {
+			calls = append(calls, index)
+			return [][2]int{{0, 2}}
+		},
+	})
+	list.Init()
+
+	output := ansi.Strip(list.View())
+
+	assert.Contains(t, output, "mouse", "Highlighting should keep the text intact")
+	assert.Contains(t, output, "monitor")
+	assert.Equal(t, []int{0, 1}, calls)
+}
+
+// TestList_Wrap tests up and down wrap around the ends with Wrap
+func TestList_Wrap(t *testing.T) {
+	cursor := bubbly.NewRef(0)
+	list := List(ListProps[string]{
+		Items:      bubbly.NewRef([]string{"a", "b", "c"}),
+		RenderItem: func(item string, _ int) string { return item },
+		Selected:   cursor,
+		Wrap:       true,
+	})
+	list.Init()
+
+	list.Emit("keyUp", nil)
+	assert.Equal(t, 2, cursor.GetTyped(), "up on first wraps to last")
+	list.Emit("keyDown", nil)
+	assert.Equal(t, 0, cursor.GetTyped(), "down on last wraps to first")
+	list.Emit("keyPageUp", nil)
+	assert.Equal(t, 0, cursor.GetTyped(), "paging does not wrap")
+}
@@ -0,0 +1,161 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// LoadingOverlayProps defines the configuration properties for a LoadingOverlay component.
+//
+// Example usage:
+//
+//	refreshing := bubbly.NewRef(false)
+//	overlay := components.LoadingOverlay(components.LoadingOverlayProps{
+//	    Child:   reportTable,
+//	    Loading: refreshing,
+//	    Label:   "Refreshing...",
+//	})
+type LoadingOverlayProps struct {
+	// Child is the component rendered underneath the overlay.
+	// Required - it stays visible (dimmed) while loading.
+	Child bubbly.Component
+
+	// Loading controls whether the overlay is shown.
+	// Optional - if nil, the overlay is never shown.
+	Loading *bubbly.Ref[bool]
+
+	// Label is the text shown next to the spinner.
+	// Optional - defaults to "Loading...".
+	Label string
+
+	// Common props for all components
+	CommonProps
+}
+
+// LoadingOverlay creates a component that renders its child as-is, and while
+// Loading is true, renders it dimmed with a centered spinner box on top.
+//
+// Unlike swapping the content for a Spinner, the previous content stays
+// visible during a refresh, so the screen doesn't jump. The child remains
+// part of the component tree and keeps receiving messages while loading.
+//
+// The overlay automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	overlay := components.LoadingOverlay(components.LoadingOverlayProps{
+//	    Child:   table,
+//	    Loading: async.Loading,
+//	})
+func LoadingOverlay(props LoadingOverlayProps) bubbly.Component {
+	if props.Label == "" {
+		props.Label = "Loading..."
+	}
+
+	component, err := bubbly.NewComponent("LoadingOverlay").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+
+			if props.Child != nil {
+				_ = ctx.ExposeComponent("child", props.Child)
+			}
+
+			spinner := Spinner(SpinnerProps{
+				Label:  props.Label,
+				Active: true,
+			})
+			_ = ctx.ExposeComponent("spinner", spinner)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(LoadingOverlayProps)
+			theme := ctx.Get("theme").(Theme)
+
+			content := ""
+			if p.Child != nil {
+				content = p.Child.View()
+			}
+			if p.Loading == nil || !p.Loading.GetTyped() {
+				return content
+			}
+
+			boxStyle := lipgloss.NewStyle().
+				Border(lipgloss.RoundedBorder()).
+				BorderForeground(theme.Primary).
+				Padding(0, 1)
+			if p.Style != nil {
+				boxStyle = boxStyle.Inherit(*p.Style)
+			}
+			box := boxStyle.Render(ctx.Get("spinner").(bubbly.Component).View())
+
+			dimStyle := lipgloss.NewStyle().Foreground(theme.Muted).Faint(true)
+			return loadingOverlayCompose(content, box, dimStyle)
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
+
+// WithLoadingOverlay wraps child in a LoadingOverlay shown while loading is true.
+//
+// Example:
+//
+//	fetch := composables.UseAsync(ctx, loadReport)
+//	screen := components.WithLoadingOverlay(reportView, fetch.Loading)
+func WithLoadingOverlay(child bubbly.Component, loading *bubbly.Ref[bool]) bubbly.Component {
+	return LoadingOverlay(LoadingOverlayProps{
+		Child:   child,
+		Loading: loading,
+	})
+}
+
+// loadingOverlayCompose dims background and draws box centered on top of it.
+// The canvas grows to fit the box if the background is smaller.
+func loadingOverlayCompose(background, box string, dimStyle lipgloss.Style) string {
+	bgLines := strings.Split(background, "\n")
+	boxLines := strings.Split(box, "\n")
+
+	width := lipgloss.Width(background)
+	boxWidth := lipgloss.Width(box)
+	if boxWidth > width {
+		width = boxWidth
+	}
+	height := len(bgLines)
+	if len(boxLines) > height {
+		height = len(boxLines)
+	}
+
+	top := (height - len(boxLines)) / 2
+	left := (width - boxWidth) / 2
+
+	lines := make([]string, height)
+	for i := range lines {
+		bg := ""
+		if i < len(bgLines) {
+			bg = ansi.Strip(bgLines[i])
+		}
+		bg = bubbly.PadVisible(bg, width)
+
+		if i < top || i >= top+len(boxLines) {
+			lines[i] = dimStyle.Render(bg)
+			continue
+		}
+
+		boxLine := bubbly.PadVisible(boxLines[i-top], boxWidth)
+		leftPart := bubbly.PadVisible(ansi.Truncate(bg, left, ""), left)
+		rightPart := ansi.TruncateLeft(bg, left+boxWidth, "")
+		lines[i] = dimStyle.Render(leftPart) + boxLine + dimStyle.Render(rightPart)
+	}
+
+	return strings.Join(lines, "\n")
+}
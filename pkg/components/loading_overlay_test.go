@@ -0,0 +1,73 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// loadingOverlayTestChild renders a fixed block of text.
+func loadingOverlayTestChild(t *testing.T, content string) bubbly.Component {
+	t.Helper()
+	child, err := bubbly.NewComponent("Content").
+		Template(func(ctx bubbly.RenderContext) string { return content }).
+		Build()
+	require.NoError(t, err)
+	return child
+}
+
+func TestLoadingOverlay_TogglesWithLoading(t *testing.T) {
+	content := strings.Repeat(strings.Repeat("x", 30)+"\n", 6) + strings.Repeat("x", 30)
+	loading := bubbly.NewRef(false)
+	overlay := WithLoadingOverlay(loadingOverlayTestChild(t, content), loading)
+	overlay.Init()
+
+	assert.Equal(t, content, overlay.View(), "renders child untouched when idle")
+
+	loading.Set(true)
+	output := ansi.Strip(overlay.View())
+	lines := strings.Split(output, "\n")
+	require.Len(t, lines, 7, "keeps the child's height")
+
+	assert.Contains(t, output, "Loading...")
+	assert.True(t, strings.HasPrefix(lines[0], "xxxx"), "child stays visible around the box")
+	assert.Contains(t, lines[3], "Loading...", "box is vertically centered")
+	for _, line := range lines {
+		assert.Equal(t, 30, bubbly.VisibleWidth(line), "keeps the child's width")
+	}
+
+	loading.Set(false)
+	assert.Equal(t, content, overlay.View())
+}
+
+func TestLoadingOverlay_GrowsToFitBox(t *testing.T) {
+	overlay := LoadingOverlay(LoadingOverlayProps{
+		Child:   loadingOverlayTestChild(t, "ok"),
+		Loading: bubbly.NewRef(true),
+		Label:   "Refreshing data",
+	})
+	overlay.Init()
+
+	output := ansi.Strip(overlay.View())
+	assert.Contains(t, output, "Refreshing data")
+	assert.Len(t, strings.Split(output, "\n"), 3, "border plus spinner line")
+}
+
+func TestLoadingOverlay_NilChildAndLoading(t *testing.T) {
+	overlay := LoadingOverlay(LoadingOverlayProps{})
+	overlay.Init()
+	assert.Equal(t, "", overlay.View())
+}
+
+func TestLoadingOverlayCompose_KeepsSurroundingText(t *testing.T) {
+	background := "abcdefghij\nabcdefghij\nabcdefghij"
+	output := loadingOverlayCompose(background, "XX", lipgloss.NewStyle())
+
+	assert.Equal(t, "abcdefghij\nabcdXXghij\nabcdefghij", output)
+}
@@ -0,0 +1,386 @@
+package components
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// MarkdownStyles defines the lipgloss styles used to render each markdown element.
+//
+// Use DefaultMarkdownStyles to derive styles from a Theme and override only
+// the elements you want to change:
+//
+//	styles := components.DefaultMarkdownStyles(theme)
+//	styles.Heading1 = styles.Heading1.Foreground(lipgloss.Color("205"))
+type MarkdownStyles struct {
+	// Heading1 styles level-1 headings ("# Title").
+	Heading1 lipgloss.Style
+
+	// Heading2 styles level-2 headings ("## Section").
+	Heading2 lipgloss.Style
+
+	// Heading styles level-3 and deeper headings.
+	Heading lipgloss.Style
+
+	// Text styles paragraph text.
+	Text lipgloss.Style
+
+	// Bold styles **strong** text.
+	Bold lipgloss.Style
+
+	// Italic styles *emphasized* text.
+	Italic lipgloss.Style
+
+	// Code styles `inline code` spans.
+	Code lipgloss.Style
+
+	// CodeBlock styles fenced code blocks.
+	CodeBlock lipgloss.Style
+
+	// Link styles link text.
+	Link lipgloss.Style
+
+	// LinkURL styles the URL shown after link text.
+	LinkURL lipgloss.Style
+
+	// Bullet styles list markers.
+	Bullet lipgloss.Style
+
+	// Quote styles blockquotes.
+	Quote lipgloss.Style
+
+	// Rule styles horizontal rules.
+	Rule lipgloss.Style
+}
+
+// DefaultMarkdownStyles returns markdown styles derived from a theme.
+// Headings use the primary color, code uses the secondary color, and links
+// use the info color.
+func DefaultMarkdownStyles(theme Theme) MarkdownStyles {
+	return MarkdownStyles{
+		Heading1:  lipgloss.NewStyle().Bold(true).Underline(true).Foreground(theme.Primary),
+		Heading2:  lipgloss.NewStyle().Bold(true).Foreground(theme.Primary),
+		Heading:   lipgloss.NewStyle().Bold(true).Foreground(theme.Secondary),
+		Text:      lipgloss.NewStyle().Foreground(theme.Foreground),
+		Bold:      lipgloss.NewStyle().Bold(true),
+		Italic:    lipgloss.NewStyle().Italic(true),
+		Code:      lipgloss.NewStyle().Foreground(theme.Secondary),
+		CodeBlock: lipgloss.NewStyle().Foreground(theme.Secondary).PaddingLeft(2),
+		Link:      lipgloss.NewStyle().Underline(true).Foreground(theme.Info),
+		LinkURL:   lipgloss.NewStyle().Foreground(theme.Muted),
+		Bullet:    lipgloss.NewStyle().Foreground(theme.Primary),
+		Quote:     lipgloss.NewStyle().Italic(true).Foreground(theme.Muted),
+		Rule:      lipgloss.NewStyle().Foreground(theme.BorderColor),
+	}
+}
+
+// MarkdownProps defines the configuration properties for a Markdown component.
+//
+// Example usage:
+//
+//	help := components.Markdown(components.MarkdownProps{
+//	    Content: "# Help\n\nPress **q** to quit.\n\n- `j`/`k` to move\n- `enter` to select",
+//	    Width:   60,
+//	})
+type MarkdownProps struct {
+	// Content is the markdown source to render.
+	// Required - an empty string renders nothing.
+	Content string
+
+	// Width is the wrap width in characters.
+	// Optional - if 0, lines are not wrapped.
+	Width int
+
+	// Styles customizes element styles based on the active theme.
+	// Optional - defaults to DefaultMarkdownStyles.
+	Styles func(Theme) MarkdownStyles
+
+	// HideLinkURLs renders only the link text, omitting "(url)".
+	// Default: false.
+	HideLinkURLs bool
+
+	// Common props for all components
+	CommonProps
+}
+
+// Inline markdown patterns, applied in order.
+var (
+	markdownCodePattern   = regexp.MustCompile("`([^`]+)`")
+	markdownLinkPattern   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	markdownBoldPattern   = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	markdownItalicPattern = regexp.MustCompile(`\*([^*\s][^*]*)\*|\b_([^_\s][^_]*)_\b`)
+	markdownOrderedItem   = regexp.MustCompile(`^(\d+)[.)]\s+(.*)$`)
+	markdownHeading       = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	markdownRule          = regexp.MustCompile(`^(\*\s*){3,}$|^(-\s*){3,}$|^(_\s*){3,}$`)
+)
+
+// markdownRenderer renders markdown source to styled terminal output.
+type markdownRenderer struct {
+	styles   MarkdownStyles
+	theme    Theme
+	width    int
+	showURLs bool
+}
+
+// renderInline applies inline formatting (code, links, bold, italic) to text.
+// Code spans are rendered first and protected from further formatting.
+func (r *markdownRenderer) renderInline(text string) string {
+	var codes []string
+	text = markdownCodePattern.ReplaceAllStringFunc(text, func(m string) string {
+		codes = append(codes, r.styles.Code.Render(m[1:len(m)-1]))
+		return markdownPlaceholder(len(codes) - 1)
+	})
+
+	text = markdownLinkPattern.ReplaceAllStringFunc(text, func(m string) string {
+		parts := markdownLinkPattern.FindStringSubmatch(m)
+		link := r.styles.Link.Render(parts[1])
+		if r.showURLs && parts[2] != parts[1] {
+			link += " " + r.styles.LinkURL.Render("("+parts[2]+")")
+		}
+		return link
+	})
+
+	text = markdownBoldPattern.ReplaceAllStringFunc(text, func(m string) string {
+		parts := markdownBoldPattern.FindStringSubmatch(m)
+		return r.styles.Bold.Render(parts[1] + parts[2])
+	})
+
+	text = markdownItalicPattern.ReplaceAllStringFunc(text, func(m string) string {
+		parts := markdownItalicPattern.FindStringSubmatch(m)
+		return r.styles.Italic.Render(parts[1] + parts[2])
+	})
+
+	for i, code := range codes {
+		text = strings.Replace(text, markdownPlaceholder(i), code, 1)
+	}
+	return text
+}
+
+// markdownPlaceholder returns the placeholder that protects the i-th code span.
+func markdownPlaceholder(i int) string {
+	return "\x00" + strconv.Itoa(i) + "\x00"
+}
+
+// wrap renders text wrapped to width minus indent, prefixing the first line
+// with first and continuation lines with spaces of equal width.
+func (r *markdownRenderer) wrap(text, first string, style lipgloss.Style) string {
+	indent := lipgloss.Width(first)
+	if r.width > indent {
+		style = style.Width(r.width - indent)
+	}
+	lines := strings.Split(style.Render(text), "\n")
+	pad := strings.Repeat(" ", indent)
+	for i := range lines {
+		if i == 0 {
+			lines[i] = first + lines[i]
+		} else {
+			lines[i] = pad + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// heading returns the style for a heading level.
+func (r *markdownRenderer) heading(level int) lipgloss.Style {
+	switch level {
+	case 1:
+		return r.styles.Heading1
+	case 2:
+		return r.styles.Heading2
+	default:
+		return r.styles.Heading
+	}
+}
+
+// render converts markdown source into rendered blocks separated by blank lines.
+//
+//nolint:gocyclo // Block parsing is a single linear state machine
+func (r *markdownRenderer) render(source string) string {
+	var blocks []string
+	var paragraph []string
+	var code []string
+	inCode := false
+	codeLang := ""
+	lastKind := ""
+
+	// add appends a rendered block; consecutive list items and quote lines
+	// are grouped into one block so they aren't separated by blank lines.
+	add := func(kind, rendered string) {
+		if n := len(blocks); n > 0 && kind != "" && kind == lastKind {
+			blocks[n-1] += "\n" + rendered
+		} else {
+			blocks = append(blocks, rendered)
+		}
+		lastKind = kind
+	}
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			text := r.renderInline(strings.Join(paragraph, " "))
+			add("", r.wrap(text, "", r.styles.Text))
+			paragraph = nil
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		// Fenced code blocks are rendered verbatim
+		if strings.HasPrefix(trimmed, "```") {
+			if inCode {
+				add("", r.codeBlock(code, codeLang))
+				code = nil
+				inCode = false
+			} else {
+				flush()
+				inCode = true
+				codeLang = strings.TrimSpace(strings.TrimPrefix(trimmed, "```"))
+			}
+			continue
+		}
+		if inCode {
+			code = append(code, line)
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			flush()
+			lastKind = ""
+
+		case markdownHeading.MatchString(trimmed):
+			flush()
+			m := markdownHeading.FindStringSubmatch(trimmed)
+			add("", r.wrap(r.renderInline(m[2]), "", r.heading(len(m[1]))))
+
+		case markdownRule.MatchString(trimmed):
+			flush()
+			width := r.width
+			if width <= 0 {
+				width = 40
+			}
+			add("", r.styles.Rule.Render(strings.Repeat("─", width)))
+
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+			text := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			add("quote", r.wrap(r.renderInline(text), "│ ", r.styles.Quote))
+
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "+ "):
+			flush()
+			depth := markdownIndentDepth(line)
+			marker := strings.Repeat("  ", depth) + r.styles.Bullet.Render("•") + " "
+			add("list", r.wrap(r.renderInline(trimmed[2:]), marker, r.styles.Text))
+
+		case markdownOrderedItem.MatchString(trimmed):
+			flush()
+			m := markdownOrderedItem.FindStringSubmatch(trimmed)
+			depth := markdownIndentDepth(line)
+			marker := strings.Repeat("  ", depth) + r.styles.Bullet.Render(m[1]+".") + " "
+			add("list", r.wrap(r.renderInline(m[2]), marker, r.styles.Text))
+
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+
+	// Unterminated code fences still render their contents
+	if inCode {
+		add("", r.codeBlock(code, codeLang))
+	}
+	flush()
+
+	return strings.Join(blocks, "\n\n")
+}
+
+// codeBlock renders a fenced code block, highlighting it when the fence
+// names a language CodeBlock understands.
+func (r *markdownRenderer) codeBlock(lines []string, language string) string {
+	source := strings.Join(lines, "\n")
+	if _, ok := codeLanguages[strings.ToLower(language)]; ok {
+		source = strings.Join(highlightCode(source, language, r.theme), "\n")
+	}
+	return r.styles.CodeBlock.Render(source)
+}
+
+// markdownIndentDepth returns the nesting depth of a list item from its indentation.
+func markdownIndentDepth(line string) int {
+	spaces := 0
+	for _, ch := range line {
+		switch ch {
+		case ' ':
+			spaces++
+		case '\t':
+			spaces += 4
+		default:
+			return spaces / 2
+		}
+	}
+	return spaces / 2
+}
+
+// Markdown creates a component that renders a subset of markdown as styled terminal output.
+//
+// Supported syntax:
+//   - Headings: "#" through "######"
+//   - Emphasis: **bold**, __bold__, *italic*, _italic_
+//   - Inline code: `code`
+//   - Fenced code blocks: ``` ... ```, highlighted when a language is named
+//   - Lists: "-", "*", "+" bullets and "1." numbered items, nested by indentation
+//   - Links: [text](url), rendered as text followed by the URL
+//   - Blockquotes: "> quote"
+//   - Horizontal rules: "---", "***", "___"
+//
+// Paragraphs and list items are word-wrapped to Width. Element styles come
+// from the injected theme via DefaultMarkdownStyles, or from the Styles hook.
+//
+// Example:
+//
+//	doc := components.Markdown(components.MarkdownProps{
+//	    Content: helpText,
+//	    Width:   70,
+//	    Styles: func(theme components.Theme) components.MarkdownStyles {
+//	        s := components.DefaultMarkdownStyles(theme)
+//	        s.Code = s.Code.Foreground(theme.Warning)
+//	        return s
+//	    },
+//	})
+func Markdown(props MarkdownProps) bubbly.Component {
+	component, _ := bubbly.NewComponent("Markdown").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(MarkdownProps)
+			theme := ctx.Get("theme").(Theme)
+
+			styles := DefaultMarkdownStyles(theme)
+			if p.Styles != nil {
+				styles = p.Styles(theme)
+			}
+
+			r := &markdownRenderer{
+				styles:   styles,
+				theme:    theme,
+				width:    p.Width,
+				showURLs: !p.HideLinkURLs,
+			}
+			result := r.render(p.Content)
+
+			if p.Style != nil {
+				result = p.Style.Render(result)
+			}
+			return result
+		}).
+		Build()
+
+	return component
+}
@@ -0,0 +1,142 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// renderMarkdown renders markdown and returns plain text with trailing spaces trimmed per line.
+func renderMarkdown(t *testing.T, props MarkdownProps) string {
+	t.Helper()
+	md := Markdown(props)
+	require.NotNil(t, md)
+	md.Init()
+
+	lines := strings.Split(ansi.Strip(md.View()), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// TestMarkdown_Blocks tests block-level markdown rendering.
+func TestMarkdown_Blocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "heading and paragraph",
+			content:  "# Title\n\nSome text\ncontinues here.",
+			expected: "Title\n\nSome text continues here.",
+		},
+		{
+			name:     "bullet list grouped",
+			content:  "- one\n* two\n+ three",
+			expected: "• one\n• two\n• three",
+		},
+		{
+			name:     "nested bullet list",
+			content:  "- parent\n  - child",
+			expected: "• parent\n  • child",
+		},
+		{
+			name:     "ordered list",
+			content:  "1. first\n2. second",
+			expected: "1. first\n2. second",
+		},
+		{
+			name:     "code block verbatim",
+			content:  "```go\nx := **1**\n```",
+			expected: "  x := **1**",
+		},
+		{
+			name:     "blockquote",
+			content:  "> quoted",
+			expected: "│ quoted",
+		},
+		{
+			name:     "empty content",
+			content:  "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, renderMarkdown(t, MarkdownProps{Content: tt.content}))
+		})
+	}
+}
+
+// TestMarkdown_Inline tests inline formatting.
+func TestMarkdown_Inline(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    MarkdownProps
+		expected string
+	}{
+		{name: "bold and italic", props: MarkdownProps{Content: "a **b** and *c* and __d__"}, expected: "a b and c and d"},
+		{name: "inline code protects emphasis", props: MarkdownProps{Content: "run `**x**` now"}, expected: "run **x** now"},
+		{name: "link with url", props: MarkdownProps{Content: "see [docs](https://x.dev)"}, expected: "see docs (https://x.dev)"},
+		{name: "link url hidden", props: MarkdownProps{Content: "see [docs](https://x.dev)", HideLinkURLs: true}, expected: "see docs"},
+		{name: "snake_case untouched", props: MarkdownProps{Content: "my_var_name"}, expected: "my_var_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, renderMarkdown(t, tt.props))
+		})
+	}
+}
+
+// TestMarkdown_Wrapping tests that paragraphs and list items wrap to Width.
+func TestMarkdown_Wrapping(t *testing.T) {
+	output := renderMarkdown(t, MarkdownProps{
+		Content: "alpha beta gamma delta\n\n- epsilon zeta eta theta",
+		Width:   12,
+	})
+
+	for _, line := range strings.Split(output, "\n") {
+		assert.LessOrEqual(t, lipgloss.Width(line), 12, "line %q exceeds width", line)
+	}
+	assert.Contains(t, output, "• epsilon")
+	assert.Contains(t, output, "\n  zeta", "list continuation is indented under the text")
+}
+
+// TestMarkdown_StylesHook tests that the Styles hook receives the injected theme.
+func TestMarkdown_StylesHook(t *testing.T) {
+	var got Theme
+	custom := DarkTheme
+
+	parent, err := bubbly.NewComponent("Parent").
+		Setup(func(ctx *bubbly.Context) {
+			ctx.Provide("theme", custom)
+			_ = ctx.ExposeComponent("md", Markdown(MarkdownProps{
+				Content: "# Hi",
+				Styles: func(theme Theme) MarkdownStyles {
+					got = theme
+					s := DefaultMarkdownStyles(theme)
+					s.Heading1 = lipgloss.NewStyle().SetString(">")
+					return s
+				},
+			}))
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			return ctx.Get("md").(bubbly.Component).View()
+		}).
+		Build()
+	require.NoError(t, err)
+	parent.Init()
+
+	assert.Equal(t, "> Hi", ansi.Strip(parent.View()))
+	assert.Equal(t, custom.Primary, got.Primary)
+}
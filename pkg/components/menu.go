@@ -36,6 +36,22 @@ type MenuProps struct {
 	// Optional - if nil, no callback is executed.
 	OnSelect func(string)
 
+	// Cursor is the reactive index of the item keyboard navigation is on,
+	// -1 for none. Set it to move the cursor programmatically.
+	// Optional - an internal ref is used if nil, which starts on the
+	// Selected item and moves to it whenever Selected changes.
+	Cursor *bubbly.Ref[int]
+
+	// Focused, when set, limits the built-in key bindings to times when it
+	// is true, so the menu only navigates while it has focus.
+	// Optional - if nil, the menu always handles its keys.
+	Focused *bubbly.Ref[bool]
+
+	// Wrap makes up on the first item move the cursor to the last, and
+	// down on the last to the first. Paging and Home/End never wrap.
+	// Optional - defaults to false (the cursor stops at the ends).
+	Wrap bool
+
 	// Width sets the menu width in characters.
 	// Default is 30 if not specified.
 	Width int
@@ -50,12 +66,25 @@ type MenuProps struct {
 // Features:
 //   - List of menu items with labels
 //   - Selected item highlighting
+//   - Keyboard navigation with a cursor that skips disabled items
 //   - Disabled item support
 //   - Reactive selection state
 //   - OnSelect callback
 //   - Theme integration
 //   - Custom style override
 //
+// Keyboard controls (built-in key bindings):
+//   - ↑/k: Move cursor up ("keyUp")
+//   - ↓/j: Move cursor down ("keyDown")
+//   - PgUp/PgDown: Move cursor by one page of 10 items ("keyPageUp", "keyPageDown")
+//   - Home/g: Jump to first item ("keyHome")
+//   - End/G: Jump to last item ("keyEnd")
+//   - Enter/Space: Select the item under the cursor ("keyEnter")
+//
+// The item under the cursor is highlighted and the Selected item is marked
+// with ▶. Use HandleKey to forward keys from a parent that handles keys
+// itself.
+//
 // Example:
 //
 //	selected := bubbly.NewRef("")
@@ -76,12 +105,29 @@ func Menu(props MenuProps) bubbly.Component {
 		props.Width = 30
 	}
 
-	component, _ := bubbly.NewComponent("Menu").
+	builder := bubbly.NewComponent("Menu").
 		Props(props).
 		Setup(func(ctx *bubbly.Context) {
 			// Inject theme using helper
 			setupTheme(ctx)
 
+			cursor := props.Cursor
+			if cursor == nil {
+				cursor = bubbly.NewRef(menuIndexOf(props))
+
+				// Keep the internal cursor on the selection when it is set
+				// from outside
+				if props.Selected != nil {
+					cleanup := bubbly.Watch(props.Selected, func(_, _ string) {
+						if index := menuIndexOf(props); index != cursor.GetTyped() {
+							cursor.Set(index)
+						}
+					})
+					ctx.OnUnmounted(cleanup)
+				}
+			}
+			ctx.Expose("cursor", cursor)
+
 			// Handle select event
 			ctx.On("select", func(data interface{}) {
 				value := data.(string)
@@ -92,10 +138,25 @@ func Menu(props MenuProps) bubbly.Component {
 					props.OnSelect(value)
 				}
 			})
+
+			// Register keyboard navigation events
+			ctx.On("keyDown", menuHandleMove(props, cursor, 1))
+			ctx.On("keyUp", menuHandleMove(props, cursor, -1))
+			ctx.On("keyPageDown", menuHandleMove(props, cursor, menuPageSize))
+			ctx.On("keyPageUp", menuHandleMove(props, cursor, -menuPageSize))
+			ctx.On("keyHome", menuHandleJump(props, cursor, 1))
+			ctx.On("keyEnd", menuHandleJump(props, cursor, -1))
+			ctx.On("keyEnter", func(_ interface{}) {
+				index := cursor.GetTyped()
+				if index >= 0 && index < len(props.Items) && !props.Items[index].Disabled {
+					ctx.Emit("select", props.Items[index].Value)
+				}
+			})
 		}).
 		Template(func(ctx bubbly.RenderContext) string {
 			p := ctx.Props().(MenuProps)
 			theme := ctx.Get("theme").(Theme)
+			cursor := ctx.Get("cursor").(*bubbly.Ref[int]).GetTyped()
 
 			var content strings.Builder
 
@@ -109,6 +170,12 @@ func Menu(props MenuProps) bubbly.Component {
 			for i, item := range p.Items {
 				isSelected := item.Value == selectedValue
 
+				// Highlight the cursor, or the selection without one
+				isHighlighted := i == cursor
+				if cursor < 0 || cursor >= len(p.Items) {
+					isHighlighted = isSelected
+				}
+
 				// Build item style
 				itemStyle := lipgloss.NewStyle().
 					Width(p.Width-2).
@@ -117,8 +184,8 @@ func Menu(props MenuProps) bubbly.Component {
 				if item.Disabled {
 					// Disabled state
 					itemStyle = itemStyle.Foreground(theme.Muted)
-				} else if isSelected {
-					// Selected state
+				} else if isHighlighted {
+					// Highlighted state
 					itemStyle = itemStyle.
 						Foreground(lipgloss.Color("230")).
 						Background(theme.Primary).
@@ -152,8 +219,61 @@ func Menu(props MenuProps) bubbly.Component {
 			}
 
 			return menuStyle.Render(content.String())
-		}).
-		Build()
+		})
 
+	for _, binding := range listKeyBindings {
+		builder = builder.WithConditionalKeyBinding(bubbly.KeyBinding{
+			Key:         binding.Key,
+			Event:       binding.Event,
+			Description: binding.Description,
+			Condition:   listFocusCondition(props.Focused),
+		})
+	}
+
+	component, _ := builder.Build()
 	return component
 }
+
+// menuPageSize is the number of items PgUp and PgDown move the cursor.
+const menuPageSize = 10
+
+// menuIndexOf returns the index of the Selected item, or -1.
+func menuIndexOf(props MenuProps) int {
+	if props.Selected == nil {
+		return -1
+	}
+	selected := props.Selected.GetTyped()
+	for i, item := range props.Items {
+		if item.Value == selected {
+			return i
+		}
+	}
+	return -1
+}
+
+// menuHandleMove moves the cursor by delta items, skipping disabled items.
+func menuHandleMove(props MenuProps, cursor *bubbly.Ref[int], delta int) func(interface{}) {
+	return func(_ interface{}) {
+		current := cursor.GetTyped()
+		next := navigateIndex(len(props.Items), current, delta, props.Wrap, menuItemEnabled(props))
+		if next != current {
+			cursor.Set(next)
+		}
+	}
+}
+
+// menuHandleJump moves the cursor to the first enabled item (direction 1)
+// or the last (direction -1).
+func menuHandleJump(props MenuProps, cursor *bubbly.Ref[int], direction int) func(interface{}) {
+	return func(_ interface{}) {
+		next := navigateIndex(len(props.Items), -1, direction, false, menuItemEnabled(props))
+		if next >= 0 && next != cursor.GetTyped() {
+			cursor.Set(next)
+		}
+	}
+}
+
+// menuItemEnabled reports whether item i of props can take the cursor.
+func menuItemEnabled(props MenuProps) func(int) bool {
+	return func(i int) bool { return !props.Items[i].Disabled }
+}
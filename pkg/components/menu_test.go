@@ -3,6 +3,7 @@ package components
 import (
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
@@ -110,3 +111,91 @@ func TestMenu_ThemeIntegration(t *testing.T) {
 
 	assert.NotEmpty(t, output, "Should render with theme")
 }
+
+// TestMenu_KeyboardNavigation tests the cursor skips disabled items and selects with enter
+func TestMenu_KeyboardNavigation(t *testing.T) {
+	items := []MenuItem{
+		{Label: "Home", Value: "home"},
+		{Label: "Archive", Value: "archive", Disabled: true},
+		{Label: "Settings", Value: "settings"},
+		{Label: "Logout", Value: "logout"},
+	}
+
+	tests := []struct {
+		name           string
+		wrap           bool
+		keys           []tea.KeyType
+		expectedCursor int
+	}{
+		{name: "down skips disabled", keys: []tea.KeyType{tea.KeyDown, tea.KeyDown}, expectedCursor: 2},
+		{name: "up from selection skips disabled", keys: []tea.KeyType{tea.KeyEnd, tea.KeyUp, tea.KeyUp}, expectedCursor: 0},
+		{name: "stops at the end", keys: []tea.KeyType{tea.KeyEnd, tea.KeyDown}, expectedCursor: 3},
+		{name: "wraps at the end", wrap: true, keys: []tea.KeyType{tea.KeyEnd, tea.KeyDown}, expectedCursor: 0},
+		{name: "wraps at the start", wrap: true, keys: []tea.KeyType{tea.KeyHome, tea.KeyUp}, expectedCursor: 3},
+		{name: "page down", keys: []tea.KeyType{tea.KeyDown, tea.KeyPgDown}, expectedCursor: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := bubbly.NewRef(-1)
+			menu := Menu(MenuProps{Items: items, Cursor: cursor, Wrap: tt.wrap})
+			menu.Init()
+
+			for _, key := range tt.keys {
+				assert.True(t, HandleKey(menu, tea.KeyMsg{Type: key}))
+			}
+			assert.Equal(t, tt.expectedCursor, cursor.GetTyped())
+		})
+	}
+}
+
+// TestMenu_KeyboardSelect tests enter selects the cursor item, and the cursor starts on the selection
+func TestMenu_KeyboardSelect(t *testing.T) {
+	selected := bubbly.NewRef("settings")
+	var chosen []string
+	focused := bubbly.NewRef(true)
+	menu := Menu(MenuProps{
+		Items: []MenuItem{
+			{Label: "Home", Value: "home"},
+			{Label: "Settings", Value: "settings"},
+			{Label: "Logout", Value: "logout"},
+		},
+		Selected: selected,
+		Focused:  focused,
+		OnSelect: func(value string) { chosen = append(chosen, value) },
+	})
+	menu.Init()
+
+	menu.Update(tea.KeyMsg{Type: tea.KeyDown})
+	menu.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"logout"}, chosen)
+	assert.Equal(t, "logout", selected.GetTyped())
+
+	focused.Set(false)
+	menu.Update(tea.KeyMsg{Type: tea.KeyUp})
+	menu.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"logout"}, chosen, "keys ignored while not focused")
+	assert.Contains(t, menu.View(), "▶ Logout")
+}
+
+// TestMenu_CursorFollowsSelected tests the internal cursor moves with Selected set from outside
+func TestMenu_CursorFollowsSelected(t *testing.T) {
+	selected := bubbly.NewRef("home")
+	var chosen []string
+	menu := Menu(MenuProps{
+		Items: []MenuItem{
+			{Label: "Home", Value: "home"},
+			{Label: "Settings", Value: "settings"},
+			{Label: "Logout", Value: "logout"},
+		},
+		Selected: selected,
+		OnSelect: func(value string) { chosen = append(chosen, value) },
+	})
+	menu.Init()
+
+	selected.Set("logout")
+
+	menu.Update(tea.KeyMsg{Type: tea.KeyUp})
+	menu.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"settings"}, chosen, "navigation continues from the new selection")
+}
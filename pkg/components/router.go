@@ -0,0 +1,343 @@
+package components
+
+import (
+	"sync"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// Router event names.
+//
+// Emit these on the Router, or from any component rendered by it (events
+// bubble up to the Router), to navigate. The helpers Navigate, NavigateBack,
+// and NavigateForward wrap them.
+const (
+	// RouterNavigateEvent navigates to the RouteLocation given as event data,
+	// or to a route name given as a string.
+	RouterNavigateEvent = "routerNavigate"
+
+	// RouterBackEvent moves one entry back in the history stack.
+	RouterBackEvent = "routerBack"
+
+	// RouterForwardEvent moves one entry forward in the history stack.
+	RouterForwardEvent = "routerForward"
+)
+
+// routeProvideKey is the provide/inject key for the current RouteLocation.
+const routeProvideKey = "components.route"
+
+// RouteFactory creates the component rendered for a route.
+// It is called each time the route becomes active, so every visit gets a
+// fresh component instance.
+type RouteFactory func() bubbly.Component
+
+// RouteLocation identifies an entry in the Router history: a route name and
+// the parameters it was navigated with.
+type RouteLocation struct {
+	// Name is the route name (a key of RouterProps.Routes).
+	Name string
+
+	// Params are the parameters passed to Navigate.
+	// May be nil when the route was navigated to without parameters.
+	Params map[string]string
+}
+
+// Param returns the named parameter, or "" if it was not provided.
+func (l RouteLocation) Param(key string) string {
+	return l.Params[key]
+}
+
+// RouterProps defines the configuration properties for a Router component.
+//
+// Example usage:
+//
+//	router := components.Router(components.RouterProps{
+//	    Routes: map[string]components.RouteFactory{
+//	        "home":    CreateHomeScreen,
+//	        "details": CreateDetailsScreen,
+//	    },
+//	    Initial: "home",
+//	})
+type RouterProps struct {
+	// Routes maps route names to component factories.
+	// Required - navigation to a name not in Routes is ignored.
+	Routes map[string]RouteFactory
+
+	// Initial is the name of the route shown first.
+	// Optional - if empty, nothing is rendered until the first Navigate.
+	Initial string
+
+	// InitialParams are the parameters for the initial route.
+	// Optional.
+	InitialParams map[string]string
+
+	// Current is a reactive reference to the active location.
+	// Provide your own Ref to observe navigation from outside the Router.
+	// Optional - an internal Ref is created if nil.
+	Current *bubbly.Ref[RouteLocation]
+
+	// BackKey is the key binding for navigating back.
+	// Optional - defaults to "alt+left". Set to "-" to disable.
+	BackKey string
+
+	// ForwardKey is the key binding for navigating forward.
+	// Optional - defaults to "alt+right". Set to "-" to disable.
+	ForwardKey string
+
+	// Common props for all components
+	CommonProps
+}
+
+// routerApplyDefaults sets default values for RouterProps.
+func routerApplyDefaults(props *RouterProps) {
+	if props.Current == nil {
+		props.Current = bubbly.NewRef(RouteLocation{})
+	}
+	if props.BackKey == "" {
+		props.BackKey = "alt+left"
+	}
+	if props.ForwardKey == "" {
+		props.ForwardKey = "alt+right"
+	}
+}
+
+// routerHistory is the Router's navigation stack.
+// Entries after index are the forward history.
+type routerHistory struct {
+	mu      sync.Mutex
+	entries []RouteLocation
+	index   int
+	active  bubbly.Component
+
+	// generation increments on every activation, to detect navigations
+	// that happen while a route component is being set up
+	generation int
+}
+
+// Router creates a multi-screen container that renders one named route at a time.
+//
+// The Router component provides:
+//   - Named routes mapped to component factories
+//   - A history stack with back and forward navigation
+//   - Route parameters injectable by the rendered component (see UseRoute)
+//   - Global back/forward key bindings (alt+left / alt+right by default)
+//
+// Only the active route's component is mounted: navigating away unmounts it,
+// and navigating back creates a fresh instance from the route's factory with
+// the parameters recorded in history. Navigating from the middle of the
+// history discards the forward entries, like a browser.
+//
+// Events:
+//   - routerNavigate (RouteLocation or string): Navigate to a route
+//   - routerBack: Go back one entry
+//   - routerForward: Go forward one entry
+//
+// Because events bubble, a screen can navigate with ctx.Emit directly:
+//
+//	ctx.Emit(components.RouterNavigateEvent, components.RouteLocation{
+//	    Name:   "details",
+//	    Params: map[string]string{"id": id},
+//	})
+//
+// Example:
+//
+//	router := components.Router(components.RouterProps{
+//	    Routes: map[string]components.RouteFactory{
+//	        "list":    CreateListScreen,
+//	        "details": CreateDetailsScreen,
+//	    },
+//	    Initial: "list",
+//	})
+//
+//	// In CreateDetailsScreen's Setup
+//	route := components.UseRoute(ctx)
+//	item := loadItem(route.Param("id"))
+func Router(props RouterProps) bubbly.Component {
+	routerApplyDefaults(&props)
+
+	builder := bubbly.NewComponent("Router").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+			ctx.Expose("route", props.Current)
+
+			history := &routerHistory{}
+			ctx.Provide(routeProvideKey, props.Current)
+
+			ctx.On(RouterNavigateEvent, func(data interface{}) {
+				var location RouteLocation
+				switch v := data.(type) {
+				case RouteLocation:
+					location = v
+				case string:
+					location = RouteLocation{Name: v}
+				default:
+					return
+				}
+				routerNavigate(ctx, props, history, location)
+			})
+			ctx.On(RouterBackEvent, func(_ interface{}) {
+				routerMove(ctx, props, history, -1)
+			})
+			ctx.On(RouterForwardEvent, func(_ interface{}) {
+				routerMove(ctx, props, history, 1)
+			})
+
+			if props.Initial != "" {
+				routerNavigate(ctx, props, history, RouteLocation{
+					Name:   props.Initial,
+					Params: props.InitialParams,
+				})
+			}
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(RouterProps)
+
+			active, ok := ctx.Get("activeRoute").(bubbly.Component)
+			if !ok || active == nil {
+				return ""
+			}
+
+			result := active.View()
+			if p.Style != nil {
+				result = p.Style.Render(result)
+			}
+			return result
+		})
+
+	if props.BackKey != "-" {
+		builder = builder.WithKeyBinding(props.BackKey, RouterBackEvent, "Back")
+	}
+	if props.ForwardKey != "-" {
+		builder = builder.WithKeyBinding(props.ForwardKey, RouterForwardEvent, "Forward")
+	}
+
+	component, err := builder.Build()
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
+
+// routerNavigate pushes location onto the history and activates it.
+// Unknown route names are ignored.
+func routerNavigate(ctx *bubbly.Context, props RouterProps, history *routerHistory, location RouteLocation) {
+	if _, ok := props.Routes[location.Name]; !ok {
+		return
+	}
+
+	history.mu.Lock()
+	// Drop forward entries, then push
+	if len(history.entries) > 0 {
+		history.entries = history.entries[:history.index+1]
+	}
+	history.entries = append(history.entries, location)
+	history.index = len(history.entries) - 1
+	history.mu.Unlock()
+
+	routerActivate(ctx, props, history, location)
+}
+
+// routerMove moves delta entries through the history, if possible.
+func routerMove(ctx *bubbly.Context, props RouterProps, history *routerHistory, delta int) {
+	history.mu.Lock()
+	target := history.index + delta
+	if target < 0 || target >= len(history.entries) {
+		history.mu.Unlock()
+		return
+	}
+	history.index = target
+	location := history.entries[target]
+	history.mu.Unlock()
+
+	routerActivate(ctx, props, history, location)
+}
+
+// routerActivate unmounts the active route component and mounts a fresh
+// instance for location.
+//
+// history.mu is not held here, so a route component may itself navigate
+// (e.g., redirect) from its Setup; the innermost navigation wins.
+func routerActivate(ctx *bubbly.Context, props RouterProps, history *routerHistory, location RouteLocation) {
+	history.mu.Lock()
+	previous := history.active
+	history.active = nil
+	history.generation++
+	generation := history.generation
+	history.mu.Unlock()
+
+	if previous != nil {
+		if unmounter, ok := previous.(interface{ Unmount() }); ok {
+			unmounter.Unmount()
+		}
+		_ = ctx.RemoveComponent(previous)
+	}
+
+	// Update the location before creating the component so its Setup
+	// can read the new params via UseRoute
+	props.Current.Set(location)
+
+	next := props.Routes[location.Name]()
+	if next == nil {
+		ctx.Expose("activeRoute", nil)
+		return
+	}
+	if err := ctx.ExposeComponent("activeRoute", next); err != nil {
+		ctx.Expose("activeRoute", nil)
+		return
+	}
+
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	// A nested navigation during next's Setup already replaced it
+	if history.generation != generation {
+		if unmounter, ok := next.(interface{ Unmount() }); ok {
+			unmounter.Unmount()
+		}
+		_ = ctx.RemoveComponent(next)
+		ctx.Expose("activeRoute", history.active)
+		return
+	}
+	history.active = next
+}
+
+// UseRoute returns the location of the nearest enclosing Router, for use in
+// the Setup of a route component. Returns the zero RouteLocation when the
+// component is not rendered by a Router.
+//
+// Example:
+//
+//	Setup(func(ctx *bubbly.Context) {
+//	    route := components.UseRoute(ctx)
+//	    ctx.Expose("userID", route.Param("id"))
+//	})
+func UseRoute(ctx *bubbly.Context) RouteLocation {
+	if current, ok := ctx.Inject(routeProvideKey, nil).(*bubbly.Ref[RouteLocation]); ok && current != nil {
+		return current.GetTyped()
+	}
+	return RouteLocation{}
+}
+
+// Navigate navigates a Router to the named route with optional params.
+//
+// Example:
+//
+//	components.Navigate(router, "details", map[string]string{"id": "42"})
+func Navigate(router bubbly.Component, name string, params map[string]string) {
+	router.Emit(RouterNavigateEvent, RouteLocation{Name: name, Params: params})
+}
+
+// NavigateBack moves a Router one entry back in its history.
+// Does nothing at the start of the history.
+func NavigateBack(router bubbly.Component) {
+	router.Emit(RouterBackEvent, nil)
+}
+
+// NavigateForward moves a Router one entry forward in its history.
+// Does nothing at the end of the history.
+func NavigateForward(router bubbly.Component) {
+	router.Emit(RouterForwardEvent, nil)
+}
@@ -0,0 +1,163 @@
+package components
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// routerTestScreens builds route factories that render "<name>:<id param>"
+// and count mounts/unmounts per route.
+func routerTestScreens(t *testing.T, unmounted map[string]int, names ...string) map[string]RouteFactory {
+	t.Helper()
+	routes := make(map[string]RouteFactory, len(names))
+	for _, name := range names {
+		name := name
+		routes[name] = func() bubbly.Component {
+			screen, err := bubbly.NewComponent("Screen-" + name).
+				Setup(func(ctx *bubbly.Context) {
+					ctx.Expose("id", UseRoute(ctx).Param("id"))
+					ctx.OnUnmounted(func() { unmounted[name]++ })
+				}).
+				Template(func(ctx bubbly.RenderContext) string {
+					return name + ":" + ctx.Get("id").(string)
+				}).
+				Build()
+			require.NoError(t, err)
+			return screen
+		}
+	}
+	return routes
+}
+
+// routerTestChildren returns the Router's current child components.
+func routerTestChildren(router bubbly.Component) []bubbly.Component {
+	return router.(interface{ Children() []bubbly.Component }).Children()
+}
+
+// TestRouter_NavigateAndHistory tests navigation, back, forward, and history truncation.
+func TestRouter_NavigateAndHistory(t *testing.T) {
+	unmounted := map[string]int{}
+	current := bubbly.NewRef(RouteLocation{})
+	router := Router(RouterProps{
+		Routes:        routerTestScreens(t, unmounted, "list", "details", "settings"),
+		Initial:       "list",
+		InitialParams: map[string]string{"id": "0"},
+		Current:       current,
+	})
+	router.Init()
+
+	assert.Equal(t, "list:0", router.View())
+	assert.Equal(t, "list", current.GetTyped().Name)
+
+	Navigate(router, "details", map[string]string{"id": "42"})
+	assert.Equal(t, "details:42", router.View())
+	assert.Equal(t, 1, unmounted["list"], "previous screen is unmounted")
+	assert.Len(t, routerTestChildren(router), 1, "only the active screen is a child")
+
+	NavigateBack(router)
+	assert.Equal(t, "list:0", router.View())
+	NavigateBack(router)
+	assert.Equal(t, "list:0", router.View(), "back at the start is a no-op")
+
+	NavigateForward(router)
+	assert.Equal(t, "details:42", router.View(), "forward restores params")
+
+	// Navigating from the middle drops forward history
+	NavigateBack(router)
+	router.Emit(RouterNavigateEvent, "settings")
+	assert.Equal(t, "settings:", router.View())
+	NavigateForward(router)
+	assert.Equal(t, "settings:", router.View())
+
+	// Unknown routes are ignored
+	Navigate(router, "missing", nil)
+	assert.Equal(t, "settings:", router.View())
+	assert.Equal(t, "settings", current.GetTyped().Name)
+}
+
+// TestRouter_ChildNavigatesByEmit tests that events from a screen bubble to the Router.
+func TestRouter_ChildNavigatesByEmit(t *testing.T) {
+	routes := routerTestScreens(t, map[string]int{}, "details")
+	routes["home"] = func() bubbly.Component {
+		screen, err := bubbly.NewComponent("Home").
+			Setup(func(ctx *bubbly.Context) {
+				ctx.On("open", func(_ interface{}) {
+					ctx.Emit(RouterNavigateEvent, RouteLocation{
+						Name:   "details",
+						Params: map[string]string{"id": "7"},
+					})
+				})
+			}).
+			Template(func(ctx bubbly.RenderContext) string { return "home" }).
+			Build()
+		require.NoError(t, err)
+		return screen
+	}
+
+	router := Router(RouterProps{Routes: routes, Initial: "home"})
+	router.Init()
+	require.Equal(t, "home", router.View())
+
+	routerTestChildren(router)[0].Emit("open", nil)
+	assert.Equal(t, "details:7", router.View())
+}
+
+// TestRouter_KeyBindings tests global back/forward key bindings.
+func TestRouter_KeyBindings(t *testing.T) {
+	router := Router(RouterProps{
+		Routes:  routerTestScreens(t, map[string]int{}, "a", "b"),
+		Initial: "a",
+	})
+	router.Init()
+	Navigate(router, "b", nil)
+
+	router.Update(tea.KeyMsg{Type: tea.KeyLeft, Alt: true})
+	assert.Equal(t, "a:", router.View())
+
+	router.Update(tea.KeyMsg{Type: tea.KeyRight, Alt: true})
+	assert.Equal(t, "b:", router.View())
+
+	disabled := Router(RouterProps{
+		Routes:  routerTestScreens(t, map[string]int{}, "a"),
+		BackKey: "-",
+	})
+	assert.NotContains(t, disabled.KeyBindings(), "-")
+	assert.Contains(t, disabled.KeyBindings(), "alt+right")
+}
+
+// TestRouter_Redirect tests navigation from a screen's Setup.
+func TestRouter_Redirect(t *testing.T) {
+	unmounted := map[string]int{}
+	routes := routerTestScreens(t, unmounted, "login")
+	routes["home"] = func() bubbly.Component {
+		screen, err := bubbly.NewComponent("Home").
+			Setup(func(ctx *bubbly.Context) {
+				ctx.Emit(RouterNavigateEvent, "login")
+			}).
+			Template(func(ctx bubbly.RenderContext) string { return "home" }).
+			Build()
+		require.NoError(t, err)
+		return screen
+	}
+
+	router := Router(RouterProps{Routes: routes, Initial: "home"})
+	router.Init()
+
+	assert.Equal(t, "login:", router.View())
+	assert.Len(t, routerTestChildren(router), 1)
+}
+
+// TestRouter_Empty tests a Router without an initial route.
+func TestRouter_Empty(t *testing.T) {
+	router := Router(RouterProps{Routes: routerTestScreens(t, map[string]int{}, "a")})
+	router.Init()
+	assert.Equal(t, "", router.View())
+
+	NavigateBack(router)
+	assert.Equal(t, "", router.View())
+}
@@ -0,0 +1,415 @@
+package components
+
+import (
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// ScrollView event names.
+//
+// The built-in key bindings (up/k, down/j, pgup, pgdown, home/g, end/G) emit
+// the line, page, and top/bottom events; see ScrollViewProps.Focused. The
+// events can also be emitted directly with scrollView.Emit(event, nil).
+const (
+	// ScrollUpEvent scrolls up by one line.
+	ScrollUpEvent = "scrollUp"
+
+	// ScrollDownEvent scrolls down by one line.
+	ScrollDownEvent = "scrollDown"
+
+	// ScrollPageUpEvent scrolls up by one page (Height lines).
+	ScrollPageUpEvent = "pageUp"
+
+	// ScrollPageDownEvent scrolls down by one page (Height lines).
+	ScrollPageDownEvent = "pageDown"
+
+	// ScrollTopEvent scrolls to the first line.
+	ScrollTopEvent = "scrollTop"
+
+	// ScrollBottomEvent scrolls to the last page.
+	ScrollBottomEvent = "scrollBottom"
+
+	// ScrollToEvent scrolls so the line given as event data (int) is at the top.
+	ScrollToEvent = "scrollTo"
+
+	// ScrollIntoViewEvent scrolls the minimum amount needed to make the
+	// component given as event data (bubbly.Component) visible.
+	ScrollIntoViewEvent = "scrollIntoView"
+)
+
+// scrollViewWheelStep is the number of lines scrolled per mouse wheel tick.
+const scrollViewWheelStep = 3
+
+// ScrollViewProps defines the configuration properties for a ScrollView component.
+//
+// Example usage:
+//
+//	offset := bubbly.NewRef(0)
+//	scroll := components.ScrollView(components.ScrollViewProps{
+//	    Content: articleComponent,
+//	    Height:  20,
+//	    Offset:  offset,
+//	})
+type ScrollViewProps struct {
+	// Content is the component rendered inside the scroll view.
+	// Its output is clipped to Height lines starting at the scroll offset.
+	// Required - a nil Content renders nothing.
+	Content bubbly.Component
+
+	// Height is the visible height of the scroll view in lines.
+	// Optional - defaults to 10 if not specified.
+	Height int
+
+	// Width is the visible width of the content area in characters.
+	// Wider lines are truncated; narrower lines are padded.
+	// Optional - defaults to the content's rendered width.
+	Width int
+
+	// Offset is a reactive reference to the scroll offset (first visible line).
+	// Provide your own Ref to observe or control scrolling from outside.
+	// Optional - an internal Ref is created if nil.
+	Offset *bubbly.Ref[int]
+
+	// HideScrollbar hides the scrollbar indicator.
+	// Optional - defaults to false (scrollbar shown when content overflows).
+	HideScrollbar bool
+
+	// Focused, when set, limits the built-in key bindings to times when it
+	// is true, so the scroll keys can be shared with other components.
+	// Optional - if nil, the scroll view always handles its keys.
+	Focused *bubbly.Ref[bool]
+
+	// Common props for all components
+	CommonProps
+}
+
+// scrollViewLayout records where the content's descendants were rendered,
+// relative to the first content line, as of the last render.
+type scrollViewLayout struct {
+	mu        sync.Mutex
+	positions map[bubbly.Component]bubbly.Rect
+}
+
+// set replaces the recorded positions.
+func (l *scrollViewLayout) set(positions map[bubbly.Component]bubbly.Rect) {
+	l.mu.Lock()
+	l.positions = positions
+	l.mu.Unlock()
+}
+
+// position returns the recorded position of a descendant.
+func (l *scrollViewLayout) position(child bubbly.Component) (bubbly.Rect, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r, ok := l.positions[child]
+	return r, ok
+}
+
+// scrollViewApplyDefaults sets default values for ScrollViewProps.
+func scrollViewApplyDefaults(props *ScrollViewProps) {
+	if props.Height <= 0 {
+		props.Height = 10
+	}
+	if props.Offset == nil {
+		props.Offset = bubbly.NewRef(0)
+	}
+}
+
+// scrollViewContentHeight returns the number of lines in the content's last render.
+func scrollViewContentHeight(content bubbly.Component) int {
+	if content == nil {
+		return 0
+	}
+	_, h := bubbly.Measure(content)
+	if h == 0 {
+		h = lipgloss.Height(content.View())
+	}
+	return h
+}
+
+// scrollViewClamp limits an offset to the scrollable range.
+func scrollViewClamp(offset, total, height int) int {
+	maxOffset := total - height
+	if offset > maxOffset {
+		offset = maxOffset
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return offset
+}
+
+// scrollViewScrollBy returns a handler that moves the offset by delta lines.
+func scrollViewScrollBy(props ScrollViewProps, delta int) func(interface{}) {
+	return func(_ interface{}) {
+		total := scrollViewContentHeight(props.Content)
+		props.Offset.Set(scrollViewClamp(props.Offset.GetTyped()+delta, total, props.Height))
+	}
+}
+
+// scrollViewHandleScrollTo handles the scrollTo event.
+func scrollViewHandleScrollTo(props ScrollViewProps) func(interface{}) {
+	return func(data interface{}) {
+		line, ok := data.(int)
+		if !ok {
+			return
+		}
+		total := scrollViewContentHeight(props.Content)
+		props.Offset.Set(scrollViewClamp(line, total, props.Height))
+	}
+}
+
+// scrollViewHandleScrollIntoView handles the scrollIntoView event.
+func scrollViewHandleScrollIntoView(props ScrollViewProps, layout *scrollViewLayout) func(interface{}) {
+	return func(data interface{}) {
+		child, ok := data.(bubbly.Component)
+		if !ok || child == nil {
+			return
+		}
+
+		pos, found := layout.position(child)
+		if !found {
+			return
+		}
+		start := pos.Y
+		_, height := bubbly.Measure(child)
+
+		offset := props.Offset.GetTyped()
+		switch {
+		case start < offset:
+			offset = start
+		case start+height > offset+props.Height:
+			offset = start + height - props.Height
+			// Children taller than the view keep their first line visible
+			if offset > start {
+				offset = start
+			}
+		default:
+			return
+		}
+		props.Offset.Set(offset)
+	}
+}
+
+// scrollViewHandleWheel scrolls in response to mouse wheel events.
+func scrollViewHandleWheel(props ScrollViewProps) func(interface{}) {
+	up := scrollViewScrollBy(props, -scrollViewWheelStep)
+	down := scrollViewScrollBy(props, scrollViewWheelStep)
+	return func(data interface{}) {
+		ev, ok := data.(bubbly.MouseEvent)
+		if !ok {
+			return
+		}
+		switch ev.Button {
+		case tea.MouseButtonWheelUp:
+			up(nil)
+		case tea.MouseButtonWheelDown:
+			down(nil)
+		}
+	}
+}
+
+// scrollViewRenderScrollbar renders a vertical scrollbar of the given height.
+func scrollViewRenderScrollbar(offset, total, height int, theme Theme) []string {
+	thumbSize := height * height / total
+	if thumbSize < 1 {
+		thumbSize = 1
+	}
+	thumbPos := 0
+	if total > height {
+		thumbPos = offset * (height - thumbSize) / (total - height)
+	}
+
+	trackStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+	thumbStyle := lipgloss.NewStyle().Foreground(theme.Primary)
+
+	bar := make([]string, height)
+	for i := range bar {
+		if i >= thumbPos && i < thumbPos+thumbSize {
+			bar[i] = thumbStyle.Render("┃")
+		} else {
+			bar[i] = trackStyle.Render("│")
+		}
+	}
+	return bar
+}
+
+// scrollViewKeyBindings are the built-in key bindings of ScrollView.
+var scrollViewKeyBindings = []bubbly.KeyBinding{
+	{Key: "up", Event: ScrollUpEvent, Description: "Scroll up"},
+	{Key: "k", Event: ScrollUpEvent, Description: "Scroll up"},
+	{Key: "down", Event: ScrollDownEvent, Description: "Scroll down"},
+	{Key: "j", Event: ScrollDownEvent, Description: "Scroll down"},
+	{Key: "pgup", Event: ScrollPageUpEvent, Description: "Page up"},
+	{Key: "pgdown", Event: ScrollPageDownEvent, Description: "Page down"},
+	{Key: "home", Event: ScrollTopEvent, Description: "Scroll to top"},
+	{Key: "g", Event: ScrollTopEvent, Description: "Scroll to top"},
+	{Key: "end", Event: ScrollBottomEvent, Description: "Scroll to bottom"},
+	{Key: "G", Event: ScrollBottomEvent, Description: "Scroll to bottom"},
+}
+
+// ScrollView creates a scrollable container that clips its content to a fixed height.
+//
+// The ScrollView component provides:
+//   - Vertical clipping of any child component to Height lines
+//   - A reactive scroll offset Ref (see ScrollViewProps.Offset)
+//   - A scrollbar indicator when content overflows
+//   - Line, page, and top/bottom scrolling via built-in key bindings and events
+//   - Mouse wheel scrolling when mouse support is enabled
+//   - Scrolling a child into view (see ScrollIntoView)
+//
+// Events:
+//   - scrollUp / scrollDown: Scroll one line
+//   - pageUp / pageDown: Scroll one page
+//   - scrollTop / scrollBottom: Jump to the start or end
+//   - scrollTo (int): Scroll so the given line is at the top
+//   - scrollIntoView (bubbly.Component): Reveal a descendant of Content
+//
+// Key bindings:
+//   - up / k, down / j: Scroll one line
+//   - pgup / pgdown: Scroll one page
+//   - home / g, end / G: Jump to the start or end
+//
+// The offset is clamped so the view never scrolls past the end of the content.
+//
+// Example:
+//
+//	scroll := components.ScrollView(components.ScrollViewProps{
+//	    Content: components.Text(components.TextProps{Content: longText}),
+//	    Height:  15,
+//	    Focused: scrollFocused, // Keys only scroll while focused
+//	})
+//
+//	// In the parent's Update, after its own bindings
+//	components.HandleKey(scroll, keyMsg)
+func ScrollView(props ScrollViewProps) bubbly.Component {
+	scrollViewApplyDefaults(&props)
+	layout := &scrollViewLayout{}
+
+	builder := bubbly.NewComponent("ScrollView").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+			ctx.Expose("scrollOffset", props.Offset)
+
+			if props.Content != nil {
+				_ = ctx.ExposeComponent("content", props.Content)
+			}
+
+			ctx.On(ScrollUpEvent, scrollViewScrollBy(props, -1))
+			ctx.On(ScrollDownEvent, scrollViewScrollBy(props, 1))
+			ctx.On(ScrollPageUpEvent, scrollViewScrollBy(props, -props.Height))
+			ctx.On(ScrollPageDownEvent, scrollViewScrollBy(props, props.Height))
+			ctx.On(ScrollTopEvent, func(_ interface{}) {
+				props.Offset.Set(0)
+			})
+			ctx.On(ScrollBottomEvent, func(_ interface{}) {
+				total := scrollViewContentHeight(props.Content)
+				props.Offset.Set(scrollViewClamp(total, total, props.Height))
+			})
+			ctx.On(ScrollToEvent, scrollViewHandleScrollTo(props))
+			ctx.On(ScrollIntoViewEvent, scrollViewHandleScrollIntoView(props, layout))
+			ctx.On(bubbly.MouseEventWheel, scrollViewHandleWheel(props))
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(ScrollViewProps)
+			theme := ctx.Get("theme").(Theme)
+			offsetRef := ctx.Get("scrollOffset").(*bubbly.Ref[int])
+
+			if p.Content == nil {
+				return ""
+			}
+
+			rendered, positions := bubbly.RenderWithPositions(p.Content)
+			layout.set(positions)
+			lines := strings.Split(rendered, "\n")
+			total := len(lines)
+			offset := scrollViewClamp(offsetRef.GetTyped(), total, p.Height)
+
+			width := p.Width
+			if width <= 0 {
+				width = lipgloss.Width(rendered)
+			}
+
+			end := offset + p.Height
+			if end > total {
+				end = total
+			}
+			visible := make([]string, 0, p.Height)
+			for _, line := range lines[offset:end] {
+				line = ansi.Truncate(line, width, "")
+				if pad := width - lipgloss.Width(line); pad > 0 {
+					line += strings.Repeat(" ", pad)
+				}
+				visible = append(visible, line)
+			}
+
+			// Pad short content so the view keeps a constant height
+			for len(visible) < p.Height && total > p.Height {
+				visible = append(visible, strings.Repeat(" ", width))
+			}
+
+			if !p.HideScrollbar && total > p.Height {
+				bar := scrollViewRenderScrollbar(offset, total, p.Height, theme)
+				for i := range visible {
+					visible[i] += bar[i]
+				}
+			}
+
+			result := strings.Join(visible, "\n")
+			if p.Style != nil {
+				result = p.Style.Render(result)
+			}
+			return result
+		})
+
+	for _, binding := range scrollViewKeyBindings {
+		builder = builder.WithConditionalKeyBinding(bubbly.KeyBinding{
+			Key:         binding.Key,
+			Event:       binding.Event,
+			Description: binding.Description,
+			Condition:   listFocusCondition(props.Focused),
+		})
+	}
+
+	component, err := builder.Build()
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
+
+// ScrollTo scrolls a ScrollView so that the given line is at the top.
+// The line is clamped to the scrollable range.
+//
+// Example:
+//
+//	components.ScrollTo(scroll, 42)
+func ScrollTo(scrollView bubbly.Component, line int) {
+	scrollView.Emit(ScrollToEvent, line)
+}
+
+// ScrollIntoView scrolls a ScrollView the minimum amount needed to make a
+// descendant of its content visible, such as the currently focused input.
+//
+// The child is located by its position in the scroll view's last render (see
+// bubbly.RenderWithPositions), so it must be a descendant of Content that
+// was rendered at least once. If the child is already visible or has no
+// recorded position, the offset is unchanged.
+//
+// Example:
+//
+//	bubbly.Watch(focus.Current, func(_, _ string) {
+//	    components.ScrollIntoView(scroll, focusedInput)
+//	})
+func ScrollIntoView(scrollView bubbly.Component, child bubbly.Component) {
+	scrollView.Emit(ScrollIntoViewEvent, child)
+}
@@ -0,0 +1,254 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// scrollViewTestContent builds a content component rendering n numbered lines.
+func scrollViewTestContent(t *testing.T, n int) bubbly.Component {
+	t.Helper()
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %02d", i)
+	}
+	content, err := bubbly.NewComponent("Lines").
+		Template(func(ctx bubbly.RenderContext) string {
+			return strings.Join(lines, "\n")
+		}).
+		Build()
+	require.NoError(t, err)
+	return content
+}
+
+// scrollViewVisible returns the plain-text lines of a ScrollView render.
+func scrollViewVisible(sv bubbly.Component) []string {
+	return strings.Split(ansi.Strip(sv.View()), "\n")
+}
+
+// TestScrollView_ClipsToHeight tests that content is clipped to Height lines.
+func TestScrollView_ClipsToHeight(t *testing.T) {
+	sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5})
+	sv.Init()
+
+	lines := scrollViewVisible(sv)
+	require.Len(t, lines, 5)
+	assert.True(t, strings.HasPrefix(lines[0], "line 00"))
+	assert.True(t, strings.HasPrefix(lines[4], "line 04"))
+	_, h := bubbly.Measure(sv)
+	assert.Equal(t, 5, h)
+}
+
+// TestScrollView_Scrolling tests scroll events and offset clamping.
+func TestScrollView_Scrolling(t *testing.T) {
+	tests := []struct {
+		name       string
+		events     []string
+		wantOffset int
+	}{
+		{name: "scroll down", events: []string{ScrollDownEvent, ScrollDownEvent}, wantOffset: 2},
+		{name: "scroll up clamps at top", events: []string{ScrollUpEvent}, wantOffset: 0},
+		{name: "page down", events: []string{ScrollPageDownEvent}, wantOffset: 5},
+		{name: "page down clamps at bottom", events: []string{ScrollPageDownEvent, ScrollPageDownEvent, ScrollPageDownEvent, ScrollPageDownEvent}, wantOffset: 15},
+		{name: "page up after page down", events: []string{ScrollPageDownEvent, ScrollPageDownEvent, ScrollPageUpEvent}, wantOffset: 5},
+		{name: "bottom", events: []string{ScrollBottomEvent}, wantOffset: 15},
+		{name: "top after bottom", events: []string{ScrollBottomEvent, ScrollTopEvent}, wantOffset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := bubbly.NewRef(0)
+			sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5, Offset: offset})
+			sv.Init()
+
+			for _, event := range tt.events {
+				sv.Emit(event, nil)
+			}
+
+			assert.Equal(t, tt.wantOffset, offset.GetTyped())
+			lines := scrollViewVisible(sv)
+			assert.True(t, strings.HasPrefix(lines[0], fmt.Sprintf("line %02d", tt.wantOffset)))
+		})
+	}
+}
+
+// TestScrollView_ScrollTo tests scrolling to a specific line.
+func TestScrollView_ScrollTo(t *testing.T) {
+	offset := bubbly.NewRef(0)
+	sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5, Offset: offset})
+	sv.Init()
+
+	ScrollTo(sv, 7)
+	assert.Equal(t, 7, offset.GetTyped())
+
+	ScrollTo(sv, 100)
+	assert.Equal(t, 15, offset.GetTyped(), "clamped to last page")
+
+	ScrollTo(sv, -3)
+	assert.Equal(t, 0, offset.GetTyped(), "clamped to top")
+}
+
+// TestScrollView_ScrollIntoView tests revealing a child component.
+func TestScrollView_ScrollIntoView(t *testing.T) {
+	tests := []struct {
+		name  string
+		zones bool
+	}{
+		{name: "mouse zones disabled", zones: false},
+		{name: "mouse zones enabled", zones: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bubbly.EnableMouseZones(tt.zones)
+			defer bubbly.EnableMouseZones(false)
+
+			// The decoy renders the same text as the target, above it
+			decoy := Text(TextProps{Content: "target row"})
+			target := Text(TextProps{Content: "target row"})
+			lines := make([]string, 10)
+			for i := range lines {
+				lines[i] = fmt.Sprintf("filler %d", i)
+			}
+			content, err := bubbly.NewComponent("Doc").
+				Setup(func(ctx *bubbly.Context) {
+					_ = ctx.ExposeComponent("decoy", decoy)
+					_ = ctx.ExposeComponent("target", target)
+				}).
+				Template(func(ctx bubbly.RenderContext) string {
+					return ctx.Get("decoy").(bubbly.Component).View() + "\n" +
+						strings.Join(lines, "\n") + "\n" +
+						ctx.Get("target").(bubbly.Component).View()
+				}).
+				Build()
+			require.NoError(t, err)
+
+			offset := bubbly.NewRef(0)
+			sv := ScrollView(ScrollViewProps{Content: content, Height: 4, Offset: offset})
+			sv.Init()
+			output := sv.View()
+			if !tt.zones {
+				assert.NotRegexp(t, "\x1b\\[[0-9]+z", output, "no layout markers leak without mouse zones")
+			}
+			bubbly.ScanMouseZones(output)
+
+			ScrollIntoView(sv, target)
+			assert.Equal(t, 8, offset.GetTyped(), "target on line 11 becomes the last visible line")
+			bubbly.ScanMouseZones(sv.View())
+
+			ScrollIntoView(sv, decoy)
+			assert.Equal(t, 0, offset.GetTyped(), "decoy on line 0 becomes the first visible line")
+			bubbly.ScanMouseZones(sv.View())
+
+			sv.Emit(ScrollIntoViewEvent, Text(TextProps{Content: "not in content"}))
+			assert.Equal(t, 0, offset.GetTyped(), "unknown child leaves offset unchanged")
+		})
+	}
+}
+
+// TestScrollView_KeyBindings tests the built-in scroll key bindings.
+func TestScrollView_KeyBindings(t *testing.T) {
+	tests := []struct {
+		name       string
+		keys       []tea.KeyMsg
+		wantOffset int
+	}{
+		{name: "down", keys: []tea.KeyMsg{{Type: tea.KeyDown}, {Type: tea.KeyRunes, Runes: []rune("j")}}, wantOffset: 2},
+		{name: "up", keys: []tea.KeyMsg{{Type: tea.KeyPgDown}, {Type: tea.KeyUp}, {Type: tea.KeyRunes, Runes: []rune("k")}}, wantOffset: 3},
+		{name: "page down", keys: []tea.KeyMsg{{Type: tea.KeyPgDown}}, wantOffset: 5},
+		{name: "page up", keys: []tea.KeyMsg{{Type: tea.KeyPgDown}, {Type: tea.KeyPgDown}, {Type: tea.KeyPgUp}}, wantOffset: 5},
+		{name: "end", keys: []tea.KeyMsg{{Type: tea.KeyEnd}}, wantOffset: 15},
+		{name: "G", keys: []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("G")}}, wantOffset: 15},
+		{name: "home", keys: []tea.KeyMsg{{Type: tea.KeyEnd}, {Type: tea.KeyHome}}, wantOffset: 0},
+		{name: "g", keys: []tea.KeyMsg{{Type: tea.KeyEnd}, {Type: tea.KeyRunes, Runes: []rune("g")}}, wantOffset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := bubbly.NewRef(0)
+			sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5, Offset: offset})
+			sv.Init()
+
+			for _, key := range tt.keys {
+				assert.True(t, HandleKey(sv, key), "key %q is bound", key.String())
+			}
+			assert.Equal(t, tt.wantOffset, offset.GetTyped())
+		})
+	}
+}
+
+// TestScrollView_Focused tests that key bindings only apply while focused.
+func TestScrollView_Focused(t *testing.T) {
+	focused := bubbly.NewRef(false)
+	offset := bubbly.NewRef(0)
+	sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5, Offset: offset, Focused: focused})
+	sv.Init()
+
+	assert.False(t, HandleKey(sv, tea.KeyMsg{Type: tea.KeyDown}))
+	assert.Equal(t, 0, offset.GetTyped(), "Unfocused scroll view should ignore keys")
+
+	focused.Set(true)
+	assert.True(t, HandleKey(sv, tea.KeyMsg{Type: tea.KeyDown}))
+	assert.Equal(t, 1, offset.GetTyped())
+}
+
+// TestScrollView_Scrollbar tests the scrollbar indicator.
+func TestScrollView_Scrollbar(t *testing.T) {
+	t.Run("shown when content overflows", func(t *testing.T) {
+		sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 4})
+		sv.Init()
+		lines := scrollViewVisible(sv)
+		assert.True(t, strings.HasSuffix(lines[0], "┃"), "thumb at top")
+		assert.True(t, strings.HasSuffix(lines[3], "│"), "track below thumb")
+	})
+
+	t.Run("hidden when content fits", func(t *testing.T) {
+		sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 3), Height: 4})
+		sv.Init()
+		output := ansi.Strip(sv.View())
+		assert.NotContains(t, output, "│")
+		assert.Equal(t, "line 00\nline 01\nline 02", output)
+	})
+
+	t.Run("hidden by prop", func(t *testing.T) {
+		sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 4, HideScrollbar: true})
+		sv.Init()
+		assert.NotContains(t, ansi.Strip(sv.View()), "┃")
+	})
+}
+
+// TestScrollView_Width tests truncation and padding to a fixed width.
+func TestScrollView_Width(t *testing.T) {
+	sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 3), Height: 3, Width: 4})
+	sv.Init()
+	assert.Equal(t, "line\nline\nline", ansi.Strip(sv.View()))
+}
+
+// TestScrollView_MouseWheel tests wheel scrolling.
+func TestScrollView_MouseWheel(t *testing.T) {
+	offset := bubbly.NewRef(0)
+	sv := ScrollView(ScrollViewProps{Content: scrollViewTestContent(t, 20), Height: 5, Offset: offset})
+	sv.Init()
+
+	sv.Emit(bubbly.MouseEventWheel, bubbly.MouseEvent{Button: tea.MouseButtonWheelDown})
+	assert.Equal(t, scrollViewWheelStep, offset.GetTyped())
+
+	sv.Emit(bubbly.MouseEventWheel, bubbly.MouseEvent{Button: tea.MouseButtonWheelUp})
+	assert.Equal(t, 0, offset.GetTyped())
+}
+
+// TestScrollView_NilContent tests that nil content renders nothing.
+func TestScrollView_NilContent(t *testing.T) {
+	sv := ScrollView(ScrollViewProps{})
+	sv.Init()
+	assert.Equal(t, "", sv.View())
+	sv.Emit(ScrollDownEvent, nil)
+}
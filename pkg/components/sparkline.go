@@ -0,0 +1,151 @@
+package components
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// sparklineBlocks are the eight bar heights used by Sparkline, lowest first.
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// SparklineProps defines the configuration properties for a Sparkline component.
+//
+// Example usage:
+//
+//	cpu := components.Sparkline(components.SparklineProps{
+//	    Data:  []float64{12, 40, 35, 80, 65},
+//	    Width: 20,
+//	})
+type SparklineProps struct {
+	// Data is the series to plot, oldest first.
+	// Ignored when Source is set.
+	Data []float64
+
+	// Source supplies the series reactively, re-read on every render.
+	// Accepts a *bubbly.Ref[[]float64] or a *bubbly.RingRef[float64].
+	// Optional - takes precedence over Data.
+	Source bubbly.Watchable[[]float64]
+
+	// Width is the number of columns to draw. When the series is longer,
+	// only the most recent Width values are shown; shorter series are
+	// left-padded with spaces.
+	// Optional - defaults to the length of the series.
+	Width int
+
+	// Min and Max fix the plotted range, e.g. 0 and 100 for percentages.
+	// Values outside the range are clamped.
+	// Optional - when Max <= Min, the range of the shown values is used.
+	Min float64
+	Max float64
+
+	// Color sets the foreground color of the bars.
+	// Optional - if not specified, uses theme primary color.
+	Color lipgloss.Color
+
+	// Common props for all components
+	CommonProps
+}
+
+// sparklineRender renders values as a row of block characters.
+func sparklineRender(props SparklineProps) string {
+	data := props.Data
+	if props.Source != nil {
+		data = props.Source.GetTyped()
+	}
+
+	width := props.Width
+	if width <= 0 {
+		width = len(data)
+	}
+	if len(data) > width {
+		data = data[len(data)-width:]
+	}
+
+	low, high := props.Min, props.Max
+	if high <= low {
+		low, high = math.Inf(1), math.Inf(-1)
+		for _, v := range data {
+			if math.IsNaN(v) {
+				continue
+			}
+			low, high = math.Min(low, v), math.Max(high, v)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(strings.Repeat(" ", width-len(data)))
+	for _, v := range data {
+		sb.WriteRune(sparklineBlock(v, low, high))
+	}
+	return sb.String()
+}
+
+// sparklineBlock picks the block for v within [low, high]. NaN values render
+// as a gap; a flat series renders at mid height.
+func sparklineBlock(v, low, high float64) rune {
+	if math.IsNaN(v) {
+		return ' '
+	}
+	if high <= low {
+		return sparklineBlocks[len(sparklineBlocks)/2-1]
+	}
+	ratio := (math.Max(low, math.Min(high, v)) - low) / (high - low)
+	return sparklineBlocks[int(math.Round(ratio*float64(len(sparklineBlocks)-1)))]
+}
+
+// Sparkline creates a new Sparkline atom component.
+//
+// Sparkline draws a series of numbers as a one-line chart of Unicode block
+// characters, for trends inside dashboards, tables, and status bars. Empty
+// series render as blank space of the requested width.
+//
+// The component automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	samples := bubbly.NewRingRef[float64](30)
+//
+//	cpu := components.Sparkline(components.SparklineProps{
+//	    Source: samples,
+//	    Width:  30,
+//	    Min:    0,
+//	    Max:    100,
+//	})
+//
+//	cpu.Init()
+//	samples.Push(12, 40, 35, 80)
+//	// "                          ▂▄▃▇"
+func Sparkline(props SparklineProps) bubbly.Component {
+	component, err := bubbly.NewComponent("Sparkline").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(SparklineProps)
+			theme := ctx.Get("theme").(Theme)
+
+			color := p.Color
+			if color == "" {
+				color = theme.Primary
+			}
+			style := lipgloss.NewStyle().Foreground(color)
+			if p.Style != nil {
+				style = style.Inherit(*p.Style)
+			}
+			return style.Render(sparklineRender(p))
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
@@ -0,0 +1,83 @@
+package components
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+func TestSparkline_Rendering(t *testing.T) {
+	tests := []struct {
+		name     string
+		props    SparklineProps
+		expected string
+	}{
+		{
+			name:     "auto scaled",
+			props:    SparklineProps{Data: []float64{0, 1, 2, 3, 4, 5, 6, 7}},
+			expected: "▁▂▃▄▅▆▇█",
+		},
+		{
+			name:     "fixed range clamps",
+			props:    SparklineProps{Data: []float64{-10, 50, 150}, Min: 0, Max: 100},
+			expected: "▁▅█",
+		},
+		{
+			name:     "width keeps most recent values",
+			props:    SparklineProps{Data: []float64{0, 1, 2, 3}, Width: 2},
+			expected: "▁█",
+		},
+		{
+			name:     "short series is left padded",
+			props:    SparklineProps{Data: []float64{1, 2}, Width: 4},
+			expected: "  ▁█",
+		},
+		{
+			name:     "flat series renders mid height",
+			props:    SparklineProps{Data: []float64{3, 3, 3}},
+			expected: "▄▄▄",
+		},
+		{
+			name:     "NaN renders as gap",
+			props:    SparklineProps{Data: []float64{0, math.NaN(), 1}},
+			expected: "▁ █",
+		},
+		{
+			name:     "empty with width",
+			props:    SparklineProps{Width: 3},
+			expected: "   ",
+		},
+		{
+			name:     "empty",
+			props:    SparklineProps{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spark := Sparkline(tt.props)
+			spark.Init()
+
+			assert.Equal(t, tt.expected, ansi.Strip(spark.View()))
+		})
+	}
+}
+
+func TestSparkline_ReactiveSource(t *testing.T) {
+	samples := bubbly.NewRingRef[float64](3)
+	spark := Sparkline(SparklineProps{Source: samples, Width: 3, Min: 0, Max: 7})
+	spark.Init()
+
+	assert.Equal(t, "   ", ansi.Strip(spark.View()))
+
+	samples.Push(0, 7)
+	assert.Equal(t, " ▁█", ansi.Strip(spark.View()))
+
+	samples.Push(3, 7)
+	assert.Equal(t, "█▄█", ansi.Strip(spark.View()))
+}
@@ -0,0 +1,196 @@
+package components
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// Segment is a single cell of a StatusBar, such as a mode indicator or a
+// help hint.
+type Segment struct {
+	// Text is the segment content.
+	Text string
+
+	// Value is a reactive segment content. When set, it overrides Text and
+	// the bar re-renders with its current value.
+	// Optional.
+	Value *bubbly.Ref[string]
+
+	// Variant highlights the segment with the variant's theme color as
+	// background (e.g., VariantPrimary for a mode indicator).
+	// Optional - if empty, the segment is rendered as plain muted text.
+	Variant Variant
+
+	// Style overrides the segment's style.
+	// Optional.
+	Style *lipgloss.Style
+}
+
+// StatusBarProps defines the configuration properties for a StatusBar component.
+//
+// Example usage:
+//
+//	mode := bubbly.NewRef("NORMAL")
+//	bar := components.StatusBar(components.StatusBarProps{
+//	    Left:   []components.Segment{{Value: mode, Variant: components.VariantPrimary}},
+//	    Center: []components.Segment{{Text: "main.go"}},
+//	    Right:  []components.Segment{{Text: "?: help"}, {Text: "q: quit"}},
+//	})
+type StatusBarProps struct {
+	// Left are the segments aligned to the left edge.
+	Left []Segment
+
+	// Center are the segments centered in the bar.
+	Center []Segment
+
+	// Right are the segments aligned to the right edge.
+	Right []Segment
+
+	// Width is the bar width in characters.
+	// Optional - if 0, the bar follows the terminal width reported by
+	// tea.WindowSizeMsg (80 until the first one arrives).
+	Width int
+
+	// Common props for all components
+	CommonProps
+}
+
+// statusBarDefaultWidth is the width used before the terminal size is known.
+const statusBarDefaultWidth = 80
+
+// statusBarRenderSegments renders segments side by side.
+func statusBarRenderSegments(segments []Segment, theme Theme) string {
+	var sb strings.Builder
+	for _, seg := range segments {
+		text := seg.Text
+		if seg.Value != nil {
+			text = seg.Value.GetTyped()
+		}
+
+		style := lipgloss.NewStyle().Padding(0, 1)
+		if seg.Variant != "" {
+			style = style.
+				Background(theme.GetVariantColor(seg.Variant)).
+				Foreground(lipgloss.Color("230")). // Light text for contrast
+				Bold(true)
+		} else {
+			style = style.Foreground(theme.Muted)
+		}
+		if seg.Style != nil {
+			style = style.Inherit(*seg.Style)
+		}
+		sb.WriteString(style.Render(text))
+	}
+	return sb.String()
+}
+
+// statusBarLayout places left, center, and right on a line of exactly width
+// cells. When space is tight, the center is truncated first, then the left
+// group, and the right group last.
+func statusBarLayout(left, center, right string, width int) string {
+	rightW := bubbly.VisibleWidth(right)
+	if rightW >= width {
+		return bubbly.FitVisible(right, width)
+	}
+
+	leftW := bubbly.VisibleWidth(left)
+	if leftW+rightW > width {
+		return bubbly.FitVisible(left, width-rightW) + right
+	}
+
+	gap := width - leftW - rightW
+	centerW := bubbly.VisibleWidth(center)
+	start := leftW
+	if centerW > gap {
+		center = bubbly.TruncateVisible(center, gap)
+		centerW = bubbly.VisibleWidth(center)
+	} else {
+		// Center on the whole bar when possible, otherwise within the gap
+		start = max(leftW, (width-centerW)/2)
+		if start+centerW > width-rightW {
+			start = width - rightW - centerW
+		}
+	}
+
+	return left +
+		strings.Repeat(" ", start-leftW) +
+		center +
+		strings.Repeat(" ", width-rightW-start-centerW) +
+		right
+}
+
+// StatusBar creates a single-line bar with left, center, and right segment
+// groups, typically placed at the bottom of the screen.
+//
+// The StatusBar component provides:
+//   - Left, center, and right aligned segment groups
+//   - Variant highlighting for segments such as mode indicators
+//   - Reactive segments (see Segment.Value)
+//   - Truncation when the width is tight: center first, then left, then right
+//   - Automatic resizing with the terminal when Width is 0
+//
+// Forward tea.WindowSizeMsg to the bar (e.g., from the parent's message
+// handler) to keep it sized to the terminal.
+//
+// The bar automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	bar := components.StatusBar(components.StatusBarProps{
+//	    Left:  []components.Segment{{Value: mode, Variant: components.VariantPrimary}, {Text: filename}},
+//	    Right: []components.Segment{{Text: "?: help"}},
+//	})
+func StatusBar(props StatusBarProps) bubbly.Component {
+	component, err := bubbly.NewComponent("StatusBar").
+		Props(props).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Expose("theme", theme)
+
+			width := bubbly.NewRef(statusBarDefaultWidth)
+			if props.Width > 0 {
+				width.Set(props.Width)
+			}
+			ctx.Expose("width", width)
+
+			ctx.On("resize", func(data interface{}) {
+				if w, ok := data.(int); ok && props.Width <= 0 && w > 0 {
+					width.Set(w)
+				}
+			})
+		}).
+		WithMessageHandler(func(comp bubbly.Component, msg tea.Msg) tea.Cmd {
+			if size, ok := msg.(tea.WindowSizeMsg); ok {
+				comp.Emit("resize", size.Width)
+			}
+			return nil
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(StatusBarProps)
+			theme := ctx.Get("theme").(Theme)
+			width := ctx.Get("width").(*bubbly.Ref[int]).GetTyped()
+
+			line := statusBarLayout(
+				statusBarRenderSegments(p.Left, theme),
+				statusBarRenderSegments(p.Center, theme),
+				statusBarRenderSegments(p.Right, theme),
+				width,
+			)
+			if p.Style != nil {
+				line = p.Style.Render(line)
+			}
+			return line
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
@@ -0,0 +1,82 @@
+package components
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+func TestStatusBar_Layout(t *testing.T) {
+	tests := []struct {
+		name     string
+		left     string
+		center   string
+		right    string
+		width    int
+		expected string
+	}{
+		{name: "all fit", left: "L", center: "C", right: "R", width: 9, expected: "L   C   R"},
+		{name: "center shifts to avoid left", left: "LLLLL", center: "CC", right: "R", width: 10, expected: "LLLLLCC  R"},
+		{name: "center truncated first", left: "LL", center: "CENTERED", right: "RR", width: 10, expected: "LLCEN...RR"},
+		{name: "left truncated and center dropped", left: "LEFTLEFTLEFT", center: "C", right: "RR", width: 10, expected: "LEFTL...RR"},
+		{name: "right truncated last", left: "L", center: "", right: "RIGHTRIGHT", width: 6, expected: "RIG..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line := statusBarLayout(tt.left, tt.center, tt.right, tt.width)
+			assert.Equal(t, tt.expected, line)
+			assert.Equal(t, tt.width, bubbly.VisibleWidth(line))
+		})
+	}
+}
+
+func TestStatusBar_Rendering(t *testing.T) {
+	mode := bubbly.NewRef("NORMAL")
+	bar := StatusBar(StatusBarProps{
+		Left:   []Segment{{Value: mode, Variant: VariantPrimary}, {Text: "main.go"}},
+		Center: []Segment{{Text: "ok"}},
+		Right:  []Segment{{Text: "?: help"}},
+		Width:  40,
+	})
+	bar.Init()
+
+	line := ansi.Strip(bar.View())
+	assert.Equal(t, 40, bubbly.VisibleWidth(line))
+	assert.True(t, strings.HasPrefix(line, " NORMAL  main.go "))
+	assert.True(t, strings.HasSuffix(line, " ?: help "))
+	assert.Contains(t, line, " ok ")
+
+	mode.Set("INSERT")
+	assert.True(t, strings.HasPrefix(ansi.Strip(bar.View()), " INSERT "), "reactive segments should update")
+}
+
+func TestStatusBar_Resize(t *testing.T) {
+	tests := []struct {
+		name      string
+		width     int
+		wantWidth int
+	}{
+		{name: "follows terminal width", width: 0, wantWidth: 50},
+		{name: "fixed width ignores resize", width: 30, wantWidth: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bar := StatusBar(StatusBarProps{
+				Left:  []Segment{{Text: "left"}},
+				Width: tt.width,
+			})
+			bar.Init()
+
+			bar.Update(tea.WindowSizeMsg{Width: 50, Height: 20})
+
+			assert.Equal(t, tt.wantWidth, bubbly.VisibleWidth(bar.View()))
+		})
+	}
+}
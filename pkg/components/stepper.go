@@ -0,0 +1,261 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+// Step is a single step of a Stepper.
+type Step struct {
+	// Title is shown in the progress indicator.
+	Title string
+
+	// Component is rendered while the step is current.
+	// Optional - a step without a component renders only the indicator.
+	Component bubbly.Component
+
+	// Validate is called before moving forward from this step. A non-nil
+	// error keeps the stepper on this step and is displayed below it.
+	// Optional - if nil, the step is always valid.
+	Validate func() error
+}
+
+// StepperProps defines the configuration properties for a Stepper component.
+//
+// Example usage:
+//
+//	stepper := components.Stepper(components.StepperProps{
+//	    Steps: []components.Step{
+//	        {Title: "Account", Component: accountForm, Validate: validateAccount},
+//	        {Title: "Plan", Component: planPicker},
+//	        {Title: "Confirm", Component: summary},
+//	    },
+//	    OnComplete: func() { createAccount() },
+//	})
+type StepperProps struct {
+	// Steps are the steps of the flow, in order.
+	// Required - an empty list renders nothing.
+	Steps []Step
+
+	// Current is the reactive index of the current step.
+	// Optional - an internal ref starting at 0 is used if nil.
+	Current *bubbly.Ref[int]
+
+	// AllowSkip lets "goto" jump forward without validating the steps in
+	// between. Without it, each step on the way must validate, and the
+	// stepper stops at the first one that fails.
+	// Default: false.
+	AllowSkip bool
+
+	// OnStepChange is called with the new index when the current step changes.
+	// Optional - if nil, no callback is executed.
+	OnStepChange func(int)
+
+	// OnComplete is called when "next" is emitted on a valid last step.
+	// The stepper also emits a "complete" event for parent components.
+	// Optional - if nil, no callback is executed.
+	OnComplete func()
+
+	// Common props for all components
+	CommonProps
+}
+
+// Stepper creates a new Stepper organism component.
+//
+// Stepper drives a multi-step flow such as onboarding or checkout: it shows
+// a progress indicator, renders the current step's component, and moves
+// between steps with per-step validation.
+//
+// Events (emit on the stepper):
+//   - "next": validate the current step, then advance; on the last step,
+//     mark the flow complete, call OnComplete, and emit "complete"
+//   - "prev": go back one step (never validated)
+//   - "goto" (int): go to a step; see AllowSkip for forward jumps
+//
+// Step components are registered as children, so they are initialized with
+// the stepper and inherit its theme.
+//
+// The component automatically integrates with the theme system via the composition API's
+// Provide/Inject mechanism. If no theme is provided, it uses DefaultTheme.
+//
+// Example:
+//
+//	current := bubbly.NewRef(0)
+//	stepper := components.Stepper(components.StepperProps{
+//	    Steps: []components.Step{
+//	        {Title: "Account", Component: accountForm, Validate: func() error {
+//	            if email.GetTyped() == "" {
+//	                return errors.New("email is required")
+//	            }
+//	            return nil
+//	        }},
+//	        {Title: "Confirm", Component: summary},
+//	    },
+//	    Current:    current,
+//	    OnComplete: func() { submit() },
+//	})
+//
+//	stepper.Init()
+//	stepper.Emit("next", nil)
+//	// ✓ Account ─ ● Confirm
+//	// Step 2 of 2
+func Stepper(props StepperProps) bubbly.Component {
+	children := make([]bubbly.Component, 0, len(props.Steps))
+	for _, step := range props.Steps {
+		if step.Component != nil {
+			children = append(children, step.Component)
+		}
+	}
+
+	component, err := bubbly.NewComponent("Stepper").
+		Props(props).
+		Children(children...).
+		Setup(func(ctx *bubbly.Context) {
+			theme := injectTheme(ctx)
+			ctx.Provide("theme", theme)
+			ctx.Expose("theme", theme)
+
+			current := props.Current
+			if current == nil {
+				current = bubbly.NewRef(0)
+			}
+			stepErr := bubbly.NewRef("")
+			completed := bubbly.NewRef(false)
+
+			moveTo := func(index int) {
+				stepErr.Set("")
+				if index == current.GetTyped() {
+					return
+				}
+				current.Set(index)
+				if props.OnStepChange != nil {
+					props.OnStepChange(index)
+				}
+			}
+
+			validate := func(index int) error {
+				if validateFn := props.Steps[index].Validate; validateFn != nil {
+					return validateFn()
+				}
+				return nil
+			}
+
+			ctx.On("next", func(_ interface{}) {
+				index := current.GetTyped()
+				if index < 0 || index >= len(props.Steps) {
+					return
+				}
+				if err := validate(index); err != nil {
+					stepErr.Set(err.Error())
+					return
+				}
+				if index < len(props.Steps)-1 {
+					moveTo(index + 1)
+					return
+				}
+				stepErr.Set("")
+				completed.Set(true)
+				if props.OnComplete != nil {
+					props.OnComplete()
+				}
+				ctx.Emit("complete", nil)
+			})
+
+			ctx.On("prev", func(_ interface{}) {
+				if index := current.GetTyped(); index > 0 {
+					completed.Set(false)
+					moveTo(index - 1)
+				}
+			})
+
+			ctx.On("goto", func(data interface{}) {
+				target, ok := data.(int)
+				if !ok || target < 0 || target >= len(props.Steps) {
+					return
+				}
+				completed.Set(false)
+				if !props.AllowSkip {
+					// Stop at the first step on the way that fails
+					for index := current.GetTyped(); index < target; index++ {
+						if err := validate(index); err != nil {
+							moveTo(index)
+							stepErr.Set(err.Error())
+							return
+						}
+					}
+				}
+				moveTo(target)
+			})
+
+			ctx.Expose("current", current)
+			ctx.Expose("stepErr", stepErr)
+			ctx.Expose("completed", completed)
+		}).
+		Template(func(ctx bubbly.RenderContext) string {
+			p := ctx.Props().(StepperProps)
+			theme := ctx.Get("theme").(Theme)
+			current := ctx.Get("current").(*bubbly.Ref[int]).GetTyped()
+			stepErr := ctx.Get("stepErr").(*bubbly.Ref[string]).GetTyped()
+			completed := ctx.Get("completed").(*bubbly.Ref[bool]).GetTyped()
+
+			if len(p.Steps) == 0 {
+				return ""
+			}
+			current = max(0, min(current, len(p.Steps)-1))
+
+			var output strings.Builder
+			output.WriteString(stepperIndicator(p.Steps, current, completed, theme))
+
+			if step := p.Steps[current]; step.Component != nil {
+				output.WriteString("\n\n")
+				output.WriteString(step.Component.View())
+			}
+
+			if stepErr != "" {
+				errorStyle := lipgloss.NewStyle().
+					Foreground(theme.Danger).
+					Italic(true)
+				output.WriteString("\n")
+				output.WriteString(errorStyle.Render("⚠ " + stepErr))
+			}
+
+			if p.Style != nil {
+				return p.Style.Render(output.String())
+			}
+			return output.String()
+		}).
+		Build()
+
+	if err != nil {
+		panic(err) // Should never happen with valid setup
+	}
+
+	return component
+}
+
+// stepperIndicator renders the step titles with done, current, and pending
+// markers, followed by a "Step n of m" counter.
+func stepperIndicator(steps []Step, current int, completed bool, theme Theme) string {
+	doneStyle := lipgloss.NewStyle().Foreground(theme.Success)
+	currentStyle := lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	pendingStyle := lipgloss.NewStyle().Foreground(theme.Muted)
+
+	parts := make([]string, len(steps))
+	for i, step := range steps {
+		switch {
+		case i < current || completed:
+			parts[i] = doneStyle.Render("✓ " + step.Title)
+		case i == current:
+			parts[i] = currentStyle.Render("● " + step.Title)
+		default:
+			parts[i] = pendingStyle.Render("○ " + step.Title)
+		}
+	}
+
+	counter := pendingStyle.Render(fmt.Sprintf("Step %d of %d", current+1, len(steps)))
+	return strings.Join(parts, pendingStyle.Render(" ─ ")) + "\n" + counter
+}
@@ -0,0 +1,139 @@
+package components
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/newbpydev/bubblyui/pkg/bubbly"
+)
+
+func newStepperSteps(valid *bool) []Step {
+	return []Step{
+		{
+			Title:     "Account",
+			Component: Text(TextProps{Content: "account form"}),
+			Validate: func() error {
+				if !*valid {
+					return errors.New("email is required")
+				}
+				return nil
+			},
+		},
+		{Title: "Plan", Component: Text(TextProps{Content: "plan picker"})},
+		{Title: "Confirm", Component: Text(TextProps{Content: "summary"})},
+	}
+}
+
+func TestStepper_Rendering(t *testing.T) {
+	valid := true
+	stepper := Stepper(StepperProps{Steps: newStepperSteps(&valid)})
+	stepper.Init()
+
+	lines := strings.Split(ansi.Strip(stepper.View()), "\n")
+	assert.Equal(t, []string{
+		"● Account ─ ○ Plan ─ ○ Confirm",
+		"Step 1 of 3",
+		"",
+		"account form",
+	}, lines)
+
+	stepper.Emit("next", nil)
+	assert.Contains(t, ansi.Strip(stepper.View()), "✓ Account ─ ● Plan ─ ○ Confirm")
+	assert.Contains(t, ansi.Strip(stepper.View()), "plan picker")
+}
+
+func TestStepper_Empty(t *testing.T) {
+	stepper := Stepper(StepperProps{})
+	stepper.Init()
+
+	assert.Equal(t, "", stepper.View())
+}
+
+func TestStepper_NextValidates(t *testing.T) {
+	valid := false
+	current := bubbly.NewRef(0)
+	var changes []int
+	stepper := Stepper(StepperProps{
+		Steps:        newStepperSteps(&valid),
+		Current:      current,
+		OnStepChange: func(i int) { changes = append(changes, i) },
+	})
+	stepper.Init()
+
+	stepper.Emit("next", nil)
+	assert.Equal(t, 0, current.GetTyped())
+	assert.Contains(t, ansi.Strip(stepper.View()), "⚠ email is required")
+
+	valid = true
+	stepper.Emit("next", nil)
+	assert.Equal(t, 1, current.GetTyped())
+	assert.NotContains(t, ansi.Strip(stepper.View()), "email is required")
+
+	stepper.Emit("prev", nil)
+	stepper.Emit("prev", nil)
+	assert.Equal(t, 0, current.GetTyped())
+	assert.Equal(t, []int{1, 0}, changes)
+}
+
+func TestStepper_Goto(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowSkip bool
+		valid     bool
+		expected  int
+	}{
+		{name: "validated path", valid: true, expected: 2},
+		{name: "stops at invalid step", valid: false, expected: 0},
+		{name: "skip ignores validation", allowSkip: true, valid: false, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid := tt.valid
+			current := bubbly.NewRef(0)
+			stepper := Stepper(StepperProps{
+				Steps:     newStepperSteps(&valid),
+				Current:   current,
+				AllowSkip: tt.allowSkip,
+			})
+			stepper.Init()
+
+			stepper.Emit("goto", 2)
+			assert.Equal(t, tt.expected, current.GetTyped())
+
+			stepper.Emit("goto", 99)
+			assert.Equal(t, tt.expected, current.GetTyped(), "out of range goto is ignored")
+		})
+	}
+}
+
+func TestStepper_Complete(t *testing.T) {
+	valid := true
+	completed := 0
+	stepper := Stepper(StepperProps{
+		Steps:      newStepperSteps(&valid),
+		Current:    bubbly.NewRef(2),
+		OnComplete: func() { completed++ },
+	})
+
+	var events int
+	parent, err := bubbly.NewComponent("Parent").
+		Children(stepper).
+		Setup(func(ctx *bubbly.Context) {
+			ctx.On("complete", func(interface{}) { events++ })
+		}).
+		Template(func(ctx bubbly.RenderContext) string { return "" }).
+		Build()
+	assert.NoError(t, err)
+	parent.Init()
+
+	stepper.Emit("next", nil)
+
+	assert.Equal(t, 1, completed)
+	assert.Equal(t, 1, events)
+	assert.Contains(t, ansi.Strip(stepper.View()), "✓ Account ─ ✓ Plan ─ ✓ Confirm")
+}
@@ -1,19 +1,22 @@
 package components
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
-	"sort"
+	"slices"
 	"strings"
-	"unicode/utf8"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
 )
 
 // TableColumn defines a single column in a table.
-// Each column has a header, field name, width, and optional custom render function.
+// Each column has a header, field name, width, alignment, and optional custom
+// render function.
 type TableColumn[T any] struct {
 	// Header is the display text shown in the table header row.
 	// Required - should be descriptive of the column content.
@@ -21,7 +24,9 @@ type TableColumn[T any] struct {
 
 	// Field is the name of the struct field to display in this column.
 	// Must match an exported field name in type T.
-	// Required - used with reflection to extract values.
+	// Required unless Render is set - used with reflection to extract values.
+	// Sortable columns always need it: it identifies the column in the sort
+	// state and is the sort key unless Compare is set.
 	Field string
 
 	// Width is the column width in characters.
@@ -30,15 +35,67 @@ type TableColumn[T any] struct {
 	Width int
 
 	// Sortable indicates if this column can be sorted.
-	// When true and table Sortable is true, clicking header sorts by this column.
+	// When true and table Sortable is true, clicking the header (or pressing
+	// s while the column is focused) cycles it through ascending, descending,
+	// and unsorted.
 	// Optional - defaults to false.
 	Sortable bool
 
+	// Compare orders two rows for this column, returning a negative number
+	// when a sorts before b, zero when they are equal, and a positive number
+	// otherwise (like cmp.Compare).
+	// Optional - if nil, the Field values are compared via reflection:
+	// numbers numerically, strings lexically, time.Time chronologically,
+	// and nil values first.
+	Compare func(a, b T) int
+
 	// Render is an optional custom rendering function.
 	// If provided, it overrides the default field value extraction.
-	// Useful for formatting dates, numbers, or complex types.
-	// Optional - if nil, uses default fmt.Sprintf("%v", value).
+	// Useful for formatting dates, numbers, or complex types. Styled output
+	// (e.g., a rendered Badge) is fine: widths are measured in terminal cells.
+	// Optional - if nil, uses default fmt.Sprintf("%v", value) of Field.
 	Render func(T) string
+
+	// Align sets the horizontal alignment of the header and cells within
+	// the column width. Right alignment suits numbers and currency.
+	// Optional - defaults to AlignLeft.
+	Align Alignment
+}
+
+// SortDirection is the direction a Table column is sorted in.
+type SortDirection int
+
+const (
+	// SortNone means the data is in its original order.
+	SortNone SortDirection = iota
+
+	// SortAscending sorts from the smallest value to the largest.
+	SortAscending
+
+	// SortDescending sorts from the largest value to the smallest.
+	SortDescending
+)
+
+// TableSort is the sort state of a Table: the Field of the sorted column
+// and its direction.
+type TableSort struct {
+	// Field is the Field of the sorted column, or "" when unsorted.
+	Field string
+
+	// Direction is the sort direction.
+	Direction SortDirection
+}
+
+// TableCounts reports how many rows a Table has and shows.
+type TableCounts struct {
+	// Total is the number of rows in Data.
+	Total int
+
+	// Filtered is the number of rows passing Filter and FilterText.
+	Filtered int
+
+	// Pages is the number of pages of filtered rows (at least 1).
+	Pages int
 }
 
 // TableProps defines the configuration properties for a Table component.
@@ -83,16 +140,91 @@ type TableProps[T any] struct {
 	Columns []TableColumn[T]
 
 	// Sortable enables sorting functionality for the entire table.
-	// When true, columns with Sortable=true can be sorted by clicking headers.
-	// Clicking a sorted column toggles between ascending/descending.
+	// When true, columns with Sortable=true can be sorted by clicking headers
+	// or with the keyboard. Each click cycles the column through ascending,
+	// descending, and unsorted, which restores the original row order.
 	// Optional - defaults to false.
 	Sortable bool
 
+	// Sort is the reactive sort state. Read it to show the sort elsewhere,
+	// or set it to sort the data programmatically (this works even when
+	// Sortable is false).
+	// Optional - if nil, the table keeps the sort state internally.
+	Sort *bubbly.Ref[TableSort]
+
+	// Filter limits the rows shown to those it returns true for. The Data
+	// ref is not changed, so clearing the filter shows every row again.
+	// Optional - if nil, all rows pass.
+	Filter func(T) bool
+
+	// FilterText limits the rows shown to those with a cell containing the
+	// text, ignoring case. Cells are matched as displayed (after Render),
+	// in every column, including ones scrolled out of view. Rows must pass
+	// both Filter and FilterText.
+	// Optional - if nil or "", all rows pass.
+	FilterText *bubbly.Ref[string]
+
+	// PageSize is the number of rows per page. The rows that pass the
+	// filters are split into pages, and a "Page X of Y" footer is shown.
+	// Optional - if 0, all rows are shown on one page.
+	PageSize int
+
+	// CurrentPage is the reactive 1-based page number. It is clamped to the
+	// last page when the filtered rows shrink, and reset to 1 when
+	// FilterText changes.
+	// Optional - if nil, the table keeps the page internally.
+	CurrentPage *bubbly.Ref[int]
+
+	// Counts receives the number of rows in Data, passing the filters, and
+	// the number of pages, so they can be shown outside the table.
+	// It updates when Data, FilterText, or CurrentPage change and after each
+	// Update of the table (for Filter functions that read other state).
+	// Optional - if nil, counts are not reported.
+	Counts *bubbly.Ref[TableCounts]
+
 	// OnRowClick is a callback function executed when a row is clicked.
-	// Receives the row data and index as parameters.
+	// Receives the row data and its index in Data as parameters.
 	// Optional - if nil, no callback is executed.
 	OnRowClick func(T, int)
 
+	// RowStyle returns the style for a data row, based on its data and its
+	// index in the current (sorted) data. Use it to highlight rows
+	// conditionally, e.g., offline servers in red.
+	// Width, padding, and margins of the returned style are ignored so rows
+	// stay aligned with the header and columns.
+	// Optional - if nil, rows alternate between the theme's Foreground and
+	// Muted colors.
+	RowStyle func(row T, index int) lipgloss.Style
+
+	// SelectedRowStyle is the style for the selected row. It is layered on
+	// top of RowStyle: properties it leaves unset (e.g., Foreground) fall
+	// back to the row's own style.
+	// Optional - if nil, uses a bold highlight with the theme's Primary
+	// color as background.
+	SelectedRowStyle *lipgloss.Style
+
+	// Width is the width of the viewport in terminal cells. When the columns
+	// are wider, only the columns that fit are shown and the rest are
+	// reached by scrolling horizontally.
+	// Optional - if 0, all columns are shown.
+	Width int
+
+	// FrozenColumns is the number of leading columns that stay visible while
+	// scrolling horizontally, like a spreadsheet's first column.
+	// Default: 0.
+	FrozenColumns int
+
+	// AutoFit scales the column widths proportionally so the table fills
+	// Width exactly, instead of scrolling. Columns never shrink below 3
+	// cells, so very narrow viewports may still scroll.
+	// Requires Width. Default: false.
+	AutoFit bool
+
+	// Focused, when set, limits the built-in key bindings (scrolling,
+	// sorting, and paging) to times when it is true.
+	// Optional - if nil, the table always handles its keys.
+	Focused *bubbly.Ref[bool]
+
 	// Common props for all components
 	CommonProps
 }
@@ -127,6 +259,7 @@ type TableProps[T any] struct {
 //	            Header: "Price",
 //	            Field:  "Price",
 //	            Width:  15,
+//	            Align:  components.AlignRight,
 //	            Render: func(p Product) string {
 //	                return fmt.Sprintf("$%.2f", p.Price)
 //	            },
@@ -147,6 +280,7 @@ type TableProps[T any] struct {
 //   - Custom render functions per column
 //   - Row selection with callbacks
 //   - Reactive data updates via Ref[[]T]
+//   - Sorting, filtering, and pagination
 //   - Theme integration
 //   - Custom style override
 //   - Automatic field value extraction via reflection
@@ -156,6 +290,75 @@ type TableProps[T any] struct {
 //   - k/j: Vim-style navigation (up/down)
 //   - Enter/Space: Confirm selection and trigger OnRowClick callback
 //   - Click: Select row via rowClick event
+//   - Left/Right, h/l: Scroll columns when wider than Width (built-in key
+//     bindings emitting "scrollLeft" and "scrollRight")
+//   - [/]: Focus the previous/next sortable column (Sortable tables,
+//     built-in key bindings emitting "columnPrev" and "columnNext")
+//   - s: Cycle the sort of the focused column ("sortFocused")
+//   - Click on a sortable header: Cycle its sort (with mouse zones enabled;
+//     emit "headerClick" with the column index to do the same)
+//   - PgUp/PgDown: Previous/next page (tables with PageSize, built-in key
+//     bindings emitting "prevPage" and "nextPage"); Up/Down also turn the
+//     page when the selection leaves it
+//
+// Sorting:
+//
+// Sorting re-orders the Data ref itself, so the sorted rows are what
+// OnRowClick and RowStyle see. Each sort of a column cycles it through
+// ascending (▲), descending (▼), and unsorted, which restores the original
+// order. Emit "sort" with a column Field, or set the Sort ref, to sort
+// programmatically:
+//
+//	sortState := bubbly.NewRef(components.TableSort{})
+//	table := components.Table(components.TableProps[Server]{
+//	    Data:     servers,
+//	    Sortable: true,
+//	    Sort:     sortState,
+//	    Columns: []components.TableColumn[Server]{
+//	        {Header: "Host", Field: "Host", Width: 20, Sortable: true},
+//	        {
+//	            Header:   "Version",
+//	            Field:    "Version",
+//	            Width:    10,
+//	            Sortable: true,
+//	            Compare: func(a, b Server) int {
+//	                return semver.Compare(a.Version, b.Version)
+//	            },
+//	        },
+//	    },
+//	})
+//
+// Data replaced while sorted becomes the new original order and is sorted
+// again.
+//
+// Filtering and pagination:
+//
+// Filter and FilterText hide rows without changing Data, and PageSize then
+// splits the remaining rows into pages with a "Page X of Y" footer. Row
+// indexes (selection, "rowClick", OnRowClick, RowStyle) always refer to
+// Data. Pass a Counts ref to show how many rows match:
+//
+//	query := bubbly.NewRef("")
+//	counts := bubbly.NewRef(components.TableCounts{})
+//	table := components.Table(components.TableProps[Server]{
+//	    Data:       servers,
+//	    Columns:    columns,
+//	    Filter:     func(s Server) bool { return s.Status != "Retired" },
+//	    FilterText: query, // e.g. bound to an Input
+//	    PageSize:   20,
+//	    Counts:     counts,
+//	})
+//	// "12 of 340 servers"
+//	fmt.Sprintf("%d of %d servers", counts.GetTyped().Filtered, counts.GetTyped().Total)
+//
+// Wide data:
+//
+//	table := components.Table(components.TableProps[Server]{
+//	    Data:          servers,
+//	    Columns:       columns, // 12 columns, 180 cells wide
+//	    Width:         80,
+//	    FrozenColumns: 1, // Keep the host name visible while scrolling
+//	})
 //
 // The table uses reflection to extract field values from generic type T,
 // supporting string, int, float, bool, and other types with fmt.Sprintf formatting.
@@ -170,138 +373,352 @@ func tableSelectRow[T any](props TableProps[T], selectedRow *bubbly.Ref[int], in
 	}
 }
 
-// tableHandleKeyUp handles the keyUp event for moving selection up.
-func tableHandleKeyUp[T any](props TableProps[T], selectedRow *bubbly.Ref[int]) func(interface{}) {
+// tableHandleMove moves the selection by delta among the rows passing the
+// filters, turning the page to keep it visible.
+func tableHandleMove[T any](props TableProps[T], selectedRow, currentPage *bubbly.Ref[int], delta int) func(interface{}) {
 	return func(_ interface{}) {
-		currentRow := selectedRow.Get().(int)
-		items := props.Data.Get().([]T)
+		matched := tableFilterRows(props, props.Data.GetTyped())
+		current := slices.Index(matched, selectedRow.GetTyped())
+		next := navigateIndex(len(matched), current, delta, false, nil)
+		if next < 0 || next == current {
+			return
+		}
+		selectedRow.Set(matched[next])
+		if props.PageSize > 0 {
+			tableSetPage(currentPage, next/props.PageSize+1)
+		}
+	}
+}
 
-		if len(items) == 0 {
+// tableHandlePage turns the page by delta, clamped to the existing pages.
+// A selected row moves to the first row of the new page.
+func tableHandlePage[T any](props TableProps[T], selectedRow, currentPage *bubbly.Ref[int], delta int) func(interface{}) {
+	return func(_ interface{}) {
+		if props.PageSize <= 0 {
 			return
 		}
+		matched := tableFilterRows(props, props.Data.GetTyped())
+		pages := tablePageCount(len(matched), props.PageSize)
+		current := tableClampPage(currentPage.GetTyped(), pages)
+		next := tableClampPage(current+delta, pages)
+		if next == current {
+			return
+		}
+		tableSetPage(currentPage, next)
+		if selectedRow.GetTyped() >= 0 {
+			selectedRow.Set(matched[(next-1)*props.PageSize])
+		}
+	}
+}
+
+// tableSetPage sets the current page if it differs.
+func tableSetPage(currentPage *bubbly.Ref[int], page int) {
+	if currentPage.GetTyped() != page {
+		currentPage.Set(page)
+	}
+}
+
+// tableFilterRows returns the indexes in data of the rows passing
+// p.Filter and p.FilterText, in order.
+func tableFilterRows[T any](p TableProps[T], data []T) []int {
+	text := ""
+	if p.FilterText != nil {
+		text = strings.ToLower(p.FilterText.GetTyped())
+	}
+
+	matched := make([]int, 0, len(data))
+	for i, row := range data {
+		if p.Filter != nil && !p.Filter(row) {
+			continue
+		}
+		if text != "" && !tableRowContains(p.Columns, row, text) {
+			continue
+		}
+		matched = append(matched, i)
+	}
+	return matched
+}
 
-		if currentRow == -1 {
-			selectedRow.Set(len(items) - 1)
-		} else if currentRow > 0 {
-			selectedRow.Set(currentRow - 1)
+// tableRowContains reports whether a displayed cell of row contains the
+// lowercase text.
+func tableRowContains[T any](columns []TableColumn[T], row T, text string) bool {
+	for _, col := range columns {
+		if strings.Contains(strings.ToLower(ansi.Strip(tableCellText(col, row))), text) {
+			return true
 		}
 	}
+	return false
 }
 
-// tableHandleKeyDown handles the keyDown event for moving selection down.
-func tableHandleKeyDown[T any](props TableProps[T], selectedRow *bubbly.Ref[int]) func(interface{}) {
-	return func(_ interface{}) {
-		currentRow := selectedRow.Get().(int)
-		items := props.Data.Get().([]T)
+// tablePageCount returns the number of pages of count rows (at least 1).
+func tablePageCount(count, pageSize int) int {
+	if pageSize <= 0 || count == 0 {
+		return 1
+	}
+	return (count + pageSize - 1) / pageSize
+}
 
-		if len(items) == 0 {
-			return
+// tableClampPage clamps a 1-based page to [1, pages].
+func tableClampPage(page, pages int) int {
+	return max(1, min(page, pages))
+}
+
+// tablePageRows returns the rows of the 1-based page among matched, and
+// the page clamped to the existing pages.
+func tablePageRows(matched []int, pageSize, page int) ([]int, int) {
+	if pageSize <= 0 {
+		return matched, 1
+	}
+	page = tableClampPage(page, tablePageCount(len(matched), pageSize))
+	start := (page - 1) * pageSize
+	end := min(start+pageSize, len(matched))
+	return matched[start:end], page
+}
+
+// tableCountRows computes the row counts of the table.
+func tableCountRows[T any](p TableProps[T]) TableCounts {
+	data := p.Data.GetTyped()
+	filtered := len(tableFilterRows(p, data))
+	return TableCounts{
+		Total:    len(data),
+		Filtered: filtered,
+		Pages:    tablePageCount(filtered, p.PageSize),
+	}
+}
+
+// tableRefreshPage clamps the current page to the filtered rows and
+// reports the row counts.
+func tableRefreshPage[T any](p TableProps[T], currentPage *bubbly.Ref[int]) {
+	counts := tableCountRows(p)
+	tableSetPage(currentPage, tableClampPage(currentPage.GetTyped(), counts.Pages))
+	if p.Counts != nil && p.Counts.GetTyped() != counts {
+		p.Counts.Set(counts)
+	}
+}
+
+// tableNextSort returns the sort state after cycling field: unsorted and
+// other columns become ascending, ascending becomes descending, and
+// descending becomes unsorted.
+func tableNextSort(current TableSort, field string) TableSort {
+	if current.Field != field || current.Direction == SortNone {
+		return TableSort{Field: field, Direction: SortAscending}
+	}
+	if current.Direction == SortAscending {
+		return TableSort{Field: field, Direction: SortDescending}
+	}
+	return TableSort{}
+}
+
+// tableSorter keeps the table data ordered by the sort state. It remembers
+// the original order so that unsorting can restore it, and moves the
+// selection with the selected row when the data is re-ordered.
+type tableSorter[T any] struct {
+	props       TableProps[T]
+	selectedRow *bubbly.Ref[int]
+	original    []T   // nil while unsorted
+	order       []int // index in original of each row of Data while sorted
+	applying    bool
+}
+
+// apply re-orders the data for state.
+func (s *tableSorter[T]) apply(state TableSort) {
+	if state.Direction == SortNone || state.Field == "" {
+		if s.original != nil {
+			original := s.original
+			s.original = nil
+			s.set(original, nil)
 		}
+		return
+	}
+
+	if s.original == nil {
+		s.original = slices.Clone(s.props.Data.GetTyped())
+	}
 
-		if currentRow == -1 {
-			selectedRow.Set(0)
-		} else if currentRow < len(items)-1 {
-			selectedRow.Set(currentRow + 1)
+	// Sort the original order so ties keep it in both directions
+	compare := tableColumnCompare(s.props.Columns, state.Field)
+	order := make([]int, len(s.original))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if state.Direction == SortDescending {
+			return compare(s.original[b], s.original[a])
 		}
+		return compare(s.original[a], s.original[b])
+	})
+
+	sorted := make([]T, len(order))
+	for i, index := range order {
+		sorted[i] = s.original[index]
 	}
+	s.set(sorted, order)
 }
 
-// tableHandleSort handles the sort event for sorting table data.
-func tableHandleSort[T any](props TableProps[T], sortColumn *bubbly.Ref[string], sortAsc *bubbly.Ref[bool]) func(interface{}) {
-	return func(data interface{}) {
-		if !props.Sortable {
-			return
+// dataChanged re-sorts data replaced from outside the table, which becomes
+// the new original order.
+func (s *tableSorter[T]) dataChanged(items []T, state TableSort) {
+	if s.applying || s.original == nil {
+		return
+	}
+	s.original = slices.Clone(items)
+	s.order = nil // The selection refers to the new data as given
+	s.apply(state)
+}
+
+// set updates the data to the rows of original in order (nil for the
+// original order itself) without treating it as an outside change, and
+// keeps the same row selected.
+func (s *tableSorter[T]) set(items []T, order []int) {
+	selected := -1
+	if row := s.selectedRow.GetTyped(); row >= 0 {
+		selected = row
+		if s.order != nil && row < len(s.order) {
+			selected = s.order[row]
 		}
+		if order != nil {
+			selected = slices.Index(order, selected)
+		}
+	}
+	s.order = order
 
-		fieldName := data.(string)
-		currentSortColumn := sortColumn.Get().(string)
+	s.applying = true
+	defer func() { s.applying = false }()
+	s.props.Data.Set(items)
+	if selected != s.selectedRow.GetTyped() {
+		s.selectedRow.Set(selected)
+	}
+}
 
-		// Toggle direction if same column, otherwise set new column ascending
-		if currentSortColumn == fieldName {
-			sortAsc.Set(!sortAsc.Get().(bool))
-		} else {
-			sortColumn.Set(fieldName)
-			sortAsc.Set(true)
+// tableColumnCompare returns the comparator of the column with field,
+// falling back to comparing the field values via reflection.
+func tableColumnCompare[T any](columns []TableColumn[T], field string) func(a, b T) int {
+	for _, col := range columns {
+		if col.Field == field && col.Compare != nil {
+			return col.Compare
 		}
+	}
+	return func(a, b T) int {
+		return compareValues(getFieldValueForSort(a, field), getFieldValueForSort(b, field))
+	}
+}
 
-		// Get current data
-		items := props.Data.Get().([]T)
-		if len(items) == 0 {
+// tableHandleSort handles the sort event, which cycles the sort of the
+// column with the given field.
+func tableHandleSort[T any](props TableProps[T], sort *bubbly.Ref[TableSort]) func(interface{}) {
+	return func(data interface{}) {
+		field, ok := data.(string)
+		if !props.Sortable || !ok {
 			return
 		}
+		sort.Set(tableNextSort(sort.GetTyped(), field))
+	}
+}
 
-		// Create a copy to sort
-		sortedItems := make([]T, len(items))
-		copy(sortedItems, items)
-
-		// Sort the copy
-		ascending := sortAsc.Get().(bool)
-		sort.Slice(sortedItems, func(i, j int) bool {
-			valI := getFieldValueForSort(sortedItems[i], fieldName)
-			valJ := getFieldValueForSort(sortedItems[j], fieldName)
+// tableHandleHeaderClick handles the headerClick event, which focuses and
+// cycles the sort of the sortable column at the given index.
+func tableHandleHeaderClick[T any](props TableProps[T], sort *bubbly.Ref[TableSort], sortFocus, columnOffset *bubbly.Ref[int]) func(interface{}) {
+	return func(data interface{}) {
+		index, ok := data.(int)
+		if !ok || !tableColumnSortable(props, index) {
+			return
+		}
+		sortFocus.Set(index)
+		tableScrollToColumn(props, columnOffset, index)
+		sort.Set(tableNextSort(sort.GetTyped(), props.Columns[index].Field))
+	}
+}
 
-			cmp := compareValues(valI, valJ)
-			if ascending {
-				return cmp < 0
-			}
-			return cmp > 0
+// tableHandleSortFocus moves the focused column by delta sortable columns,
+// wrapping around, and scrolls it into view.
+func tableHandleSortFocus[T any](props TableProps[T], sortFocus, columnOffset *bubbly.Ref[int], delta int) func(interface{}) {
+	return func(_ interface{}) {
+		if !props.Sortable {
+			return
+		}
+		next := navigateIndex(len(props.Columns), sortFocus.GetTyped(), delta, true, func(i int) bool {
+			return tableColumnSortable(props, i)
 		})
+		if next >= 0 && next != sortFocus.GetTyped() {
+			sortFocus.Set(next)
+			tableScrollToColumn(props, columnOffset, next)
+		}
+	}
+}
 
-		// Update the data ref with sorted data
-		props.Data.Set(sortedItems)
+// tableHandleSortFocused cycles the sort of the focused column, focusing
+// the first sortable column if none is.
+func tableHandleSortFocused[T any](props TableProps[T], sort *bubbly.Ref[TableSort], sortFocus, columnOffset *bubbly.Ref[int]) func(interface{}) {
+	focusFirst := tableHandleSortFocus(props, sortFocus, columnOffset, 1)
+	return func(_ interface{}) {
+		if !tableColumnSortable(props, sortFocus.GetTyped()) {
+			focusFirst(nil)
+		}
+		if index := sortFocus.GetTyped(); tableColumnSortable(props, index) {
+			sort.Set(tableNextSort(sort.GetTyped(), props.Columns[index].Field))
+		}
 	}
 }
 
-// tableRenderSortableHeader renders a sortable column header with sort indicator.
-func tableRenderSortableHeader[T any](col TableColumn[T], width int, currentSortColumn string, ascending bool) string {
+// tableColumnSortable reports whether the column at index can be sorted.
+func tableColumnSortable[T any](props TableProps[T], index int) bool {
+	return props.Sortable && index >= 0 && index < len(props.Columns) &&
+		props.Columns[index].Sortable && props.Columns[index].Field != ""
+}
+
+// tableRenderSortableHeader renders a sortable column header with sort
+// indicator, underlined when the column is focused.
+func tableRenderSortableHeader[T any](col TableColumn[T], width int, direction SortDirection, focused bool) string {
 	const sortIndicatorWidth = 2
 	maxHeaderWidth := width - sortIndicatorWidth
 	if maxHeaderWidth < 1 {
 		maxHeaderWidth = 1
 	}
 
-	headerText := col.Header
-	if runeCount := utf8.RuneCountInString(headerText); runeCount > maxHeaderWidth {
-		runes := []rune(headerText)
-		if maxHeaderWidth <= 3 {
-			headerText = string(runes[:maxHeaderWidth])
-		} else {
-			headerText = string(runes[:maxHeaderWidth-3]) + "..."
-		}
+	headerText := bubbly.TruncateVisible(col.Header, maxHeaderWidth)
+	if focused {
+		headerText = lipgloss.NewStyle().Underline(true).Render(headerText)
 	}
 
 	indicator := "  "
-	if currentSortColumn == col.Field {
-		if ascending {
-			indicator = " ↑"
-		} else {
-			indicator = " ↓"
-		}
+	switch direction {
+	case SortAscending:
+		indicator = " ▲"
+	case SortDescending:
+		indicator = " ▼"
 	}
 
-	combined := headerText + indicator
-	if paddingNeeded := width - utf8.RuneCountInString(combined); paddingNeeded > 0 {
-		return combined + strings.Repeat(" ", paddingNeeded)
-	}
-	return combined
+	return bubbly.PadVisible(headerText+indicator, width)
 }
 
-// tableRenderHeaderRow renders the complete header row.
-func tableRenderHeaderRow[T any](columns []TableColumn[T], sortable bool, currentSortColumn string, ascending bool, theme Theme) string {
+// tableRenderHeaderRow renders the complete header row. indexes holds the
+// index in p.Columns of each visible column; sortable headers are marked
+// as mouse zones that emit headerClick through onClick.
+func tableRenderHeaderRow[T any](p TableProps[T], columns []TableColumn[T], indexes []int, state TableSort, sortFocus int, onClick func(int), theme Theme) string {
 	headerStyle := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(theme.Primary).
 		Padding(0, 1)
 
 	headerParts := make([]string, 0, len(columns))
-	for _, col := range columns {
-		var finalHeader string
-		if sortable && col.Sortable {
-			finalHeader = tableRenderSortableHeader(col, col.Width, currentSortColumn, ascending)
-		} else {
-			finalHeader = padString(col.Header, col.Width)
+	for i, col := range columns {
+		if !tableColumnSortable(p, indexes[i]) {
+			headerParts = append(headerParts, alignString(col.Header, col.Width, col.Align))
+			continue
+		}
+
+		direction := SortNone
+		if state.Field == col.Field {
+			direction = state.Direction
 		}
-		headerParts = append(headerParts, finalHeader)
+		header := tableRenderSortableHeader(col, col.Width, direction, indexes[i] == sortFocus)
+		if onClick != nil {
+			index := indexes[i]
+			header = bubbly.MouseZone(header, bubbly.MouseEventClick, func(bubbly.MouseEvent) {
+				onClick(index)
+			})
+		}
+		headerParts = append(headerParts, header)
 	}
 
 	borderStyle := lipgloss.NewStyle().
@@ -311,97 +728,410 @@ func tableRenderHeaderRow[T any](columns []TableColumn[T], sortable bool, curren
 	return borderStyle.Render(headerStyle.Render(strings.Join(headerParts, " ")))
 }
 
-// tableRenderDataRow renders a single data row.
-func tableRenderDataRow[T any](row T, rowIndex int, columns []TableColumn[T], selectedIndex int, theme Theme) string {
+// tableCellText returns the text of the cell of col in row.
+func tableCellText[T any](col TableColumn[T], row T) string {
+	if col.Render != nil {
+		return col.Render(row)
+	}
+	return getFieldValue(row, col.Field)
+}
+
+// tableRenderDataRow renders a single data row. rowIndex is the row's index
+// in Data and position its position among the rows shown.
+func tableRenderDataRow[T any](p TableProps[T], columns []TableColumn[T], row T, rowIndex, position int, selectedIndex int, theme Theme) string {
 	rowParts := make([]string, 0, len(columns))
 	for _, col := range columns {
-		var cellValue string
-		if col.Render != nil {
-			cellValue = col.Render(row)
-		} else {
-			cellValue = getFieldValue(row, col.Field)
-		}
-		rowParts = append(rowParts, padString(cellValue, col.Width))
+		rowParts = append(rowParts, alignString(tableCellText(col, row), col.Width, col.Align))
 	}
 
 	rowText := strings.Join(rowParts, " ")
-	rowStyle := lipgloss.NewStyle().Padding(0, 1)
+	return tableRowStyle(p, row, rowIndex, position, rowIndex == selectedIndex, theme).Render(rowText)
+}
+
+// tableRowStyle resolves the style of a data row from RowStyle and
+// SelectedRowStyle, falling back to the theme defaults, which alternate by
+// the row's position among the rows shown.
+func tableRowStyle[T any](p TableProps[T], row T, rowIndex, position int, selected bool, theme Theme) lipgloss.Style {
+	var rowStyle lipgloss.Style
+	switch {
+	case p.RowStyle != nil:
+		rowStyle = p.RowStyle(row, rowIndex)
+	case position%2 == 0:
+		rowStyle = lipgloss.NewStyle().Foreground(theme.Foreground)
+	default:
+		rowStyle = lipgloss.NewStyle().Foreground(theme.Muted)
+	}
 
-	if rowIndex == selectedIndex {
-		rowStyle = rowStyle.Background(theme.Primary).Foreground(lipgloss.Color("230")).Bold(true)
-	} else if rowIndex%2 == 0 {
-		rowStyle = rowStyle.Foreground(theme.Foreground)
-	} else {
-		rowStyle = rowStyle.Foreground(theme.Muted)
+	if selected {
+		selectedStyle := lipgloss.NewStyle().Background(theme.Primary).Foreground(lipgloss.Color("230")).Bold(true)
+		if p.SelectedRowStyle != nil {
+			selectedStyle = *p.SelectedRowStyle
+		}
+		rowStyle = selectedStyle.Inherit(rowStyle)
 	}
 
-	return rowStyle.Render(rowText)
+	// Keep the row layout in line with the header regardless of user styles
+	return rowStyle.
+		UnsetWidth().
+		UnsetMaxWidth().
+		UnsetMargins().
+		Padding(0, 1)
 }
 
-// tableRenderBody renders all data rows or empty state.
-func tableRenderBody[T any](data []T, columns []TableColumn[T], selectedIndex int, theme Theme) string {
-	if len(data) == 0 {
+// tableRenderBody renders the data rows at rows (indexes in data), or the
+// empty state.
+func tableRenderBody[T any](data []T, rows []int, p TableProps[T], columns []TableColumn[T], selectedIndex int, theme Theme) string {
+	if len(rows) == 0 {
+		message := "No data available"
+		if len(data) > 0 {
+			message = "No matching rows"
+		}
 		emptyStyle := lipgloss.NewStyle().
 			Foreground(theme.Muted).
 			Italic(true).
 			Padding(1, 2)
-		return emptyStyle.Render("No data available")
+		return emptyStyle.Render(message)
 	}
 
 	var output strings.Builder
-	for i, row := range data {
-		output.WriteString(tableRenderDataRow(row, i, columns, selectedIndex, theme))
+	for position, i := range rows {
+		output.WriteString(tableRenderDataRow(p, columns, data[i], i, position, selectedIndex, theme))
 		output.WriteString("\n")
 	}
 	return output.String()
 }
 
+// tableRenderPageFooter renders the "Page X of Y" footer of a paginated
+// table.
+func tableRenderPageFooter(page, pages int, theme Theme) string {
+	footerStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Italic(true).
+		Padding(0, 1)
+	return footerStyle.Render(fmt.Sprintf("Page %d of %d", page, pages))
+}
+
+// tableRowPadding is the horizontal padding of header and data rows.
+const tableRowPadding = 2
+
+// tableMinAutoFitWidth is the narrowest a column gets with AutoFit.
+const tableMinAutoFitWidth = 3
+
+// tableLayoutColumns returns the columns with AutoFit widths applied.
+func tableLayoutColumns[T any](p TableProps[T]) []TableColumn[T] {
+	if !p.AutoFit || p.Width <= 0 || len(p.Columns) == 0 {
+		return p.Columns
+	}
+
+	total := 0
+	for _, col := range p.Columns {
+		total += max(col.Width, 1)
+	}
+	available := p.Width - tableRowPadding - (len(p.Columns) - 1)
+	if total <= 0 || available <= 0 {
+		return p.Columns
+	}
+
+	columns := make([]TableColumn[T], len(p.Columns))
+	used := 0
+	for i, col := range p.Columns {
+		col.Width = max(tableMinAutoFitWidth, max(col.Width, 1)*available/total)
+		used += col.Width
+		columns[i] = col
+	}
+	// Give rounding leftovers to the last column
+	if last := &columns[len(columns)-1]; used < available {
+		last.Width += available - used
+	}
+	return columns
+}
+
+// tableVisibleColumns returns the frozen columns followed by the scrollable
+// columns from offset that fit in width, together with the offset clamped
+// to the scrollable range and the index after the last shown column.
+// Without a width, all columns are visible.
+func tableVisibleColumns[T any](columns []TableColumn[T], width, frozen, offset int) (visible []TableColumn[T], clamped, end int) {
+	if width <= 0 {
+		return columns, 0, len(columns)
+	}
+	frozen = max(0, min(frozen, len(columns)))
+	offset = max(0, min(offset, tableMaxColumnOffset(columns, width, frozen)))
+
+	budget := width - tableRowPadding
+	visible = make([]TableColumn[T], 0, len(columns))
+	for _, col := range columns[:frozen] {
+		visible = append(visible, col)
+		budget -= col.Width + 1
+	}
+
+	end = frozen + offset
+	for end < len(columns) {
+		// Always show at least one scrollable column
+		if len(visible) > frozen && columns[end].Width > budget {
+			break
+		}
+		visible = append(visible, columns[end])
+		budget -= columns[end].Width + 1
+		end++
+	}
+	return visible, offset, end
+}
+
+// tableMaxColumnOffset returns the largest useful scroll offset: the one
+// at which the last column just becomes visible.
+func tableMaxColumnOffset[T any](columns []TableColumn[T], width, frozen int) int {
+	budget := width - tableRowPadding
+	for _, col := range columns[:frozen] {
+		budget -= col.Width + 1
+	}
+
+	offset := len(columns) - frozen
+	for offset > 0 {
+		col := columns[frozen+offset-1]
+		if col.Width > budget && offset < len(columns)-frozen {
+			break
+		}
+		budget -= col.Width + 1
+		offset--
+	}
+	return offset
+}
+
+// tableRenderScrollIndicator renders which columns are shown when some are
+// scrolled out of view, or "" when all are visible.
+func tableRenderScrollIndicator(frozen, offset, end, total int, theme Theme) string {
+	first := frozen + offset
+	if offset == 0 && end >= total {
+		return ""
+	}
+
+	left, right := " ", " "
+	if offset > 0 {
+		left = "◀"
+	}
+	if end < total {
+		right = "▶"
+	}
+	indicatorStyle := lipgloss.NewStyle().
+		Foreground(theme.Muted).
+		Italic(true).
+		Padding(0, 1)
+	return indicatorStyle.Render(fmt.Sprintf("%s columns %d-%d of %d %s", left, first+1, end, total, right))
+}
+
+// tableHandleScroll moves the horizontal scroll offset by delta columns.
+func tableHandleScroll[T any](props TableProps[T], columnOffset *bubbly.Ref[int], delta int) func(interface{}) {
+	return func(_ interface{}) {
+		if props.Width <= 0 {
+			return
+		}
+		columns := tableLayoutColumns(props)
+		frozen := max(0, min(props.FrozenColumns, len(columns)))
+		maxOffset := tableMaxColumnOffset(columns, props.Width, frozen)
+
+		current := min(columnOffset.GetTyped(), maxOffset)
+		next := max(0, min(maxOffset, current+delta))
+		if next != columnOffset.GetTyped() {
+			columnOffset.Set(next)
+		}
+	}
+}
+
+// tableScrollToColumn scrolls horizontally until the column at index is
+// visible. Frozen columns are always visible.
+func tableScrollToColumn[T any](props TableProps[T], columnOffset *bubbly.Ref[int], index int) {
+	if props.Width <= 0 {
+		return
+	}
+	columns := tableLayoutColumns(props)
+	frozen := max(0, min(props.FrozenColumns, len(columns)))
+	if index < frozen || index >= len(columns) {
+		return
+	}
+
+	_, offset, end := tableVisibleColumns(columns, props.Width, frozen, columnOffset.GetTyped())
+	if index < frozen+offset {
+		offset = index - frozen
+	}
+	maxOffset := tableMaxColumnOffset(columns, props.Width, frozen)
+	for index >= end && offset < maxOffset {
+		offset++
+		_, _, end = tableVisibleColumns(columns, props.Width, frozen, offset)
+	}
+	if offset != columnOffset.GetTyped() {
+		columnOffset.Set(offset)
+	}
+}
+
+// tableKeyBindings are the built-in horizontal scrolling key bindings of Table.
+var tableKeyBindings = []bubbly.KeyBinding{
+	{Key: "left", Event: "scrollLeft", Description: "Scroll columns left"},
+	{Key: "h", Event: "scrollLeft", Description: "Scroll columns left"},
+	{Key: "right", Event: "scrollRight", Description: "Scroll columns right"},
+	{Key: "l", Event: "scrollRight", Description: "Scroll columns right"},
+}
+
+// tablePageKeyBindings are the built-in page key bindings of a paginated
+// Table.
+var tablePageKeyBindings = []bubbly.KeyBinding{
+	{Key: "pgup", Event: "prevPage", Description: "Previous page"},
+	{Key: "pgdown", Event: "nextPage", Description: "Next page"},
+}
+
+// tableSortKeyBindings are the built-in sorting key bindings of a Sortable
+// Table.
+var tableSortKeyBindings = []bubbly.KeyBinding{
+	{Key: "[", Event: "columnPrev", Description: "Focus previous sortable column"},
+	{Key: "]", Event: "columnNext", Description: "Focus next sortable column"},
+	{Key: "s", Event: "sortFocused", Description: "Cycle sort of focused column"},
+}
+
 func Table[T any](props TableProps[T]) bubbly.Component {
-	comp, err := bubbly.NewComponent("Table").
+	builder := bubbly.NewComponent("Table").
 		Props(props).
 		Setup(func(ctx *bubbly.Context) {
 			theme := ctx.Inject("theme", DefaultTheme).(Theme)
 			selectedRow := bubbly.NewRef(-1)
-			sortColumn := bubbly.NewRef("")
-			sortAsc := bubbly.NewRef(true)
 
 			ctx.On("rowClick", func(data interface{}) {
 				tableSelectRow(props, selectedRow, data.(int))
 			})
-			ctx.On("keyUp", tableHandleKeyUp(props, selectedRow))
-			ctx.On("keyDown", tableHandleKeyDown(props, selectedRow))
+			currentPage := props.CurrentPage
+			if currentPage == nil {
+				currentPage = bubbly.NewRef(1)
+			}
+			ctx.On("keyUp", tableHandleMove(props, selectedRow, currentPage, -1))
+			ctx.On("keyDown", tableHandleMove(props, selectedRow, currentPage, 1))
+			ctx.On("prevPage", tableHandlePage(props, selectedRow, currentPage, -1))
+			ctx.On("nextPage", tableHandlePage(props, selectedRow, currentPage, 1))
 			ctx.On("keyEnter", func(_ interface{}) {
 				if currentRow := selectedRow.Get().(int); currentRow >= 0 {
 					tableSelectRow(props, selectedRow, currentRow)
 				}
 			})
-			ctx.On("sort", tableHandleSort(props, sortColumn, sortAsc))
+
+			columnOffset := bubbly.NewRef(0)
+			ctx.On("scrollLeft", tableHandleScroll(props, columnOffset, -1))
+			ctx.On("scrollRight", tableHandleScroll(props, columnOffset, 1))
+
+			// Keep the data ordered by the sort state, however it changed
+			sort := props.Sort
+			if sort == nil {
+				sort = bubbly.NewRef(TableSort{})
+			}
+			sorter := &tableSorter[T]{props: props, selectedRow: selectedRow}
+			sorter.apply(sort.GetTyped())
+			cleanupSort := bubbly.Watch(sort, func(state, _ TableSort) {
+				sorter.apply(state)
+			})
+			cleanupData := bubbly.Watch(props.Data, func(items, _ []T) {
+				sorter.dataChanged(items, sort.GetTyped())
+				tableRefreshPage(props, currentPage)
+			})
+			ctx.OnUnmounted(func() {
+				cleanupSort()
+				cleanupData()
+			})
+
+			// Keep the page and counts in line with the filtered rows
+			tableRefreshPage(props, currentPage)
+			if props.FilterText != nil {
+				cleanupFilter := bubbly.Watch(props.FilterText, func(_, _ string) {
+					if row := selectedRow.GetTyped(); row >= 0 && !slices.Contains(tableFilterRows(props, props.Data.GetTyped()), row) {
+						selectedRow.Set(-1)
+					}
+					tableSetPage(currentPage, 1)
+					tableRefreshPage(props, currentPage)
+				})
+				ctx.OnUnmounted(cleanupFilter)
+			}
+			cleanupPage := bubbly.Watch(currentPage, func(_, _ int) {
+				tableRefreshPage(props, currentPage)
+			})
+			ctx.OnUnmounted(cleanupPage)
+			ctx.OnUpdated(func() {
+				tableRefreshPage(props, currentPage)
+			})
+
+			sortFocus := bubbly.NewRef(-1) // Column index keyboard sorting is on
+			ctx.On("sort", tableHandleSort(props, sort))
+			ctx.On("headerClick", tableHandleHeaderClick(props, sort, sortFocus, columnOffset))
+			ctx.On("columnPrev", tableHandleSortFocus(props, sortFocus, columnOffset, -1))
+			ctx.On("columnNext", tableHandleSortFocus(props, sortFocus, columnOffset, 1))
+			ctx.On("sortFocused", tableHandleSortFocused(props, sort, sortFocus, columnOffset))
 
 			ctx.Expose("selectedRow", selectedRow)
-			ctx.Expose("sortColumn", sortColumn)
-			ctx.Expose("sortAsc", sortAsc)
+			ctx.Expose("columnOffset", columnOffset)
+			ctx.Expose("currentPage", currentPage)
+			ctx.Expose("sortState", sort)
+			ctx.Expose("sortFocus", sortFocus)
+			ctx.Expose("headerClick", func(index int) {
+				ctx.Emit("headerClick", index)
+			})
 			ctx.Expose("theme", theme)
 		}).
 		Template(func(ctx bubbly.RenderContext) string {
 			p := ctx.Props().(TableProps[T])
 			selectedRow := ctx.Get("selectedRow").(*bubbly.Ref[int])
-			sortColumn := ctx.Get("sortColumn").(*bubbly.Ref[string])
-			sortAsc := ctx.Get("sortAsc").(*bubbly.Ref[bool])
+			sortState := ctx.Get("sortState").(*bubbly.Ref[TableSort]).GetTyped()
+			sortFocus := ctx.Get("sortFocus").(*bubbly.Ref[int]).GetTyped()
+			headerClick := ctx.Get("headerClick").(func(int))
 			theme := ctx.Get("theme").(Theme)
 
 			data := p.Data.Get().([]T)
-			currentSortColumn := sortColumn.Get().(string)
-			ascending := sortAsc.Get().(bool)
+
+			// Filter first, then paginate
+			matched := tableFilterRows(p, data)
+			rows, page := tablePageRows(matched, p.PageSize,
+				ctx.Get("currentPage").(*bubbly.Ref[int]).GetTyped())
+
+			columns := tableLayoutColumns(p)
+			frozen := max(0, min(p.FrozenColumns, len(columns)))
+			visible, offset, end := tableVisibleColumns(columns, p.Width, frozen,
+				ctx.Get("columnOffset").(*bubbly.Ref[int]).GetTyped())
+
+			// Map the visible columns back to their index in p.Columns
+			indexes := make([]int, len(visible))
+			for i := range visible {
+				indexes[i] = i
+				if i >= frozen {
+					indexes[i] += offset
+				}
+			}
 
 			var output strings.Builder
-			output.WriteString(tableRenderHeaderRow(p.Columns, p.Sortable, currentSortColumn, ascending, theme))
+			output.WriteString(tableRenderHeaderRow(p, visible, indexes, sortState, sortFocus, headerClick, theme))
 			output.WriteString("\n")
-			output.WriteString(tableRenderBody(data, p.Columns, selectedRow.Get().(int), theme))
+			output.WriteString(tableRenderBody(data, rows, p, visible, selectedRow.Get().(int), theme))
+			if p.PageSize > 0 {
+				output.WriteString(tableRenderPageFooter(page, tablePageCount(len(matched), p.PageSize), theme))
+				output.WriteString("\n")
+			}
+			if indicator := tableRenderScrollIndicator(frozen, offset, end, len(columns), theme); indicator != "" {
+				output.WriteString(indicator)
+				output.WriteString("\n")
+			}
 
 			return output.String()
-		}).
-		Build()
+		})
+
+	bindings := tableKeyBindings
+	if props.Sortable {
+		bindings = append(slices.Clip(bindings), tableSortKeyBindings...)
+	}
+	if props.PageSize > 0 {
+		bindings = append(slices.Clip(bindings), tablePageKeyBindings...)
+	}
+	for _, binding := range bindings {
+		builder = builder.WithConditionalKeyBinding(bubbly.KeyBinding{
+			Key:         binding.Key,
+			Event:       binding.Event,
+			Description: binding.Description,
+			Condition:   listFocusCondition(props.Focused),
+		})
+	}
 
+	comp, err := builder.Build()
 	if err != nil {
 		panic(err)
 	}
@@ -441,26 +1171,30 @@ func getFieldValue[T any](row T, fieldName string) string {
 // padString pads or truncates a string to the specified width.
 // If the string is longer than width, it truncates with "...".
 // If shorter, it pads with spaces on the right.
-// Uses rune count (visual character width) instead of byte count.
+// Widths are measured in terminal cells, so ANSI styling and wide
+// characters (emoji, CJK) don't break column alignment.
 func padString(s string, width int) string {
+	return alignString(s, width, AlignLeft)
+}
+
+// alignString truncates s to width like padString, then pads it on the side(s)
+// given by align. Unknown alignments behave like AlignLeft.
+func alignString(s string, width int, align Alignment) string {
 	if width <= 0 {
 		return s
 	}
 
-	// Count visual characters (runes), not bytes
-	runeCount := utf8.RuneCountInString(s)
-
-	// Truncate if too long
-	if runeCount > width {
-		runes := []rune(s)
-		if width <= 3 {
-			return string(runes[:width])
-		}
-		return string(runes[:width-3]) + "..."
+	s = bubbly.TruncateVisible(s, width)
+	gap := width - bubbly.VisibleWidth(s)
+	switch align {
+	case AlignRight:
+		return strings.Repeat(" ", gap) + s
+	case AlignCenter:
+		left := gap / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", gap-left)
+	default:
+		return bubbly.PadVisible(s, width)
 	}
-
-	// Pad if too short
-	return s + strings.Repeat(" ", width-runeCount)
 }
 
 // getFieldValueForSort extracts a field value from a struct for sorting purposes.
@@ -483,85 +1217,124 @@ func getFieldValueForSort[T any](row T, fieldName string) interface{} {
 
 	// Get field by name
 	field := v.FieldByName(fieldName)
-	if !field.IsValid() {
+	if !field.IsValid() || !field.CanInterface() {
 		return nil
 	}
 
 	return field.Interface()
 }
 
+// Sort groups order values of unrelated kinds in mixed columns.
+const (
+	sortGroupBool = iota
+	sortGroupNumber
+	sortGroupString
+	sortGroupTime
+	sortGroupOther
+)
+
+// timeType is the reflect type of time.Time.
+var timeType = reflect.TypeOf(time.Time{})
+
 // compareValues compares two values for sorting.
 // Returns -1 if a < b, 0 if a == b, 1 if a > b.
-// Handles string, int, int64, float64, and bool types.
-// compareNumerics compares numeric values and returns -1, 0, or 1.
-func compareNumerics[T int | int64 | float64](a, b T) int {
-	if a < b {
+// Pointers and interfaces are followed, and nil sorts before any value.
+// Numbers compare numerically across int, uint, and float kinds, strings
+// lexically, bools false first, and time.Time chronologically. Values of
+// different groups (e.g., a number and a string in an interface{} field)
+// are ordered bools, numbers, strings, times, then anything else, which
+// compares by its fmt.Sprintf("%v") text.
+func compareValues(a, b interface{}) int {
+	va, vb := sortValue(reflect.ValueOf(a)), sortValue(reflect.ValueOf(b))
+	switch {
+	case !va.IsValid() && !vb.IsValid():
+		return 0
+	case !va.IsValid():
 		return -1
-	}
-	if a > b {
+	case !vb.IsValid():
 		return 1
 	}
-	return 0
-}
 
-// compareBools compares boolean values (false < true).
-func compareBools(a, b bool) int {
-	if !a && b {
-		return -1
+	groupA, groupB := sortGroup(va), sortGroup(vb)
+	if groupA != groupB {
+		return cmp.Compare(groupA, groupB)
 	}
-	if a && !b {
-		return 1
+
+	switch groupA {
+	case sortGroupBool:
+		return compareBools(va.Bool(), vb.Bool())
+	case sortGroupNumber:
+		return compareNumbers(va, vb)
+	case sortGroupString:
+		return strings.Compare(va.String(), vb.String())
+	case sortGroupTime:
+		return va.Interface().(time.Time).Compare(vb.Interface().(time.Time))
+	default:
+		return strings.Compare(fmt.Sprintf("%v", va), fmt.Sprintf("%v", vb))
 	}
-	return 0
 }
 
-// compareStrings compares string values.
-func compareStrings(a, b string) int {
-	if a < b {
-		return -1
+// sortValue follows pointers and interfaces, returning the zero Value
+// for nil.
+func sortValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
 	}
-	if a > b {
-		return 1
+	return v
+}
+
+// sortGroup returns the sort group of a non-nil value.
+func sortGroup(v reflect.Value) int {
+	switch {
+	case v.Type() == timeType && v.CanInterface():
+		return sortGroupTime
+	case v.Kind() == reflect.Bool:
+		return sortGroupBool
+	case v.CanInt(), v.CanUint(), v.CanFloat():
+		return sortGroupNumber
+	case v.Kind() == reflect.String:
+		return sortGroupString
+	default:
+		return sortGroupOther
 	}
-	return 0
 }
 
-func compareValues(a, b interface{}) int {
-	// Handle nil values
-	if a == nil && b == nil {
-		return 0
+// compareNumbers compares two numeric values of any int, uint, or float
+// kind. Integers of the same signedness compare exactly; other mixes
+// compare as float64.
+func compareNumbers(a, b reflect.Value) int {
+	switch {
+	case a.CanInt() && b.CanInt():
+		return cmp.Compare(a.Int(), b.Int())
+	case a.CanUint() && b.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint())
+	default:
+		return cmp.Compare(sortFloat(a), sortFloat(b))
+	}
+}
+
+// sortFloat converts a numeric value to float64.
+func sortFloat(v reflect.Value) float64 {
+	switch {
+	case v.CanInt():
+		return float64(v.Int())
+	case v.CanUint():
+		return float64(v.Uint())
+	default:
+		return v.Float()
 	}
-	if a == nil {
+}
+
+// compareBools compares boolean values (false < true).
+func compareBools(a, b bool) int {
+	if !a && b {
 		return -1
 	}
-	if b == nil {
+	if a && !b {
 		return 1
 	}
-
-	// Use type switch for comparison
-	switch aVal := a.(type) {
-	case string:
-		if bVal, ok := b.(string); ok {
-			return compareStrings(aVal, bVal)
-		}
-	case int:
-		if bVal, ok := b.(int); ok {
-			return compareNumerics(aVal, bVal)
-		}
-	case int64:
-		if bVal, ok := b.(int64); ok {
-			return compareNumerics(aVal, bVal)
-		}
-	case float64:
-		if bVal, ok := b.(float64); ok {
-			return compareNumerics(aVal, bVal)
-		}
-	case bool:
-		if bVal, ok := b.(bool); ok {
-			return compareBools(aVal, bVal)
-		}
-	}
-
-	// Fallback to string comparison
-	return compareStrings(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	return 0
 }
@@ -3,7 +3,11 @@ package components
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/newbpydev/bubblyui/pkg/bubbly"
@@ -722,7 +726,13 @@ func TestTable_Sorting_ToggleDirection(t *testing.T) {
 	sortedData = data.Get().([]User)
 	assert.Equal(t, "Charlie", sortedData[0].Name, "First should be Charlie (desc)")
 
-	// Sort ascending again (toggle back)
+	// Unsorted restores the original order
+	table.Emit("sort", "Name")
+	sortedData = data.Get().([]User)
+	assert.Equal(t, "Charlie", sortedData[0].Name, "First should be Charlie (original)")
+	assert.Equal(t, "Alice", sortedData[1].Name, "Second should be Alice (original)")
+
+	// Sort ascending again
 	table.Emit("sort", "Name")
 	sortedData = data.Get().([]User)
 	assert.Equal(t, "Alice", sortedData[0].Name, "First should be Alice (asc again)")
@@ -828,12 +838,12 @@ func TestTable_Sorting_VisualIndicators(t *testing.T) {
 	// Sort by Name ascending
 	table.Emit("sort", "Name")
 	output := table.View()
-	assert.Contains(t, output, "↑", "Should show ascending indicator")
+	assert.Contains(t, output, "▲", "Should show ascending indicator")
 
 	// Toggle to descending
 	table.Emit("sort", "Name")
 	output = table.View()
-	assert.Contains(t, output, "↓", "Should show descending indicator")
+	assert.Contains(t, output, "▼", "Should show descending indicator")
 }
 
 func TestTable_Sorting_FloatColumn(t *testing.T) {
@@ -898,14 +908,14 @@ func TestTable_Sorting_NoLayoutShift(t *testing.T) {
 	// This is verified by checking that all sortable headers have space reserved
 
 	// Each sortable header should have 2 extra characters reserved (space + arrow)
-	// So "Name" becomes "Name  " (with spaces) or "Name ↑" (with arrow)
+	// So "Name" becomes "Name  " (with spaces) or "Name ▲" (with arrow)
 	assert.NotEmpty(t, outputBefore, "Should render before sorting")
 	assert.NotEmpty(t, outputSorted, "Should render after sorting")
 	assert.NotEmpty(t, outputDifferent, "Should render after changing sort column")
 
 	// Verify both columns show indicators (one active, one reserved space)
-	assert.Contains(t, outputSorted, "↑", "Should show sort indicator")
-	assert.Contains(t, outputDifferent, "↑", "Should show sort indicator on different column")
+	assert.Contains(t, outputSorted, "▲", "Should show sort indicator")
+	assert.Contains(t, outputDifferent, "▲", "Should show sort indicator on different column")
 }
 
 func TestTable_Sorting_ExactColumnWidths(t *testing.T) {
@@ -944,9 +954,9 @@ func TestTable_Sorting_ExactColumnWidths(t *testing.T) {
 
 	// Verify visual indicators appear in correct positions
 	// The indicator should be immediately after the header text, not at column edge
-	assert.Contains(t, output2, "ID ↑", "ID column should show 'ID ↑' (indicator adjacent to text)")
-	assert.Contains(t, output3, "Name ↑", "Name column should show 'Name ↑' (indicator adjacent to text)")
-	assert.Contains(t, output4, "Name ↓", "Name column should show 'Name ↓' (indicator adjacent to text)")
+	assert.Contains(t, output2, "ID ▲", "ID column should show 'ID ▲' (indicator adjacent to text)")
+	assert.Contains(t, output3, "Name ▲", "Name column should show 'Name ▲' (indicator adjacent to text)")
+	assert.Contains(t, output4, "Name ▼", "Name column should show 'Name ▼' (indicator adjacent to text)")
 
 	// Verify all outputs render without errors
 	assert.NotEmpty(t, output1, "Should render unsorted state")
@@ -955,8 +965,8 @@ func TestTable_Sorting_ExactColumnWidths(t *testing.T) {
 	assert.NotEmpty(t, output4, "Should render sorted by Name descending")
 
 	// Verify indicators don't appear in unsorted state
-	assert.NotContains(t, output1, "↑", "Unsorted state should not show arrows")
-	assert.NotContains(t, output1, "↓", "Unsorted state should not show arrows")
+	assert.NotContains(t, output1, "▲", "Unsorted state should not show arrows")
+	assert.NotContains(t, output1, "▼", "Unsorted state should not show arrows")
 }
 
 // ============================================================================
@@ -1368,3 +1378,763 @@ func TestTable_Sorting_StringEquality(t *testing.T) {
 	assert.Equal(t, "Alice", sortedData[1].Name)
 	assert.Equal(t, "Bob", sortedData[2].Name)
 }
+
+func TestTable_PadString_WideAndStyledText(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{"ascii", "Alice", 8, "Alice   "},
+		{"emoji", "🚀 Go", 8, "🚀 Go   "},
+		{"cjk truncated", "世界世界世界", 8, "世界... "},
+		{"styled", "\x1b[32mok\x1b[0m", 4, "\x1b[32mok\x1b[0m  "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := padString(tt.input, tt.width)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.width, bubbly.VisibleWidth(got), "column width in terminal cells")
+		})
+	}
+}
+
+func TestTable_ColumnAlignment(t *testing.T) {
+	tests := []struct {
+		name  string
+		align Alignment
+		want  string
+	}{
+		{"default left", "", "ab    "},
+		{"left", AlignLeft, "ab    "},
+		{"right", AlignRight, "    ab"},
+		{"center", AlignCenter, "  ab  "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, alignString("ab", 6, tt.align))
+		})
+	}
+
+	assert.Equal(t, "a...", alignString("abcdef", 4, AlignRight), "truncates before aligning")
+}
+
+func TestTable_RenderFormatsCells(t *testing.T) {
+	data := bubbly.NewRef([]Product{
+		{ID: 1, Name: "Widget", Price: 19.5},
+		{ID: 2, Name: "Gadget", Price: 1234},
+	})
+
+	table := Table(TableProps[Product]{
+		Data: data,
+		Columns: []TableColumn[Product]{
+			{Header: "Product", Field: "Name", Width: 8},
+			{
+				Header: "Price",
+				Field:  "Price",
+				Width:  10,
+				Align:  AlignRight,
+				Render: func(p Product) string { return fmt.Sprintf("$%.2f", p.Price) },
+			},
+			{
+				Header: "Tag",
+				Width:  6,
+				Render: func(p Product) string {
+					badge := Badge(BadgeProps{Label: fmt.Sprintf("#%d", p.ID)})
+					badge.Init()
+					return badge.View()
+				},
+			},
+		},
+	})
+	table.Init()
+
+	output := table.View()
+	assert.Contains(t, output, "Widget       $19.50")
+	assert.Contains(t, output, "Gadget     $1234.00")
+	assert.Contains(t, output, "     Price", "header follows column alignment")
+	assert.Contains(t, output, "#2")
+}
+
+func TestTable_RowStyle(t *testing.T) {
+	offline := lipgloss.Color("196")
+	selected := lipgloss.NewStyle().Bold(true).Underline(true)
+	props := TableProps[User]{
+		Columns: []TableColumn[User]{
+			{Header: "Name", Field: "Name", Width: 8},
+			{Header: "Age", Field: "Age", Width: 4, Align: AlignRight},
+		},
+		RowStyle: func(u User, index int) lipgloss.Style {
+			style := lipgloss.NewStyle().Width(80).Padding(2).Margin(1)
+			if !u.Active {
+				style = style.Foreground(offline)
+			}
+			return style
+		},
+		SelectedRowStyle: &selected,
+	}
+
+	tests := []struct {
+		name      string
+		user      User
+		selected  bool
+		wantFg    lipgloss.TerminalColor
+		wantBold  bool
+		wantUnder bool
+	}{
+		{"active row", User{Name: "web", Active: true}, false, lipgloss.NoColor{}, false, false},
+		{"offline row", User{Name: "db"}, false, offline, false, false},
+		{"selected keeps row color", User{Name: "db"}, true, offline, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style := tableRowStyle(props, tt.user, 0, 0, tt.selected, DefaultTheme)
+			assert.Equal(t, tt.wantFg, style.GetForeground())
+			assert.Equal(t, tt.wantBold, style.GetBold())
+			assert.Equal(t, tt.wantUnder, style.GetUnderline())
+			assert.Equal(t, 0, style.GetWidth(), "width is reset")
+			assert.Equal(t, 0, style.GetMarginLeft(), "margins are reset")
+			assert.Equal(t, 1, style.GetPaddingLeft())
+			assert.Equal(t, 0, style.GetPaddingTop())
+		})
+	}
+
+	// Rows stay aligned with the header
+	data := bubbly.NewRef([]User{{Name: "web", Age: 3, Active: true}, {Name: "db", Age: 12}})
+	props.Data = data
+	table := Table(props)
+	table.Init()
+	table.Emit("rowClick", 1)
+
+	output := table.View()
+	assert.Contains(t, output, "\n web         3 \n")
+	assert.Contains(t, output, "\n db         12 \n")
+}
+
+// wideRow is a row with enough columns to overflow a narrow table.
+type wideRow struct {
+	A, B, C, D, E string
+}
+
+func wideColumns() []TableColumn[wideRow] {
+	return []TableColumn[wideRow]{
+		{Header: "ColA", Field: "A", Width: 10},
+		{Header: "ColB", Field: "B", Width: 10},
+		{Header: "ColC", Field: "C", Width: 10},
+		{Header: "ColD", Field: "D", Width: 10},
+		{Header: "ColE", Field: "E", Width: 10},
+	}
+}
+
+func TestTable_VisibleColumns(t *testing.T) {
+	tests := []struct {
+		name           string
+		width          int
+		frozen         int
+		offset         int
+		expected       []string
+		expectedOffset int
+		expectedEnd    int
+	}{
+		{name: "no width shows all", width: 0, expected: []string{"ColA", "ColB", "ColC", "ColD", "ColE"}, expectedEnd: 5},
+		{name: "fits two columns", width: 24, expected: []string{"ColA", "ColB"}, expectedEnd: 2},
+		{name: "scrolled", width: 24, offset: 2, expected: []string{"ColC", "ColD"}, expectedOffset: 2, expectedEnd: 4},
+		{name: "offset clamped to last page", width: 24, offset: 10, expected: []string{"ColD", "ColE"}, expectedOffset: 3, expectedEnd: 5},
+		{name: "frozen column stays", width: 35, frozen: 1, offset: 2, expected: []string{"ColA", "ColD", "ColE"}, expectedOffset: 2, expectedEnd: 5},
+		{name: "at least one scrollable column", width: 5, frozen: 1, expected: []string{"ColA", "ColB"}, expectedEnd: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible, offset, end := tableVisibleColumns(wideColumns(), tt.width, tt.frozen, tt.offset)
+
+			headers := make([]string, 0, len(visible))
+			for _, col := range visible {
+				headers = append(headers, col.Header)
+			}
+			assert.Equal(t, tt.expected, headers)
+			assert.Equal(t, tt.expectedOffset, offset)
+			assert.Equal(t, tt.expectedEnd, end)
+		})
+	}
+}
+
+func TestTable_HorizontalScroll(t *testing.T) {
+	data := bubbly.NewRef([]wideRow{{A: "a1", B: "b1", C: "c1", D: "d1", E: "e1"}})
+	table := Table(TableProps[wideRow]{
+		Data:          data,
+		Columns:       wideColumns(),
+		Width:         35,
+		FrozenColumns: 1,
+	})
+	table.Init()
+
+	output := ansi.Strip(table.View())
+	assert.Contains(t, output, "ColA")
+	assert.Contains(t, output, "ColC")
+	assert.NotContains(t, output, "ColD", "Columns past the width should be hidden")
+	assert.Contains(t, output, "columns 2-3 of 5 ▶")
+
+	table.Update(tea.KeyMsg{Type: tea.KeyRight})
+	table.Update(runeKey('l'))
+	table.Update(tea.KeyMsg{Type: tea.KeyRight}) // Already at the last column
+
+	output = ansi.Strip(table.View())
+	assert.Contains(t, output, "ColA", "Frozen column should stay visible")
+	assert.Contains(t, output, "e1")
+	assert.NotContains(t, output, "ColB")
+	assert.Contains(t, output, "◀ columns 4-5 of 5")
+
+	table.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	output = ansi.Strip(table.View())
+	assert.Contains(t, output, "ColC")
+	assert.Contains(t, output, "ColD")
+}
+
+func TestTable_HorizontalScroll_Focused(t *testing.T) {
+	focused := bubbly.NewRef(false)
+	table := Table(TableProps[wideRow]{
+		Data:    bubbly.NewRef([]wideRow{{}}),
+		Columns: wideColumns(),
+		Width:   24,
+		Focused: focused,
+	})
+	table.Init()
+
+	table.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.NotContains(t, ansi.Strip(table.View()), "ColC", "Unfocused table should ignore keys")
+
+	focused.Set(true)
+	table.Update(tea.KeyMsg{Type: tea.KeyRight})
+	assert.Contains(t, ansi.Strip(table.View()), "ColC")
+}
+
+func TestTable_AutoFit(t *testing.T) {
+	tests := []struct {
+		name     string
+		width    int
+		expected []int
+	}{
+		{name: "shrinks proportionally", width: 31, expected: []int{5, 5, 5, 5, 5}},
+		{name: "grows to fill width", width: 66, expected: []int{12, 12, 12, 12, 12}},
+		{name: "minimum width", width: 10, expected: []int{3, 3, 3, 3, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns := tableLayoutColumns(TableProps[wideRow]{
+				Columns: wideColumns(),
+				Width:   tt.width,
+				AutoFit: true,
+			})
+
+			widths := make([]int, 0, len(columns))
+			for _, col := range columns {
+				widths = append(widths, col.Width)
+			}
+			assert.Equal(t, tt.expected, widths)
+		})
+	}
+}
+
+func TestTable_AutoFit_RemainderToLastColumn(t *testing.T) {
+	columns := tableLayoutColumns(TableProps[wideRow]{
+		Columns: wideColumns()[:2],
+		Width:   24, // 21 cells for two columns
+		AutoFit: true,
+	})
+
+	assert.Equal(t, 10, columns[0].Width)
+	assert.Equal(t, 11, columns[1].Width)
+	assert.Equal(t, 10, wideColumns()[0].Width, "Props columns should not be modified")
+}
+
+func TestTable_Sorting_CycleState(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "Charlie"}, {Name: "Alice"}, {Name: "Bob"}})
+	sortState := bubbly.NewRef(TableSort{})
+	table := Table(TableProps[User]{
+		Data:     data,
+		Columns:  []TableColumn[User]{{Header: "Name", Field: "Name", Width: 20, Sortable: true}},
+		Sortable: true,
+		Sort:     sortState,
+	})
+	table.Init()
+
+	names := func() []string {
+		var names []string
+		for _, u := range data.GetTyped() {
+			names = append(names, u.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		expectedSort  TableSort
+		expectedNames []string
+		indicator     string
+	}{
+		{TableSort{Field: "Name", Direction: SortAscending}, []string{"Alice", "Bob", "Charlie"}, "Name ▲"},
+		{TableSort{Field: "Name", Direction: SortDescending}, []string{"Charlie", "Bob", "Alice"}, "Name ▼"},
+		{TableSort{}, []string{"Charlie", "Alice", "Bob"}, "Name  "},
+	}
+	for _, tt := range tests {
+		table.Emit("sort", "Name")
+		assert.Equal(t, tt.expectedSort, sortState.GetTyped())
+		assert.Equal(t, tt.expectedNames, names())
+		assert.Contains(t, ansi.Strip(table.View()), tt.indicator)
+	}
+}
+
+func TestTable_Sorting_SortRef(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "Bob", Age: 25}, {Name: "Alice", Age: 30}})
+	sortState := bubbly.NewRef(TableSort{Field: "Age", Direction: SortDescending})
+	table := Table(TableProps[User]{
+		Data:    data,
+		Columns: []TableColumn[User]{{Header: "Age", Field: "Age", Width: 10, Sortable: true}},
+		Sort:    sortState,
+	})
+	table.Init()
+	assert.Equal(t, "Alice", data.GetTyped()[0].Name, "Initial sort state should be applied")
+
+	sortState.Set(TableSort{Field: "Name", Direction: SortAscending})
+	assert.Equal(t, "Alice", data.GetTyped()[0].Name)
+
+	sortState.Set(TableSort{})
+	assert.Equal(t, "Bob", data.GetTyped()[0].Name, "Unsorting should restore the original order")
+}
+
+func TestTable_Sorting_DataReplacedWhileSorted(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "Charlie"}, {Name: "Alice"}})
+	table := Table(TableProps[User]{
+		Data:     data,
+		Columns:  []TableColumn[User]{{Header: "Name", Field: "Name", Width: 20, Sortable: true}},
+		Sortable: true,
+	})
+	table.Init()
+	table.Emit("sort", "Name")
+
+	data.Set([]User{{Name: "Dave"}, {Name: "Bob"}, {Name: "Erin"}})
+	assert.Equal(t, []User{{Name: "Bob"}, {Name: "Dave"}, {Name: "Erin"}}, data.GetTyped(), "New data should be sorted")
+
+	table.Emit("sort", "Name") // Descending
+	table.Emit("sort", "Name") // Unsorted
+	assert.Equal(t, []User{{Name: "Dave"}, {Name: "Bob"}, {Name: "Erin"}}, data.GetTyped(), "New data's order should be restored")
+}
+
+func TestTable_Sorting_CustomCompare(t *testing.T) {
+	priority := map[string]int{"high": 0, "medium": 1, "low": 2}
+	data := bubbly.NewRef([]User{{Name: "low"}, {Name: "high"}, {Name: "medium"}})
+	table := Table(TableProps[User]{
+		Data: data,
+		Columns: []TableColumn[User]{{
+			Header:   "Priority",
+			Field:    "Name",
+			Width:    10,
+			Sortable: true,
+			Compare: func(a, b User) int {
+				return priority[a.Name] - priority[b.Name]
+			},
+		}},
+		Sortable: true,
+	})
+	table.Init()
+
+	table.Emit("sort", "Name")
+	assert.Equal(t, []User{{Name: "high"}, {Name: "medium"}, {Name: "low"}}, data.GetTyped())
+
+	table.Emit("sort", "Name")
+	assert.Equal(t, []User{{Name: "low"}, {Name: "medium"}, {Name: "high"}}, data.GetTyped())
+}
+
+func TestTable_Sorting_StableTies(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "A", Age: 30}, {Name: "B", Age: 25}, {Name: "C", Age: 30}})
+	table := Table(TableProps[User]{
+		Data:     data,
+		Columns:  []TableColumn[User]{{Header: "Age", Field: "Age", Width: 10, Sortable: true}},
+		Sortable: true,
+	})
+	table.Init()
+
+	table.Emit("sort", "Age")
+	assert.Equal(t, "BAC", data.GetTyped()[0].Name+data.GetTyped()[1].Name+data.GetTyped()[2].Name)
+
+	table.Emit("sort", "Age")
+	assert.Equal(t, "ACB", data.GetTyped()[0].Name+data.GetTyped()[1].Name+data.GetTyped()[2].Name,
+		"Ties should keep their original order when descending")
+}
+
+func TestTable_Sorting_Keyboard(t *testing.T) {
+	data := bubbly.NewRef([]wideRow{{A: "2", C: "x"}, {A: "1", C: "y"}})
+	columns := wideColumns()
+	columns[0].Sortable = true
+	columns[3].Sortable = true
+	sortState := bubbly.NewRef(TableSort{})
+	focused := bubbly.NewRef(true)
+	table := Table(TableProps[wideRow]{
+		Data:          data,
+		Columns:       columns,
+		Sortable:      true,
+		Sort:          sortState,
+		Width:         35,
+		FrozenColumns: 1,
+		Focused:       focused,
+	})
+	table.Init()
+
+	// s with no focused column sorts the first sortable one
+	assert.True(t, HandleKey(table, runeKey('s')))
+	assert.Equal(t, TableSort{Field: "A", Direction: SortAscending}, sortState.GetTyped())
+
+	// ] moves to the next sortable column, scrolling it into view
+	assert.True(t, HandleKey(table, runeKey(']')))
+	assert.True(t, HandleKey(table, runeKey('s')))
+	assert.Equal(t, TableSort{Field: "D", Direction: SortAscending}, sortState.GetTyped())
+	assert.Contains(t, ansi.Strip(table.View()), "ColD ▲")
+
+	// ] wraps back to the first column
+	table.Update(runeKey(']'))
+	table.Update(runeKey('s'))
+	assert.Equal(t, TableSort{Field: "A", Direction: SortAscending}, sortState.GetTyped())
+
+	focused.Set(false)
+	assert.False(t, HandleKey(table, runeKey('s')), "Unfocused table should ignore sort keys")
+}
+
+func TestTable_Sorting_KeysRequireSortable(t *testing.T) {
+	table := Table(TableProps[User]{
+		Data:    bubbly.NewRef([]User{{Name: "B"}, {Name: "A"}}),
+		Columns: []TableColumn[User]{{Header: "Name", Field: "Name", Width: 20, Sortable: true}},
+	})
+	table.Init()
+
+	assert.False(t, HandleKey(table, runeKey('s')))
+}
+
+func TestTable_Sorting_HeaderClick(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "Bob", Age: 30}, {Name: "Alice", Age: 25}})
+	sortState := bubbly.NewRef(TableSort{})
+	table := Table(TableProps[User]{
+		Data: data,
+		Columns: []TableColumn[User]{
+			{Header: "Name", Field: "Name", Width: 20, Sortable: true},
+			{Header: "Email", Field: "Email", Width: 20},
+			{Header: "Age", Field: "Age", Width: 10, Sortable: true},
+		},
+		Sortable: true,
+		Sort:     sortState,
+	})
+	table.Init()
+
+	table.Emit("headerClick", 1)
+	assert.Equal(t, TableSort{}, sortState.GetTyped(), "Non-sortable column should ignore clicks")
+
+	table.Emit("headerClick", 2)
+	assert.Equal(t, TableSort{Field: "Age", Direction: SortAscending}, sortState.GetTyped())
+
+	t.Run("mouse", func(t *testing.T) {
+		bubbly.EnableMouseZones(true)
+		defer bubbly.EnableMouseZones(false)
+
+		bubbly.ScanMouseZones(table.View())
+		// Header text starts after the border and padding: Name at x=2,
+		// Email at x=23, Age at x=44
+		click := func(x int) {
+			bubbly.DispatchMouse(tea.MouseMsg{X: x, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+			bubbly.DispatchMouse(tea.MouseMsg{X: x, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+		}
+		click(45)
+		assert.Equal(t, TableSort{Field: "Age", Direction: SortDescending}, sortState.GetTyped())
+
+		bubbly.ScanMouseZones(table.View())
+		click(3)
+		assert.Equal(t, TableSort{Field: "Name", Direction: SortAscending}, sortState.GetTyped())
+		assert.Equal(t, "Alice", data.GetTyped()[0].Name)
+	})
+}
+
+func TestCompareValues(t *testing.T) {
+	one, two := 1, 2
+	var nilInt *int
+	earlier := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := earlier.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		a, b     interface{}
+		expected int
+	}{
+		{name: "ints", a: 1, b: 2, expected: -1},
+		{name: "int8 and uint16", a: int8(-1), b: uint16(1), expected: -1},
+		{name: "int and float", a: 3, b: 2.5, expected: 1},
+		{name: "large uints", a: uint64(1<<63 + 1), b: uint64(1 << 63), expected: 1},
+		{name: "named string type", a: Variant("b"), b: Variant("a"), expected: 1},
+		{name: "bools", a: false, b: true, expected: -1},
+		{name: "times", a: later, b: earlier, expected: 1},
+		{name: "pointers", a: &one, b: &two, expected: -1},
+		{name: "nil first", a: nil, b: 0, expected: -1},
+		{name: "nil pointer first", a: 1, b: nilInt, expected: 1},
+		{name: "both nil", a: nilInt, b: nil, expected: 0},
+		{name: "numbers before strings", a: "1", b: 2, expected: 1},
+		{name: "bools before numbers", a: true, b: 0, expected: -1},
+		{name: "others by text", a: []int{2}, b: []int{1, 5}, expected: 1},
+		{name: "equal", a: "x", b: "x", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareValues(tt.a, tt.b))
+		})
+	}
+}
+
+func TestTable_Sorting_MixedInterfaceField(t *testing.T) {
+	type cell struct{ Value interface{} }
+	data := bubbly.NewRef([]cell{{"b"}, {2}, {nil}, {1.5}, {"a"}})
+	table := Table(TableProps[cell]{
+		Data:     data,
+		Columns:  []TableColumn[cell]{{Header: "Value", Field: "Value", Width: 10, Sortable: true}},
+		Sortable: true,
+	})
+	table.Init()
+
+	assert.NotPanics(t, func() { table.Emit("sort", "Value") })
+	assert.Equal(t, []cell{{nil}, {1.5}, {2}, {"a"}, {"b"}}, data.GetTyped())
+}
+
+// userRows returns n users named u1..un with ages 1..n.
+func userRows(n int) []User {
+	rows := make([]User, n)
+	for i := range rows {
+		rows[i] = User{Name: fmt.Sprintf("u%d", i+1), Age: i + 1, Active: i%2 == 0}
+	}
+	return rows
+}
+
+func TestTable_Filter(t *testing.T) {
+	data := bubbly.NewRef([]User{
+		{Name: "Alice", Email: "alice@example.com", Active: true},
+		{Name: "Bob", Email: "bob@test.org", Active: false},
+		{Name: "Carol", Email: "carol@example.com", Active: true},
+	})
+	query := bubbly.NewRef("")
+	counts := bubbly.NewRef(TableCounts{})
+	table := Table(TableProps[User]{
+		Data: data,
+		Columns: []TableColumn[User]{
+			{Header: "Name", Field: "Name", Width: 10},
+			{Header: "Email", Width: 20, Render: func(u User) string {
+				return lipgloss.NewStyle().Bold(true).Render(u.Email)
+			}},
+		},
+		Filter:     func(u User) bool { return u.Active },
+		FilterText: query,
+		Counts:     counts,
+	})
+	table.Init()
+
+	output := ansi.Strip(table.View())
+	assert.Contains(t, output, "Alice")
+	assert.Contains(t, output, "Carol")
+	assert.NotContains(t, output, "Bob", "Filter should hide inactive users")
+	assert.Equal(t, TableCounts{Total: 3, Filtered: 2, Pages: 1}, counts.GetTyped())
+
+	query.Set("CAROL@") // Matched against the rendered cell, ignoring case
+	output = ansi.Strip(table.View())
+	assert.NotContains(t, output, "Alice")
+	assert.Contains(t, output, "Carol")
+	assert.Equal(t, 1, counts.GetTyped().Filtered)
+
+	query.Set("test.org") // Only Bob matches, and Filter hides him
+	assert.Contains(t, ansi.Strip(table.View()), "No matching rows")
+	assert.Equal(t, TableCounts{Total: 3, Filtered: 0, Pages: 1}, counts.GetTyped())
+	assert.Len(t, data.GetTyped(), 3, "Filtering should not change Data")
+
+	data.Set(append(data.GetTyped(), User{Name: "Dan", Email: "dan@test.org", Active: true}))
+	assert.Contains(t, ansi.Strip(table.View()), "Dan")
+	assert.Equal(t, TableCounts{Total: 4, Filtered: 1, Pages: 1}, counts.GetTyped())
+}
+
+func TestTable_Filter_Navigation(t *testing.T) {
+	var clicked []int
+	table := Table(TableProps[User]{
+		Data:       bubbly.NewRef(userRows(5)),
+		Columns:    []TableColumn[User]{{Header: "Name", Field: "Name", Width: 10}},
+		Filter:     func(u User) bool { return u.Active }, // u1, u3, u5
+		OnRowClick: func(_ User, index int) { clicked = append(clicked, index) },
+	})
+	table.Init()
+
+	table.Emit("keyDown", nil)
+	table.Emit("keyEnter", nil)
+	table.Emit("keyDown", nil)
+	table.Emit("keyEnter", nil)
+	table.Emit("keyUp", nil)
+	table.Emit("keyUp", nil) // Stays on the first row
+	table.Emit("keyEnter", nil)
+
+	assert.Equal(t, []int{0, 2, 0}, clicked, "Navigation should skip filtered rows and report Data indexes")
+}
+
+func TestTable_Pagination(t *testing.T) {
+	page := bubbly.NewRef(1)
+	counts := bubbly.NewRef(TableCounts{})
+	table := Table(TableProps[User]{
+		Data:        bubbly.NewRef(userRows(7)),
+		Columns:     []TableColumn[User]{{Header: "Name", Field: "Name", Width: 10}},
+		PageSize:    3,
+		CurrentPage: page,
+		Counts:      counts,
+	})
+	table.Init()
+
+	output := ansi.Strip(table.View())
+	assert.Contains(t, output, "u3")
+	assert.NotContains(t, output, "u4")
+	assert.Contains(t, output, "Page 1 of 3")
+	assert.Equal(t, TableCounts{Total: 7, Filtered: 7, Pages: 3}, counts.GetTyped())
+
+	assert.True(t, HandleKey(table, tea.KeyMsg{Type: tea.KeyPgDown}))
+	output = ansi.Strip(table.View())
+	assert.Equal(t, 2, page.GetTyped())
+	assert.Contains(t, output, "u4")
+	assert.Contains(t, output, "u6")
+	assert.NotContains(t, output, "u3")
+	assert.Contains(t, output, "Page 2 of 3")
+
+	table.Emit("nextPage", nil)
+	table.Emit("nextPage", nil) // Already on the last page
+	assert.Equal(t, 3, page.GetTyped())
+	assert.Contains(t, ansi.Strip(table.View()), "u7")
+
+	table.Emit("prevPage", nil)
+	assert.Equal(t, 2, page.GetTyped())
+
+	page.Set(10) // Out of range pages show the last page
+	assert.Contains(t, ansi.Strip(table.View()), "Page 3 of 3")
+}
+
+func TestTable_Pagination_SelectionFollowsPage(t *testing.T) {
+	page := bubbly.NewRef(1)
+	var clicked []int
+	table := Table(TableProps[User]{
+		Data:        bubbly.NewRef(userRows(5)),
+		Columns:     []TableColumn[User]{{Header: "Name", Field: "Name", Width: 10}},
+		PageSize:    2,
+		CurrentPage: page,
+		OnRowClick:  func(_ User, index int) { clicked = append(clicked, index) },
+	})
+	table.Init()
+
+	table.Emit("keyDown", nil)
+	table.Emit("keyDown", nil)
+	table.Emit("keyDown", nil) // Moves onto page 2
+	assert.Equal(t, 2, page.GetTyped())
+
+	table.Emit("nextPage", nil) // Selection moves to the first row of page 3
+	table.Emit("keyEnter", nil)
+	table.Emit("keyUp", nil) // Back onto page 2
+	assert.Equal(t, 2, page.GetTyped())
+
+	assert.Equal(t, []int{4}, clicked)
+}
+
+func TestTable_FilterAndPagination(t *testing.T) {
+	data := bubbly.NewRef(userRows(10))
+	query := bubbly.NewRef("")
+	page := bubbly.NewRef(1)
+	counts := bubbly.NewRef(TableCounts{})
+	table := Table(TableProps[User]{
+		Data:        data,
+		Columns:     []TableColumn[User]{{Header: "Name", Field: "Name", Width: 10, Sortable: true}},
+		Sortable:    true,
+		Filter:      func(u User) bool { return u.Active }, // u1, u3, u5, u7, u9
+		FilterText:  query,
+		PageSize:    2,
+		CurrentPage: page,
+		Counts:      counts,
+	})
+	table.Init()
+	assert.Equal(t, TableCounts{Total: 10, Filtered: 5, Pages: 3}, counts.GetTyped())
+
+	page.Set(3)
+	output := ansi.Strip(table.View())
+	assert.Contains(t, output, "u9", "Filtering should apply before paginating")
+	assert.Contains(t, output, "Page 3 of 3")
+
+	query.Set("u")
+	assert.Equal(t, 1, page.GetTyped(), "Changing FilterText should go back to the first page")
+
+	page.Set(3)
+	data.Set(userRows(4)) // u1, u3 left: one page
+	assert.Equal(t, 1, page.GetTyped(), "Page should be clamped when rows shrink")
+	assert.Equal(t, TableCounts{Total: 4, Filtered: 2, Pages: 1}, counts.GetTyped())
+
+	// Sorting re-orders Data; the filter and pages apply to the sorted rows
+	data.Set(userRows(10))
+	table.Emit("sort", "Name")
+	table.Emit("sort", "Name") // Descending: u9, u7 | u5, u3 | u1
+	output = ansi.Strip(table.View())
+	assert.Contains(t, output, "u9")
+	assert.Contains(t, output, "u7")
+	assert.NotContains(t, output, "u5")
+}
+
+func TestTable_PageRows(t *testing.T) {
+	tests := []struct {
+		name         string
+		matched      []int
+		pageSize     int
+		page         int
+		expected     []int
+		expectedPage int
+	}{
+		{name: "no pagination", matched: []int{0, 1, 2}, pageSize: 0, page: 5, expected: []int{0, 1, 2}, expectedPage: 1},
+		{name: "first page", matched: []int{0, 2, 4, 6}, pageSize: 3, page: 1, expected: []int{0, 2, 4}, expectedPage: 1},
+		{name: "partial last page", matched: []int{0, 2, 4, 6}, pageSize: 3, page: 2, expected: []int{6}, expectedPage: 2},
+		{name: "clamped high", matched: []int{0, 2, 4, 6}, pageSize: 3, page: 9, expected: []int{6}, expectedPage: 2},
+		{name: "clamped low", matched: []int{0, 2}, pageSize: 3, page: 0, expected: []int{0, 2}, expectedPage: 1},
+		{name: "empty", matched: []int{}, pageSize: 3, page: 2, expected: []int{}, expectedPage: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, page := tablePageRows(tt.matched, tt.pageSize, tt.page)
+			assert.Equal(t, tt.expected, rows)
+			assert.Equal(t, tt.expectedPage, page)
+		})
+	}
+}
+
+func TestTable_Sorting_KeepsSelection(t *testing.T) {
+	data := bubbly.NewRef([]User{{Name: "Bob"}, {Name: "Alice"}, {Name: "Carl"}})
+	var selected []string
+	table := Table(TableProps[User]{
+		Data:       data,
+		Columns:    []TableColumn[User]{{Header: "Name", Field: "Name", Width: 20, Sortable: true}},
+		Sortable:   true,
+		OnRowClick: func(u User, _ int) { selected = append(selected, u.Name) },
+	})
+	table.Init()
+
+	table.Emit("rowClick", 0) // Bob
+	for i := 0; i < 3; i++ {  // Ascending, descending, unsorted
+		table.Emit("sort", "Name")
+		table.Emit("keyEnter", nil)
+	}
+	assert.Equal(t, []string{"Bob", "Bob", "Bob", "Bob"}, selected)
+
+	// Moving after a sort continues from the selected row's new position
+	table.Emit("sort", "Name") // Alice, Bob, Carl
+	table.Emit("keyDown", nil)
+	table.Emit("keyEnter", nil)
+	assert.Equal(t, "Carl", selected[len(selected)-1])
+
+	// Data replaced while sorted keeps the selected index of the new data
+	data.Set([]User{{Name: "Zed"}, {Name: "Dan"}, {Name: "Eve"}}) // Selected: Eve
+	table.Emit("keyEnter", nil)
+	assert.Equal(t, "Eve", selected[len(selected)-1])
+}
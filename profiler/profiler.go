@@ -469,6 +469,12 @@ type AggregatedCounter = profiler.AggregatedCounter
 // AggregatedAllocation contains aggregated allocation data.
 type AggregatedAllocation = profiler.AggregatedAllocation
 
+// WindowRollup contains render statistics for one time window.
+type WindowRollup = profiler.WindowRollup
+
+// WindowSeries is a gap-free series of WindowRollups from AggregateByWindow.
+type WindowSeries = profiler.WindowSeries
+
 // =============================================================================
 // Baseline Comparison
 // =============================================================================
@@ -544,6 +550,12 @@ type Instrumentor = profiler.Instrumentor
 // NewInstrumentor creates a new instrumentor.
 var NewInstrumentor = profiler.NewInstrumentor
 
+// RenderSamplingConfig configures an Instrumentor to record only slow renders.
+type RenderSamplingConfig = profiler.RenderSamplingConfig
+
+// DefaultRenderSamplingConfig returns the default slow-render sampling config.
+var DefaultRenderSamplingConfig = profiler.DefaultRenderSamplingConfig
+
 // KeyBinding defines a key binding for profiler controls.
 type KeyBinding = profiler.KeyBinding
 
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -56,7 +57,7 @@ func TestComposablesInComponents(t *testing.T) {
 
 		var fetchCalled bool
 		var mu sync.Mutex
-		fetchFunc := func() (*User, error) { //nolint:unparam // Error is always nil in success test case
+		fetchFunc := func(_ context.Context) (*User, error) { //nolint:unparam // Error is always nil in success test case
 			mu.Lock()
 			fetchCalled = true
 			mu.Unlock()